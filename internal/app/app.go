@@ -7,10 +7,14 @@ import (
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/app/server"
 	"github.com/arifinhermawan/bubi/internal/app/utils"
-	"github.com/arifinhermawan/bubi/internal/infrastructure/authentication"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/breachlist"
 	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
 	"github.com/arifinhermawan/bubi/internal/infrastructure/golang"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/hasher"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
 	reader "github.com/arifinhermawan/bubi/internal/infrastructure/reader"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/requestmeta"
 	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
 	"github.com/arifinhermawan/bubi/internal/repository/redis"
 )
@@ -18,16 +22,41 @@ import (
 func NewApplication() {
 
 	cfg := configuration.NewConfiguration()
-	auth := authentication.NewAuth(cfg)
 	golang := golang.NewGolang()
+	keyring, errKeyring := keyring.NewKeyring(keyring.KeyringParam{
+		Config: cfg,
+	})
+	if errKeyring != nil {
+		log.Fatalf("[NewApplication] keyring.NewKeyring() got an error: %+v", errKeyring)
+	}
+
+	breachList, errBreachList := breachlist.NewBreachList(breachlist.BreachListParam{
+		Config: cfg,
+	})
+	if errBreachList != nil {
+		log.Fatalf("[NewApplication] breachlist.NewBreachList() got an error: %+v", errBreachList)
+	}
+
+	hasher := hasher.NewHasher(hasher.HasherParam{
+		Config: cfg,
+	})
+
+	mailer := mailer.NewMailer(mailer.MailerParam{
+		Config: cfg,
+	})
 	reader := reader.NewReader()
+	requestMeta := requestmeta.NewRequestMeta()
 
 	// init infra
 	infraParam := server.InfraParam{
-		Auth:   auth,
-		Config: cfg,
-		Golang: golang,
-		Reader: reader,
+		BreachList:  breachList,
+		Config:      cfg,
+		Golang:      golang,
+		Hasher:      hasher,
+		Keyring:     keyring,
+		Mailer:      mailer,
+		Reader:      reader,
+		RequestMeta: requestMeta,
 	}
 
 	infra := server.NewInfra(infraParam)
@@ -73,6 +102,9 @@ func NewApplication() {
 	// init services
 	services := server.NewService(resources, infra)
 
+	// init authentication
+	infra.Auth = server.NewAuth(keyring, services)
+
 	// init usecases
 	useCases := server.NewUsecase(services)
 
@@ -80,6 +112,10 @@ func NewApplication() {
 	handlers := server.NewHandler(useCases, infra)
 	log.Println("Successfully initialize app stack!")
 
+	// run background jobs
+	jobs := server.NewJob(useCases)
+	utils.RunJobs(infra, jobs)
+
 	// register handler
 	utils.HandleRequest(infra, handlers)
 }
@@ -0,0 +1,16 @@
+package server
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/authentication"
+)
+
+// NewAuth will initialize a new instance of authentication.Auth.
+// Auth is initialized after services because it relies on
+// account service to validate user's session.
+func NewAuth(keys keyringProvider, svc *Services) *authentication.Auth {
+	return authentication.NewAuth(authentication.AuthParam{
+		Keyring: keys,
+		Session: svc.account,
+	})
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/authentication"
+)
+
+func TestNewAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockKeyring := NewMockkeyringProvider(ctrl)
+	mockSvc := &Services{}
+
+	want := authentication.NewAuth(authentication.AuthParam{
+		Keyring: mockKeyring,
+		Session: mockSvc.account,
+	})
+
+	got := NewAuth(mockKeyring, mockSvc)
+	assert.Equal(t, want, got)
+}
@@ -3,11 +3,21 @@ package server
 import (
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/server/account"
+	"github.com/arifinhermawan/bubi/internal/server/budget"
+	"github.com/arifinhermawan/bubi/internal/server/category"
+	"github.com/arifinhermawan/bubi/internal/server/period"
+	"github.com/arifinhermawan/bubi/internal/server/transaction"
+	"github.com/arifinhermawan/bubi/internal/server/wallet"
 )
 
 // Handlers holds all available handlers in bubi app.
 type Handlers struct {
-	Account *account.Handler
+	Account     *account.Handler
+	Budget      *budget.Handler
+	Category    *category.Handler
+	Period      *period.Handler
+	Transaction *transaction.Handler
+	Wallet      *wallet.Handler
 }
 
 // NewHandler initialize new instance of Handlers.
@@ -17,7 +27,36 @@ func NewHandler(usecases *UseCases, infra *Infra) *Handlers {
 		Infra:   infra,
 	}
 
+	budgetHandlerParam := budget.BudgetHandlerParam{
+		Budget: usecases.budget,
+		Infra:  infra,
+	}
+
+	categoryHandlerParam := category.CategoryHandlerParam{
+		Category: usecases.category,
+		Infra:    infra,
+	}
+
+	periodHandlerParam := period.PeriodHandlerParam{
+		Period: usecases.period,
+	}
+
+	transactionHandlerParam := transaction.TransactionHandlerParam{
+		Infra:       infra,
+		Transaction: usecases.transaction,
+	}
+
+	walletHandlerParam := wallet.WalletHandlerParam{
+		Infra:  infra,
+		Wallet: usecases.wallet,
+	}
+
 	return &Handlers{
-		Account: account.NewHandler(accountHandlerParam),
+		Account:     account.NewHandler(accountHandlerParam),
+		Budget:      budget.NewHandler(budgetHandlerParam),
+		Category:    category.NewHandler(categoryHandlerParam),
+		Period:      period.NewHandler(periodHandlerParam),
+		Transaction: transaction.NewHandler(transactionHandlerParam),
+		Wallet:      wallet.NewHandler(walletHandlerParam),
 	}
 }
@@ -9,6 +9,11 @@ import (
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/server/account"
+	"github.com/arifinhermawan/bubi/internal/server/budget"
+	"github.com/arifinhermawan/bubi/internal/server/category"
+	"github.com/arifinhermawan/bubi/internal/server/period"
+	"github.com/arifinhermawan/bubi/internal/server/transaction"
+	"github.com/arifinhermawan/bubi/internal/server/wallet"
 )
 
 func TestNewHandler(t *testing.T) {
@@ -22,8 +27,37 @@ func TestNewHandler(t *testing.T) {
 		Infra:   infra,
 	}
 
+	budgetHandlersParam := budget.BudgetHandlerParam{
+		Budget: usecases.budget,
+		Infra:  infra,
+	}
+
+	categoryHandlersParam := category.CategoryHandlerParam{
+		Category: usecases.category,
+		Infra:    infra,
+	}
+
+	periodHandlersParam := period.PeriodHandlerParam{
+		Period: usecases.period,
+	}
+
+	transactionHandlersParam := transaction.TransactionHandlerParam{
+		Infra:       infra,
+		Transaction: usecases.transaction,
+	}
+
+	walletHandlersParam := wallet.WalletHandlerParam{
+		Infra:  infra,
+		Wallet: usecases.wallet,
+	}
+
 	want := &Handlers{
-		Account: account.NewHandler(accountHandlersParam),
+		Account:     account.NewHandler(accountHandlersParam),
+		Budget:      budget.NewHandler(budgetHandlersParam),
+		Category:    category.NewHandler(categoryHandlersParam),
+		Period:      period.NewHandler(periodHandlersParam),
+		Transaction: transaction.NewHandler(transactionHandlersParam),
+		Wallet:      wallet.NewHandler(walletHandlersParam),
 	}
 
 	assert.Equal(t, want, got)
@@ -2,18 +2,42 @@ package server
 
 import (
 	// internal package
+	"context"
 	"io"
 	"net/http"
 	"time"
 
+	// external package
+	"github.com/golang-jwt/jwt"
+
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
 )
 
 //go:generate mockgen -source=infra.go -destination=infra_mock.go -package=server
 
+// authenticationProvider provides methods available in authentication infra.
 type authenticationProvider interface {
+	// HandleJWKS will serve public keys used to verify JWTs issued by bubi as a JSON Web Key Set.
+	HandleJWKS(writer http.ResponseWriter, request *http.Request)
+
+	// JWTAuthorization will check authorization of a JWT.
 	JWTAuthorization(endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc
+
+	// RequirePermission will check authorization of a JWT, then check whether
+	// the role carried by the JWT grants the given permission.
+	RequirePermission(permission string, endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc
+
+	// TokenAuthorization will check authorization of either a personal access token or a JWT.
+	TokenAuthorization(endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc
+}
+
+// breachListProvider provides methods available in breachlist infra.
+type breachListProvider interface {
+	// IsBreached will check whether password was exposed in a known breach.
+	IsBreached(password string) bool
 }
 
 // configProvider provides methods available in config infra.
@@ -34,6 +58,36 @@ type golangProvider interface {
 	JsonUnmarshal(input []byte, dest interface{}) error
 }
 
+// hasherProvider provides methods available in hasher infra.
+type hasherProvider interface {
+	// ComparePassword will check whether password matches hash.
+	ComparePassword(hash, password string) error
+
+	// HashPassword will hash password with the configured algorithm and parameters.
+	HashPassword(password string) (string, error)
+
+	// NeedsRehash will check whether hash was made with an outdated algorithm or parameters.
+	NeedsRehash(hash string) bool
+}
+
+// keyringProvider provides methods available in keyring infra.
+type keyringProvider interface {
+	// JWKS returns public keys of the keyring as a JSON Web Key Set.
+	JWKS() keyring.JWKS
+
+	// Sign will sign claims with the active key.
+	Sign(claims jwt.Claims) (string, error)
+
+	// VerificationKey will find the key that verifies the signature of token using its kid header.
+	VerificationKey(token *jwt.Token) (interface{}, error)
+}
+
+// mailerProvider provides methods available in mailer infra.
+type mailerProvider interface {
+	// SendMail will send an email using the configured driver.
+	SendMail(ctx context.Context, msg mailer.Message) error
+}
+
 // readerProvider provides methods available in reader infra.
 type readerProvider interface {
 	// ReadAll reads from r until an error or EOF and returns the data it read.
@@ -43,32 +97,57 @@ type readerProvider interface {
 	ReadAll(input io.Reader) ([]byte, error)
 }
 
+// requestMetaProvider provides methods available in requestmeta infra.
+type requestMetaProvider interface {
+	// Middleware will inject information about the client that made a request
+	// to request's context as entity.RequestMetadata.
+	Middleware(next http.Handler) http.Handler
+}
+
 // InfraParam represents parameters needed to initialize infrastructure.
 type InfraParam struct {
-	Auth   authenticationProvider
-	Config configProvider
-	Golang golangProvider
-	Reader readerProvider
+	BreachList  breachListProvider
+	Config      configProvider
+	Golang      golangProvider
+	Hasher      hasherProvider
+	Keyring     keyringProvider
+	Mailer      mailerProvider
+	Reader      readerProvider
+	RequestMeta requestMetaProvider
 }
 
 // Infra holds methods needed to initialize infrastructure.
 type Infra struct {
-	Auth   authenticationProvider
-	Config configProvider
-	Golang golangProvider
-	Reader readerProvider
+	Auth        authenticationProvider
+	BreachList  breachListProvider
+	Config      configProvider
+	Golang      golangProvider
+	Hasher      hasherProvider
+	Keyring     keyringProvider
+	Mailer      mailerProvider
+	Reader      readerProvider
+	RequestMeta requestMetaProvider
 }
 
 // NewInfra will initialize a new instance of Infra.
 func NewInfra(param InfraParam) *Infra {
 	return &Infra{
-		Auth:   param.Auth,
-		Config: param.Config,
-		Golang: param.Golang,
-		Reader: param.Reader,
+		BreachList:  param.BreachList,
+		Config:      param.Config,
+		Golang:      param.Golang,
+		Hasher:      param.Hasher,
+		Keyring:     param.Keyring,
+		Mailer:      param.Mailer,
+		Reader:      param.Reader,
+		RequestMeta: param.RequestMeta,
 	}
 }
 
+// ComparePassword will check whether password matches hash.
+func (infra *Infra) ComparePassword(hash, password string) error {
+	return infra.Hasher.ComparePassword(hash, password)
+}
+
 // GetConfig will get configuration that had been saved to memory.
 func (infra *Infra) GetConfig() *configuration.AppConfig {
 	return infra.Config.GetConfig()
@@ -79,6 +158,16 @@ func (infra *Infra) GetTimeGMT7() time.Time {
 	return infra.Golang.GetTimeGMT7()
 }
 
+// HashPassword will hash password with the configured algorithm and parameters.
+func (infra *Infra) HashPassword(password string) (string, error) {
+	return infra.Hasher.HashPassword(password)
+}
+
+// IsPasswordBreached will check whether password was exposed in a known breach.
+func (infra *Infra) IsPasswordBreached(password string) bool {
+	return infra.BreachList.IsBreached(password)
+}
+
 // JsonMarshal returns the JSON encoding of input.
 func (infra *Infra) JsonMarshal(input interface{}) ([]byte, error) {
 	return infra.Golang.JsonMarshal(input)
@@ -93,6 +182,11 @@ func (infra *Infra) JWTAuthorization(endpointHandler func(writer http.ResponseWr
 	return infra.Auth.JWTAuthorization(endpointHandler)
 }
 
+// NeedsRehash will check whether hash was made with an outdated algorithm or parameters.
+func (infra *Infra) NeedsRehash(hash string) bool {
+	return infra.Hasher.NeedsRehash(hash)
+}
+
 // ReadAll reads from r until an error or EOF and returns the data it read.
 // A successful call returns err == nil, not err == EOF. Because ReadAll is
 // defined to read from src until EOF, it does not treat an EOF from Read
@@ -100,3 +194,13 @@ func (infra *Infra) JWTAuthorization(endpointHandler func(writer http.ResponseWr
 func (infra *Infra) ReadAll(input io.Reader) ([]byte, error) {
 	return infra.Reader.ReadAll(input)
 }
+
+// SendMail will send an email using the configured driver.
+func (infra *Infra) SendMail(ctx context.Context, msg mailer.Message) error {
+	return infra.Mailer.SendMail(ctx, msg)
+}
+
+// SignJWT will sign claims with the active key of the keyring.
+func (infra *Infra) SignJWT(claims jwt.Claims) (string, error) {
+	return infra.Keyring.Sign(claims)
+}
@@ -5,12 +5,16 @@
 package server
 
 import (
+	context "context"
 	io "io"
 	http "net/http"
 	reflect "reflect"
 	time "time"
 
 	configuration "github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	keyring "github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
+	mailer "github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+	jwt "github.com/golang-jwt/jwt"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,6 +41,18 @@ func (m *MockauthenticationProvider) EXPECT() *MockauthenticationProviderMockRec
 	return m.recorder
 }
 
+// HandleJWKS mocks base method.
+func (m *MockauthenticationProvider) HandleJWKS(writer http.ResponseWriter, request *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleJWKS", writer, request)
+}
+
+// HandleJWKS indicates an expected call of HandleJWKS.
+func (mr *MockauthenticationProviderMockRecorder) HandleJWKS(writer, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleJWKS", reflect.TypeOf((*MockauthenticationProvider)(nil).HandleJWKS), writer, request)
+}
+
 // JWTAuthorization mocks base method.
 func (m *MockauthenticationProvider) JWTAuthorization(endpointHandler func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -51,6 +67,71 @@ func (mr *MockauthenticationProviderMockRecorder) JWTAuthorization(endpointHandl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWTAuthorization", reflect.TypeOf((*MockauthenticationProvider)(nil).JWTAuthorization), endpointHandler)
 }
 
+// RequirePermission mocks base method.
+func (m *MockauthenticationProvider) RequirePermission(permission string, endpointHandler func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequirePermission", permission, endpointHandler)
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// RequirePermission indicates an expected call of RequirePermission.
+func (mr *MockauthenticationProviderMockRecorder) RequirePermission(permission, endpointHandler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequirePermission", reflect.TypeOf((*MockauthenticationProvider)(nil).RequirePermission), permission, endpointHandler)
+}
+
+// TokenAuthorization mocks base method.
+func (m *MockauthenticationProvider) TokenAuthorization(endpointHandler func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenAuthorization", endpointHandler)
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// TokenAuthorization indicates an expected call of TokenAuthorization.
+func (mr *MockauthenticationProviderMockRecorder) TokenAuthorization(endpointHandler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenAuthorization", reflect.TypeOf((*MockauthenticationProvider)(nil).TokenAuthorization), endpointHandler)
+}
+
+// MockbreachListProvider is a mock of breachListProvider interface.
+type MockbreachListProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockbreachListProviderMockRecorder
+}
+
+// MockbreachListProviderMockRecorder is the mock recorder for MockbreachListProvider.
+type MockbreachListProviderMockRecorder struct {
+	mock *MockbreachListProvider
+}
+
+// NewMockbreachListProvider creates a new mock instance.
+func NewMockbreachListProvider(ctrl *gomock.Controller) *MockbreachListProvider {
+	mock := &MockbreachListProvider{ctrl: ctrl}
+	mock.recorder = &MockbreachListProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbreachListProvider) EXPECT() *MockbreachListProviderMockRecorder {
+	return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockbreachListProvider) IsBreached(password string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBreached", password)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockbreachListProviderMockRecorder) IsBreached(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockbreachListProvider)(nil).IsBreached), password)
+}
+
 // MockconfigProvider is a mock of configProvider interface.
 type MockconfigProvider struct {
 	ctrl     *gomock.Controller
@@ -154,6 +235,176 @@ func (mr *MockgolangProviderMockRecorder) JsonUnmarshal(input, dest interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JsonUnmarshal", reflect.TypeOf((*MockgolangProvider)(nil).JsonUnmarshal), input, dest)
 }
 
+// MockhasherProvider is a mock of hasherProvider interface.
+type MockhasherProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasherProviderMockRecorder
+}
+
+// MockhasherProviderMockRecorder is the mock recorder for MockhasherProvider.
+type MockhasherProviderMockRecorder struct {
+	mock *MockhasherProvider
+}
+
+// NewMockhasherProvider creates a new mock instance.
+func NewMockhasherProvider(ctrl *gomock.Controller) *MockhasherProvider {
+	mock := &MockhasherProvider{ctrl: ctrl}
+	mock.recorder = &MockhasherProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasherProvider) EXPECT() *MockhasherProviderMockRecorder {
+	return m.recorder
+}
+
+// ComparePassword mocks base method.
+func (m *MockhasherProvider) ComparePassword(hash, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComparePassword", hash, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComparePassword indicates an expected call of ComparePassword.
+func (mr *MockhasherProviderMockRecorder) ComparePassword(hash, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePassword", reflect.TypeOf((*MockhasherProvider)(nil).ComparePassword), hash, password)
+}
+
+// HashPassword mocks base method.
+func (m *MockhasherProvider) HashPassword(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashPassword", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashPassword indicates an expected call of HashPassword.
+func (mr *MockhasherProviderMockRecorder) HashPassword(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockhasherProvider)(nil).HashPassword), password)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockhasherProvider) NeedsRehash(hash string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", hash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockhasherProviderMockRecorder) NeedsRehash(hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockhasherProvider)(nil).NeedsRehash), hash)
+}
+
+// MockkeyringProvider is a mock of keyringProvider interface.
+type MockkeyringProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyringProviderMockRecorder
+}
+
+// MockkeyringProviderMockRecorder is the mock recorder for MockkeyringProvider.
+type MockkeyringProviderMockRecorder struct {
+	mock *MockkeyringProvider
+}
+
+// NewMockkeyringProvider creates a new mock instance.
+func NewMockkeyringProvider(ctrl *gomock.Controller) *MockkeyringProvider {
+	mock := &MockkeyringProvider{ctrl: ctrl}
+	mock.recorder = &MockkeyringProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyringProvider) EXPECT() *MockkeyringProviderMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockkeyringProvider) JWKS() keyring.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(keyring.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockkeyringProviderMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockkeyringProvider)(nil).JWKS))
+}
+
+// Sign mocks base method.
+func (m *MockkeyringProvider) Sign(claims jwt.Claims) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", claims)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockkeyringProviderMockRecorder) Sign(claims interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockkeyringProvider)(nil).Sign), claims)
+}
+
+// VerificationKey mocks base method.
+func (m *MockkeyringProvider) VerificationKey(token *jwt.Token) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerificationKey", token)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerificationKey indicates an expected call of VerificationKey.
+func (mr *MockkeyringProviderMockRecorder) VerificationKey(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationKey", reflect.TypeOf((*MockkeyringProvider)(nil).VerificationKey), token)
+}
+
+// MockmailerProvider is a mock of mailerProvider interface.
+type MockmailerProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockmailerProviderMockRecorder
+}
+
+// MockmailerProviderMockRecorder is the mock recorder for MockmailerProvider.
+type MockmailerProviderMockRecorder struct {
+	mock *MockmailerProvider
+}
+
+// NewMockmailerProvider creates a new mock instance.
+func NewMockmailerProvider(ctrl *gomock.Controller) *MockmailerProvider {
+	mock := &MockmailerProvider{ctrl: ctrl}
+	mock.recorder = &MockmailerProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmailerProvider) EXPECT() *MockmailerProviderMockRecorder {
+	return m.recorder
+}
+
+// SendMail mocks base method.
+func (m *MockmailerProvider) SendMail(ctx context.Context, msg mailer.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMail", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMail indicates an expected call of SendMail.
+func (mr *MockmailerProviderMockRecorder) SendMail(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMail", reflect.TypeOf((*MockmailerProvider)(nil).SendMail), ctx, msg)
+}
+
 // MockreaderProvider is a mock of readerProvider interface.
 type MockreaderProvider struct {
 	ctrl     *gomock.Controller
@@ -191,3 +442,40 @@ func (mr *MockreaderProviderMockRecorder) ReadAll(input interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockreaderProvider)(nil).ReadAll), input)
 }
+
+// MockrequestMetaProvider is a mock of requestMetaProvider interface.
+type MockrequestMetaProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockrequestMetaProviderMockRecorder
+}
+
+// MockrequestMetaProviderMockRecorder is the mock recorder for MockrequestMetaProvider.
+type MockrequestMetaProviderMockRecorder struct {
+	mock *MockrequestMetaProvider
+}
+
+// NewMockrequestMetaProvider creates a new mock instance.
+func NewMockrequestMetaProvider(ctrl *gomock.Controller) *MockrequestMetaProvider {
+	mock := &MockrequestMetaProvider{ctrl: ctrl}
+	mock.recorder = &MockrequestMetaProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrequestMetaProvider) EXPECT() *MockrequestMetaProviderMockRecorder {
+	return m.recorder
+}
+
+// Middleware mocks base method.
+func (m *MockrequestMetaProvider) Middleware(next http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Middleware", next)
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// Middleware indicates an expected call of Middleware.
+func (mr *MockrequestMetaProviderMockRecorder) Middleware(next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Middleware", reflect.TypeOf((*MockrequestMetaProvider)(nil).Middleware), next)
+}
@@ -2,40 +2,71 @@ package server
 
 import (
 	// golang package
+	"context"
 	"io"
 	"testing"
 	"time"
 
 	// external package
+	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
 )
 
 func TestNewInfra(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
+	mockBreachList := NewMockbreachListProvider(ctrl)
 	mockConfig := NewMockconfigProvider(ctrl)
 	mockGolang := NewMockgolangProvider(ctrl)
+	mockHasher := NewMockhasherProvider(ctrl)
+	mockKeyring := NewMockkeyringProvider(ctrl)
+	mockMailer := NewMockmailerProvider(ctrl)
 	mockReader := NewMockreaderProvider(ctrl)
+	mockRequestMeta := NewMockrequestMetaProvider(ctrl)
 
 	want := &Infra{
-		Config: mockConfig,
-		Golang: mockGolang,
-		Reader: mockReader,
+		BreachList:  mockBreachList,
+		Config:      mockConfig,
+		Golang:      mockGolang,
+		Hasher:      mockHasher,
+		Keyring:     mockKeyring,
+		Mailer:      mockMailer,
+		Reader:      mockReader,
+		RequestMeta: mockRequestMeta,
 	}
 
 	got := NewInfra(InfraParam{
-		Config: mockConfig,
-		Golang: mockGolang,
-		Reader: mockReader,
+		BreachList:  mockBreachList,
+		Config:      mockConfig,
+		Golang:      mockGolang,
+		Hasher:      mockHasher,
+		Keyring:     mockKeyring,
+		Mailer:      mockMailer,
+		Reader:      mockReader,
+		RequestMeta: mockRequestMeta,
 	})
 
 	assert.Equal(t, want, got)
 }
 
+func TestInfra_ComparePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHasher := NewMockhasherProvider(ctrl)
+	mockHasher.EXPECT().ComparePassword("hash", "password").Return(assert.AnError)
+
+	i := &Infra{
+		Hasher: mockHasher,
+	}
+
+	err := i.ComparePassword("hash", "password")
+	assert.Equal(t, assert.AnError, err)
+}
+
 func TestInfra_GetConfig(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -119,3 +150,70 @@ func TestInfra_ReadAll(t *testing.T) {
 	got, _ := i.ReadAll(&io.LimitedReader{})
 	assert.Equal(t, want, got)
 }
+
+func TestInfra_HashPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHasher := NewMockhasherProvider(ctrl)
+	mockHasher.EXPECT().HashPassword("password").Return("hash", nil)
+
+	i := &Infra{
+		Hasher: mockHasher,
+	}
+
+	got, err := i.HashPassword("password")
+	assert.Equal(t, "hash", got)
+	assert.Nil(t, err)
+}
+
+func TestInfra_IsPasswordBreached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBreachList := NewMockbreachListProvider(ctrl)
+	mockBreachList.EXPECT().IsBreached("password").Return(true)
+
+	i := &Infra{
+		BreachList: mockBreachList,
+	}
+
+	got := i.IsPasswordBreached("password")
+	assert.True(t, got)
+}
+
+func TestInfra_NeedsRehash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockHasher := NewMockhasherProvider(ctrl)
+	mockHasher.EXPECT().NeedsRehash("hash").Return(true)
+
+	i := &Infra{
+		Hasher: mockHasher,
+	}
+
+	got := i.NeedsRehash("hash")
+	assert.True(t, got)
+}
+
+func TestInfra_SendMail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMailer := NewMockmailerProvider(ctrl)
+	mockMailer.EXPECT().SendMail(context.Background(), mailer.Message{To: "email"}).Return(assert.AnError)
+
+	i := &Infra{
+		Mailer: mockMailer,
+	}
+
+	err := i.SendMail(context.Background(), mailer.Message{To: "email"})
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestInfra_SignJWT(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockKeyring := NewMockkeyringProvider(ctrl)
+	mockKeyring.EXPECT().Sign(jwt.MapClaims{"sub": "123"}).Return("token", nil)
+
+	i := &Infra{
+		Keyring: mockKeyring,
+	}
+
+	got, err := i.SignJWT(jwt.MapClaims{"sub": "123"})
+	assert.Equal(t, "token", got)
+	assert.Nil(t, err)
+}
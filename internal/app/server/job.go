@@ -0,0 +1,18 @@
+package server
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// Jobs holds all usecases that are run periodically in background.
+type Jobs struct {
+	Account *account.UseCase
+}
+
+// NewJob will initialize a new instance of Jobs.
+func NewJob(usecases *UseCases) *Jobs {
+	return &Jobs{
+		Account: usecases.account,
+	}
+}
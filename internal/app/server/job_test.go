@@ -0,0 +1,25 @@
+package server
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestNewJob(t *testing.T) {
+	usecases := &UseCases{
+		account: &account.UseCase{},
+	}
+
+	want := &Jobs{
+		Account: usecases.account,
+	}
+
+	got := NewJob(usecases)
+	assert.Equal(t, want, got)
+}
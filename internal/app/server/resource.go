@@ -5,11 +5,19 @@ import (
 	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
 	"github.com/arifinhermawan/bubi/internal/repository/redis"
 	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
 )
 
 // Resources holds all available resources in bubi app.
 type Resources struct {
-	account *account.Resource
+	account     *account.Resource
+	budget      *budget.Resource
+	category    *category.Resource
+	transaction *transaction.Resource
+	wallet      *wallet.Resource
 }
 
 // ResourceParam represents parameters needed to initialize Resources.
@@ -27,7 +35,27 @@ func NewResource(param ResourceParam) *Resources {
 		DB:    param.DB,
 	}
 
+	budgetResourceParam := budget.BudgetResourceParam{
+		DB: param.DB,
+	}
+
+	categoryResourceParam := category.CategoryResourceParam{
+		DB: param.DB,
+	}
+
+	transactionResourceParam := transaction.TransactionResourceParam{
+		DB: param.DB,
+	}
+
+	walletResourceParam := wallet.WalletResourceParam{
+		DB: param.DB,
+	}
+
 	return &Resources{
-		account: account.NewResource(accountResourceParam),
+		account:     account.NewResource(accountResourceParam),
+		budget:      budget.NewResource(budgetResourceParam),
+		category:    category.NewResource(categoryResourceParam),
+		transaction: transaction.NewResource(transactionResourceParam),
+		wallet:      wallet.NewResource(walletResourceParam),
 	}
 }
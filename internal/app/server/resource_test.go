@@ -11,6 +11,10 @@ import (
 	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
 	"github.com/arifinhermawan/bubi/internal/repository/redis"
 	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
 )
 
 func TestNewResource(t *testing.T) {
@@ -24,6 +28,18 @@ func TestNewResource(t *testing.T) {
 			Cache: mockCache,
 			Infra: mockInfra,
 		}),
+		budget: budget.NewResource(budget.BudgetResourceParam{
+			DB: mockDB,
+		}),
+		category: category.NewResource(category.CategoryResourceParam{
+			DB: mockDB,
+		}),
+		transaction: transaction.NewResource(transaction.TransactionResourceParam{
+			DB: mockDB,
+		}),
+		wallet: wallet.NewResource(wallet.WalletResourceParam{
+			DB: mockDB,
+		}),
 	}
 
 	got := NewResource(ResourceParam{
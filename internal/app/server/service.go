@@ -3,11 +3,19 @@ package server
 import (
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
 )
 
 // Services holds all available services in bubi app.
 type Services struct {
-	account *account.Service
+	account     *account.Service
+	budget      *budget.Service
+	category    *category.Service
+	transaction *transaction.Service
+	wallet      *wallet.Service
 }
 
 // NewService will initialize a new instance of Services.
@@ -17,7 +25,28 @@ func NewService(rsc *Resources, infra *Infra) *Services {
 		Infra: infra,
 	}
 
+	budgetServiceParam := budget.BudgetServiceParam{
+		Infra: infra,
+		Rsc:   rsc.budget,
+	}
+
+	categoryServiceParam := category.CategoryServiceParam{
+		Rsc: rsc.category,
+	}
+
+	transactionServiceParam := transaction.TransactionServiceParam{
+		Rsc: rsc.transaction,
+	}
+
+	walletServiceParam := wallet.WalletServiceParam{
+		Rsc: rsc.wallet,
+	}
+
 	return &Services{
-		account: account.NewService(accountServiceParam),
+		account:     account.NewService(accountServiceParam),
+		budget:      budget.NewService(budgetServiceParam),
+		category:    category.NewService(categoryServiceParam),
+		transaction: transaction.NewService(transactionServiceParam),
+		wallet:      wallet.NewService(walletServiceParam),
 	}
 }
@@ -9,6 +9,10 @@ import (
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
 )
 
 func TestNewService(t *testing.T) {
@@ -20,6 +24,19 @@ func TestNewService(t *testing.T) {
 			Infra: mockInfra,
 			Rsc:   mockRsc.account,
 		}),
+		budget: budget.NewService(budget.BudgetServiceParam{
+			Infra: mockInfra,
+			Rsc:   mockRsc.budget,
+		}),
+		category: category.NewService(category.CategoryServiceParam{
+			Rsc: mockRsc.category,
+		}),
+		transaction: transaction.NewService(transaction.TransactionServiceParam{
+			Rsc: mockRsc.transaction,
+		}),
+		wallet: wallet.NewService(wallet.WalletServiceParam{
+			Rsc: mockRsc.wallet,
+		}),
 	}
 
 	got := NewService(mockRsc, mockInfra)
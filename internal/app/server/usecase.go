@@ -3,20 +3,59 @@ package server
 import (
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/usecase/account"
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+	"github.com/arifinhermawan/bubi/internal/usecase/period"
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
 )
 
 // UseCases holds all available usecases in bubi app.
 type UseCases struct {
-	account *account.UseCase
+	account     *account.UseCase
+	budget      *budget.UseCase
+	category    *category.UseCase
+	period      *period.UseCase
+	transaction *transaction.UseCase
+	wallet      *wallet.UseCase
 }
 
 // NewUsecase will initialize a new instance of Usecases.
 func NewUsecase(svc *Services) *UseCases {
 	accountUseCaseParam := account.AccountUsecaseParam{
+		Account:  svc.account,
+		Category: svc.category,
+	}
+
+	budgetUseCaseParam := budget.BudgetUsecaseParam{
+		Account:  svc.account,
+		Budget:   svc.budget,
+		Category: svc.category,
+	}
+
+	categoryUseCaseParam := category.CategoryUsecaseParam{
+		Category: svc.category,
+	}
+
+	periodUseCaseParam := period.PeriodUsecaseParam{
 		Account: svc.account,
 	}
 
+	transactionUseCaseParam := transaction.TransactionUsecaseParam{
+		Category:    svc.category,
+		Transaction: svc.transaction,
+	}
+
+	walletUseCaseParam := wallet.WalletUsecaseParam{
+		Wallet: svc.wallet,
+	}
+
 	return &UseCases{
-		account: account.NewUseCase(accountUseCaseParam),
+		account:     account.NewUseCase(accountUseCaseParam),
+		budget:      budget.NewUseCase(budgetUseCaseParam),
+		category:    category.NewUseCase(categoryUseCaseParam),
+		period:      period.NewUseCase(periodUseCaseParam),
+		transaction: transaction.NewUseCase(transactionUseCaseParam),
+		wallet:      wallet.NewUseCase(walletUseCaseParam),
 	}
 }
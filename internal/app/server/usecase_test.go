@@ -9,6 +9,11 @@ import (
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/usecase/account"
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+	"github.com/arifinhermawan/bubi/internal/usecase/period"
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
 )
 
 func TestNewUsecase(t *testing.T) {
@@ -16,8 +21,27 @@ func TestNewUsecase(t *testing.T) {
 
 	want := &UseCases{
 		account: account.NewUseCase(account.AccountUsecaseParam{
+			Account:  mockSvc.account,
+			Category: mockSvc.category,
+		}),
+		budget: budget.NewUseCase(budget.BudgetUsecaseParam{
+			Account:  mockSvc.account,
+			Budget:   mockSvc.budget,
+			Category: mockSvc.category,
+		}),
+		category: category.NewUseCase(category.CategoryUsecaseParam{
+			Category: mockSvc.category,
+		}),
+		period: period.NewUseCase(period.PeriodUsecaseParam{
 			Account: mockSvc.account,
 		}),
+		transaction: transaction.NewUseCase(transaction.TransactionUsecaseParam{
+			Category:    mockSvc.category,
+			Transaction: mockSvc.transaction,
+		}),
+		wallet: wallet.NewUseCase(wallet.WalletUsecaseParam{
+			Wallet: mockSvc.wallet,
+		}),
 	}
 
 	got := NewUsecase(mockSvc)
@@ -10,12 +10,15 @@ import (
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/app/server"
+	"github.com/arifinhermawan/bubi/internal/entity"
 )
 
 // HandleRequest handles all incoming request to backend.
 func HandleRequest(infra *server.Infra, handlers *server.Handlers) {
 	router := mux.NewRouter().StrictSlash(true)
+	router.Use(infra.RequestMeta.Middleware)
 
+	handleDeleteRequest(infra, handlers, router)
 	handleGetRequest(infra, handlers, router)
 	handlePatchRequest(infra, handlers, router)
 	handlePostRequest(infra, handlers, router)
@@ -23,21 +26,113 @@ func HandleRequest(infra *server.Infra, handlers *server.Handlers) {
 	log.Fatal(http.ListenAndServe(":8080", router))
 }
 
+// handleDeleteRequest will handle request with type DELETE
+func handleDeleteRequest(infra *server.Infra, handlers *server.Handlers, router *mux.Router) {
+	// account
+	router.HandleFunc("/account", infra.Auth.JWTAuthorization(handlers.Account.HandleDeleteAccount)).Methods("DELETE")
+	router.HandleFunc("/account/sessions/{session_id}", infra.Auth.JWTAuthorization(handlers.Account.HandleRevokeSession)).Methods("DELETE")
+	router.HandleFunc("/account/tokens/{token_id}", infra.Auth.JWTAuthorization(handlers.Account.HandleRevokePersonalAccessToken)).Methods("DELETE")
+
+	// budget
+	router.HandleFunc("/budgets/{category_id}", infra.Auth.TokenAuthorization(handlers.Budget.HandleDeleteBudget)).Methods("DELETE")
+
+	// transaction
+	router.HandleFunc("/transactions/{transaction_id}", infra.Auth.TokenAuthorization(handlers.Transaction.HandleDeleteTransaction)).Methods("DELETE")
+
+	// wallet
+	router.HandleFunc("/wallets/{wallet_id}", infra.Auth.TokenAuthorization(handlers.Wallet.HandleDeleteWallet)).Methods("DELETE")
+}
+
 // handleGetRequest will handle request with type GET
 func handleGetRequest(infra *server.Infra, handlers *server.Handlers, router *mux.Router) {
+	// account
+	router.HandleFunc("/account/activity", infra.Auth.JWTAuthorization(handlers.Account.HandleGetActivity)).Methods("GET")
+	router.HandleFunc("/account/me", infra.Auth.TokenAuthorization(handlers.Account.HandleGetProfile)).Methods("GET")
+	router.HandleFunc("/account/sessions", infra.Auth.JWTAuthorization(handlers.Account.HandleGetSessions)).Methods("GET")
+	router.HandleFunc("/account/tokens", infra.Auth.JWTAuthorization(handlers.Account.HandleGetPersonalAccessTokens)).Methods("GET")
+	router.HandleFunc("/account/verify", handlers.Account.HandleVerifyEmail).Methods("GET")
+
+	// admin
+	router.HandleFunc("/admin/users", infra.Auth.RequirePermission(entity.PermissionAccountRead, handlers.Account.HandleSearchAccounts)).Methods("GET")
+
+	// authentication
+	router.HandleFunc("/.well-known/jwks.json", infra.Auth.HandleJWKS).Methods("GET")
+
+	// budget
+	router.HandleFunc("/budgets", infra.Auth.TokenAuthorization(handlers.Budget.HandleGetBudgets)).Methods("GET")
+
+	// category
+	router.HandleFunc("/categories", infra.Auth.TokenAuthorization(handlers.Category.HandleGetCategories)).Methods("GET")
+	router.HandleFunc("/categories/{category_id}", infra.Auth.TokenAuthorization(handlers.Category.HandleGetCategory)).Methods("GET")
+
+	// period
+	router.HandleFunc("/period", infra.Auth.TokenAuthorization(handlers.Period.HandleGetPeriod)).Methods("GET")
+	router.HandleFunc("/period/current", infra.Auth.TokenAuthorization(handlers.Period.HandleGetCurrentPeriod)).Methods("GET")
+
+	// transaction
+	router.HandleFunc("/transactions", infra.Auth.TokenAuthorization(handlers.Transaction.HandleGetTransactions)).Methods("GET")
+	router.HandleFunc("/transactions/{transaction_id}", infra.Auth.TokenAuthorization(handlers.Transaction.HandleGetTransaction)).Methods("GET")
+
+	// wallet
+	router.HandleFunc("/wallets", infra.Auth.TokenAuthorization(handlers.Wallet.HandleGetWallets)).Methods("GET")
+	router.HandleFunc("/wallets/{wallet_id}", infra.Auth.TokenAuthorization(handlers.Wallet.HandleGetWallet)).Methods("GET")
 }
 
 // handlePatchRequest will handle request with type PATCH
 func handlePatchRequest(infra *server.Infra, handlers *server.Handlers, router *mux.Router) {
 	// account
-	router.HandleFunc("/account/update", infra.Auth.JWTAuthorization(handlers.Account.HandleUpdateUserAccount)).Methods("PATCH")
+	router.HandleFunc("/account/update", infra.Auth.TokenAuthorization(handlers.Account.HandleUpdateUserAccount)).Methods("PATCH")
 	router.HandleFunc("/account/update_password", infra.Auth.JWTAuthorization(handlers.Account.HandleUpdateUserPassword)).Methods("PATCH")
+
+	// category
+	router.HandleFunc("/categories/{category_id}", infra.Auth.TokenAuthorization(handlers.Category.HandleUpdateCategory)).Methods("PATCH")
+
+	// transaction
+	router.HandleFunc("/transactions/{transaction_id}", infra.Auth.TokenAuthorization(handlers.Transaction.HandleUpdateTransaction)).Methods("PATCH")
+
+	// wallet
+	router.HandleFunc("/wallets/{wallet_id}", infra.Auth.TokenAuthorization(handlers.Wallet.HandleUpdateWallet)).Methods("PATCH")
 }
 
 // handlePostRequest will handle request with type POST
 func handlePostRequest(infra *server.Infra, handlers *server.Handlers, router *mux.Router) {
 	// account
+	router.HandleFunc("/account/email", infra.Auth.JWTAuthorization(handlers.Account.HandleRequestEmailChange)).Methods("POST")
+	router.HandleFunc("/account/email/confirm", handlers.Account.HandleConfirmEmailChange).Methods("POST")
 	router.HandleFunc("/account/login", handlers.Account.HandleUserLogIn).Methods("POST")
-	router.HandleFunc("/account/logout", handlers.Account.HandlerUserLogOut).Methods("POST")
+	router.HandleFunc("/account/login/magic", handlers.Account.HandleRequestMagicLink).Methods("POST")
+	router.HandleFunc("/account/login/magic/consume", handlers.Account.HandleConsumeMagicLink).Methods("POST")
+	router.HandleFunc("/account/login/mfa", handlers.Account.HandleUserLogInMFA).Methods("POST")
+	router.HandleFunc("/account/logout", infra.Auth.JWTAuthorization(handlers.Account.HandlerUserLogOut)).Methods("POST")
+	router.HandleFunc("/account/password/forgot", handlers.Account.HandleForgotPassword).Methods("POST")
+	router.HandleFunc("/account/password/reset", handlers.Account.HandleResetPassword).Methods("POST")
+	router.HandleFunc("/account/sessions/revoke_others", infra.Auth.JWTAuthorization(handlers.Account.HandleRevokeOtherSessions)).Methods("POST")
 	router.HandleFunc("/account/signup", handlers.Account.HandleUserSignUp).Methods("POST")
+	router.HandleFunc("/account/token/refresh", handlers.Account.HandleRefreshToken).Methods("POST")
+	router.HandleFunc("/account/tokens", infra.Auth.JWTAuthorization(handlers.Account.HandleCreatePersonalAccessToken)).Methods("POST")
+	router.HandleFunc("/account/totp/confirm", infra.Auth.JWTAuthorization(handlers.Account.HandleConfirmTOTP)).Methods("POST")
+	router.HandleFunc("/account/totp/disable", infra.Auth.JWTAuthorization(handlers.Account.HandleDisableTOTP)).Methods("POST")
+	router.HandleFunc("/account/totp/enroll", infra.Auth.JWTAuthorization(handlers.Account.HandleEnrollTOTP)).Methods("POST")
+	router.HandleFunc("/account/verify/resend", handlers.Account.HandleResendEmailVerification).Methods("POST")
+
+	// admin
+	router.HandleFunc("/admin/users/{user_id}/disable", infra.Auth.RequirePermission(entity.PermissionAccountDisable, handlers.Account.HandleDisableAccount)).Methods("POST")
+	router.HandleFunc("/admin/users/{user_id}/enable", infra.Auth.RequirePermission(entity.PermissionAccountDisable, handlers.Account.HandleEnableAccount)).Methods("POST")
+	router.HandleFunc("/admin/users/{user_id}/logout", infra.Auth.RequirePermission(entity.PermissionAccountLogOut, handlers.Account.HandleForceLogOut)).Methods("POST")
+	router.HandleFunc("/admin/users/{user_id}/mfa/reset", infra.Auth.RequirePermission(entity.PermissionAccountResetMFA, handlers.Account.HandleResetMFA)).Methods("POST")
+
+	// budget
+	router.HandleFunc("/budgets", infra.Auth.TokenAuthorization(handlers.Budget.HandleSetBudget)).Methods("POST")
+	router.HandleFunc("/budgets/copy", infra.Auth.TokenAuthorization(handlers.Budget.HandleCopyBudgets)).Methods("POST")
+	router.HandleFunc("/budgets/move", infra.Auth.TokenAuthorization(handlers.Budget.HandleMoveBudget)).Methods("POST")
+
+	// category
+	router.HandleFunc("/categories", infra.Auth.TokenAuthorization(handlers.Category.HandleCreateCategory)).Methods("POST")
+	router.HandleFunc("/categories/{category_id}/merge", infra.Auth.TokenAuthorization(handlers.Category.HandleMergeCategory)).Methods("POST")
+
+	// transaction
+	router.HandleFunc("/transactions", infra.Auth.TokenAuthorization(handlers.Transaction.HandleCreateTransaction)).Methods("POST")
+
+	// wallet
+	router.HandleFunc("/wallets", infra.Auth.TokenAuthorization(handlers.Wallet.HandleCreateWallet)).Methods("POST")
 }
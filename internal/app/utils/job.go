@@ -0,0 +1,44 @@
+package utils
+
+import (
+	// golang package
+	"context"
+	"log"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/app/server"
+)
+
+// RunJobs will start every background job of bubi app.
+// A job whose interval isn't configured won't be started.
+func RunJobs(infra *server.Infra, jobs *server.Jobs) {
+	cfg := infra.Config.GetConfig()
+
+	purgeInterval := time.Duration(cfg.Account.DeletionPurgeInterval) * time.Minute
+	go runPeriodically(context.Background(), purgeInterval, "PurgeDeletedAccounts", jobs.Account.PurgeDeletedAccounts)
+}
+
+// runPeriodically will call job every interval until ctx is done.
+// An error returned by job is only logged, so the next run still happens.
+func runPeriodically(ctx context.Context, interval time.Duration, name string, job func(ctx context.Context) error) {
+	if interval <= 0 {
+		log.Printf("[runPeriodically] job %s is disabled\n", name)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := job(ctx)
+			if err != nil {
+				log.Printf("[runPeriodically] %s() got an error: %+v\n", name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunPeriodically(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		jobErr   error
+		wantRun  bool
+	}{
+		{
+			name:     "when_interval_not_configured_then_job_not_run",
+			interval: 0,
+		},
+		{
+			name:     "when_job_error_then_keep_running",
+			interval: time.Millisecond,
+			jobErr:   assert.AnError,
+			wantRun:  true,
+		},
+		{
+			name:     "when_job_succeed_then_keep_running",
+			interval: time.Millisecond,
+			wantRun:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			runs := 0
+			job := func(ctx context.Context) error {
+				runs++
+				if runs >= 2 {
+					cancel()
+				}
+				return test.jobErr
+			}
+
+			done := make(chan struct{})
+			go func() {
+				runPeriodically(ctx, test.interval, "job", job)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("runPeriodically didn't stop")
+			}
+			cancel()
+
+			assert.Equal(t, test.wantRun, runs >= 2)
+		})
+	}
+}
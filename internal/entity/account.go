@@ -1,11 +1,44 @@
 package entity
 
+import (
+	// golang package
+	"time"
+)
+
 // Account holds information about user's account
 type Account struct {
+	// BudgetingMode is one of BudgetingModeClassic or BudgetingModeEnvelope.
+	BudgetingMode string
+
+	CreatedAt time.Time
+
+	// DeletionRequestedAt is zero unless the account is pending deletion.
+	DeletionRequestedAt time.Time
+
+	// DisabledAt is zero unless the account is disabled by an admin.
+	DisabledAt time.Time
+
 	Email             string
+	EmailVerifiedAt   time.Time
 	FirstName         string
 	ID                int64
 	LastName          string
 	Password          string
 	RecordPeriodStart int
+	Role              string
+
+	// TOTPEnabledAt is zero until the TOTP enrollment of the account is confirmed.
+	TOTPEnabledAt time.Time
+
+	// TOTPRecoveryCodes holds hash of recovery codes that haven't been used yet.
+	TOTPRecoveryCodes []string
+
+	// TOTPSecret is the encrypted TOTP secret of the account.
+	TOTPSecret string
+
+	// Timezone is an IANA timezone name, periods of the account are counted in it.
+	Timezone string
+
+	// UpdatedAt is zero until the account is updated for the first time.
+	UpdatedAt time.Time
 }
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	// golang package
+	"time"
+)
+
+const (
+	// BudgetingModeClassic sets a spending limit per category, regardless of how much is earned.
+	BudgetingModeClassic = "classic"
+
+	// BudgetingModeEnvelope assigns every income to budgets until nothing is left to assign.
+	BudgetingModeEnvelope = "envelope"
+
+	// RolloverModeBoth carries both the unspent and the overspent amount of a budget into the next period.
+	RolloverModeBoth = "both"
+
+	// RolloverModeNone starts every period of a budget from its amount.
+	RolloverModeNone = "none"
+
+	// RolloverModePositive carries only the unspent amount of a budget into the next period.
+	RolloverModePositive = "positive"
+)
+
+// Budget is the spending limit of a user for an expense category in a period.
+// A budget of a top level category covers its subcategories as well.
+type Budget struct {
+	// Amount is the spending limit, in minor unit of Currency.
+	Amount int64
+
+	// CarriedOver is the amount carried from the budget of the previous period, it's negative when
+	// the previous period was overspent. It's fixed once the previous period has ended.
+	CarriedOver int64
+
+	// CarryPending is true while the budget waits for the previous period to end,
+	// CarriedOver is zero until then.
+	CarryPending bool
+
+	CategoryID int64
+	CreatedAt  time.Time
+
+	// Currency is an ISO 4217 currency code, only expenses from wallets with this currency are counted.
+	Currency string
+
+	// Envelope is true when the budget is set in envelope budgeting mode,
+	// only then it's assigned from what the user has left to assign.
+	Envelope bool
+
+	ID int64
+
+	// PeriodStart is the date the period of the budget starts on.
+	PeriodStart time.Time
+
+	// RolloverMode is one of RolloverModeBoth, RolloverModeNone or RolloverModePositive,
+	// it decides what this budget carries into the next period.
+	RolloverMode string
+
+	// Spent is the total expense of the category in the period, in minor unit of Currency.
+	Spent int64
+
+	// UpdatedAt is zero until the budget is updated for the first time.
+	UpdatedAt time.Time
+
+	UserID int64
+}
+
+// IsBudgetingMode will check whether budgetingMode is one of the known budgeting modes.
+func IsBudgetingMode(budgetingMode string) bool {
+	switch budgetingMode {
+	case BudgetingModeClassic, BudgetingModeEnvelope:
+		return true
+	}
+
+	return false
+}
+
+// IsRolloverMode will check whether rolloverMode is one of the known rollover modes.
+func IsRolloverMode(rolloverMode string) bool {
+	switch rolloverMode {
+	case RolloverModeBoth, RolloverModeNone, RolloverModePositive:
+		return true
+	}
+
+	return false
+}
+
+// BudgetRollover will calculate how much a budget carries into the next period.
+// Remaining is what is left of the budget, which is its amount and carried over amount minus what is spent.
+func BudgetRollover(rolloverMode string, remaining int64) int64 {
+	switch rolloverMode {
+	case RolloverModeBoth:
+		return remaining
+	case RolloverModePositive:
+		if remaining > 0 {
+			return remaining
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBudgetingMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		budgetingMode string
+		want          bool
+	}{
+		{
+			name:          "when_mode_unknown_then_return_false",
+			budgetingMode: "zero",
+		},
+		{
+			name:          "when_mode_empty_then_return_false",
+			budgetingMode: "",
+		},
+		{
+			name:          "when_mode_is_classic_then_return_true",
+			budgetingMode: BudgetingModeClassic,
+			want:          true,
+		},
+		{
+			name:          "when_mode_is_envelope_then_return_true",
+			budgetingMode: BudgetingModeEnvelope,
+			want:          true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsBudgetingMode(test.budgetingMode))
+		})
+	}
+}
+
+func TestIsRolloverMode(t *testing.T) {
+	tests := []struct {
+		name         string
+		rolloverMode string
+		want         bool
+	}{
+		{
+			name:         "when_mode_unknown_then_return_false",
+			rolloverMode: "negative",
+		},
+		{
+			name:         "when_mode_empty_then_return_false",
+			rolloverMode: "",
+		},
+		{
+			name:         "when_mode_is_both_then_return_true",
+			rolloverMode: RolloverModeBoth,
+			want:         true,
+		},
+		{
+			name:         "when_mode_is_none_then_return_true",
+			rolloverMode: RolloverModeNone,
+			want:         true,
+		},
+		{
+			name:         "when_mode_is_positive_then_return_true",
+			rolloverMode: RolloverModePositive,
+			want:         true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsRolloverMode(test.rolloverMode))
+		})
+	}
+}
+
+func TestBudgetRollover(t *testing.T) {
+	tests := []struct {
+		name         string
+		rolloverMode string
+		remaining    int64
+		want         int64
+	}{
+		{
+			name:         "when_mode_is_none_then_return_zero",
+			rolloverMode: RolloverModeNone,
+			remaining:    25000,
+		},
+		{
+			name:         "when_mode_is_positive_and_unspent_then_return_remaining",
+			rolloverMode: RolloverModePositive,
+			remaining:    25000,
+			want:         25000,
+		},
+		{
+			name:         "when_mode_is_positive_and_overspent_then_return_zero",
+			rolloverMode: RolloverModePositive,
+			remaining:    -25000,
+		},
+		{
+			name:         "when_mode_is_both_and_overspent_then_return_remaining",
+			rolloverMode: RolloverModeBoth,
+			remaining:    -25000,
+			want:         -25000,
+		},
+		{
+			name:         "when_mode_unknown_then_return_zero",
+			rolloverMode: "",
+			remaining:    25000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, BudgetRollover(test.rolloverMode, test.remaining))
+		})
+	}
+}
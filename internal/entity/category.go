@@ -0,0 +1,33 @@
+package entity
+
+import (
+	// golang package
+	"time"
+)
+
+// Category groups transactions of the same kind, such as food or salary.
+// A category is either a top level category or a subcategory of one,
+// and it has the same type as the transactions it groups.
+type Category struct {
+	// ArchivedAt is zero unless the category is archived.
+	ArchivedAt time.Time
+
+	// Color is a hex color code such as #FF9800, it's empty when the category has no color.
+	Color string
+
+	CreatedAt time.Time
+	Icon      string
+	ID        int64
+	Name      string
+
+	// ParentID is zero for a top level category.
+	ParentID int64
+
+	// Type is either TransactionTypeExpense or TransactionTypeIncome.
+	Type string
+
+	// UpdatedAt is zero until the category is updated for the first time.
+	UpdatedAt time.Time
+
+	UserID int64
+}
@@ -0,0 +1,19 @@
+package entity
+
+const (
+	// LanguageEnglish is the English language.
+	LanguageEnglish = "en"
+
+	// LanguageIndonesian is the Indonesian language, which is used when no language is chosen.
+	LanguageIndonesian = "id"
+)
+
+// IsLanguage will check whether language is one of the supported languages.
+func IsLanguage(language string) bool {
+	switch language {
+	case LanguageEnglish, LanguageIndonesian:
+		return true
+	}
+
+	return false
+}
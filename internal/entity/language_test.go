@@ -0,0 +1,41 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		want     bool
+	}{
+		{
+			name:     "when_language_unknown_then_return_false",
+			language: "fr",
+		},
+		{
+			name:     "when_language_empty_then_return_false",
+			language: "",
+		},
+		{
+			name:     "when_language_is_english_then_return_true",
+			language: LanguageEnglish,
+			want:     true,
+		},
+		{
+			name:     "when_language_is_indonesian_then_return_true",
+			language: LanguageIndonesian,
+			want:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsLanguage(test.language))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	// golang package
+	"time"
+)
+
+const (
+	// DefaultTimezone is the timezone of an account that hasn't chosen one.
+	DefaultTimezone = "Asia/Jakarta"
+
+	// MaxPeriodStartDay is the latest day of month a period can start on.
+	MaxPeriodStartDay = 31
+)
+
+// Period is a payday-to-payday cycle of an account.
+// Start is inclusive and End is exclusive, both are at midnight of the account's timezone.
+type Period struct {
+	End   time.Time
+	Start time.Time
+}
+
+// Contains will check whether t falls within the period.
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// GetPeriod will return the period that is offset periods away from the one containing now.
+// Offset 0 is the current period, -1 is the previous one and so on.
+// When a month is shorter than startDay, the period starts on the last day of that month.
+// The period is in the location of now.
+func GetPeriod(startDay int, now time.Time, offset int) Period {
+	if startDay < 1 {
+		startDay = 1
+	}
+
+	if startDay > MaxPeriodStartDay {
+		startDay = MaxPeriodStartDay
+	}
+
+	year, month, _ := now.Date()
+	if now.Before(periodStart(year, month, startDay, now.Location())) {
+		month--
+	}
+
+	month += time.Month(offset)
+
+	return Period{
+		End:   periodStart(year, month+1, startDay, now.Location()),
+		Start: periodStart(year, month, startDay, now.Location()),
+	}
+}
+
+// IsTimezone will check whether name is a known IANA timezone.
+func IsTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// LoadTimezone will return the location of name.
+// If name isn't a known timezone, it will return location of DefaultTimezone.
+func LoadTimezone(name string) *time.Location {
+	if IsTimezone(name) {
+		location, _ := time.LoadLocation(name)
+		return location
+	}
+
+	location, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return time.FixedZone(DefaultTimezone, 7*60*60)
+	}
+
+	return location
+}
+
+// periodStart will return midnight of startDay in the given month,
+// or of the month's last day when the month is shorter than startDay.
+// month might be out of range, it's normalized the same way time.Date does.
+func periodStart(year int, month time.Month, startDay int, location *time.Location) time.Time {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, location)
+	lastDay := time.Date(firstDay.Year(), firstDay.Month()+1, 0, 0, 0, 0, 0, location).Day()
+	if startDay > lastDay {
+		startDay = lastDay
+	}
+
+	return time.Date(firstDay.Year(), firstDay.Month(), startDay, 0, 0, 0, 0, location)
+}
@@ -0,0 +1,215 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+	"time"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPeriod(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+
+	type args struct {
+		startDay int
+		now      time.Time
+		offset   int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Period
+	}{
+		{
+			name: "when_now_is_after_start_day_then_return_period_starting_this_month",
+			args: args{
+				startDay: 25,
+				now:      time.Date(2024, 3, 27, 10, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_now_is_before_start_day_then_return_period_starting_last_month",
+			args: args{
+				startDay: 25,
+				now:      time.Date(2024, 3, 24, 23, 59, 59, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_now_is_exactly_start_day_then_return_period_starting_now",
+			args: args{
+				startDay: 25,
+				now:      time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_start_day_is_31_and_month_has_30_days_then_start_on_last_day",
+			args: args{
+				startDay: 31,
+				now:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_start_day_is_30_in_leap_february_then_start_on_29th",
+			args: args{
+				startDay: 30,
+				now:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_start_day_is_29_in_non_leap_february_then_start_on_28th",
+			args: args{
+				startDay: 29,
+				now:      time.Date(2023, 2, 28, 12, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2023, 3, 29, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_offset_is_negative_then_return_previous_period",
+			args: args{
+				startDay: 31,
+				now:      time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+				offset:   -1,
+			},
+			want: Period{
+				End:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_offset_crosses_year_then_return_period_in_other_year",
+			args: args{
+				startDay: 15,
+				now:      time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC),
+				offset:   14,
+			},
+			want: Period{
+				End:   time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_start_day_out_of_range_then_clamp_it",
+			args: args{
+				startDay: 0,
+				now:      time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			},
+			want: Period{
+				End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "when_now_has_location_then_return_period_in_that_location",
+			args: args{
+				startDay: 1,
+				now:      time.Date(2024, 1, 31, 20, 0, 0, 0, time.UTC).In(jakarta),
+			},
+			want: Period{
+				End:   time.Date(2024, 3, 1, 0, 0, 0, 0, jakarta),
+				Start: time.Date(2024, 2, 1, 0, 0, 0, 0, jakarta),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := GetPeriod(test.args.startDay, test.args.now, test.args.offset)
+			assert.Equal(t, test.want, got)
+			if test.args.offset == 0 {
+				assert.True(t, got.Contains(test.args.now))
+			}
+		})
+	}
+}
+
+func TestPeriod_Contains(t *testing.T) {
+	period := Period{
+		End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{
+			name: "when_t_is_before_start_then_return_false",
+			t:    time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name: "when_t_is_end_then_return_false",
+			t:    time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "when_t_is_start_then_return_true",
+			t:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, period.Contains(test.t))
+		})
+	}
+}
+
+func TestIsTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		want     bool
+	}{
+		{
+			name:     "when_timezone_empty_then_return_false",
+			timezone: "",
+		},
+		{
+			name:     "when_timezone_is_local_then_return_false",
+			timezone: "Local",
+		},
+		{
+			name:     "when_timezone_unknown_then_return_false",
+			timezone: "Asia/Bandung",
+		},
+		{
+			name:     "when_timezone_known_then_return_true",
+			timezone: "Asia/Makassar",
+			want:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsTimezone(test.timezone))
+		})
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	assert.Equal(t, "Asia/Makassar", LoadTimezone("Asia/Makassar").String())
+	assert.Equal(t, DefaultTimezone, LoadTimezone("").String())
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	// golang package
+	"context"
+	"time"
+)
+
+const (
+	// PersonalAccessTokenPrefix marks a bearer token as a personal access token instead of a JWT.
+	PersonalAccessTokenPrefix = "bubi_pat_"
+
+	// ScopeRead allows a personal access token to read data.
+	ScopeRead = "read"
+
+	// ScopeWrite allows a personal access token to read and change data.
+	ScopeWrite = "write"
+)
+
+// principalContextKey is the key used to store Principal in a context.
+type principalContextKey struct{}
+
+// Principal holds information about the authenticated user that is acting on a request.
+// Scopes is only filled when the user is authenticated by a personal access token,
+// while Role, SessionID and TokenID are only filled when the user is authenticated by a JWT.
+type Principal struct {
+	Email     string
+	IssuedAt  time.Time
+	Role      string
+	Scopes    []string
+	SessionID string
+	TokenID   string
+	UserID    int64
+}
+
+// HasPermission will check whether the role of the principal grants permission.
+func (p Principal) HasPermission(permission string) bool {
+	return RoleHasPermission(p.Role, permission)
+}
+
+// HasScope will check whether the principal is allowed to act within scope.
+// A principal authenticated by a JWT is allowed to do anything,
+// and ScopeWrite also allows ScopeRead.
+func (p Principal) HasScope(scope string) bool {
+	if len(p.Scopes) == 0 {
+		return true
+	}
+
+	for _, s := range p.Scopes {
+		if s == scope || s == ScopeWrite {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewContextWithPrincipal returns a copy of ctx that carries the given principal.
+func NewContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// GetPrincipalFromContext will get the principal carried by ctx.
+// It returns false if ctx doesn't carry any principal.
+func GetPrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	if !ok || principal.UserID <= 0 {
+		return Principal{}, false
+	}
+
+	return principal, true
+}
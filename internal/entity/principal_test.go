@@ -0,0 +1,87 @@
+package entity
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPrincipalFromContext(t *testing.T) {
+	mockPrincipal := Principal{
+		Email:    "email",
+		IssuedAt: time.Unix(1672531200, 0),
+		TokenID:  "jti",
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		want   Principal
+		wantOk bool
+	}{
+		{
+			name: "when_context_has_no_principal_then_return_false",
+			ctx:  context.Background(),
+		},
+		{
+			name: "when_principal_has_no_user_id_then_return_false",
+			ctx:  NewContextWithPrincipal(context.Background(), Principal{Email: "email"}),
+		},
+		{
+			name:   "when_context_has_principal_then_return_principal",
+			ctx:    NewContextWithPrincipal(context.Background(), mockPrincipal),
+			want:   mockPrincipal,
+			wantOk: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := GetPrincipalFromContext(test.ctx)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantOk, ok)
+		})
+	}
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		scope     string
+		want      bool
+	}{
+		{
+			name:      "when_principal_has_no_scopes_then_return_true",
+			principal: Principal{UserID: 123},
+			scope:     ScopeWrite,
+			want:      true,
+		},
+		{
+			name:      "when_principal_has_scope_then_return_true",
+			principal: Principal{Scopes: []string{ScopeRead}, UserID: 123},
+			scope:     ScopeRead,
+			want:      true,
+		},
+		{
+			name:      "when_principal_has_write_scope_then_allow_read",
+			principal: Principal{Scopes: []string{ScopeWrite}, UserID: 123},
+			scope:     ScopeRead,
+			want:      true,
+		},
+		{
+			name:      "when_principal_lacks_scope_then_return_false",
+			principal: Principal{Scopes: []string{ScopeRead}, UserID: 123},
+			scope:     ScopeWrite,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.principal.HasScope(test.scope))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	// golang package
+	"context"
+)
+
+// requestMetadataContextKey is the key used to store RequestMetadata in a context.
+type requestMetadataContextKey struct{}
+
+// RequestMetadata holds information about the client that made a request.
+// RequestID identifies the request in logs and security events.
+type RequestMetadata struct {
+	IPAddress string
+	RequestID string
+	UserAgent string
+}
+
+// NewContextWithRequestMetadata returns a copy of ctx that carries the given request metadata.
+func NewContextWithRequestMetadata(ctx context.Context, metadata RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataContextKey{}, metadata)
+}
+
+// GetRequestMetadataFromContext will get the request metadata carried by ctx.
+// It returns empty metadata if ctx doesn't carry any, such as in background jobs.
+func GetRequestMetadataFromContext(ctx context.Context) RequestMetadata {
+	metadata, _ := ctx.Value(requestMetadataContextKey{}).(RequestMetadata)
+	return metadata
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	// golang package
+	"context"
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRequestMetadataFromContext(t *testing.T) {
+	mockMetadata := RequestMetadata{
+		IPAddress: "127.0.0.1",
+		RequestID: "request",
+		UserAgent: "agent",
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want RequestMetadata
+	}{
+		{
+			name: "when_context_has_no_metadata_then_return_empty_metadata",
+			ctx:  context.Background(),
+		},
+		{
+			name: "when_context_has_metadata_then_return_metadata",
+			ctx:  NewContextWithRequestMetadata(context.Background(), mockMetadata),
+			want: mockMetadata,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := GetRequestMetadataFromContext(test.ctx)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package entity
+
+const (
+	// RoleAdmin can do everything RoleSupport can do, and can also disable or enable accounts.
+	RoleAdmin = "admin"
+
+	// RoleSupport can look up accounts and help their owners regain access to them.
+	RoleSupport = "support"
+
+	// RoleUser is the role of every account unless it's changed in database. It has no admin permission.
+	RoleUser = "user"
+)
+
+const (
+	// PermissionAccountDisable allows disabling and enabling accounts of other users.
+	PermissionAccountDisable = "account:disable"
+
+	// PermissionAccountLogOut allows revoking every session of other users.
+	PermissionAccountLogOut = "account:logout"
+
+	// PermissionAccountRead allows searching accounts of other users.
+	PermissionAccountRead = "account:read"
+
+	// PermissionAccountResetMFA allows turning off two-factor authentication of other users.
+	PermissionAccountResetMFA = "account:reset_mfa"
+)
+
+// rolePermissions lists permissions granted to each role.
+var rolePermissions = map[string][]string{
+	RoleAdmin: {
+		PermissionAccountDisable,
+		PermissionAccountLogOut,
+		PermissionAccountRead,
+		PermissionAccountResetMFA,
+	},
+	RoleSupport: {
+		PermissionAccountLogOut,
+		PermissionAccountRead,
+		PermissionAccountResetMFA,
+	},
+}
+
+// roleRanks orders roles from the least to the most privileged.
+var roleRanks = map[string]int{
+	RoleUser:    1,
+	RoleSupport: 2,
+	RoleAdmin:   3,
+}
+
+// RoleHasPermission will check whether role grants permission.
+// An unknown role grants nothing.
+func RoleHasPermission(role, permission string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RoleOutranks will check whether role is more privileged than other.
+// An unknown role neither outranks nor is outranked by any role.
+func RoleOutranks(role, other string) bool {
+	rank, ok := roleRanks[role]
+	if !ok {
+		return false
+	}
+
+	otherRank, ok := roleRanks[other]
+	if !ok {
+		return false
+	}
+
+	return rank > otherRank
+}
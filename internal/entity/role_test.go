@@ -0,0 +1,97 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleHasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		permission string
+		want       bool
+	}{
+		{
+			name:       "when_role_unknown_then_return_false",
+			role:       "root",
+			permission: PermissionAccountRead,
+		},
+		{
+			name:       "when_role_is_user_then_return_false",
+			role:       RoleUser,
+			permission: PermissionAccountRead,
+		},
+		{
+			name:       "when_role_lacks_permission_then_return_false",
+			role:       RoleSupport,
+			permission: PermissionAccountDisable,
+		},
+		{
+			name:       "when_role_grants_permission_then_return_true",
+			role:       RoleSupport,
+			permission: PermissionAccountResetMFA,
+			want:       true,
+		},
+		{
+			name:       "when_role_is_admin_then_return_true",
+			role:       RoleAdmin,
+			permission: PermissionAccountDisable,
+			want:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := RoleHasPermission(test.role, test.permission)
+			assert.Equal(t, test.want, got)
+
+			got = Principal{Role: test.role}.HasPermission(test.permission)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRoleOutranks(t *testing.T) {
+	tests := []struct {
+		name  string
+		role  string
+		other string
+		want  bool
+	}{
+		{
+			name:  "when_role_unknown_then_return_false",
+			role:  "root",
+			other: RoleUser,
+		},
+		{
+			name:  "when_other_role_unknown_then_return_false",
+			role:  RoleAdmin,
+			other: "root",
+		},
+		{
+			name:  "when_roles_are_equal_then_return_false",
+			role:  RoleAdmin,
+			other: RoleAdmin,
+		},
+		{
+			name:  "when_other_role_is_higher_then_return_false",
+			role:  RoleSupport,
+			other: RoleAdmin,
+		},
+		{
+			name:  "when_role_is_higher_then_return_true",
+			role:  RoleSupport,
+			other: RoleUser,
+			want:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := RoleOutranks(test.role, test.other)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	// golang package
+	"time"
+)
+
+const (
+	// TransactionTypeExpense is money that goes out of a wallet.
+	TransactionTypeExpense = "expense"
+
+	// TransactionTypeIncome is money that comes into a wallet.
+	TransactionTypeIncome = "income"
+)
+
+// Transaction holds information about money that comes into or goes out of a wallet.
+type Transaction struct {
+	// Amount is in minor unit of the wallet's currency. It's always positive,
+	// Type tells whether it's added to or taken from the wallet.
+	Amount int64
+
+	// CategoryID is zero when the transaction isn't categorized.
+	CategoryID int64
+
+	CreatedAt time.Time
+	ID        int64
+	Note      string
+	Payee     string
+	Tags      []string
+
+	// TransactedAt is when the money actually moved, which may differ from when it was recorded.
+	TransactedAt time.Time
+
+	Type string
+
+	// UpdatedAt is zero until the transaction is updated for the first time.
+	UpdatedAt time.Time
+
+	UserID   int64
+	WalletID int64
+}
+
+// IsTransactionType will check whether transactionType is one of the known transaction types.
+func IsTransactionType(transactionType string) bool {
+	switch transactionType {
+	case TransactionTypeExpense, TransactionTypeIncome:
+		return true
+	}
+
+	return false
+}
+
+// TransactionBalanceChange will calculate how much a transaction changes the balance of its wallet.
+// An income adds amount to the balance, an expense takes it away.
+func TransactionBalanceChange(transactionType string, amount int64) int64 {
+	if transactionType == TransactionTypeExpense {
+		return -amount
+	}
+
+	return amount
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransactionType(t *testing.T) {
+	tests := []struct {
+		name            string
+		transactionType string
+		want            bool
+	}{
+		{
+			name:            "when_type_unknown_then_return_false",
+			transactionType: "transfer",
+		},
+		{
+			name:            "when_type_empty_then_return_false",
+			transactionType: "",
+		},
+		{
+			name:            "when_type_is_expense_then_return_true",
+			transactionType: TransactionTypeExpense,
+			want:            true,
+		},
+		{
+			name:            "when_type_is_income_then_return_true",
+			transactionType: TransactionTypeIncome,
+			want:            true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsTransactionType(test.transactionType))
+		})
+	}
+}
+
+func TestTransactionBalanceChange(t *testing.T) {
+	tests := []struct {
+		name            string
+		transactionType string
+		amount          int64
+		want            int64
+	}{
+		{
+			name:            "when_type_is_expense_then_return_negative_amount",
+			transactionType: TransactionTypeExpense,
+			amount:          25000,
+			want:            -25000,
+		},
+		{
+			name:            "when_type_is_income_then_return_amount",
+			transactionType: TransactionTypeIncome,
+			amount:          25000,
+			want:            25000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, TransactionBalanceChange(test.transactionType, test.amount))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	// golang package
+	"time"
+)
+
+const (
+	// WalletTypeBank is a bank account.
+	WalletTypeBank = "bank"
+
+	// WalletTypeCash is physical money the user carries or keeps at home.
+	WalletTypeCash = "cash"
+
+	// WalletTypeCreditCard is a credit card, its balance is usually negative.
+	WalletTypeCreditCard = "credit_card"
+
+	// WalletTypeEWallet is an electronic wallet such as GoPay or OVO.
+	WalletTypeEWallet = "e_wallet"
+)
+
+// Wallet holds information about a place where user keeps their money.
+type Wallet struct {
+	// ArchivedAt is zero unless the wallet is archived.
+	ArchivedAt time.Time
+
+	// Balance is the opening balance plus every transaction recorded on the wallet,
+	// in minor unit of its currency.
+	Balance int64
+
+	CreatedAt time.Time
+
+	// Currency is an ISO 4217 currency code, such as IDR.
+	Currency string
+
+	DisplayOrder int
+	ID           int64
+	Name         string
+
+	// OpeningBalance is the balance of the wallet when it's added, in minor unit of its currency.
+	OpeningBalance int64
+
+	Type string
+
+	// UpdatedAt is zero until the wallet is updated for the first time.
+	UpdatedAt time.Time
+
+	UserID int64
+}
+
+// IsWalletType will check whether walletType is one of the known wallet types.
+func IsWalletType(walletType string) bool {
+	switch walletType {
+	case WalletTypeBank, WalletTypeCash, WalletTypeCreditCard, WalletTypeEWallet:
+		return true
+	}
+
+	return false
+}
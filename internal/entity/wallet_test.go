@@ -0,0 +1,41 @@
+package entity
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWalletType(t *testing.T) {
+	tests := []struct {
+		name       string
+		walletType string
+		want       bool
+	}{
+		{
+			name:       "when_type_unknown_then_return_false",
+			walletType: "crypto",
+		},
+		{
+			name:       "when_type_empty_then_return_false",
+			walletType: "",
+		},
+		{
+			name:       "when_type_is_e_wallet_then_return_true",
+			walletType: WalletTypeEWallet,
+			want:       true,
+		},
+		{
+			name:       "when_type_is_credit_card_then_return_true",
+			walletType: WalletTypeCreditCard,
+			want:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsWalletType(test.walletType))
+		})
+	}
+}
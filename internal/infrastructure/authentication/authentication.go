@@ -2,67 +2,278 @@ package authentication
 
 import (
 	// golang package
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	// external package
 	"github.com/golang-jwt/jwt"
 
 	// internal package
-	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
 )
 
-// configProvider holds all methods served by package configuration that will
+const (
+	bearerScheme = "Bearer"
+
+	// jwks responses may be cached by verifiers for this long
+	jwksCacheControl = "public, max-age=300"
+
+	// messages for unauthorized response
+	msgSessionRevoked          = "session revoked!"
+	msgSessionStoreUnavailable = "session store unavailable!"
+	msgTokenStoreUnavailable   = "token store unavailable!"
+	msgUnauthorized            = "unauthorized!"
+
+	// messages for forbidden response
+	msgInsufficientPermission = "insufficient permission!"
+	msgInsufficientScope      = "insufficient scope!"
+)
+
+var (
+	errClaimsInvalid = errors.New("token claims not valid")
+)
+
+//go:generate mockgen -source=authentication.go -destination=authentication_mock.go -package=authentication
+
+// keyringProvider holds all methods served by package keyring that will
 // be needed by package authentication
-type configProvider interface {
-	// GetConfig will get configuration that had been saved to memory.
-	GetConfig() *configuration.AppConfig
+type keyringProvider interface {
+	// JWKS returns public keys of the keyring as a JSON Web Key Set.
+	JWKS() keyring.JWKS
+
+	// VerificationKey will find the key that verifies the signature of token using its kid header.
+	VerificationKey(token *jwt.Token) (interface{}, error)
+}
+
+// sessionProvider holds all methods served by package account that will
+// be needed by package authentication to validate a session.
+type sessionProvider interface {
+	// AuthenticatePersonalAccessToken will find the user that owns a personal access token.
+	// It returns false when the token is unknown or already expired.
+	AuthenticatePersonalAccessToken(ctx context.Context, token string) (entity.Principal, bool, error)
+
+	// IsJWTActive will check whether the given JWT is still the active JWT of a session.
+	IsJWTActive(ctx context.Context, userID int64, sessionID, tokenID string) (bool, error)
+}
+
+// jwtClaims represents claims carried by a JWT issued by bubi.
+type jwtClaims struct {
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid"`
+	jwt.StandardClaims
+}
+
+// AuthParam holds all parameters needed to instantiate a new instance of Auth.
+type AuthParam struct {
+	Keyring keyringProvider
+	Session sessionProvider
 }
 
 type Auth struct {
-	cfg configProvider
+	keyring keyringProvider
+	session sessionProvider
 }
 
 // NewAuth will instantiate a new instance of Auth
-func NewAuth(cfg *configuration.Configuration) *Auth {
+func NewAuth(param AuthParam) *Auth {
 	return &Auth{
-		cfg: cfg,
+		keyring: param.Keyring,
+		session: param.Session,
 	}
 }
 
+// HandleJWKS will serve public keys used to verify JWTs issued by bubi
+// as a JSON Web Key Set, so other services can verify them.
+func (auth *Auth) HandleJWKS(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("Cache-Control", jwksCacheControl)
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+	json.NewEncoder(writer).Encode(auth.keyring.JWKS())
+}
+
 // JWTAuthorization will check authorization of a JWT.
+// A JWT is authorized when it is valid and still the active JWT of its session.
+// If the session store can't be reached, the request will be rejected.
+// If the JWT is authorized, the user identified by it will be injected
+// to request's context as entity.Principal.
 func (auth *Auth) JWTAuthorization(endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc {
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-		authHeader := request.Header["Authorization"]
-		if len(authHeader) == 0 {
+		authHeader := request.Header.Get("Authorization")
+		if authHeader == "" {
 			log.Printf("[JWTAuthorization] authorization empty\n")
-			writer.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(writer).Encode("unauthorized!")
+			writeUnauthorized(writer, msgUnauthorized)
+			return
+		}
+
+		sliced := strings.Fields(authHeader)
+		if len(sliced) != 2 || !strings.EqualFold(sliced[0], bearerScheme) {
+			log.Printf("[JWTAuthorization] authorization header malformed\n")
+			writeUnauthorized(writer, msgUnauthorized)
+			return
+		}
+
+		principal, err := auth.parseJWT(sliced[1])
+		if err != nil {
+			log.Printf("[JWTAuthorization] auth.parseJWT() got an error: %+v\n", err)
+			writeUnauthorized(writer, msgUnauthorized)
 			return
 		}
 
-		sliced := strings.Split(authHeader[0], " ")
-		token, err := jwt.Parse(sliced[1], func(token *jwt.Token) (interface{}, error) {
-			_, ok := token.Method.(*jwt.SigningMethodHMAC)
-			if !ok {
-				log.Printf("[JWTAuthorization] failed to parse token method\n")
-				writer.WriteHeader(http.StatusUnauthorized)
-				json.NewEncoder(writer).Encode("unauthorized!")
-				return nil, nil
+		meta := map[string]interface{}{
+			"user_id":    principal.UserID,
+			"session_id": principal.SessionID,
+			"token_id":   principal.TokenID,
+		}
+
+		isActive, err := auth.session.IsJWTActive(request.Context(), principal.UserID, principal.SessionID, principal.TokenID)
+		if err != nil {
+			log.Printf("[JWTAuthorization] auth.session.IsJWTActive() got an error: %+v\nMeta:%+v\n", err, meta)
+			writeUnauthorized(writer, msgSessionStoreUnavailable)
+			return
+		}
+
+		if !isActive {
+			log.Printf("[JWTAuthorization] session revoked\nMeta:%+v\n", meta)
+			writeUnauthorized(writer, msgSessionRevoked)
+			return
+		}
+
+		ctx := entity.NewContextWithPrincipal(request.Context(), principal)
+		endpointHandler(writer, request.WithContext(ctx))
+	})
+}
+
+// RequirePermission will check authorization of a JWT like JWTAuthorization,
+// then check whether the role carried by the JWT grants the given permission.
+// Personal access tokens are never accepted, since they don't carry a role.
+func (auth *Auth) RequirePermission(permission string, endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc {
+	return auth.JWTAuthorization(func(writer http.ResponseWriter, request *http.Request) {
+		principal, _ := entity.GetPrincipalFromContext(request.Context())
+		if !principal.HasPermission(permission) {
+			meta := map[string]interface{}{
+				"user_id":    principal.UserID,
+				"role":       principal.Role,
+				"permission": permission,
 			}
-			return []byte(auth.cfg.GetConfig().JWT.Secret), nil
-		})
+
+			log.Printf("[RequirePermission] insufficient permission\nMeta:%+v\n", meta)
+			writer.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(writer).Encode(msgInsufficientPermission)
+			return
+		}
+
+		endpointHandler(writer, request)
+	})
+}
+
+// TokenAuthorization will check authorization of either a personal access token or a JWT.
+// A bearer token carrying entity.PersonalAccessTokenPrefix is authorized when it is known,
+// not expired and has the scope needed by the request method: read for safe methods
+// and write for the rest. Any other bearer token is checked by JWTAuthorization.
+// If the token is authorized, the user identified by it will be injected
+// to request's context as entity.Principal.
+func (auth *Auth) TokenAuthorization(endpointHandler func(writer http.ResponseWriter, request *http.Request)) http.HandlerFunc {
+	jwtAuthorization := auth.JWTAuthorization(endpointHandler)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		sliced := strings.Fields(request.Header.Get("Authorization"))
+		if len(sliced) != 2 || !strings.EqualFold(sliced[0], bearerScheme) || !strings.HasPrefix(sliced[1], entity.PersonalAccessTokenPrefix) {
+			jwtAuthorization(writer, request)
+			return
+		}
+
+		principal, ok, err := auth.session.AuthenticatePersonalAccessToken(request.Context(), sliced[1])
 		if err != nil {
-			log.Printf("[JWTAuthorization] failed to parse jwt:%+v\n", err)
-			writer.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(writer).Encode("unauthorized!")
+			log.Printf("[TokenAuthorization] auth.session.AuthenticatePersonalAccessToken() got an error: %+v\n", err)
+			writeUnauthorized(writer, msgTokenStoreUnavailable)
 			return
 		}
 
-		if token.Valid {
-			endpointHandler(writer, request)
+		if !ok {
+			log.Printf("[TokenAuthorization] personal access token not valid\n")
+			writeUnauthorized(writer, msgUnauthorized)
+			return
 		}
+
+		scope := requiredScope(request.Method)
+		if !principal.HasScope(scope) {
+			meta := map[string]interface{}{
+				"user_id": principal.UserID,
+				"scope":   scope,
+			}
+
+			log.Printf("[TokenAuthorization] insufficient scope\nMeta:%+v\n", meta)
+			writer.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(writer).Encode(msgInsufficientScope)
+			return
+		}
+
+		ctx := entity.NewContextWithPrincipal(request.Context(), principal)
+		endpointHandler(writer, request.WithContext(ctx))
 	})
 }
+
+// parseJWT will verify the signature and claims of a JWT using the keyring,
+// then convert the claims into entity.Principal.
+func (auth *Auth) parseJWT(tokenString string) (entity.Principal, error) {
+	var claims jwtClaims
+	_, err := jwt.ParseWithClaims(tokenString, &claims, auth.keyring.VerificationKey)
+	if err != nil {
+		return entity.Principal{}, err
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return entity.Principal{}, fmt.Errorf("%w: sub", errClaimsInvalid)
+	}
+
+	if claims.Email == "" {
+		return entity.Principal{}, fmt.Errorf("%w: email", errClaimsInvalid)
+	}
+
+	if claims.IssuedAt == 0 {
+		return entity.Principal{}, fmt.Errorf("%w: iat", errClaimsInvalid)
+	}
+
+	if claims.Id == "" {
+		return entity.Principal{}, fmt.Errorf("%w: jti", errClaimsInvalid)
+	}
+
+	if claims.SessionID == "" {
+		return entity.Principal{}, fmt.Errorf("%w: sid", errClaimsInvalid)
+	}
+
+	return entity.Principal{
+		Email:     claims.Email,
+		IssuedAt:  time.Unix(claims.IssuedAt, 0),
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		TokenID:   claims.Id,
+		UserID:    userID,
+	}, nil
+}
+
+// requiredScope returns the scope a personal access token needs to make a request with method.
+func requiredScope(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return entity.ScopeRead
+	}
+
+	return entity.ScopeWrite
+}
+
+// writeUnauthorized will write an unauthorized response with the given message.
+func writeUnauthorized(writer http.ResponseWriter, message string) {
+	writer.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(writer).Encode(message)
+}
@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: authentication.go
+
+// Package authentication is a generated GoMock package.
+package authentication
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	keyring "github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
+	jwt "github.com/golang-jwt/jwt"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockkeyringProvider is a mock of keyringProvider interface.
+type MockkeyringProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyringProviderMockRecorder
+}
+
+// MockkeyringProviderMockRecorder is the mock recorder for MockkeyringProvider.
+type MockkeyringProviderMockRecorder struct {
+	mock *MockkeyringProvider
+}
+
+// NewMockkeyringProvider creates a new mock instance.
+func NewMockkeyringProvider(ctrl *gomock.Controller) *MockkeyringProvider {
+	mock := &MockkeyringProvider{ctrl: ctrl}
+	mock.recorder = &MockkeyringProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyringProvider) EXPECT() *MockkeyringProviderMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockkeyringProvider) JWKS() keyring.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(keyring.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockkeyringProviderMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockkeyringProvider)(nil).JWKS))
+}
+
+// VerificationKey mocks base method.
+func (m *MockkeyringProvider) VerificationKey(token *jwt.Token) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerificationKey", token)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerificationKey indicates an expected call of VerificationKey.
+func (mr *MockkeyringProviderMockRecorder) VerificationKey(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationKey", reflect.TypeOf((*MockkeyringProvider)(nil).VerificationKey), token)
+}
+
+// MocksessionProvider is a mock of sessionProvider interface.
+type MocksessionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MocksessionProviderMockRecorder
+}
+
+// MocksessionProviderMockRecorder is the mock recorder for MocksessionProvider.
+type MocksessionProviderMockRecorder struct {
+	mock *MocksessionProvider
+}
+
+// NewMocksessionProvider creates a new mock instance.
+func NewMocksessionProvider(ctrl *gomock.Controller) *MocksessionProvider {
+	mock := &MocksessionProvider{ctrl: ctrl}
+	mock.recorder = &MocksessionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksessionProvider) EXPECT() *MocksessionProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthenticatePersonalAccessToken mocks base method.
+func (m *MocksessionProvider) AuthenticatePersonalAccessToken(ctx context.Context, token string) (entity.Principal, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatePersonalAccessToken", ctx, token)
+	ret0, _ := ret[0].(entity.Principal)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticatePersonalAccessToken indicates an expected call of AuthenticatePersonalAccessToken.
+func (mr *MocksessionProviderMockRecorder) AuthenticatePersonalAccessToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePersonalAccessToken", reflect.TypeOf((*MocksessionProvider)(nil).AuthenticatePersonalAccessToken), ctx, token)
+}
+
+// IsJWTActive mocks base method.
+func (m *MocksessionProvider) IsJWTActive(ctx context.Context, userID int64, sessionID, tokenID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsJWTActive", ctx, userID, sessionID, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsJWTActive indicates an expected call of IsJWTActive.
+func (mr *MocksessionProviderMockRecorder) IsJWTActive(ctx, userID, sessionID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsJWTActive", reflect.TypeOf((*MocksessionProvider)(nil).IsJWTActive), ctx, userID, sessionID, tokenID)
+}
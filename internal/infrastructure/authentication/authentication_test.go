@@ -0,0 +1,547 @@
+package authentication
+
+import (
+	// golang package
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/keyring"
+)
+
+func TestNewAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockKeyring := NewMockkeyringProvider(ctrl)
+	mockSession := NewMocksessionProvider(ctrl)
+
+	want := &Auth{
+		keyring: mockKeyring,
+		session: mockSession,
+	}
+
+	got := NewAuth(AuthParam{
+		Keyring: mockKeyring,
+		Session: mockSession,
+	})
+	assert.Equal(t, want, got)
+}
+
+func TestAuth_HandleJWKS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockKeyring := NewMockkeyringProvider(ctrl)
+
+	want := keyring.JWKS{
+		Keys: []keyring.JWK{
+			{
+				Alg: "EdDSA",
+				Crv: "Ed25519",
+				Kid: "key-1",
+				Kty: "OKP",
+				Use: "sig",
+				X:   "x",
+			},
+		},
+	}
+	mockKeyring.EXPECT().JWKS().Return(want)
+
+	auth := &Auth{
+		keyring: mockKeyring,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	w := httptest.NewRecorder()
+	auth.HandleJWKS(w, req)
+
+	var got keyring.JWKS
+	json.NewDecoder(w.Body).Decode(&got)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, want, got)
+}
+
+func TestAuth_JWTAuthorization(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, anotherPrivateKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	now := time.Now()
+	signToken := func(key ed25519.PrivateKey, claims jwt.MapClaims) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(key)
+		return token
+	}
+
+	type mockFields struct {
+		keyring *MockkeyringProvider
+		session *MocksessionProvider
+	}
+
+	validClaims := jwt.MapClaims{
+		"email": "email",
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"jti":   "jti",
+		"role":  entity.RoleUser,
+		"sid":   "session",
+		"sub":   "123",
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		mockFields    func(mockFields)
+		wantCode      int
+		wantPrincipal entity.Principal
+	}{
+		{
+			name:          "when_authorization_empty_then_return_unauthorized",
+			authorization: "",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_authorization_malformed_then_return_unauthorized",
+			authorization: "token",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_signature_invalid_then_return_unauthorized",
+			authorization: "Bearer " + signToken(anotherPrivateKey, validClaims),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_token_expired_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"email": "email",
+				"exp":   now.Add(-time.Hour).Unix(),
+				"iat":   now.Add(-2 * time.Hour).Unix(),
+				"jti":   "jti",
+				"sid":   "session",
+				"sub":   "123",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_sub_claim_missing_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"email": "email",
+				"exp":   now.Add(time.Hour).Unix(),
+				"iat":   now.Unix(),
+				"jti":   "jti",
+				"sid":   "session",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_email_claim_missing_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"exp": now.Add(time.Hour).Unix(),
+				"iat": now.Unix(),
+				"jti": "jti",
+				"sid": "session",
+				"sub": "123",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_iat_claim_missing_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"email": "email",
+				"exp":   now.Add(time.Hour).Unix(),
+				"jti":   "jti",
+				"sid":   "session",
+				"sub":   "123",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_jti_claim_missing_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"email": "email",
+				"exp":   now.Add(time.Hour).Unix(),
+				"iat":   now.Unix(),
+				"sid":   "session",
+				"sub":   "123",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_sid_claim_missing_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, jwt.MapClaims{
+				"email": "email",
+				"exp":   now.Add(time.Hour).Unix(),
+				"iat":   now.Unix(),
+				"jti":   "jti",
+				"sub":   "123",
+			}),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_IsJWTActive_error_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, validClaims),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(false, assert.AnError)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_session_revoked_then_return_unauthorized",
+			authorization: "Bearer " + signToken(privateKey, validClaims),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(false, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_token_valid_then_inject_principal_to_context",
+			authorization: "Bearer " + signToken(privateKey, validClaims),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(true, nil)
+			},
+			wantCode: http.StatusOK,
+			wantPrincipal: entity.Principal{
+				Email:     "email",
+				IssuedAt:  time.Unix(now.Unix(), 0),
+				Role:      entity.RoleUser,
+				SessionID: "session",
+				TokenID:   "jti",
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				keyring: NewMockkeyringProvider(ctrl),
+				session: NewMocksessionProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			auth := &Auth{
+				keyring: mockFields.keyring,
+				session: mockFields.session,
+			}
+
+			var gotPrincipal entity.Principal
+			handler := auth.JWTAuthorization(func(writer http.ResponseWriter, request *http.Request) {
+				gotPrincipal, _ = entity.GetPrincipalFromContext(request.Context())
+				writer.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler(w, req)
+
+			assert.Equal(t, test.wantCode, w.Code)
+			assert.Equal(t, test.wantPrincipal, gotPrincipal)
+		})
+	}
+}
+
+func TestAuth_RequirePermission(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	now := time.Now()
+	signToken := func(role string) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"email": "email",
+			"exp":   now.Add(time.Hour).Unix(),
+			"iat":   now.Unix(),
+			"jti":   "jti",
+			"role":  role,
+			"sid":   "session",
+			"sub":   "123",
+		}).SignedString(privateKey)
+		return token
+	}
+
+	type mockFields struct {
+		keyring *MockkeyringProvider
+		session *MocksessionProvider
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		mockFields    func(mockFields)
+		wantCode      int
+		wantPrincipal entity.Principal
+	}{
+		{
+			name:          "when_authorization_empty_then_return_unauthorized",
+			authorization: "",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_personal_access_token_used_then_return_unauthorized",
+			authorization: "Bearer " + entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil).AnyTimes()
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_role_lacks_permission_then_return_forbidden",
+			authorization: "Bearer " + signToken(entity.RoleSupport),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(true, nil)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "when_role_missing_then_return_forbidden",
+			authorization: "Bearer " + signToken(""),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(true, nil)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "when_role_has_permission_then_inject_principal_to_context",
+			authorization: "Bearer " + signToken(entity.RoleAdmin),
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(true, nil)
+			},
+			wantCode: http.StatusOK,
+			wantPrincipal: entity.Principal{
+				Email:     "email",
+				IssuedAt:  time.Unix(now.Unix(), 0),
+				Role:      entity.RoleAdmin,
+				SessionID: "session",
+				TokenID:   "jti",
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				keyring: NewMockkeyringProvider(ctrl),
+				session: NewMocksessionProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			auth := &Auth{
+				keyring: mockFields.keyring,
+				session: mockFields.session,
+			}
+
+			var gotPrincipal entity.Principal
+			handler := auth.RequirePermission(entity.PermissionAccountDisable, func(writer http.ResponseWriter, request *http.Request) {
+				gotPrincipal, _ = entity.GetPrincipalFromContext(request.Context())
+				writer.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler(w, req)
+
+			assert.Equal(t, test.wantCode, w.Code)
+			assert.Equal(t, test.wantPrincipal, gotPrincipal)
+		})
+	}
+}
+
+func TestAuth_TokenAuthorization(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	now := time.Now()
+	jwtToken, _ := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"email": "email",
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"jti":   "jti",
+		"sid":   "session",
+		"sub":   "123",
+	}).SignedString(privateKey)
+
+	type mockFields struct {
+		keyring *MockkeyringProvider
+		session *MocksessionProvider
+	}
+
+	mockPAT := entity.PersonalAccessTokenPrefix + "token"
+	readPrincipal := entity.Principal{
+		Email:    "email",
+		IssuedAt: now,
+		Scopes:   []string{entity.ScopeRead},
+		UserID:   123,
+	}
+	writePrincipal := entity.Principal{
+		Email:    "email",
+		IssuedAt: now,
+		Scopes:   []string{entity.ScopeWrite},
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		mockFields    func(mockFields)
+		wantCode      int
+		wantPrincipal entity.Principal
+	}{
+		{
+			name:          "when_authorization_empty_then_return_unauthorized",
+			method:        http.MethodGet,
+			authorization: "",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_AuthenticatePersonalAccessToken_error_then_return_unauthorized",
+			method:        http.MethodGet,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(entity.Principal{}, false, assert.AnError)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_personal_access_token_not_valid_then_return_unauthorized",
+			method:        http.MethodGet,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(entity.Principal{}, false, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "when_read_scope_used_to_write_then_return_forbidden",
+			method:        http.MethodPatch,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(readPrincipal, true, nil)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "when_read_scope_used_to_read_then_inject_principal_to_context",
+			method:        http.MethodGet,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(readPrincipal, true, nil)
+			},
+			wantCode:      http.StatusOK,
+			wantPrincipal: readPrincipal,
+		},
+		{
+			name:          "when_write_scope_used_to_read_then_inject_principal_to_context",
+			method:        http.MethodGet,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(writePrincipal, true, nil)
+			},
+			wantCode:      http.StatusOK,
+			wantPrincipal: writePrincipal,
+		},
+		{
+			name:          "when_write_scope_used_to_write_then_inject_principal_to_context",
+			method:        http.MethodPatch,
+			authorization: "Bearer " + mockPAT,
+			mockFields: func(mf mockFields) {
+				mf.session.EXPECT().AuthenticatePersonalAccessToken(gomock.Any(), mockPAT).Return(writePrincipal, true, nil)
+			},
+			wantCode:      http.StatusOK,
+			wantPrincipal: writePrincipal,
+		},
+		{
+			name:          "when_jwt_valid_then_inject_principal_to_context",
+			method:        http.MethodPatch,
+			authorization: "Bearer " + jwtToken,
+			mockFields: func(mf mockFields) {
+				mf.keyring.EXPECT().VerificationKey(gomock.Any()).Return(publicKey, nil)
+				mf.session.EXPECT().IsJWTActive(gomock.Any(), int64(123), "session", "jti").Return(true, nil)
+			},
+			wantCode: http.StatusOK,
+			wantPrincipal: entity.Principal{
+				Email:     "email",
+				IssuedAt:  time.Unix(now.Unix(), 0),
+				SessionID: "session",
+				TokenID:   "jti",
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				keyring: NewMockkeyringProvider(ctrl),
+				session: NewMocksessionProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			auth := &Auth{
+				keyring: mockFields.keyring,
+				session: mockFields.session,
+			}
+
+			var gotPrincipal entity.Principal
+			handler := auth.TokenAuthorization(func(writer http.ResponseWriter, request *http.Request) {
+				gotPrincipal, _ = entity.GetPrincipalFromContext(request.Context())
+				writer.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(test.method, "/", nil)
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler(w, req)
+
+			assert.Equal(t, test.wantCode, w.Code)
+			assert.Equal(t, test.wantPrincipal, gotPrincipal)
+		})
+	}
+}
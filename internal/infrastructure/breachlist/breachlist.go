@@ -0,0 +1,192 @@
+package breachlist
+
+import (
+	// golang package
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+// rangePrefixLength is the length of the hex prefix of SHA-1 hashes
+// a k-anonymity range of a Pwned Passwords dump is named by.
+const rangePrefixLength = 5
+
+var (
+	errDirInvalid  = errors.New("breached password dir is not a directory")
+	errHashInvalid = errors.New("breached password hash not valid")
+
+	// for mocking purpose
+	osOpen = os.Open
+	osStat = os.Stat
+)
+
+//go:generate mockgen -source=breachlist.go -destination=breachlist_mock.go -package=breachlist
+
+// configProvider holds all methods served by package configuration that will
+// be needed by package breachlist
+type configProvider interface {
+	// GetConfig will get configuration that had been saved to memory.
+	GetConfig() *configuration.AppConfig
+}
+
+// BreachListParam holds all parameters needed to instantiate a new instance of BreachList.
+type BreachListParam struct {
+	Config configProvider
+}
+
+// BreachList holds SHA-1 hashes of passwords that were exposed in known breaches.
+// Only hashes are kept, so the list never holds a password in plain text.
+// Hashes of the breached password file are kept in memory, while the breached password dir
+// is read one k-anonymity range at a time when a password is checked.
+type BreachList struct {
+	dir    string
+	hashes map[[sha1.Size]byte]struct{}
+}
+
+// NewBreachList will instantiate a new instance of BreachList
+// using the file and the dir set in password policy configuration.
+// If neither is configured, the list is empty.
+// It returns an error when the file can't be read or holds an invalid hash,
+// or when the dir isn't a directory.
+func NewBreachList(param BreachListParam) (*BreachList, error) {
+	policy := param.Config.GetConfig().Account.Password.Policy
+
+	list := &BreachList{
+		dir:    policy.BreachedPasswordDir,
+		hashes: make(map[[sha1.Size]byte]struct{}),
+	}
+
+	path := policy.BreachedPasswordFile
+	meta := map[string]interface{}{
+		"dir":  list.dir,
+		"path": path,
+	}
+
+	if list.dir != "" {
+		info, err := osStat(list.dir)
+		if err != nil {
+			log.Printf("[NewBreachList] osStat() got an error: %+v\nMeta:%+v\n", err, meta)
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			log.Printf("[NewBreachList] breached password dir is not a directory\nMeta:%+v\n", meta)
+			return nil, errDirInvalid
+		}
+	}
+
+	if path == "" {
+		return list, nil
+	}
+
+	file, err := osOpen(path)
+	if err != nil {
+		log.Printf("[NewBreachList] osOpen() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		hash, err := parseHash(line)
+		if err != nil {
+			log.Printf("[NewBreachList] parseHash() got an error: %+v\nMeta:%+v\n", err, meta)
+			return nil, err
+		}
+
+		list.hashes[hash] = struct{}{}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		log.Printf("[NewBreachList] scanner.Err() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// IsBreached will check whether password was exposed in a known breach.
+// A range of the breached password dir that can't be read is logged and treated as not breached,
+// so a broken dump doesn't refuse every password.
+func (bl *BreachList) IsBreached(password string) bool {
+	hash := sha1.Sum([]byte(password))
+	if _, ok := bl.hashes[hash]; ok {
+		return true
+	}
+
+	if bl.dir == "" {
+		return false
+	}
+
+	breached, err := bl.isInRange(hash)
+	if err != nil {
+		meta := map[string]interface{}{
+			"dir": bl.dir,
+		}
+
+		log.Printf("[IsBreached] bl.isInRange() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false
+	}
+
+	return breached
+}
+
+// isInRange will look for hash in the k-anonymity range of the breached password dir it belongs to,
+// e.g. 1E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493 in 5BAA6.txt.
+// A range without a file holds no hash.
+func (bl *BreachList) isInRange(hash [sha1.Size]byte) (bool, error) {
+	hexHash := strings.ToUpper(hex.EncodeToString(hash[:]))
+	prefix, suffix := hexHash[:rangePrefixLength], hexHash[rangePrefixLength:]
+
+	file, err := osOpen(filepath.Join(bl.dir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineSuffix, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(lineSuffix, suffix) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+// parseHash will parse a line of the breached password file,
+// e.g. 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493.
+func parseHash(line string) ([sha1.Size]byte, error) {
+	var hash [sha1.Size]byte
+
+	hexHash, _, _ := strings.Cut(line, ":")
+	if len(hexHash) != hex.EncodedLen(sha1.Size) {
+		return hash, errHashInvalid
+	}
+
+	_, err := hex.Decode(hash[:], []byte(hexHash))
+	if err != nil {
+		return hash, errHashInvalid
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,256 @@
+package breachlist
+
+import (
+	// golang package
+	"crypto/sha1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+// SHA-1 of "password" and "123456".
+const (
+	hashPassword = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+	hash123456   = "7c4a8d09ca3762af61e59520943dc26494f8941b"
+)
+
+func TestNewBreachList(t *testing.T) {
+	osOpenOri := osOpen
+	osStatOri := osStat
+	defer func() {
+		osOpen = osOpenOri
+		osStat = osStatOri
+	}()
+
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "breached.txt")
+		err := os.WriteFile(path, []byte(content), 0o600)
+		assert.Nil(t, err)
+		return path
+	}
+
+	tests := []struct {
+		name       string
+		dir        func(t *testing.T) string
+		path       func(t *testing.T) string
+		osOpen     func(name string) (*os.File, error)
+		osStat     func(name string) (os.FileInfo, error)
+		wantDir    bool
+		wantHashes int
+		wantErr    error
+	}{
+		{
+			name: "when_file_not_configured_then_return_empty_list",
+			path: func(t *testing.T) string {
+				return ""
+			},
+		},
+		{
+			name: "when_osStat_error_then_return_error",
+			dir: func(t *testing.T) string {
+				return "breached"
+			},
+			path: func(t *testing.T) string {
+				return ""
+			},
+			osStat: func(name string) (os.FileInfo, error) {
+				return nil, assert.AnError
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_dir_not_directory_then_return_error",
+			dir: func(t *testing.T) string {
+				return writeFile(t, hashPassword+"\n")
+			},
+			path: func(t *testing.T) string {
+				return ""
+			},
+			wantErr: errDirInvalid,
+		},
+		{
+			name: "when_dir_configured_then_return_list_reading_the_dir",
+			dir: func(t *testing.T) string {
+				return t.TempDir()
+			},
+			path: func(t *testing.T) string {
+				return ""
+			},
+			wantDir: true,
+		},
+		{
+			name: "when_osOpen_error_then_return_error",
+			path: func(t *testing.T) string {
+				return "breached.txt"
+			},
+			osOpen: func(name string) (*os.File, error) {
+				return nil, assert.AnError
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_hash_length_invalid_then_return_error",
+			path: func(t *testing.T) string {
+				return writeFile(t, "5BAA61E4:3\n")
+			},
+			wantErr: errHashInvalid,
+		},
+		{
+			name: "when_hash_not_hex_then_return_error",
+			path: func(t *testing.T) string {
+				return writeFile(t, "ZBAA61E4C9B93F3F0682250B6CF8331B7EE68FD8\n")
+			},
+			wantErr: errHashInvalid,
+		},
+		{
+			name: "when_no_error_occured_then_return_list",
+			path: func(t *testing.T) string {
+				return writeFile(t, hashPassword+":3861493\n\n"+hash123456+"\n")
+			},
+			wantHashes: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			osOpen = osOpenOri
+			if test.osOpen != nil {
+				osOpen = test.osOpen
+			}
+
+			osStat = osStatOri
+			if test.osStat != nil {
+				osStat = test.osStat
+			}
+
+			var dir string
+			if test.dir != nil {
+				dir = test.dir(t)
+			}
+
+			ctrl := gomock.NewController(t)
+			mockConfig := NewMockconfigProvider(ctrl)
+			mockConfig.EXPECT().GetConfig().Return(&configuration.AppConfig{
+				Account: configuration.AccountConfig{
+					Password: configuration.PasswordConfig{
+						Policy: configuration.PasswordPolicyConfig{
+							BreachedPasswordDir:  dir,
+							BreachedPasswordFile: test.path(t),
+						},
+					},
+				},
+			})
+
+			got, err := NewBreachList(BreachListParam{
+				Config: mockConfig,
+			})
+			assert.Equal(t, test.wantErr, err)
+			if test.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.Len(t, got.hashes, test.wantHashes)
+			assert.Equal(t, test.wantDir, got.dir != "")
+		})
+	}
+}
+
+func TestBreachList_IsBreached(t *testing.T) {
+	hash, err := parseHash(hashPassword)
+	assert.Nil(t, err)
+
+	bl := &BreachList{
+		hashes: map[[sha1.Size]byte]struct{}{
+			hash: {},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{
+			name:     "when_password_breached_then_return_true",
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "when_password_not_breached_then_return_false",
+			password: "correct horse battery staple",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := bl.IsBreached(test.password)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestBreachList_IsBreached_range(t *testing.T) {
+	osOpenOri := osOpen
+	defer func() {
+		osOpen = osOpenOri
+	}()
+
+	// the range of "password" holds its suffix, in lowercase to match case-insensitively.
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\n1e4c9b93f3f0682250b6cf8331b7ee68fd8:3861493\n"), 0o600)
+	assert.Nil(t, err)
+
+	// the range of "123456" doesn't hold its suffix, while the range of "password1" has no file.
+	err = os.WriteFile(filepath.Join(dir, "7C4A8.txt"), []byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\n"), 0o600)
+	assert.Nil(t, err)
+
+	bl := &BreachList{
+		dir:    dir,
+		hashes: map[[sha1.Size]byte]struct{}{},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		osOpen   func(name string) (*os.File, error)
+		want     bool
+	}{
+		{
+			name:     "when_suffix_in_range_then_return_true",
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "when_suffix_not_in_range_then_return_false",
+			password: "123456",
+		},
+		{
+			name:     "when_range_has_no_file_then_return_false",
+			password: "password1",
+		},
+		{
+			name:     "when_osOpen_error_then_return_false",
+			password: "password",
+			osOpen: func(name string) (*os.File, error) {
+				return nil, assert.AnError
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			osOpen = osOpenOri
+			if test.osOpen != nil {
+				osOpen = test.osOpen
+			}
+
+			got := bl.IsBreached(test.password)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
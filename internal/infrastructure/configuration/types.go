@@ -5,6 +5,7 @@ type AppConfig struct {
 	Account  AccountConfig  `mapstructure:"account"`
 	Database DatabaseConfig `mapstructure:"database"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
+	Mailer   MailerConfig   `mapstructure:"mailer"`
 	Redis    RedisConfig    `mapstructure:"redis"`
 }
 
@@ -23,6 +24,24 @@ type DatabaseConfig struct {
 	DefaultTimeout int    `mapstructure:"default_timeout_in_seconds"`
 }
 
+// MailerConfig holds configuration related with mailer.
+// Driver is either "smtp" to send email through SMTP server
+// or "outbox" to write email as file to OutboxDir for local and test runs.
+type MailerConfig struct {
+	Driver    string     `mapstructure:"driver"`
+	From      string     `mapstructure:"from"`
+	OutboxDir string     `mapstructure:"outbox_dir"`
+	SMTP      SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig holds configuration related with SMTP server
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Password string `mapstructure:"password"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+}
+
 // DatabaseConfig holds configuration related with redis
 type RedisConfig struct {
 	Address  string `mapstructure:"address"`
@@ -34,10 +53,147 @@ type RedisConfig struct {
 // ----------------------
 
 type AccountConfig struct {
+	// DeletionGracePeriod is how long an account stays pending deletion before it's purged.
+	// Logging in during this period cancels the deletion.
+	DeletionGracePeriod int `mapstructure:"deletion_grace_period_in_hours"`
+
+	// DeletionPurgeInterval is how often accounts whose grace period has passed are purged.
+	DeletionPurgeInterval int `mapstructure:"deletion_purge_interval_in_minutes"`
+
+	// EmailChangeTTL is lifetime of an email change confirmation token.
+	EmailChangeTTL int `mapstructure:"email_change_ttl_in_minutes"`
+
+	// EmailChangeURL is the page that will receive the email change confirmation token
+	// as its "token" query parameter, and post it to /account/email/confirm.
+	EmailChangeURL string `mapstructure:"email_change_url"`
+
+	// EmailVerificationResendCooldown is the minimum gap between two verification emails of a user.
+	EmailVerificationResendCooldown int `mapstructure:"email_verification_resend_cooldown_in_seconds"`
+
+	// EmailVerificationTTL is lifetime of an email verification token.
+	EmailVerificationTTL int `mapstructure:"email_verification_ttl_in_minutes"`
+
+	// EmailVerificationURL is the endpoint that will receive the email verification token
+	// as its "token" query parameter.
+	EmailVerificationURL string `mapstructure:"email_verification_url"`
+
 	ExpiredTimeInHour int `mapstructure:"expired_times_in_hour"`
+
+	// MagicLinkCooldown is the minimum gap between two magic link emails of a user.
+	MagicLinkCooldown int `mapstructure:"magic_link_cooldown_in_seconds"`
+
+	// MagicLinkTTL is lifetime of a magic link login token.
+	MagicLinkTTL int `mapstructure:"magic_link_ttl_in_minutes"`
+
+	// MagicLinkURL is the page that will receive the magic link login token
+	// as its "token" query parameter.
+	MagicLinkURL string `mapstructure:"magic_link_url"`
+
+	// PasswordResetCooldown is the minimum gap between two password reset emails of a user.
+	PasswordResetCooldown int `mapstructure:"password_reset_cooldown_in_seconds"`
+
+	// PasswordResetTTL is lifetime of a password reset token.
+	PasswordResetTTL int `mapstructure:"password_reset_ttl_in_minutes"`
+
+	// PasswordResetURL is the page that will receive the password reset token
+	// as its "token" query parameter.
+	PasswordResetURL string `mapstructure:"password_reset_url"`
+
+	// RequireVerifiedEmail makes log in refuse accounts whose email is not verified yet.
+	RequireVerifiedEmail bool `mapstructure:"require_verified_email"`
+
+	Password PasswordConfig `mapstructure:"password"`
+
+	Security SecurityConfig `mapstructure:"security"`
+
+	TOTP TOTPConfig `mapstructure:"totp"`
+}
+
+// PasswordConfig holds configuration related with password hashing and password policy.
+// New passwords are hashed with argon2id using the Argon2 parameters. A stored hash that was made
+// with bcrypt or with other parameters is rehashed on the next successful password check.
+// A zero Argon2 parameter falls back to its default.
+type PasswordConfig struct {
+	Argon2Iterations  int `mapstructure:"argon2_iterations"`
+	Argon2KeyLength   int `mapstructure:"argon2_key_length"`
+	Argon2Memory      int `mapstructure:"argon2_memory_in_kib"`
+	Argon2Parallelism int `mapstructure:"argon2_parallelism"`
+	Argon2SaltLength  int `mapstructure:"argon2_salt_length"`
+
+	Policy PasswordPolicyConfig `mapstructure:"policy"`
+}
+
+// PasswordPolicyConfig holds rules a new password has to follow.
+// A zero rule is disabled.
+type PasswordPolicyConfig struct {
+	// BreachedPasswordDir is a Pwned Passwords dump split by k-anonymity range: one file per
+	// 5 character hex prefix of the SHA-1 hashes, named "<PREFIX>.txt", holding the remaining 35 characters
+	// of each hash per line, optionally followed by ":<count>". Only the file of the prefix of a password
+	// is read when the password is checked, so the dump is never loaded into memory.
+	BreachedPasswordDir string `mapstructure:"breached_password_dir"`
+
+	// BreachedPasswordFile is a list of full 40 character SHA-1 hashes of breached passwords, one hash per line.
+	// A line may be followed by ":<count>". The list is loaded into memory and a line in any other format
+	// is refused at startup, so it's meant for a short list; use BreachedPasswordDir for a full dump.
+	BreachedPasswordFile string `mapstructure:"breached_password_file"`
+
+	// MinCharacterClasses is the minimum number of character classes,
+	// out of lowercase, uppercase, digit and symbol, a password has to use.
+	MinCharacterClasses int `mapstructure:"min_character_classes"`
+
+	// MinEntropy is the minimum estimated entropy of a password in bits,
+	// based on its length and the character classes it uses.
+	MinEntropy int `mapstructure:"min_entropy_in_bits"`
+
+	MinLength int `mapstructure:"min_length"`
+}
+
+// TOTPConfig holds configuration related with TOTP two-factor authentication.
+type TOTPConfig struct {
+	// EncryptionKey is a base64 encoded 32 bytes key used to encrypt TOTP secrets at rest.
+	EncryptionKey string `mapstructure:"encryption_key"`
+
+	// Issuer is the name shown by authenticator apps next to the account.
+	Issuer string `mapstructure:"issuer"`
+
+	// MFAChallengeTTL is lifetime of the challenge token returned by log in
+	// while waiting for a TOTP code.
+	MFAChallengeTTL int `mapstructure:"mfa_challenge_ttl_in_seconds"`
+
+	// RecoveryCodeCount is the number of recovery codes issued on enrollment.
+	RecoveryCodeCount int `mapstructure:"recovery_code_count"`
+}
+
+// SecurityConfig holds configuration related with brute-force protection of log in.
+// Failed attempts are counted per email and per client IP. Once the count passes
+// BackoffAfterAttempts, further attempts are refused for an exponentially growing
+// backoff. Once the count reaches MaxFailedAttempts, attempts are refused for LockoutDuration.
+// A zero MaxFailedAttempts disables lockout and a zero BackoffBase disables backoff.
+type SecurityConfig struct {
+	BackoffAfterAttempts int `mapstructure:"backoff_after_attempts"`
+	BackoffBase          int `mapstructure:"backoff_base_in_seconds"`
+	BackoffMax           int `mapstructure:"backoff_max_in_seconds"`
+	FailedAttemptWindow  int `mapstructure:"failed_attempt_window_in_seconds"`
+	LockoutDuration      int `mapstructure:"lockout_duration_in_seconds"`
+	MaxFailedAttempts    int `mapstructure:"max_failed_attempts"`
 }
 
+// JWTConfig holds configuration related with JWT issued by bubi.
+// JWTs are signed with the key whose ID is ActiveKeyID. The other keys are retired keys,
+// which are only used to verify JWTs that were signed before the active key was rotated.
 type JWTConfig struct {
-	Secret string `mapstructure:"secret"`
-	TTL    int    `mapstructure:"ttl_in_seconds"`
+	ActiveKeyID string         `mapstructure:"active_key_id"`
+	Keys        []JWTKeyConfig `mapstructure:"keys"`
+	TTL         int            `mapstructure:"ttl_in_seconds"`
+}
+
+// JWTKeyConfig holds a key used to sign or verify JWT.
+// Algorithm is either RS256 or EdDSA, and ID is sent as the kid header of JWT.
+// The key is PEM encoded, given either inline through PEM or as a file through PEMFile.
+// The active key must be a private key, while a retired key may be a public key.
+type JWTKeyConfig struct {
+	Algorithm string `mapstructure:"algorithm"`
+	ID        string `mapstructure:"id"`
+	PEM       string `mapstructure:"pem"`
+	PEMFile   string `mapstructure:"pem_file"`
 }
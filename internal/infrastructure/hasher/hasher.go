@@ -0,0 +1,213 @@
+package hasher
+
+import (
+	// golang package
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	// external package
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+const (
+	algorithmArgon2ID = "argon2id"
+
+	defaultArgon2Iterations  = 2
+	defaultArgon2KeyLength   = 32
+	defaultArgon2Memory      = 19 * 1024
+	defaultArgon2Parallelism = 1
+	defaultArgon2SaltLength  = 16
+)
+
+var (
+	// ErrHashInvalid is returned when a stored hash isn't in a supported format.
+	ErrHashInvalid = errors.New("password hash not valid")
+
+	// ErrPasswordMismatch is returned when a password doesn't match its hash.
+	ErrPasswordMismatch = errors.New("password doesn't match")
+
+	// for mocking purpose
+	randRead = rand.Read
+)
+
+//go:generate mockgen -source=hasher.go -destination=hasher_mock.go -package=hasher
+
+// configProvider holds all methods served by package configuration that will
+// be needed by package hasher
+type configProvider interface {
+	// GetConfig will get configuration that had been saved to memory.
+	GetConfig() *configuration.AppConfig
+}
+
+// argon2Params holds parameters of an argon2id hash.
+type argon2Params struct {
+	iterations  uint32
+	keyLength   uint32
+	memory      uint32
+	parallelism uint8
+	saltLength  uint32
+}
+
+// HasherParam holds all parameters needed to instantiate a new instance of Hasher.
+type HasherParam struct {
+	Config configProvider
+}
+
+type Hasher struct {
+	cfg configProvider
+}
+
+// NewHasher will instantiate a new instance of Hasher.
+func NewHasher(param HasherParam) *Hasher {
+	return &Hasher{
+		cfg: param.Config,
+	}
+}
+
+// ComparePassword will check whether password matches hash.
+// Both argon2id hashes in PHC format and bcrypt hashes are supported.
+// It returns ErrPasswordMismatch if the password doesn't match.
+func (h *Hasher) ComparePassword(hash, password string) error {
+	if isBcrypt(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrPasswordMismatch
+		}
+
+		return err
+	}
+
+	params, salt, key, err := decodeArgon2ID(hash)
+	if err != nil {
+		return err
+	}
+
+	derived := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, params.keyLength)
+	if subtle.ConstantTimeCompare(derived, key) != 1 {
+		return ErrPasswordMismatch
+	}
+
+	return nil
+}
+
+// HashPassword will hash password with argon2id using the configured parameters.
+// The hash is encoded in PHC format, so its parameters travel with it.
+func (h *Hasher) HashPassword(password string) (string, error) {
+	params := h.params()
+
+	salt := make([]byte, params.saltLength)
+	_, err := randRead(salt)
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, params.keyLength)
+
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		algorithmArgon2ID,
+		argon2.Version,
+		params.memory,
+		params.iterations,
+		params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// NeedsRehash will check whether hash was made with an outdated algorithm or parameters,
+// so it should be replaced by a new hash of the same password.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	params, _, _, err := decodeArgon2ID(hash)
+	if err != nil {
+		return true
+	}
+
+	return params != h.params()
+}
+
+// params will get argon2id parameters from config.
+// A parameter that isn't configured falls back to its default.
+func (h *Hasher) params() argon2Params {
+	cfg := h.cfg.GetConfig().Account.Password
+
+	params := argon2Params{
+		iterations:  defaultArgon2Iterations,
+		keyLength:   defaultArgon2KeyLength,
+		memory:      defaultArgon2Memory,
+		parallelism: defaultArgon2Parallelism,
+		saltLength:  defaultArgon2SaltLength,
+	}
+
+	if cfg.Argon2Iterations > 0 {
+		params.iterations = uint32(cfg.Argon2Iterations)
+	}
+
+	if cfg.Argon2KeyLength > 0 {
+		params.keyLength = uint32(cfg.Argon2KeyLength)
+	}
+
+	if cfg.Argon2Memory > 0 {
+		params.memory = uint32(cfg.Argon2Memory)
+	}
+
+	if cfg.Argon2Parallelism > 0 {
+		params.parallelism = uint8(cfg.Argon2Parallelism)
+	}
+
+	if cfg.Argon2SaltLength > 0 {
+		params.saltLength = uint32(cfg.Argon2SaltLength)
+	}
+
+	return params
+}
+
+// decodeArgon2ID will parse an argon2id hash in PHC format,
+// e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>.
+func decodeArgon2ID(hash string) (argon2Params, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != algorithmArgon2ID {
+		return argon2Params{}, nil, nil, ErrHashInvalid
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return argon2Params{}, nil, nil, ErrHashInvalid
+	}
+
+	var params argon2Params
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism)
+	if err != nil || params.memory == 0 || params.iterations == 0 || params.parallelism == 0 {
+		return argon2Params{}, nil, nil, ErrHashInvalid
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return argon2Params{}, nil, nil, ErrHashInvalid
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return argon2Params{}, nil, nil, ErrHashInvalid
+	}
+
+	params.keyLength = uint32(len(key))
+	params.saltLength = uint32(len(salt))
+
+	return params, salt, key, nil
+}
+
+// isBcrypt will check whether hash is a bcrypt hash, e.g. $2a$10$<salt and key>.
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$")
+}
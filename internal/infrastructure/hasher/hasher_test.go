@@ -0,0 +1,245 @@
+package hasher
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+// mockConfig holds deliberately cheap argon2id parameters so tests stay fast.
+var mockConfig = &configuration.AppConfig{
+	Account: configuration.AccountConfig{
+		Password: configuration.PasswordConfig{
+			Argon2Iterations:  1,
+			Argon2KeyLength:   16,
+			Argon2Memory:      64,
+			Argon2Parallelism: 1,
+			Argon2SaltLength:  8,
+		},
+	},
+}
+
+func TestNewHasher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCfg := NewMockconfigProvider(ctrl)
+
+	want := &Hasher{
+		cfg: mockCfg,
+	}
+
+	got := NewHasher(HasherParam{
+		Config: mockCfg,
+	})
+	assert.Equal(t, want, got)
+}
+
+func TestHasher_HashPassword(t *testing.T) {
+	randReadOri := randRead
+	defer func() {
+		randRead = randReadOri
+	}()
+
+	tests := []struct {
+		name     string
+		randRead func(b []byte) (int, error)
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "when_generate_salt_error_then_return_error",
+			randRead: func(b []byte) (int, error) {
+				return 0, assert.AnError
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_phc_hash",
+			randRead: func(b []byte) (int, error) {
+				for i := range b {
+					b[i] = 0xab
+				}
+				return len(b), nil
+			},
+			want: "$argon2id$v=19$m=64,t=1,p=1$q6urq6urq6s$",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randRead = test.randRead
+
+			ctrl := gomock.NewController(t)
+			mockCfg := NewMockconfigProvider(ctrl)
+			mockCfg.EXPECT().GetConfig().Return(mockConfig)
+
+			h := &Hasher{
+				cfg: mockCfg,
+			}
+
+			got, err := h.HashPassword("password")
+			assert.Equal(t, test.wantErr, err)
+			if test.wantErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Regexp(t, `^\Q`+test.want+`\E[A-Za-z0-9+/]{22}$`, got)
+		})
+	}
+}
+
+func TestHasher_ComparePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCfg := NewMockconfigProvider(ctrl)
+	mockCfg.EXPECT().GetConfig().Return(mockConfig).AnyTimes()
+
+	h := &Hasher{
+		cfg: mockCfg,
+	}
+
+	argon2Hash, err := h.HashPassword("password")
+	assert.Nil(t, err)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "when_argon2id_password_match_then_return_nil",
+			hash:     argon2Hash,
+			password: "password",
+		},
+		{
+			name:     "when_argon2id_password_not_match_then_return_error",
+			hash:     argon2Hash,
+			password: "wrong",
+			wantErr:  ErrPasswordMismatch,
+		},
+		{
+			name:     "when_bcrypt_password_match_then_return_nil",
+			hash:     string(bcryptHash),
+			password: "password",
+		},
+		{
+			name:     "when_bcrypt_password_not_match_then_return_error",
+			hash:     string(bcryptHash),
+			password: "wrong",
+			wantErr:  ErrPasswordMismatch,
+		},
+		{
+			name:     "when_algorithm_unsupported_then_return_error",
+			hash:     "$argon2i$v=19$m=64,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urqw",
+			password: "password",
+			wantErr:  ErrHashInvalid,
+		},
+		{
+			name:     "when_version_unsupported_then_return_error",
+			hash:     "$argon2id$v=16$m=64,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urqw",
+			password: "password",
+			wantErr:  ErrHashInvalid,
+		},
+		{
+			name:     "when_params_invalid_then_return_error",
+			hash:     "$argon2id$v=19$m=0,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urqw",
+			password: "password",
+			wantErr:  ErrHashInvalid,
+		},
+		{
+			name:     "when_salt_invalid_then_return_error",
+			hash:     "$argon2id$v=19$m=64,t=1,p=1$!!!$q6urq6urq6urq6urq6urqw",
+			password: "password",
+			wantErr:  ErrHashInvalid,
+		},
+		{
+			name:     "when_key_invalid_then_return_error",
+			hash:     "$argon2id$v=19$m=64,t=1,p=1$q6urq6urq6s$",
+			password: "password",
+			wantErr:  ErrHashInvalid,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := h.ComparePassword(test.hash, test.password)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{
+			name: "when_hash_is_bcrypt_then_return_true",
+			hash: "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy",
+			want: true,
+		},
+		{
+			name: "when_hash_invalid_then_return_true",
+			hash: "plain",
+			want: true,
+		},
+		{
+			name: "when_argon2id_params_outdated_then_return_true",
+			hash: "$argon2id$v=19$m=32,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urqw",
+			want: true,
+		},
+		{
+			name: "when_argon2id_key_length_outdated_then_return_true",
+			hash: "$argon2id$v=19$m=64,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s",
+			want: true,
+		},
+		{
+			name: "when_argon2id_params_up_to_date_then_return_false",
+			hash: "$argon2id$v=19$m=64,t=1,p=1$q6urq6urq6s$q6urq6urq6urq6urq6urqw",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCfg := NewMockconfigProvider(ctrl)
+			mockCfg.EXPECT().GetConfig().Return(mockConfig).AnyTimes()
+
+			h := &Hasher{
+				cfg: mockCfg,
+			}
+
+			got := h.NeedsRehash(test.hash)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestHasher_params(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCfg := NewMockconfigProvider(ctrl)
+	mockCfg.EXPECT().GetConfig().Return(&configuration.AppConfig{})
+
+	h := &Hasher{
+		cfg: mockCfg,
+	}
+
+	want := argon2Params{
+		iterations:  defaultArgon2Iterations,
+		keyLength:   defaultArgon2KeyLength,
+		memory:      defaultArgon2Memory,
+		parallelism: defaultArgon2Parallelism,
+		saltLength:  defaultArgon2SaltLength,
+	}
+
+	got := h.params()
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,302 @@
+package keyring
+
+import (
+	// golang package
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	// external package
+	"github.com/golang-jwt/jwt"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+const (
+	algorithmEdDSA = "EdDSA"
+	algorithmRS256 = "RS256"
+
+	headerKeyID = "kid"
+	rsaMinBits  = 2048
+)
+
+var (
+	errActiveKeyNotFound    = errors.New("active key not found")
+	errActiveKeyNotPrivate  = errors.New("active key is not a private key")
+	errAlgorithmInvalid     = errors.New("key algorithm not valid")
+	errKeyDuplicate         = errors.New("key id duplicated")
+	errKeyIDEmpty           = errors.New("key id is empty")
+	errKeyNotFound          = errors.New("key not found")
+	errKeyTooWeak           = errors.New("rsa key must be at least 2048 bits")
+	errKeyTypeInvalid       = errors.New("key type doesn't match algorithm")
+	errPEMEmpty             = errors.New("pem is empty")
+	errPEMInvalid           = errors.New("pem not valid")
+	errSigningMethodInvalid = errors.New("signing method not valid")
+
+	// for mocking purpose
+	osReadFile = os.ReadFile
+)
+
+//go:generate mockgen -source=keyring.go -destination=keyring_mock.go -package=keyring
+
+// configProvider holds all methods served by package configuration that will
+// be needed by package keyring
+type configProvider interface {
+	// GetConfig will get configuration that had been saved to memory.
+	GetConfig() *configuration.AppConfig
+}
+
+// JWK represents a public key in JSON Web Key format, as described in RFC 7517.
+// N and E are filled for RSA keys, while Crv and X are filled for Ed25519 keys.
+type JWK struct {
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	E   string `json:"e,omitempty"`
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n,omitempty"`
+	Use string `json:"use"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS represents a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// key holds a parsed key of the keyring.
+// privateKey is only filled when the key was given as a private key.
+type key struct {
+	id         string
+	method     jwt.SigningMethod
+	privateKey interface{}
+	publicKey  interface{}
+}
+
+// KeyringParam holds all parameters needed to instantiate a new instance of Keyring.
+type KeyringParam struct {
+	Config configProvider
+}
+
+type Keyring struct {
+	active key
+	keys   []key
+}
+
+// NewKeyring will instantiate a new instance of Keyring
+// using the keys listed in JWT configuration.
+// It returns an error when a key can't be loaded or the active key can't sign.
+func NewKeyring(param KeyringParam) (*Keyring, error) {
+	cfg := param.Config.GetConfig().JWT
+
+	keyring := &Keyring{}
+	seen := make(map[string]bool)
+	for _, keyCfg := range cfg.Keys {
+		meta := map[string]interface{}{
+			"key_id": keyCfg.ID,
+		}
+
+		k, err := loadKey(keyCfg)
+		if err != nil {
+			log.Printf("[NewKeyring] loadKey() got an error: %+v\nMeta:%+v\n", err, meta)
+			return nil, err
+		}
+
+		if seen[k.id] {
+			log.Printf("[NewKeyring] key id duplicated\nMeta:%+v\n", meta)
+			return nil, errKeyDuplicate
+		}
+		seen[k.id] = true
+
+		keyring.keys = append(keyring.keys, k)
+		if k.id == cfg.ActiveKeyID {
+			keyring.active = k
+		}
+	}
+
+	if keyring.active.id == "" {
+		log.Printf("[NewKeyring] active key %q not found\n", cfg.ActiveKeyID)
+		return nil, errActiveKeyNotFound
+	}
+
+	if keyring.active.privateKey == nil {
+		log.Printf("[NewKeyring] active key %q is not a private key\n", cfg.ActiveKeyID)
+		return nil, errActiveKeyNotPrivate
+	}
+
+	return keyring, nil
+}
+
+// JWKS returns public keys of the keyring as a JSON Web Key Set.
+// The active key comes first, followed by retired keys.
+func (kr *Keyring) JWKS() JWKS {
+	jwks := JWKS{
+		Keys: []JWK{buildJWK(kr.active)},
+	}
+
+	for _, k := range kr.keys {
+		if k.id == kr.active.id {
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, buildJWK(k))
+	}
+
+	return jwks
+}
+
+// Sign will sign claims with the active key.
+// ID of the active key is set as the kid header, so the JWT can still be
+// verified after the active key is rotated.
+func (kr *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(kr.active.method, claims)
+	token.Header[headerKeyID] = kr.active.id
+
+	return token.SignedString(kr.active.privateKey)
+}
+
+// VerificationKey will find the key that verifies the signature of token using its kid header.
+// It can be used as jwt.Keyfunc. Both the active key and retired keys are accepted,
+// as long as token is signed with the algorithm of the key.
+func (kr *Keyring) VerificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header[headerKeyID].(string)
+	for _, k := range kr.keys {
+		if k.id != kid {
+			continue
+		}
+
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, errSigningMethodInvalid
+		}
+
+		return k.publicKey, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", errKeyNotFound, kid)
+}
+
+// buildJWK will convert public part of a key into JSON Web Key format.
+func buildJWK(k key) JWK {
+	jwk := JWK{
+		Alg: k.method.Alg(),
+		Kid: k.id,
+		Use: "sig",
+	}
+
+	switch publicKey := k.publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	}
+
+	return jwk
+}
+
+// loadKey will read and parse a key listed in JWT configuration.
+func loadKey(cfg configuration.JWTKeyConfig) (key, error) {
+	if cfg.ID == "" {
+		return key{}, errKeyIDEmpty
+	}
+
+	var method jwt.SigningMethod
+	switch cfg.Algorithm {
+	case algorithmEdDSA:
+		method = jwt.SigningMethodEdDSA
+	case algorithmRS256:
+		method = jwt.SigningMethodRS256
+	default:
+		return key{}, errAlgorithmInvalid
+	}
+
+	data := []byte(cfg.PEM)
+	if cfg.PEMFile != "" {
+		var err error
+		data, err = osReadFile(cfg.PEMFile)
+		if err != nil {
+			return key{}, err
+		}
+	}
+
+	if len(data) == 0 {
+		return key{}, errPEMEmpty
+	}
+
+	privateKey, publicKey, err := parsePEM(data)
+	if err != nil {
+		return key{}, err
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		if method != jwt.SigningMethodRS256 {
+			return key{}, errKeyTypeInvalid
+		}
+
+		if publicKey.N.BitLen() < rsaMinBits {
+			return key{}, errKeyTooWeak
+		}
+	case ed25519.PublicKey:
+		if method != jwt.SigningMethodEdDSA {
+			return key{}, errKeyTypeInvalid
+		}
+	default:
+		return key{}, errKeyTypeInvalid
+	}
+
+	return key{
+		id:         cfg.ID,
+		method:     method,
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}, nil
+}
+
+// parsePEM will parse a PEM encoded private or public key.
+// When data holds a private key, its public key is derived from it.
+func parsePEM(data []byte) (privateKey, publicKey interface{}, err error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errPEMInvalid
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+		return nil, publicKey, err
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		return nil, publicKey, err
+	default:
+		return nil, nil, errPEMInvalid
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch k := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return k, &k.PublicKey, nil
+	case ed25519.PrivateKey:
+		return k, k.Public(), nil
+	}
+
+	return nil, nil, errKeyTypeInvalid
+}
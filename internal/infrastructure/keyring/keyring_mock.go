@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keyring.go
+
+// Package keyring is a generated GoMock package.
+package keyring
+
+import (
+	reflect "reflect"
+
+	configuration "github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockconfigProvider is a mock of configProvider interface.
+type MockconfigProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockconfigProviderMockRecorder
+}
+
+// MockconfigProviderMockRecorder is the mock recorder for MockconfigProvider.
+type MockconfigProviderMockRecorder struct {
+	mock *MockconfigProvider
+}
+
+// NewMockconfigProvider creates a new mock instance.
+func NewMockconfigProvider(ctrl *gomock.Controller) *MockconfigProvider {
+	mock := &MockconfigProvider{ctrl: ctrl}
+	mock.recorder = &MockconfigProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconfigProvider) EXPECT() *MockconfigProviderMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockconfigProvider) GetConfig() *configuration.AppConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*configuration.AppConfig)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockconfigProviderMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockconfigProvider)(nil).GetConfig))
+}
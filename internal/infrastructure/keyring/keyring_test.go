@@ -0,0 +1,392 @@
+package keyring
+
+import (
+	// golang package
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+
+	// external package
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+func encodePEM(blockType string, der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+func TestNewKeyring(t *testing.T) {
+	osReadFileOri := osReadFile
+	defer func() {
+		osReadFile = osReadFileOri
+	}()
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaPKCS1 := encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+	weakRSAKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	weakRSAPKCS1 := encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(weakRSAKey))
+
+	edPublic, edPrivate, _ := ed25519.GenerateKey(rand.Reader)
+	edPKCS8Der, _ := x509.MarshalPKCS8PrivateKey(edPrivate)
+	edPKCS8 := encodePEM("PRIVATE KEY", edPKCS8Der)
+	edPKIXDer, _ := x509.MarshalPKIXPublicKey(edPublic)
+	edPKIX := encodePEM("PUBLIC KEY", edPKIXDer)
+
+	tests := []struct {
+		name       string
+		cfg        configuration.JWTConfig
+		mockFields func()
+		want       *Keyring
+		wantErr    error
+	}{
+		{
+			name: "when_key_id_empty_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", PEM: rsaPKCS1},
+				},
+			},
+			wantErr: errKeyIDEmpty,
+		},
+		{
+			name: "when_algorithm_invalid_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "HS256", ID: "key-1", PEM: rsaPKCS1},
+				},
+			},
+			wantErr: errAlgorithmInvalid,
+		},
+		{
+			name: "when_read_pem_file_error_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEMFile: "key-1.pem"},
+				},
+			},
+			mockFields: func() {
+				osReadFile = func(name string) ([]byte, error) {
+					return nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_pem_empty_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1"},
+				},
+			},
+			wantErr: errPEMEmpty,
+		},
+		{
+			name: "when_pem_invalid_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEM: "not a pem"},
+				},
+			},
+			wantErr: errPEMInvalid,
+		},
+		{
+			name: "when_key_type_not_match_algorithm_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEM: edPKCS8},
+				},
+			},
+			wantErr: errKeyTypeInvalid,
+		},
+		{
+			name: "when_rsa_key_too_weak_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEM: weakRSAPKCS1},
+				},
+			},
+			wantErr: errKeyTooWeak,
+		},
+		{
+			name: "when_key_id_duplicated_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEM: rsaPKCS1},
+					{Algorithm: "EdDSA", ID: "key-1", PEM: edPKCS8},
+				},
+			},
+			wantErr: errKeyDuplicate,
+		},
+		{
+			name: "when_active_key_not_found_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-2",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "RS256", ID: "key-1", PEM: rsaPKCS1},
+				},
+			},
+			wantErr: errActiveKeyNotFound,
+		},
+		{
+			name: "when_active_key_is_public_key_then_return_error",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-1",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "EdDSA", ID: "key-1", PEM: edPKIX},
+				},
+			},
+			wantErr: errActiveKeyNotPrivate,
+		},
+		{
+			name: "when_no_error_occured_then_return_keyring",
+			cfg: configuration.JWTConfig{
+				ActiveKeyID: "key-2",
+				Keys: []configuration.JWTKeyConfig{
+					{Algorithm: "EdDSA", ID: "key-1", PEM: edPKIX},
+					{Algorithm: "RS256", ID: "key-2", PEMFile: "key-2.pem"},
+				},
+			},
+			mockFields: func() {
+				osReadFile = func(name string) ([]byte, error) {
+					assert.Equal(t, "key-2.pem", name)
+					return []byte(rsaPKCS1), nil
+				}
+			},
+			want: &Keyring{
+				active: key{
+					id:         "key-2",
+					method:     jwt.SigningMethodRS256,
+					privateKey: rsaKey,
+					publicKey:  &rsaKey.PublicKey,
+				},
+				keys: []key{
+					{
+						id:        "key-1",
+						method:    jwt.SigningMethodEdDSA,
+						publicKey: edPublic,
+					},
+					{
+						id:         "key-2",
+						method:     jwt.SigningMethodRS256,
+						privateKey: rsaKey,
+						publicKey:  &rsaKey.PublicKey,
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			osReadFile = osReadFileOri
+			if test.mockFields != nil {
+				test.mockFields()
+			}
+
+			ctrl := gomock.NewController(t)
+			mockConfig := NewMockconfigProvider(ctrl)
+			mockConfig.EXPECT().GetConfig().Return(&configuration.AppConfig{JWT: test.cfg})
+
+			got, err := NewKeyring(KeyringParam{
+				Config: mockConfig,
+			})
+			assert.Equal(t, test.want, got)
+			assert.True(t, errors.Is(err, test.wantErr), "got error %v, want %v", err, test.wantErr)
+		})
+	}
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	edPublic, _, _ := ed25519.GenerateKey(rand.Reader)
+
+	rsaJWK := key{
+		id:         "key-2",
+		method:     jwt.SigningMethodRS256,
+		privateKey: rsaKey,
+		publicKey:  &rsaKey.PublicKey,
+	}
+	edJWK := key{
+		id:        "key-1",
+		method:    jwt.SigningMethodEdDSA,
+		publicKey: edPublic,
+	}
+
+	kr := &Keyring{
+		active: rsaJWK,
+		keys:   []key{edJWK, rsaJWK},
+	}
+
+	want := JWKS{
+		Keys: []JWK{
+			{
+				Alg: "RS256",
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+				Kid: "key-2",
+				Kty: "RSA",
+				N:   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+				Use: "sig",
+			},
+			{
+				Alg: "EdDSA",
+				Crv: "Ed25519",
+				Kid: "key-1",
+				Kty: "OKP",
+				Use: "sig",
+				X:   base64.RawURLEncoding.EncodeToString(edPublic),
+			},
+		},
+	}
+
+	assert.Equal(t, want, kr.JWKS())
+	assert.Equal(t, "AQAB", want.Keys[0].E)
+}
+
+func TestKeyring_SignAndVerificationKey(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	edPublic, edPrivate, _ := ed25519.GenerateKey(rand.Reader)
+
+	rsaJWK := key{
+		id:         "key-2",
+		method:     jwt.SigningMethodRS256,
+		privateKey: rsaKey,
+		publicKey:  &rsaKey.PublicKey,
+	}
+	edJWK := key{
+		id:         "key-1",
+		method:     jwt.SigningMethodEdDSA,
+		privateKey: edPrivate,
+		publicKey:  edPublic,
+	}
+
+	oldKeyring := &Keyring{
+		active: edJWK,
+		keys:   []key{edJWK},
+	}
+	rotatedKeyring := &Keyring{
+		active: rsaJWK,
+		keys:   []key{edJWK, rsaJWK},
+	}
+
+	claims := jwt.MapClaims{"sub": "123"}
+	oldToken, err := oldKeyring.Sign(claims)
+	assert.Nil(t, err)
+
+	newToken, err := rotatedKeyring.Sign(claims)
+	assert.Nil(t, err)
+
+	hmacToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	unknownKidToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	unknownKidToken.Header["kid"] = "key-3"
+	unknownToken, _ := unknownKidToken.SignedString(rsaKey)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantKid string
+		wantErr bool
+	}{
+		{
+			name:    "when_signed_by_active_key_then_return_valid",
+			token:   newToken,
+			wantKid: "key-2",
+		},
+		{
+			name:    "when_signed_by_retired_key_then_return_valid",
+			token:   oldToken,
+			wantKid: "key-1",
+		},
+		{
+			name:    "when_kid_unknown_then_return_error",
+			token:   unknownToken,
+			wantKid: "key-3",
+			wantErr: true,
+		},
+		{
+			name:    "when_signing_method_not_match_then_return_error",
+			token:   hmacToken,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token, err := jwt.Parse(test.token, rotatedKeyring.VerificationKey)
+			assert.Equal(t, test.wantErr, err != nil)
+			if token != nil {
+				kid, _ := token.Header["kid"].(string)
+				assert.Equal(t, test.wantKid, kid)
+			}
+		})
+	}
+}
+
+func TestKeyring_VerificationKey(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaJWK := key{
+		id:         "key-1",
+		method:     jwt.SigningMethodRS256,
+		privateKey: rsaKey,
+		publicKey:  &rsaKey.PublicKey,
+	}
+
+	kr := &Keyring{
+		active: rsaJWK,
+		keys:   []key{rsaJWK},
+	}
+
+	tests := []struct {
+		name    string
+		token   *jwt.Token
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "when_kid_missing_then_return_error",
+			token: &jwt.Token{
+				Header: map[string]interface{}{},
+				Method: jwt.SigningMethodRS256,
+			},
+			wantErr: errKeyNotFound,
+		},
+		{
+			name: "when_algorithm_not_match_then_return_error",
+			token: &jwt.Token{
+				Header: map[string]interface{}{"kid": "key-1"},
+				Method: jwt.SigningMethodEdDSA,
+			},
+			wantErr: errSigningMethodInvalid,
+		},
+		{
+			name: "when_key_found_then_return_public_key",
+			token: &jwt.Token{
+				Header: map[string]interface{}{"kid": "key-1"},
+				Method: jwt.SigningMethodRS256,
+			},
+			want: &rsaKey.PublicKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := kr.VerificationKey(test.token)
+			assert.Equal(t, test.want, got)
+			assert.True(t, errors.Is(err, test.wantErr), "got error %v, want %v", err, test.wantErr)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package mailer
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+const (
+	driverOutbox = "outbox"
+	driverSMTP   = "smtp"
+)
+
+var (
+	errDriverInvalid = errors.New("mailer driver not valid")
+
+	// for mocking purpose
+	osMkdirAll   = os.MkdirAll
+	osWriteFile  = os.WriteFile
+	smtpSendMail = smtp.SendMail
+	timeNow      = time.Now
+)
+
+//go:generate mockgen -source=mailer.go -destination=mailer_mock.go -package=mailer
+
+// configProvider holds all methods served by package configuration that will
+// be needed by package mailer
+type configProvider interface {
+	// GetConfig will get configuration that had been saved to memory.
+	GetConfig() *configuration.AppConfig
+}
+
+// Message represents an email that will be sent.
+type Message struct {
+	Body    string
+	Subject string
+	To      string
+}
+
+// MailerParam holds all parameters needed to instantiate a new instance of Mailer.
+type MailerParam struct {
+	Config configProvider
+}
+
+type Mailer struct {
+	cfg configProvider
+}
+
+// NewMailer will instantiate a new instance of Mailer.
+func NewMailer(param MailerParam) *Mailer {
+	return &Mailer{
+		cfg: param.Config,
+	}
+}
+
+// SendMail will send an email using the configured driver.
+// Driver "smtp" sends the email through SMTP server, while driver "outbox"
+// writes the email as a file so it can be read on local and test runs.
+func (m *Mailer) SendMail(ctx context.Context, msg Message) error {
+	cfg := m.cfg.GetConfig().Mailer
+
+	meta := map[string]interface{}{
+		"driver":  cfg.Driver,
+		"subject": msg.Subject,
+	}
+
+	var err error
+	switch cfg.Driver {
+	case driverOutbox:
+		err = writeToOutbox(cfg, msg)
+	case driverSMTP:
+		err = sendSMTP(cfg, msg)
+	default:
+		err = errDriverInvalid
+	}
+
+	if err != nil {
+		log.Printf("[SendMail] got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// buildMessage will build an RFC 5322 formatted email.
+// CR and LF are removed from header values to prevent header injection.
+func buildMessage(from string, msg Message) []byte {
+	sanitize := strings.NewReplacer("\r", "", "\n", "")
+
+	var builder strings.Builder
+	builder.WriteString("From: " + sanitize.Replace(from) + "\r\n")
+	builder.WriteString("To: " + sanitize.Replace(msg.To) + "\r\n")
+	builder.WriteString("Subject: " + sanitize.Replace(msg.Subject) + "\r\n")
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(msg.Body)
+
+	return []byte(builder.String())
+}
+
+// sendSMTP will send an email through SMTP server.
+func sendSMTP(cfg configuration.MailerConfig, msg Message) error {
+	addr := net.JoinHostPort(cfg.SMTP.Host, strconv.Itoa(cfg.SMTP.Port))
+
+	var auth smtp.Auth
+	if cfg.SMTP.Username != "" {
+		auth = smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.Host)
+	}
+
+	return smtpSendMail(addr, auth, cfg.From, []string{msg.To}, buildMessage(cfg.From, msg))
+}
+
+// writeToOutbox will write an email as a .eml file inside outbox directory.
+func writeToOutbox(cfg configuration.MailerConfig, msg Message) error {
+	err := osMkdirAll(cfg.OutboxDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%d.eml", timeNow().UnixNano())
+	return osWriteFile(filepath.Join(cfg.OutboxDir, fileName), buildMessage(cfg.From, msg), 0o600)
+}
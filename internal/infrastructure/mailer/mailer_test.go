@@ -0,0 +1,178 @@
+package mailer
+
+import (
+	// golang package
+	"context"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+func TestNewMailer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockConfig := NewMockconfigProvider(ctrl)
+
+	want := &Mailer{
+		cfg: mockConfig,
+	}
+
+	got := NewMailer(MailerParam{
+		Config: mockConfig,
+	})
+	assert.Equal(t, want, got)
+}
+
+func TestMailer_SendMail(t *testing.T) {
+	osMkdirAllOri := osMkdirAll
+	osWriteFileOri := osWriteFile
+	smtpSendMailOri := smtpSendMail
+	timeNowOri := timeNow
+	defer func() {
+		osMkdirAll = osMkdirAllOri
+		osWriteFile = osWriteFileOri
+		smtpSendMail = smtpSendMailOri
+		timeNow = timeNowOri
+	}()
+
+	mockTime := time.Unix(0, 1672531200000000000)
+	timeNow = func() time.Time {
+		return mockTime
+	}
+
+	mockMessage := Message{
+		Body:    "body",
+		Subject: "subject\r\nBcc: someone",
+		To:      "to@bubi.id",
+	}
+	wantMessage := []byte("From: no-reply@bubi.id\r\n" +
+		"To: to@bubi.id\r\n" +
+		"Subject: subjectBcc: someone\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=\"UTF-8\"\r\n" +
+		"\r\n" +
+		"body")
+
+	outboxConfig := &configuration.AppConfig{
+		Mailer: configuration.MailerConfig{
+			Driver:    "outbox",
+			From:      "no-reply@bubi.id",
+			OutboxDir: "outbox",
+		},
+	}
+	smtpConfig := &configuration.AppConfig{
+		Mailer: configuration.MailerConfig{
+			Driver: "smtp",
+			From:   "no-reply@bubi.id",
+			SMTP: configuration.SMTPConfig{
+				Host:     "localhost",
+				Password: "password",
+				Port:     25,
+				Username: "username",
+			},
+		},
+	}
+
+	type mockFields struct {
+		config *MockconfigProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_driver_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(&configuration.AppConfig{})
+			},
+			wantErr: errDriverInvalid,
+		},
+		{
+			name: "when_outbox_MkdirAll_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(outboxConfig)
+				osMkdirAll = func(path string, perm os.FileMode) error {
+					return assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_outbox_WriteFile_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(outboxConfig)
+				osMkdirAll = func(path string, perm os.FileMode) error {
+					return nil
+				}
+				osWriteFile = func(name string, data []byte, perm os.FileMode) error {
+					return assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_outbox_no_error_occured_then_write_email_to_file",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(outboxConfig)
+				osMkdirAll = func(path string, perm os.FileMode) error {
+					assert.Equal(t, "outbox", path)
+					return nil
+				}
+				osWriteFile = func(name string, data []byte, perm os.FileMode) error {
+					assert.Equal(t, filepath.Join("outbox", "1672531200000000000.eml"), name)
+					assert.Equal(t, wantMessage, data)
+					return nil
+				}
+			},
+		},
+		{
+			name: "when_smtp_SendMail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(smtpConfig)
+				smtpSendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+					return assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_smtp_no_error_occured_then_send_email",
+			mockFields: func(mf mockFields) {
+				mf.config.EXPECT().GetConfig().Return(smtpConfig)
+				smtpSendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+					assert.Equal(t, "localhost:25", addr)
+					assert.NotNil(t, a)
+					assert.Equal(t, "no-reply@bubi.id", from)
+					assert.Equal(t, []string{"to@bubi.id"}, to)
+					assert.Equal(t, wantMessage, msg)
+					return nil
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				config: NewMockconfigProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			m := &Mailer{
+				cfg: mockFields.config,
+			}
+
+			err := m.SendMail(context.Background(), mockMessage)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
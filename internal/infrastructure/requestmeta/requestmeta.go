@@ -0,0 +1,95 @@
+package requestmeta
+
+import (
+	// golang package
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net"
+	"net/http"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+const (
+	// RequestIDHeader carries the id of a request, both in the request and its response.
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+	requestIDLength    = 16
+)
+
+var (
+	// for mocking purpose
+	randRead = rand.Read
+)
+
+type RequestMeta struct{}
+
+// NewRequestMeta will instantiate a new instance of RequestMeta.
+func NewRequestMeta() *RequestMeta {
+	return &RequestMeta{}
+}
+
+// Middleware will inject information about the client that made a request
+// to request's context as entity.RequestMetadata.
+// The request id sent by the client is kept when it looks sane,
+// otherwise a new one is generated. Either way it's echoed in the response.
+func (rm *RequestMeta) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestID := request.Header.Get(RequestIDHeader)
+		if !isRequestIDValid(requestID) {
+			requestID = generateRequestID()
+		}
+
+		writer.Header().Set(RequestIDHeader, requestID)
+		ctx := entity.NewContextWithRequestMetadata(request.Context(), entity.RequestMetadata{
+			IPAddress: getClientIP(request),
+			RequestID: requestID,
+			UserAgent: request.UserAgent(),
+		})
+
+		next.ServeHTTP(writer, request.WithContext(ctx))
+	})
+}
+
+// generateRequestID will generate a random id for a request.
+// A request without an id is still served, so it returns an empty id
+// when the random source fails.
+func generateRequestID() string {
+	b := make([]byte, requestIDLength)
+	_, err := randRead(b)
+	if err != nil {
+		log.Printf("[generateRequestID] randRead() got an error: %+v\n", err)
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// getClientIP returns the IP address of the client that made the request.
+func getClientIP(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+
+	return host
+}
+
+// isRequestIDValid will check whether a request id sent by a client is safe to be kept.
+// Only printable ASCII without spaces is allowed, so it can't forge log lines.
+func isRequestIDValid(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range requestID {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+package requestmeta
+
+import (
+	// golang package
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	// external package
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestNewRequestMeta(t *testing.T) {
+	assert.Equal(t, &RequestMeta{}, NewRequestMeta())
+}
+
+func TestRequestMeta_Middleware(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	tests := []struct {
+		name      string
+		requestID string
+		randRead  func(b []byte) (n int, err error)
+		want      entity.RequestMetadata
+	}{
+		{
+			name:      "when_request_id_sent_then_keep_it",
+			requestID: "request",
+			randRead:  mockRead,
+			want: entity.RequestMetadata{
+				IPAddress: "192.0.2.1",
+				RequestID: "request",
+				UserAgent: "agent",
+			},
+		},
+		{
+			name:     "when_request_id_missing_then_generate_one",
+			randRead: mockRead,
+			want: entity.RequestMetadata{
+				IPAddress: "192.0.2.1",
+				RequestID: "abababababababababababababababab",
+				UserAgent: "agent",
+			},
+		},
+		{
+			name:      "when_request_id_too_long_then_generate_one",
+			requestID: strings.Repeat("a", maxRequestIDLength+1),
+			randRead:  mockRead,
+			want: entity.RequestMetadata{
+				IPAddress: "192.0.2.1",
+				RequestID: "abababababababababababababababab",
+				UserAgent: "agent",
+			},
+		},
+		{
+			name:      "when_request_id_has_space_then_generate_one",
+			requestID: "request id",
+			randRead:  mockRead,
+			want: entity.RequestMetadata{
+				IPAddress: "192.0.2.1",
+				RequestID: "abababababababababababababababab",
+				UserAgent: "agent",
+			},
+		},
+		{
+			name: "when_failed_to_generate_request_id_then_leave_it_empty",
+			randRead: func(b []byte) (n int, err error) {
+				return 0, assert.AnError
+			},
+			want: entity.RequestMetadata{
+				IPAddress: "192.0.2.1",
+				UserAgent: "agent",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+			randRead = test.randRead
+
+			var got entity.RequestMetadata
+			handler := NewRequestMeta().Middleware(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				got = entity.GetRequestMetadataFromContext(request.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("User-Agent", "agent")
+			if test.requestID != "" {
+				req.Header.Set(RequestIDHeader, test.requestID)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want.RequestID, w.Header().Get(RequestIDHeader))
+		})
+	}
+}
@@ -5,9 +5,98 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"strings"
 	"time"
 )
 
+var (
+	// likeEscaper escapes wildcards of LIKE, so a search query is matched literally.
+	likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+)
+
+// DeleteUserAccountsPendingDeletion will permanently delete every account whose deletion
+// was requested at or before requestedBefore, alongside every row owned by those accounts.
+// It returns id of the deleted accounts.
+func (repo *DBRepository) DeleteUserAccountsPendingDeletion(ctx context.Context, tx *sql.Tx, requestedBefore time.Time) ([]int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"requested_before": requestedBefore,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteUserAccountsPendingDeletion, namedParam)
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletion] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletion] tx.QueryContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []int64
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			log.Printf("[DeleteUserAccountsPendingDeletion] rows.Scan() got an error: %+v\nMeta:%+v\n", err, namedParam)
+			return nil, err
+		}
+
+		result = append(result, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletion] rows.Err() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteUserTOTPRecoveryCode will remove a recovery code from recovery codes of a user whose TOTP is enabled.
+// It returns false if the user doesn't have the code, so a recovery code can only be used once.
+func (repo *DBRepository) DeleteUserTOTPRecoveryCode(ctx context.Context, tx *sql.Tx, userID int64, codeHash string) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"code_hash": codeHash,
+		"id":        userID,
+	}
+
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteUserTOTPRecoveryCode, namedParam)
+	if err != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCode] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCode] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCode] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 // GetUserAccountByEmail will fetch user's information based of account's email.
 func (repo *DBRepository) GetUserAccountByEmail(ctx context.Context, email string) (Account, error) {
 	infra := repo.infra
@@ -35,6 +124,33 @@ func (repo *DBRepository) GetUserAccountByEmail(ctx context.Context, email strin
 	return result, nil
 }
 
+// GetUserAccountByID will fetch user's information based of account's id.
+func (repo *DBRepository) GetUserAccountByID(ctx context.Context, userID int64) (Account, error) {
+	infra := repo.infra
+	timeout := time.Duration(infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetUserAccountByID, namedParam)
+	if err != nil {
+		log.Printf("[GetUserAccountByID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Account{}, err
+	}
+
+	var result Account
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetUserAccountByID] repo.db.GetContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Account{}, err
+	}
+
+	return result, nil
+}
+
 // InsertUserAccount will create a new entry in table user_account in database.
 func (repo *DBRepository) InsertUserAccount(ctx context.Context, tx *sql.Tx, email, password string) error {
 	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
@@ -66,6 +182,35 @@ func (repo *DBRepository) InsertUserAccount(ctx context.Context, tx *sql.Tx, ema
 	return nil
 }
 
+// SearchUserAccounts will fetch accounts whose email, first name or last name contains param.Query,
+// ordered by their id.
+func (repo *DBRepository) SearchUserAccounts(ctx context.Context, param SearchUserAccountsParam) ([]Account, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"limit":  param.Limit,
+		"offset": param.Offset,
+		"query":  "%" + likeEscaper.Replace(param.Query) + "%",
+	}
+
+	namedQuery, args, err := funcSQLXNamed(querySearchUserAccounts, namedParam)
+	if err != nil {
+		log.Printf("[SearchUserAccounts] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []Account
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[SearchUserAccounts] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // UpdateUserAccount will update user's account information.
 func (repo *DBRepository) UpdateUserAccount(ctx context.Context, tx *sql.Tx, param UpdateUserAccountParam) error {
 	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
@@ -73,11 +218,13 @@ func (repo *DBRepository) UpdateUserAccount(ctx context.Context, tx *sql.Tx, par
 	defer cancel()
 
 	namedParam := map[string]interface{}{
-		"first_name":    param.FirstName,
-		"id":            param.UserID,
-		"last_name":     param.LastName,
-		"record_period": param.RecordPeriod,
-		"updated_at":    repo.infra.GetTimeGMT7(),
+		"budgeting_mode": param.BudgetingMode,
+		"first_name":     param.FirstName,
+		"id":             param.UserID,
+		"last_name":      param.LastName,
+		"record_period":  param.RecordPeriod,
+		"timezone":       param.Timezone,
+		"updated_at":     repo.infra.GetTimeGMT7(),
 	}
 
 	namedQuery, args, err := funcSQLXNamed(queryUpdateUserAccount, namedParam)
@@ -95,6 +242,117 @@ func (repo *DBRepository) UpdateUserAccount(ctx context.Context, tx *sql.Tx, par
 	return nil
 }
 
+// UpdateUserDeletionRequested will set the time user requested to delete their account.
+// An invalid requestedAt cancels a pending deletion.
+func (repo *DBRepository) UpdateUserDeletionRequested(ctx context.Context, tx *sql.Tx, userID int64, requestedAt sql.NullTime) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"deletion_requested_at": requestedAt,
+		"id":                    userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserDeletionRequested, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserDeletionRequested] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserDeletionRequested] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUserDisabled will set the time user's account was disabled.
+// An invalid disabledAt enables the account again.
+func (repo *DBRepository) UpdateUserDisabled(ctx context.Context, tx *sql.Tx, userID int64, disabledAt sql.NullTime) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"disabled_at": disabledAt,
+		"id":          userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserDisabled, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserDisabled] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserDisabled] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUserEmail will replace user's email with a new one.
+// The new email is marked as verified, since its ownership had been confirmed before the update.
+func (repo *DBRepository) UpdateUserEmail(ctx context.Context, tx *sql.Tx, userID int64, email string) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	now := repo.infra.GetTimeGMT7()
+	namedParam := map[string]interface{}{
+		"email":             email,
+		"email_verified_at": now,
+		"id":                userID,
+		"updated_at":        now,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserEmail, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserEmail] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserEmail] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUserEmailVerified will mark user's email as verified.
+// An email that had been verified before will keep its verification time.
+func (repo *DBRepository) UpdateUserEmailVerified(ctx context.Context, tx *sql.Tx, userID int64) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"email_verified_at": repo.infra.GetTimeGMT7(),
+		"id":                userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserEmailVerified, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserEmailVerified] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserEmailVerified] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
 // UpdateUserPassword will update user's password.
 func (repo *DBRepository) UpdateUserPassword(ctx context.Context, tx *sql.Tx, userID int64, password string) error {
 	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
@@ -120,3 +378,109 @@ func (repo *DBRepository) UpdateUserPassword(ctx context.Context, tx *sql.Tx, us
 
 	return nil
 }
+
+// UpdateUserPasswordHash will replace the hash of user's password with password,
+// only if the hash is still oldPassword. It returns false if the hash had been changed.
+func (repo *DBRepository) UpdateUserPasswordHash(ctx context.Context, tx *sql.Tx, userID int64, oldPassword, password string) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":           userID,
+		"old_password": oldPassword,
+		"password":     password,
+	}
+
+	meta := map[string]interface{}{
+		"id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserPasswordHash, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserPasswordHash] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserPasswordHash] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateUserPasswordHash] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateUserTOTP will update user's TOTP secret, recovery codes, enablement time and last used time step.
+func (repo *DBRepository) UpdateUserTOTP(ctx context.Context, tx *sql.Tx, param UpdateUserTOTPParam) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":                  param.UserID,
+		"totp_enabled_at":     param.EnabledAt,
+		"totp_last_used_step": param.LastUsedStep,
+		"totp_recovery_codes": param.RecoveryCodes,
+		"totp_secret":         param.Secret,
+	}
+
+	meta := map[string]interface{}{
+		"user_id": param.UserID,
+		"enabled": param.EnabledAt.Valid,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserTOTP, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserTOTP] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserTOTP] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUserTOTPLastUsedStep will record the TOTP time step of a code user has just used.
+// It returns false if TOTP of the user isn't enabled, or a code of the same or a later step
+// had been used before, so a TOTP code can't be replayed.
+func (repo *DBRepository) UpdateUserTOTPLastUsedStep(ctx context.Context, tx *sql.Tx, userID, step int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":   userID,
+		"step": step,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateUserTOTPLastUsedStep, namedParam)
+	if err != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStep] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStep] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStep] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
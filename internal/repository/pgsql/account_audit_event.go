@@ -0,0 +1,68 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// GetAccountAuditEventsByUserID will fetch security events of a user,
+// ordered from the most recent event.
+func (repo *DBRepository) GetAccountAuditEventsByUserID(ctx context.Context, param GetAccountAuditEventsParam) ([]AccountAuditEvent, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"limit":   param.Limit,
+		"offset":  param.Offset,
+		"user_id": param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetAccountAuditEventsByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetAccountAuditEventsByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []AccountAuditEvent
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetAccountAuditEventsByUserID] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InsertAccountAuditEvent will create a new entry in table account_audit_event in database.
+func (repo *DBRepository) InsertAccountAuditEvent(ctx context.Context, tx *sql.Tx, param InsertAccountAuditEventParam) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"created_at": repo.infra.GetTimeGMT7(),
+		"event":      param.Event,
+		"ip_address": param.IPAddress,
+		"request_id": param.RequestID,
+		"user_agent": param.UserAgent,
+		"user_id":    param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertAccountAuditEvent, namedParam)
+	if err != nil {
+		log.Printf("[InsertAccountAuditEvent] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[InsertAccountAuditEvent] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
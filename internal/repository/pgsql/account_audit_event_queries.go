@@ -0,0 +1,36 @@
+package pgsql
+
+const (
+	queryGetAccountAuditEventsByUserID = `
+		SELECT
+			created_at,
+			event,
+			id,
+			ip_address,
+			request_id,
+			user_agent,
+			user_id
+		FROM
+			account_audit_event
+		WHERE
+			user_id = :user_id
+		ORDER BY
+			created_at DESC,
+			id DESC
+		LIMIT :limit
+		OFFSET :offset
+	`
+
+	queryInsertAccountAuditEvent = `
+		INSERT INTO
+			account_audit_event(user_id,event,ip_address,user_agent,request_id,created_at)
+		VALUES (
+			:user_id,
+			:event,
+			:ip_address,
+			:user_agent,
+			:request_id,
+			:created_at
+		)
+	`
+)
@@ -0,0 +1,236 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBRepository_GetAccountAuditEventsByUserID(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			created_at,
+			event,
+			id,
+			ip_address,
+			request_id,
+			user_agent,
+			user_id
+		FROM
+			account_audit_event
+		WHERE
+			user_id = $1
+		ORDER BY
+			created_at DESC,
+			id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AccountAuditEvent
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SelectContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_events",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"created_at", "event", "id", "ip_address", "request_id", "user_agent", "user_id"}).
+					AddRow(mockTime, "login_succeeded", "2", "127.0.0.1", "request", "agent", "123")
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(int64(123), 20, 40).WillReturnRows(rows)
+			},
+			want: []AccountAuditEvent{
+				{
+					CreatedAt: mockTime,
+					Event:     "login_succeeded",
+					ID:        2,
+					IPAddress: "127.0.0.1",
+					RequestID: "request",
+					UserAgent: "agent",
+					UserID:    123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetAccountAuditEventsByUserID(context.Background(), GetAccountAuditEventsParam{
+				Limit:  20,
+				Offset: 40,
+				UserID: 123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_InsertAccountAuditEvent(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO
+			account_audit_event(user_id,event,ip_address,user_agent,request_id,created_at)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6
+		)
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						int64(123),
+						"login_succeeded",
+						"127.0.0.1",
+						"agent",
+						"request",
+						mockTime,
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.InsertAccountAuditEvent(context.Background(), tx, InsertAccountAuditEventParam{
+				Event:     "login_succeeded",
+				IPAddress: "127.0.0.1",
+				RequestID: "request",
+				UserAgent: "agent",
+				UserID:    123,
+			})
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
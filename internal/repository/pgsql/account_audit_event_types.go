@@ -0,0 +1,33 @@
+package pgsql
+
+import (
+	// golang package
+	"time"
+)
+
+// AccountAuditEvent holds information about a security event that happened on a user's account.
+type AccountAuditEvent struct {
+	CreatedAt time.Time `db:"created_at"`
+	Event     string    `db:"event"`
+	ID        int64     `db:"id"`
+	IPAddress string    `db:"ip_address"`
+	RequestID string    `db:"request_id"`
+	UserAgent string    `db:"user_agent"`
+	UserID    int64     `db:"user_id"`
+}
+
+// GetAccountAuditEventsParam represents parameters needed to fetch security events of a user.
+type GetAccountAuditEventsParam struct {
+	Limit  int
+	Offset int
+	UserID int64
+}
+
+// InsertAccountAuditEventParam represents parameters needed to record a security event of a user.
+type InsertAccountAuditEventParam struct {
+	Event     string
+	IPAddress string
+	RequestID string
+	UserAgent string
+	UserID    int64
+}
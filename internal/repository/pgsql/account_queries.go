@@ -1,20 +1,84 @@
 package pgsql
 
 const (
+	// every table owned by user_account references it with ON DELETE CASCADE,
+	// so deleting the account purges everything it owns in a single statement.
+	queryDeleteUserAccountsPendingDeletion = `
+		WITH purged_account AS (
+			DELETE FROM
+				user_account
+			WHERE
+				deletion_requested_at <= :requested_before
+			RETURNING id
+		)
+		SELECT
+			id
+		FROM
+			purged_account
+	`
+
+	// the code is only removed while user still has it, so it can't be used twice.
+	queryDeleteUserTOTPRecoveryCode = `
+		UPDATE
+			user_account
+		SET
+			totp_recovery_codes = array_to_string(array_remove(string_to_array(totp_recovery_codes, ','), :code_hash), ',')
+		WHERE
+			id = :id
+			AND totp_enabled_at IS NOT NULL
+			AND :code_hash = ANY(string_to_array(totp_recovery_codes, ','))
+	`
+
 	queryGetUserAccountByEmail = `
 		SELECT 
+			budgeting_mode,
+			created_at,
+			deletion_requested_at,
+			disabled_at,
 			email, 
+			email_verified_at,
 			record_period_start, 
 			first_name, 
 			last_name, 
 			id,
-			password
+			password,
+			role,
+			totp_enabled_at,
+			totp_recovery_codes,
+			totp_secret,
+			timezone,
+			updated_at
 		FROM
 			user_account
 		WHERE
 			email = :email
 	`
 
+	queryGetUserAccountByID = `
+		SELECT 
+			budgeting_mode,
+			created_at,
+			deletion_requested_at,
+			disabled_at,
+			email, 
+			email_verified_at,
+			record_period_start, 
+			first_name, 
+			last_name, 
+			id,
+			password,
+			role,
+			totp_enabled_at,
+			totp_recovery_codes,
+			totp_secret,
+			timezone,
+			updated_at
+		FROM
+			user_account
+		WHERE
+			id = :id
+	`
+
 	queryInsertUserAccount = `
 		INSERT INTO 
 			user_account(email,"password",created_at)
@@ -25,18 +89,106 @@ const (
 		)
 	`
 
+	querySearchUserAccounts = `
+		SELECT
+			created_at,
+			disabled_at,
+			email,
+			email_verified_at,
+			first_name,
+			id,
+			last_name,
+			role,
+			totp_enabled_at
+		FROM
+			user_account
+		WHERE
+			email ILIKE :query
+			OR first_name ILIKE :query
+			OR last_name ILIKE :query
+		ORDER BY
+			id
+		LIMIT :limit
+		OFFSET :offset
+	`
+
 	queryUpdateUserAccount = `
 		UPDATE
 			user_account
 		SET 
+			budgeting_mode = COALESCE(NULLIF(:budgeting_mode, ''), budgeting_mode),
 			first_name = :first_name,
 			last_name = :last_name,
 			record_period_start = :record_period,
+			timezone = COALESCE(NULLIF(:timezone, ''), timezone),
+			updated_at = :updated_at
+		WHERE
+			id = :id
+	`
+
+	queryUpdateUserDeletionRequested = `
+		UPDATE
+			user_account
+		SET
+			deletion_requested_at = :deletion_requested_at
+		WHERE
+			id = :id
+	`
+
+	queryUpdateUserDisabled = `
+		UPDATE
+			user_account
+		SET
+			disabled_at = :disabled_at
+		WHERE
+			id = :id
+	`
+
+	queryUpdateUserEmail = `
+		UPDATE
+			user_account
+		SET
+			email = :email,
+			email_verified_at = :email_verified_at,
 			updated_at = :updated_at
 		WHERE
 			id = :id
 	`
 
+	queryUpdateUserEmailVerified = `
+		UPDATE
+			user_account
+		SET
+			email_verified_at = :email_verified_at
+		WHERE
+			id = :id
+			AND email_verified_at IS NULL
+	`
+
+	queryUpdateUserTOTP = `
+		UPDATE
+			user_account
+		SET
+			totp_enabled_at = :totp_enabled_at,
+			totp_last_used_step = :totp_last_used_step,
+			totp_recovery_codes = :totp_recovery_codes,
+			totp_secret = :totp_secret
+		WHERE
+			id = :id
+	`
+
+	// a step is only recorded when it's later than the last one used, so a code can't be replayed.
+	queryUpdateUserTOTPLastUsedStep = `
+		UPDATE
+			user_account
+		SET
+			totp_last_used_step = :step
+		WHERE
+			id = :id
+			AND totp_enabled_at IS NOT NULL
+			AND (totp_last_used_step IS NULL OR totp_last_used_step < :step)
+	`
+
 	queryUpdateUserPassword = `
 		UPDATE
 			user_account
@@ -45,4 +197,16 @@ const (
 		WHERE
 			id = :id
 	`
+
+	// the hash is only replaced when it's still the one that was read,
+	// so a password changed in the meantime isn't overwritten.
+	queryUpdateUserPasswordHash = `
+		UPDATE
+			user_account
+		SET
+			password = :password
+		WHERE
+			id = :id
+			AND password = :old_password
+	`
 )
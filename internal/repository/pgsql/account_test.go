@@ -26,21 +26,1320 @@ var (
 	}
 )
 
+func TestDBRepository_DeleteUserAccountsPendingDeletion(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		WITH purged_account AS (
+			DELETE FROM
+				user_account
+			WHERE
+				deletion_requested_at <= $1
+			RETURNING id
+		)
+		SELECT
+			id
+		FROM
+			purged_account
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []int64
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_QueryContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_rows_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(mockTime).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).RowError(0, assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_nothing_to_purge_then_return_empty",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(mockTime).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name: "when_accounts_purged_then_return_their_ids",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(mockTime).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+			},
+			want: []int64{1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.DeleteUserAccountsPendingDeletion(context.Background(), tx, mockTime)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_DeleteUserTOTPRecoveryCode(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET
+			totp_recovery_codes = array_to_string(array_remove(string_to_array(totp_recovery_codes, ','), $1), ',')
+		WHERE
+			id = $2
+			AND totp_enabled_at IS NOT NULL
+			AND $3 = ANY(string_to_array(totp_recovery_codes, ','))
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs("hash", int64(123), "hash").WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_recovery_code_not_exist_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs("hash", int64(123), "hash").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_recovery_code_deleted_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs("hash", int64(123), "hash").WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.DeleteUserTOTPRecoveryCode(context.Background(), tx, 123, "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestDBRepository_GetUserAccountByEmail(t *testing.T) {
 	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			budgeting_mode,
+			created_at,
+			deletion_requested_at,
+			disabled_at,
+			email,
+			email_verified_at,
+			record_period_start,
+			first_name,
+			last_name,
+			id,
+			password,
+			role,
+			totp_enabled_at,
+			totp_recovery_codes,
+			totp_secret,
+			timezone,
+			updated_at
+		FROM
+			user_account
+		WHERE
+			email = $1
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	type args struct {
+		email string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       Account
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			args: args{
+				email: "lee.jieun@iu.com",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetContext_error_then_return_empty_struct_and_an_error",
+			args: args{
+				email: "lee.jieun@iu.com",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_and_account_not_exist_then_return_empty_account",
+			args: args{
+				email: "lee.jieun@iu.com",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name: "when_no_error_occured_and_account_exist_then_return_the_account",
+			args: args{
+				email: "lee.jieun@iu.com",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"budgeting_mode", "deletion_requested_at", "email", "email_verified_at", "first_name", "id", "last_name", "password", "record_period_start", "totp_enabled_at", "totp_recovery_codes", "totp_secret", "timezone", "updated_at", "created_at", "disabled_at", "role"}).
+					AddRow(
+						"envelope",
+						mockTime,
+						"lee.jieun@iu.com",
+						mockTime,
+						"Ji Eun",
+						"1",
+						"Lee",
+						"ijigeum",
+						"1",
+						mockTime,
+						"hash1,hash2",
+						"secret",
+						"Asia/Jakarta",
+						mockTime,
+						mockTime,
+						nil,
+						"admin",
+					)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs("lee.jieun@iu.com").WillReturnRows(rows)
+			},
+			want: Account{
+				BudgetingMode:       "envelope",
+				CreatedAt:           sql.NullTime{Time: mockTime, Valid: true},
+				DeletionRequestedAt: sql.NullTime{Time: mockTime, Valid: true},
+				Email:               "lee.jieun@iu.com",
+				EmailVerifiedAt:     sql.NullTime{Time: mockTime, Valid: true},
+				FirstName:           sql.NullString{String: "Ji Eun", Valid: true},
+				ID:                  1,
+				LastName:            sql.NullString{String: "Lee", Valid: true},
+				Password:            "ijigeum",
+				RecordPeriodStart:   1,
+				Role:                "admin",
+				TOTPEnabledAt:       sql.NullTime{Time: mockTime, Valid: true},
+				TOTPRecoveryCodes:   sql.NullString{String: "hash1,hash2", Valid: true},
+				TOTPSecret:          sql.NullString{String: "secret", Valid: true},
+				Timezone:            "Asia/Jakarta",
+				UpdatedAt:           sql.NullTime{Time: mockTime, Valid: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSql, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSql,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetUserAccountByEmail(context.Background(), test.want.Email)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSql.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_GetUserAccountByID(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			budgeting_mode,
+			created_at,
+			deletion_requested_at,
+			disabled_at,
+			email,
+			email_verified_at,
+			record_period_start,
+			first_name,
+			last_name,
+			id,
+			password,
+			role,
+			totp_enabled_at,
+			totp_recovery_codes,
+			totp_secret,
+			timezone,
+			updated_at
+		FROM
+			user_account
+		WHERE
+			id = $1
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	type args struct {
+		userID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       Account
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			args: args{
+				userID: 1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetContext_error_then_return_empty_struct_and_an_error",
+			args: args{
+				userID: 1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_and_account_not_exist_then_return_empty_account",
+			args: args{
+				userID: 1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name: "when_no_error_occured_and_account_exist_then_return_the_account",
+			args: args{
+				userID: 1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"budgeting_mode", "deletion_requested_at", "email", "email_verified_at", "first_name", "id", "last_name", "password", "record_period_start", "totp_enabled_at", "totp_recovery_codes", "totp_secret", "timezone", "updated_at", "created_at", "disabled_at", "role"}).
+					AddRow(
+						"envelope",
+						mockTime,
+						"lee.jieun@iu.com",
+						mockTime,
+						"Ji Eun",
+						"1",
+						"Lee",
+						"ijigeum",
+						"1",
+						mockTime,
+						"hash1,hash2",
+						"secret",
+						"Asia/Jakarta",
+						mockTime,
+						mockTime,
+						nil,
+						"admin",
+					)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(1).WillReturnRows(rows)
+			},
+			want: Account{
+				BudgetingMode:       "envelope",
+				CreatedAt:           sql.NullTime{Time: mockTime, Valid: true},
+				DeletionRequestedAt: sql.NullTime{Time: mockTime, Valid: true},
+				Email:               "lee.jieun@iu.com",
+				EmailVerifiedAt:     sql.NullTime{Time: mockTime, Valid: true},
+				FirstName:           sql.NullString{String: "Ji Eun", Valid: true},
+				ID:                  1,
+				LastName:            sql.NullString{String: "Lee", Valid: true},
+				Password:            "ijigeum",
+				RecordPeriodStart:   1,
+				Role:                "admin",
+				TOTPEnabledAt:       sql.NullTime{Time: mockTime, Valid: true},
+				TOTPRecoveryCodes:   sql.NullString{String: "hash1,hash2", Valid: true},
+				TOTPSecret:          sql.NullString{String: "secret", Valid: true},
+				Timezone:            "Asia/Jakarta",
+				UpdatedAt:           sql.NullTime{Time: mockTime, Valid: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSql, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSql,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetUserAccountByID(context.Background(), test.args.userID)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSql.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_InsertUserAccount(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO 
+			user_account(email,"password",created_at)
+		VALUES (
+			$1,
+			$2,
+			$3
+		)
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	type args struct {
+		email    string
+		password string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			args: args{
+				email:    "lee.jieun@iu.com",
+				password: "abcd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			args: args{
+				email:    "lee.jieun@iu.com",
+				password: "abcd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{
+				email:    "lee.jieun@iu.com",
+				password: "abcd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WithArgs("lee.jieun@iu.com", "abcd", mockTime).WillReturnResult(sqlmock.NewErrorResult(nil))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.InsertUserAccount(context.Background(), tx, test.args.email, test.args.password)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_SearchUserAccounts(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			created_at,
+			disabled_at,
+			email,
+			email_verified_at,
+			first_name,
+			id,
+			last_name,
+			role,
+			totp_enabled_at
+		FROM
+			user_account
+		WHERE
+			email ILIKE $1
+			OR first_name ILIKE $2
+			OR last_name ILIKE $3
+		ORDER BY
+			id
+		LIMIT $4
+		OFFSET $5
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		mockFields func(mockFields)
+		want       []Account
+		wantErr    error
+	}{
+		{
+			name:  "when_funcSQLXNamed_error_then_return_error",
+			query: "lee",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_SelectContext_error_then_return_error",
+			query: "lee",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_query_has_wildcard_then_escape_it",
+			query: "50%_off",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				pattern := `%50\%\_off%`
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(pattern, pattern, pattern, 20, 40).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:  "when_no_error_occured_then_return_accounts",
+			query: "lee",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"created_at", "disabled_at", "email", "email_verified_at", "first_name", "id", "last_name", "role", "totp_enabled_at"}).
+					AddRow(mockTime, mockTime, "lee.jieun@iu.com", nil, "Ji Eun", "1", "Lee", "user", nil)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs("%lee%", "%lee%", "%lee%", 20, 40).WillReturnRows(rows)
+			},
+			want: []Account{
+				{
+					CreatedAt:  sql.NullTime{Time: mockTime, Valid: true},
+					DisabledAt: sql.NullTime{Time: mockTime, Valid: true},
+					Email:      "lee.jieun@iu.com",
+					FirstName:  sql.NullString{String: "Ji Eun", Valid: true},
+					ID:         1,
+					LastName:   sql.NullString{String: "Lee", Valid: true},
+					Role:       "user",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.SearchUserAccounts(context.Background(), SearchUserAccountsParam{
+				Limit:  20,
+				Offset: 40,
+				Query:  test.query,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserAccount(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET 
+			budgeting_mode = COALESCE(NULLIF($1, ''), budgeting_mode),
+			first_name = $2,
+			last_name = $3,
+			record_period_start = $4,
+			timezone = COALESCE(NULLIF($5, ''), timezone),
+			updated_at = $6
+		WHERE
+			id = $7
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	type args struct {
+		param UpdateUserAccountParam
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			args: args{},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			args: args{
+				param: UpdateUserAccountParam{
+					FirstName:    "Ji Eun",
+					LastName:     "Lee",
+					RecordPeriod: 25,
+					UserID:       123,
+				},
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{
+				param: UpdateUserAccountParam{
+					BudgetingMode: "envelope",
+					FirstName:     "Ji Eun",
+					LastName:      "Lee",
+					RecordPeriod:  25,
+					Timezone:      "Asia/Makassar",
+					UserID:        123,
+				},
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						"envelope",
+						"Ji Eun",
+						"Lee",
+						25,
+						"Asia/Makassar",
+						mockTime,
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpdateUserAccount(context.Background(), tx, test.args.param)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserPassword(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET
+			password = $1
+		WHERE
+			id = $2
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	type args struct {
+		userID   int64
+		password string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			args: args{},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			args: args{
+				userID:   123,
+				password: "pass",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{
+				userID:   123,
+				password: "pass",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						"pass",
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpdateUserPassword(context.Background(), tx, test.args.userID, test.args.password)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserDeletionRequested(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET
+			deletion_requested_at = $1
+		WHERE
+			id = $2
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name        string
+		requestedAt sql.NullTime
+		mockFields  func(mockFields)
+		wantErr     error
+	}{
+		{
+			name:        "when_funcSQLXNamed_error_then_return_error",
+			requestedAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:        "when_ExecContext_error_then_return_error",
+			requestedAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:        "when_deletion_requested_then_return_nil",
+			requestedAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs(mockTime, int64(123)).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "when_deletion_cancelled_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs(nil, int64(123)).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpdateUserDeletionRequested(context.Background(), tx, 123, test.requestedAt)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserDisabled(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET
+			disabled_at = $1
+		WHERE
+			id = $2
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		disabledAt sql.NullTime
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_funcSQLXNamed_error_then_return_error",
+			disabledAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_ExecContext_error_then_return_error",
+			disabledAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_disabling_account_then_save_the_time",
+			disabledAt: sql.NullTime{Time: mockTime, Valid: true},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						mockTime,
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "when_enabling_account_then_save_null",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						nil,
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpdateUserDisabled(context.Background(), tx, 123, test.disabledAt)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserEmail(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			user_account
+		SET
+			email = $1,
+			email_verified_at = $2,
+			updated_at = $3
+		WHERE
+			id = $4
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						"new@mail.com",
+						mockTime,
+						mockTime,
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpdateUserEmail(context.Background(), tx, 123, "new@mail.com")
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateUserEmailVerified(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
 
 	expectedQuery := `
-		SELECT
-			email,
-			record_period_start,
-			first_name,
-			last_name,
-			id,
-			password
-		FROM
+		UPDATE
 			user_account
+		SET
+			email_verified_at = $1
 		WHERE
-			email = $1
+			id = $2
+			AND email_verified_at IS NULL
 	`
 
 	type mockFields struct {
@@ -48,23 +1347,16 @@ func TestDBRepository_GetUserAccountByEmail(t *testing.T) {
 		sql   sqlmock.Sqlmock
 	}
 
-	type args struct {
-		email string
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
-		want       Account
 		wantErr    error
 	}{
 		{
 			name: "when_funcSQLXNamed_error_then_return_error",
-			args: args{
-				email: "lee.jieun@iu.com",
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
 				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
 					return "", nil, assert.AnError
@@ -73,58 +1365,32 @@ func TestDBRepository_GetUserAccountByEmail(t *testing.T) {
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_GetContext_error_then_return_empty_struct_and_an_error",
-			args: args{
-				email: "lee.jieun@iu.com",
-			},
+			name: "when_ExecContext_error_then_return_error",
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_no_error_occured_and_account_not_exist_then_return_empty_account",
-			args: args{
-				email: "lee.jieun@iu.com",
-			},
-			mockFields: func(mf mockFields) {
-				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.sql.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}))
-			},
-		},
-		{
-			name: "when_no_error_occured_and_account_exist_then_return_the_account",
-			args: args{
-				email: "lee.jieun@iu.com",
-			},
+			name: "when_no_error_occured_then_return_nil",
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
-				rows := sqlmock.NewRows([]string{"email", "first_name", "id", "last_name", "password", "record_period_start"}).
-					AddRow(
-						"lee.jieun@iu.com",
-						"Ji Eun",
-						"1",
-						"Lee",
-						"ijigeum",
-						"1",
-					)
-				mf.sql.ExpectQuery(expectedQuery).WithArgs("lee.jieun@iu.com").WillReturnRows(rows)
-			},
-			want: Account{
-				Email:             "lee.jieun@iu.com",
-				FirstName:         sql.NullString{String: "Ji Eun", Valid: true},
-				ID:                1,
-				LastName:          sql.NullString{String: "Lee", Valid: true},
-				Password:          "ijigeum",
-				RecordPeriodStart: 1,
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						mockTime,
+						int64(123),
+					).WillReturnResult(driver.RowsAffected(1))
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			mockDB, mockSql, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 			if err != nil {
 				assert.Nil(t, err)
 				return
@@ -135,10 +1401,13 @@ func TestDBRepository_GetUserAccountByEmail(t *testing.T) {
 				funcSQLXNamed = funcSQLXNamedOri
 			}()
 
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
 				infra: NewMockinfraProvider(ctrl),
-				sql:   mockSql,
+				sql:   mockSQL,
 			}
 			test.mockFields(mockFields)
 
@@ -147,26 +1416,23 @@ func TestDBRepository_GetUserAccountByEmail(t *testing.T) {
 				db:    sqlx.NewDb(mockDB, "postgres"),
 			}
 
-			got, err := r.GetUserAccountByEmail(context.Background(), test.want.Email)
-			assert.Equal(t, test.want, got)
+			err = r.UpdateUserEmailVerified(context.Background(), tx, 123)
 			assert.Equal(t, test.wantErr, err)
-			assert.Nil(t, mockSql.ExpectationsWereMet())
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestDBRepository_InsertUserAccount(t *testing.T) {
+func TestDBRepository_UpdateUserPasswordHash(t *testing.T) {
 	funcSQLXNamedOri := sqlx.Named
-	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
-
 	expectedQuery := `
-		INSERT INTO 
-			user_account(email,"password",created_at)
-		VALUES (
-			$1,
-			$2,
-			$3
-		)
+		UPDATE
+			user_account
+		SET
+			password = $1
+		WHERE
+			id = $2
+			AND password = $3
 	`
 
 	type mockFields struct {
@@ -174,25 +1440,16 @@ func TestDBRepository_InsertUserAccount(t *testing.T) {
 		sql   sqlmock.Sqlmock
 	}
 
-	type args struct {
-		email    string
-		password string
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
+		want       bool
 		wantErr    error
 	}{
 		{
 			name: "when_funcSQLXNamed_error_then_return_error",
-			args: args{
-				email:    "lee.jieun@iu.com",
-				password: "abcd",
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
 				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
 					return "", nil, assert.AnError
@@ -202,30 +1459,34 @@ func TestDBRepository_InsertUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_ExecContext_error_then_return_error",
-			args: args{
-				email:    "lee.jieun@iu.com",
-				password: "abcd",
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
-
 				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_no_error_occured_then_return_nil",
-			args: args{
-				email:    "lee.jieun@iu.com",
-				password: "abcd",
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs("new_hash", int64(123), "old_hash").WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_password_had_been_changed_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs("new_hash", int64(123), "old_hash").WillReturnResult(sqlmock.NewResult(0, 0))
 			},
+		},
+		{
+			name: "when_hash_replaced_then_return_true",
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
-
-				mf.sql.ExpectExec(expectedQuery).WithArgs("lee.jieun@iu.com", "abcd", mockTime).WillReturnResult(sqlmock.NewErrorResult(nil))
+				mf.sql.ExpectExec(expectedQuery).WithArgs("new_hash", int64(123), "old_hash").WillReturnResult(sqlmock.NewResult(0, 1))
 			},
+			want: true,
 		},
 	}
 	for _, test := range tests {
@@ -256,49 +1517,52 @@ func TestDBRepository_InsertUserAccount(t *testing.T) {
 				db:    sqlx.NewDb(mockDB, "postgres"),
 			}
 
-			err = r.InsertUserAccount(context.Background(), tx, test.args.email, test.args.password)
+			got, err := r.UpdateUserPasswordHash(context.Background(), tx, 123, "old_hash", "new_hash")
+			assert.Equal(t, test.want, got)
 			assert.Equal(t, test.wantErr, err)
 			assert.Nil(t, mockSQL.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestDBRepository_UpdateUserAccount(t *testing.T) {
+func TestDBRepository_UpdateUserTOTP(t *testing.T) {
 	funcSQLXNamedOri := sqlx.Named
 	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
 
 	expectedQuery := `
 		UPDATE
 			user_account
-		SET 
-			first_name = $1,
-			last_name = $2,
-			record_period_start = $3,
-			updated_at = $4
+		SET
+			totp_enabled_at = $1,
+			totp_last_used_step = $2,
+			totp_recovery_codes = $3,
+			totp_secret = $4
 		WHERE
 			id = $5
 	`
 
+	mockParam := UpdateUserTOTPParam{
+		EnabledAt:     sql.NullTime{Time: mockTime, Valid: true},
+		LastUsedStep:  sql.NullInt64{Int64: 56000000, Valid: true},
+		RecoveryCodes: sql.NullString{String: "hash1,hash2", Valid: true},
+		Secret:        sql.NullString{String: "secret", Valid: true},
+		UserID:        123,
+	}
+
 	type mockFields struct {
 		infra *MockinfraProvider
 		sql   sqlmock.Sqlmock
 	}
 
-	type args struct {
-		param UpdateUserAccountParam
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
 		wantErr    error
 	}{
 		{
 			name: "when_funcSQLXNamed_error_then_return_error",
-			args: args{},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
 				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
 					return "", nil, assert.AnError
@@ -308,17 +1572,8 @@ func TestDBRepository_UpdateUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_ExecContext_error_then_return_error",
-			args: args{
-				param: UpdateUserAccountParam{
-					FirstName:    "Ji Eun",
-					LastName:     "Lee",
-					RecordPeriod: 25,
-					UserID:       123,
-				},
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
 				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
 			},
@@ -326,24 +1581,15 @@ func TestDBRepository_UpdateUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_no_error_occured_then_return_nil",
-			args: args{
-				param: UpdateUserAccountParam{
-					FirstName:    "Ji Eun",
-					LastName:     "Lee",
-					RecordPeriod: 25,
-					UserID:       123,
-				},
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
 
 				mf.sql.ExpectExec(expectedQuery).
 					WithArgs(
-						"Ji Eun",
-						"Lee",
-						25,
-						mockTime,
+						mockParam.EnabledAt,
+						mockParam.LastUsedStep,
+						mockParam.RecoveryCodes,
+						mockParam.Secret,
 						int64(123),
 					).WillReturnResult(driver.RowsAffected(1))
 			},
@@ -377,23 +1623,24 @@ func TestDBRepository_UpdateUserAccount(t *testing.T) {
 				db:    sqlx.NewDb(mockDB, "postgres"),
 			}
 
-			err = r.UpdateUserAccount(context.Background(), tx, test.args.param)
+			err = r.UpdateUserTOTP(context.Background(), tx, mockParam)
 			assert.Equal(t, test.wantErr, err)
 			assert.Nil(t, mockSQL.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestDBRepository_UpdateUserPassword(t *testing.T) {
+func TestDBRepository_UpdateUserTOTPLastUsedStep(t *testing.T) {
 	funcSQLXNamedOri := sqlx.Named
-
 	expectedQuery := `
 		UPDATE
 			user_account
 		SET
-			password = $1
+			totp_last_used_step = $1
 		WHERE
 			id = $2
+			AND totp_enabled_at IS NOT NULL
+			AND (totp_last_used_step IS NULL OR totp_last_used_step < $3)
 	`
 
 	type mockFields struct {
@@ -401,19 +1648,14 @@ func TestDBRepository_UpdateUserPassword(t *testing.T) {
 		sql   sqlmock.Sqlmock
 	}
 
-	type args struct {
-		userID   int64
-		password string
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
+		want       bool
 		wantErr    error
 	}{
 		{
 			name: "when_funcSQLXNamed_error_then_return_error",
-			args: args{},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
 
@@ -425,32 +1667,34 @@ func TestDBRepository_UpdateUserPassword(t *testing.T) {
 		},
 		{
 			name: "when_ExecContext_error_then_return_error",
-			args: args{
-				userID:   123,
-				password: "pass",
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-
 				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_no_error_occured_then_return_nil",
-			args: args{
-				userID:   123,
-				password: "pass",
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs(int64(56000000), int64(123), int64(56000000)).WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
 			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_step_had_been_used_then_return_false",
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-
-				mf.sql.ExpectExec(expectedQuery).
-					WithArgs(
-						"pass",
-						int64(123),
-					).WillReturnResult(driver.RowsAffected(1))
+				mf.sql.ExpectExec(expectedQuery).WithArgs(int64(56000000), int64(123), int64(56000000)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_step_recorded_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WithArgs(int64(56000000), int64(123), int64(56000000)).WillReturnResult(sqlmock.NewResult(0, 1))
 			},
+			want: true,
 		},
 	}
 	for _, test := range tests {
@@ -481,7 +1725,8 @@ func TestDBRepository_UpdateUserPassword(t *testing.T) {
 				db:    sqlx.NewDb(mockDB, "postgres"),
 			}
 
-			err = r.UpdateUserPassword(context.Background(), tx, test.args.userID, test.args.password)
+			got, err := r.UpdateUserTOTPLastUsedStep(context.Background(), tx, 123, 56000000)
+			assert.Equal(t, test.want, got)
 			assert.Equal(t, test.wantErr, err)
 			assert.Nil(t, mockSQL.ExpectationsWereMet())
 		})
@@ -7,18 +7,50 @@ import (
 
 // Account holds information about user's account
 type Account struct {
-	Email             string         `db:"email"`
-	FirstName         sql.NullString `db:"first_name"`
-	ID                int64          `db:"id"`
-	LastName          sql.NullString `db:"last_name"`
-	Password          string         `db:"password"`
-	RecordPeriodStart int            `db:"record_period_start"`
+	BudgetingMode       string         `db:"budgeting_mode"`
+	CreatedAt           sql.NullTime   `db:"created_at"`
+	DeletionRequestedAt sql.NullTime   `db:"deletion_requested_at"`
+	DisabledAt          sql.NullTime   `db:"disabled_at"`
+	Email               string         `db:"email"`
+	EmailVerifiedAt     sql.NullTime   `db:"email_verified_at"`
+	FirstName           sql.NullString `db:"first_name"`
+	ID                  int64          `db:"id"`
+	LastName            sql.NullString `db:"last_name"`
+	Password            string         `db:"password"`
+	RecordPeriodStart   int            `db:"record_period_start"`
+	Role                string         `db:"role"`
+	TOTPEnabledAt       sql.NullTime   `db:"totp_enabled_at"`
+	TOTPRecoveryCodes   sql.NullString `db:"totp_recovery_codes"`
+	TOTPSecret          sql.NullString `db:"totp_secret"`
+	Timezone            string         `db:"timezone"`
+	UpdatedAt           sql.NullTime   `db:"updated_at"`
 }
 
-// UpdateUserAccountParam represents parameters needed to update user's account
+// SearchUserAccountsParam represents parameters needed to search user accounts.
+// Query is matched against email, first name and last name.
+type SearchUserAccountsParam struct {
+	Limit  int
+	Offset int
+	Query  string
+}
+
+// UpdateUserAccountParam represents parameters needed to update user's account.
+// An empty BudgetingMode or Timezone keeps the current one.
 type UpdateUserAccountParam struct {
-	FirstName    string
-	LastName     string
-	RecordPeriod int
-	UserID       int64
+	BudgetingMode string
+	FirstName     string
+	LastName      string
+	RecordPeriod  int
+	Timezone      string
+	UserID        int64
+}
+
+// UpdateUserTOTPParam represents parameters needed to update user's TOTP two-factor authentication.
+// A field that isn't valid will be saved as NULL.
+type UpdateUserTOTPParam struct {
+	EnabledAt     sql.NullTime
+	LastUsedStep  sql.NullInt64
+	RecoveryCodes sql.NullString
+	Secret        sql.NullString
+	UserID        int64
 }
@@ -0,0 +1,38 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// InsertAdminAuditLog will create a new entry in table admin_audit_log in database.
+func (repo *DBRepository) InsertAdminAuditLog(ctx context.Context, tx *sql.Tx, param InsertAdminAuditLogParam) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"action":         param.Action,
+		"actor_id":       param.ActorID,
+		"created_at":     repo.infra.GetTimeGMT7(),
+		"refused":        param.Refused,
+		"target_user_id": param.TargetUserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertAdminAuditLog, namedParam)
+	if err != nil {
+		log.Printf("[InsertAdminAuditLog] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[InsertAdminAuditLog] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package pgsql
+
+const (
+	queryInsertAdminAuditLog = `
+		INSERT INTO
+			admin_audit_log(actor_id,action,target_user_id,refused,created_at)
+		VALUES (
+			:actor_id,
+			:action,
+			:target_user_id,
+			:refused,
+			:created_at
+		)
+	`
+)
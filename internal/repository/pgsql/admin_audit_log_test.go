@@ -0,0 +1,120 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBRepository_InsertAdminAuditLog(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO
+			admin_audit_log(actor_id,action,target_user_id,refused,created_at)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5
+		)
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(
+						int64(1),
+						"disable_account",
+						int64(123),
+						true,
+						mockTime,
+					).WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.InsertAdminAuditLog(context.Background(), tx, InsertAdminAuditLogParam{
+				Action:       "disable_account",
+				ActorID:      1,
+				Refused:      true,
+				TargetUserID: 123,
+			})
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package pgsql
+
+// InsertAdminAuditLogParam represents parameters needed to record an action an admin took on an account.
+// Refused is true if the action was refused instead of taken.
+type InsertAdminAuditLogParam struct {
+	Action       string
+	ActorID      int64
+	Refused      bool
+	TargetUserID int64
+}
@@ -0,0 +1,369 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	// external package
+	"github.com/jmoiron/sqlx"
+)
+
+// periodDateLayout is the layout of a period start date, periods are identified by their start date
+// so they don't shift when the timezone of the user changes.
+const periodDateLayout = "2006-01-02"
+
+// DeleteBudget will delete the budget of a category of a user in a period.
+// It returns false if the category isn't budgeted in the period.
+func (repo *DBRepository) DeleteBudget(ctx context.Context, tx *sql.Tx, param DeleteBudgetParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"category_id":  param.CategoryID,
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteBudget, namedParam)
+	if err != nil {
+		log.Printf("[DeleteBudget] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteBudget] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeleteBudget] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetAvailableToAssign will count, per currency, the income of a user that isn't assigned to any envelope budget
+// up to a period, adding back what envelope budgets of the periods before have released, ordered by the currency.
+// The amount is negative when more is assigned than earned.
+func (repo *DBRepository) GetAvailableToAssign(ctx context.Context, param GetAvailableToAssignParam) ([]AvailableToAssign, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"end":          param.End,
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetAvailableToAssign, namedParam)
+	if err != nil {
+		log.Printf("[GetAvailableToAssign] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []AvailableToAssign
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetAvailableToAssign] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetBudgetsByPeriod will fetch every budget of a user in a period alongside how much is spent on them,
+// ordered by their category.
+func (repo *DBRepository) GetBudgetsByPeriod(ctx context.Context, param GetBudgetsParam) ([]Budget, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"end":          param.End,
+		"period_start": param.Start.Format(periodDateLayout),
+		"start":        param.Start,
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetBudgetsByPeriod, namedParam)
+	if err != nil {
+		log.Printf("[GetBudgetsByPeriod] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []Budget
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetBudgetsByPeriod] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetBudgetsForUpdate will fetch the budgets of two categories of a user in a period alongside
+// how much is spent on them, ordered by their category, and lock them until tx is over
+// so money moved between them can't be overwritten by another move.
+func (repo *DBRepository) GetBudgetsForUpdate(ctx context.Context, tx *sql.Tx, param GetBudgetsForUpdateParam) ([]Budget, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"end":              param.End,
+		"from_category_id": param.FromCategoryID,
+		"period_start":     param.Start.Format(periodDateLayout),
+		"start":            param.Start,
+		"to_category_id":   param.ToCategoryID,
+		"user_id":          param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetBudgetsForUpdate, namedParam)
+	if err != nil {
+		log.Printf("[GetBudgetsForUpdate] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetBudgetsForUpdate] tx.QueryContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Budget
+	err = sqlx.StructScan(rows, &result)
+	if err != nil {
+		log.Printf("[GetBudgetsForUpdate] sqlx.StructScan() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InsertBudget will create the budget of a category of a user in a period.
+// It returns false if the category is archived, doesn't belong to the user or is already budgeted in the period.
+func (repo *DBRepository) InsertBudget(ctx context.Context, tx *sql.Tx, param InsertBudgetParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":        param.Amount,
+		"carried_over":  param.CarriedOver,
+		"carry_pending": param.CarryPending,
+		"category_id":   param.CategoryID,
+		"created_at":    repo.infra.GetTimeGMT7(),
+		"currency":      param.Currency,
+		"envelope":      param.Envelope,
+		"period_start":  param.PeriodStart.Format(periodDateLayout),
+		"rollover_mode": param.RolloverMode,
+		"user_id":       param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertBudget, namedParam)
+	if err != nil {
+		log.Printf("[InsertBudget] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[InsertBudget] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[InsertBudget] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// MergeBudgets will sum every budget of a category of a user into the budget of another category in the same period,
+// or move it to the other category when that category isn't budgeted in the period.
+// It returns how many budgets are left unmerged because they are in another currency than the budget they'd be summed into.
+func (repo *DBRepository) MergeBudgets(ctx context.Context, tx *sql.Tx, param MergeBudgetsParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"from_category_id": param.FromCategoryID,
+		"to_category_id":   param.ToCategoryID,
+		"updated_at":       repo.infra.GetTimeGMT7(),
+		"user_id":          param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryMergeBudgets, namedParam)
+	if err != nil {
+		log.Printf("[MergeBudgets] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	var unmerged int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&unmerged)
+	if err != nil {
+		log.Printf("[MergeBudgets] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	return unmerged, nil
+}
+
+// ReleaseBudget will save what the budget of a category of a user in a period gives back to what is left
+// to assign once the period has ended. It returns false if the budget doesn't exist or is already released.
+func (repo *DBRepository) ReleaseBudget(ctx context.Context, tx *sql.Tx, param ReleaseBudgetParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"category_id":  param.CategoryID,
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"released":     param.Released,
+		"updated_at":   repo.infra.GetTimeGMT7(),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryReleaseBudget, namedParam)
+	if err != nil {
+		log.Printf("[ReleaseBudget] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[ReleaseBudget] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[ReleaseBudget] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// SettleBudgetCarry will fix what the budget of a category of a user in a period carries over
+// from the previous period. It returns false if the budget doesn't exist or its carry is already fixed.
+func (repo *DBRepository) SettleBudgetCarry(ctx context.Context, tx *sql.Tx, param SettleBudgetCarryParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"carried_over": param.CarriedOver,
+		"category_id":  param.CategoryID,
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"updated_at":   repo.infra.GetTimeGMT7(),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(querySettleBudgetCarry, namedParam)
+	if err != nil {
+		log.Printf("[SettleBudgetCarry] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[SettleBudgetCarry] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[SettleBudgetCarry] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateBudgetAmount will change the amount and the carried over amount of the budget of a category
+// of a user in a period. It returns false if the category isn't budgeted in the period.
+func (repo *DBRepository) UpdateBudgetAmount(ctx context.Context, tx *sql.Tx, param UpdateBudgetAmountParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":       param.Amount,
+		"carried_over": param.CarriedOver,
+		"category_id":  param.CategoryID,
+		"envelope":     param.Envelope,
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"updated_at":   repo.infra.GetTimeGMT7(),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateBudgetAmount, namedParam)
+	if err != nil {
+		log.Printf("[UpdateBudgetAmount] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateBudgetAmount] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateBudgetAmount] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpsertBudget will set the budget of a category of a user in a period,
+// replacing the budget that is already set for the category in that period.
+// The carried over amount of a budget that is already set is kept. It returns id of the budget.
+func (repo *DBRepository) UpsertBudget(ctx context.Context, tx *sql.Tx, param UpsertBudgetParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":        param.Amount,
+		"carried_over":  param.CarriedOver,
+		"carry_pending": param.CarryPending,
+		"category_id":   param.CategoryID,
+		"created_at":    repo.infra.GetTimeGMT7(),
+		"currency":      param.Currency,
+		"envelope":      param.Envelope,
+		"period_start":  param.PeriodStart.Format(periodDateLayout),
+		"rollover_mode": param.RolloverMode,
+		"user_id":       param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpsertBudget, namedParam)
+	if err != nil {
+		log.Printf("[UpsertBudget] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&id)
+	if err != nil {
+		log.Printf("[UpsertBudget] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,290 @@
+package pgsql
+
+const (
+	queryDeleteBudget = `
+		DELETE FROM
+			budget
+		WHERE
+			category_id = :category_id
+			AND period_start = :period_start
+			AND user_id = :user_id
+	`
+
+	queryGetAvailableToAssign = `
+		SELECT
+			envelope.currency,
+			SUM(envelope.amount) AS amount
+		FROM (
+			SELECT
+				wallet.currency,
+				transaction.amount
+			FROM
+				transaction
+				JOIN wallet ON wallet.id = transaction.wallet_id
+			WHERE
+				transaction.user_id = :user_id
+				AND transaction.type = 'income'
+				AND transaction.transacted_at < :end
+			UNION ALL
+			SELECT
+				budget.currency,
+				CASE WHEN budget.period_start < :period_start THEN COALESCE(budget.released, 0) ELSE 0 END - budget.amount
+			FROM
+				budget
+			WHERE
+				budget.user_id = :user_id
+				AND budget.envelope
+				AND budget.period_start <= :period_start
+		) AS envelope
+		GROUP BY
+			envelope.currency
+		ORDER BY
+			envelope.currency
+	`
+
+	queryGetBudgetsByPeriod = `
+		SELECT
+			budget.amount,
+			budget.carried_over,
+			budget.carry_pending,
+			budget.category_id,
+			budget.created_at,
+			budget.currency,
+			budget.envelope,
+			budget.id,
+			budget.period_start,
+			budget.rollover_mode,
+			COALESCE((
+				SELECT
+					SUM(transaction.amount)
+				FROM
+					transaction
+					JOIN category ON category.id = transaction.category_id
+					JOIN wallet ON wallet.id = transaction.wallet_id
+				WHERE
+					transaction.user_id = budget.user_id
+					AND transaction.type = 'expense'
+					AND transaction.transacted_at >= :start
+					AND transaction.transacted_at < :end
+					AND wallet.currency = budget.currency
+					AND (category.id = budget.category_id OR category.parent_id = budget.category_id)
+			), 0) AS spent,
+			budget.updated_at,
+			budget.user_id
+		FROM
+			budget
+		WHERE
+			budget.user_id = :user_id
+			AND budget.period_start = :period_start
+		ORDER BY
+			budget.category_id
+	`
+
+	queryGetBudgetsForUpdate = `
+		SELECT
+			budget.amount,
+			budget.carried_over,
+			budget.carry_pending,
+			budget.category_id,
+			budget.created_at,
+			budget.currency,
+			budget.envelope,
+			budget.id,
+			budget.period_start,
+			budget.rollover_mode,
+			COALESCE((
+				SELECT
+					SUM(transaction.amount)
+				FROM
+					transaction
+					JOIN category ON category.id = transaction.category_id
+					JOIN wallet ON wallet.id = transaction.wallet_id
+				WHERE
+					transaction.user_id = budget.user_id
+					AND transaction.type = 'expense'
+					AND transaction.transacted_at >= :start
+					AND transaction.transacted_at < :end
+					AND wallet.currency = budget.currency
+					AND (category.id = budget.category_id OR category.parent_id = budget.category_id)
+			), 0) AS spent,
+			budget.updated_at,
+			budget.user_id
+		FROM
+			budget
+		WHERE
+			budget.user_id = :user_id
+			AND budget.period_start = :period_start
+			AND budget.category_id IN (:from_category_id, :to_category_id)
+		ORDER BY
+			budget.category_id
+		FOR UPDATE OF budget
+	`
+
+	queryInsertBudget = `
+		INSERT INTO
+			budget(user_id,category_id,period_start,currency,amount,rollover_mode,carried_over,carry_pending,envelope,created_at)
+		SELECT
+			:user_id,
+			category.id,
+			:period_start,
+			:currency,
+			:amount,
+			:rollover_mode,
+			:carried_over,
+			:carry_pending,
+			:envelope,
+			:created_at
+		FROM
+			category
+		WHERE
+			category.id = :category_id
+			AND category.user_id = :user_id
+			AND category.archived_at IS NULL
+		ON CONFLICT (user_id, category_id, period_start) DO NOTHING
+	`
+
+	// budgets of the source category are summed into the budget of the target category in the same period,
+	// or moved to the target category when it isn't budgeted in that period. Budgets in another currency
+	// than the budget of the target category are left as they are and counted, so the merge can be refused.
+	queryMergeBudgets = `
+		WITH source AS (
+			SELECT
+				budget.amount,
+				budget.carried_over,
+				budget.carry_pending,
+				budget.currency,
+				budget.envelope,
+				budget.period_start,
+				budget.released
+			FROM
+				budget
+			WHERE
+				budget.user_id = :user_id
+				AND budget.category_id = :from_category_id
+		), summed AS (
+			UPDATE
+				budget
+			SET
+				amount = budget.amount + source.amount,
+				carried_over = budget.carried_over + source.carried_over,
+				carry_pending = budget.carry_pending OR source.carry_pending,
+				envelope = budget.envelope OR source.envelope,
+				released = CASE
+					WHEN budget.released IS NULL AND source.released IS NULL THEN NULL
+					ELSE COALESCE(budget.released, 0) + COALESCE(source.released, 0)
+				END,
+				updated_at = :updated_at
+			FROM
+				source
+			WHERE
+				budget.user_id = :user_id
+				AND budget.category_id = :to_category_id
+				AND budget.period_start = source.period_start
+				AND budget.currency = source.currency
+			RETURNING
+				budget.period_start
+		), moved AS (
+			UPDATE
+				budget
+			SET
+				category_id = :to_category_id,
+				updated_at = :updated_at
+			WHERE
+				budget.user_id = :user_id
+				AND budget.category_id = :from_category_id
+				AND NOT EXISTS (
+					SELECT
+						1
+					FROM
+						budget AS target
+					WHERE
+						target.user_id = :user_id
+						AND target.category_id = :to_category_id
+						AND target.period_start = budget.period_start
+				)
+			RETURNING
+				budget.period_start
+		), deleted AS (
+			DELETE FROM
+				budget
+			WHERE
+				budget.user_id = :user_id
+				AND budget.category_id = :from_category_id
+				AND budget.period_start IN (SELECT summed.period_start FROM summed)
+			RETURNING
+				budget.period_start
+		)
+		SELECT
+			COUNT(*)
+		FROM
+			source
+		WHERE
+			source.period_start NOT IN (SELECT moved.period_start FROM moved)
+			AND source.period_start NOT IN (SELECT deleted.period_start FROM deleted)
+	`
+
+	queryReleaseBudget = `
+		UPDATE
+			budget
+		SET
+			released = :released,
+			updated_at = :updated_at
+		WHERE
+			category_id = :category_id
+			AND period_start = :period_start
+			AND user_id = :user_id
+			AND released IS NULL
+	`
+
+	querySettleBudgetCarry = `
+		UPDATE
+			budget
+		SET
+			carried_over = carried_over + :carried_over,
+			carry_pending = FALSE,
+			updated_at = :updated_at
+		WHERE
+			category_id = :category_id
+			AND period_start = :period_start
+			AND user_id = :user_id
+			AND carry_pending
+	`
+
+	queryUpdateBudgetAmount = `
+		UPDATE
+			budget
+		SET
+			amount = :amount,
+			carried_over = :carried_over,
+			envelope = :envelope,
+			updated_at = :updated_at
+		WHERE
+			category_id = :category_id
+			AND period_start = :period_start
+			AND user_id = :user_id
+	`
+
+	queryUpsertBudget = `
+		INSERT INTO
+			budget(user_id,category_id,period_start,currency,amount,rollover_mode,carried_over,carry_pending,envelope,created_at)
+		VALUES (
+			:user_id,
+			:category_id,
+			:period_start,
+			:currency,
+			:amount,
+			:rollover_mode,
+			:carried_over,
+			:carry_pending,
+			:envelope,
+			:created_at
+		)
+		ON CONFLICT (user_id, category_id, period_start) DO UPDATE SET
+			amount = EXCLUDED.amount,
+			currency = EXCLUDED.currency,
+			rollover_mode = EXCLUDED.rollover_mode,
+			envelope = EXCLUDED.envelope,
+			updated_at = EXCLUDED.created_at
+		RETURNING id
+	`
+)
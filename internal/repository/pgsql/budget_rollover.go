@@ -0,0 +1,90 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// GetBudgetRolloverByUserID will fetch the latest period budgets of a user are rolled over into.
+// If they have never been rolled over, it will return empty BudgetRollover.
+func (repo *DBRepository) GetBudgetRolloverByUserID(ctx context.Context, userID int64) (BudgetRollover, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetBudgetRolloverByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetBudgetRolloverByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return BudgetRollover{}, err
+	}
+
+	var result BudgetRollover
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetBudgetRolloverByUserID] repo.db.GetContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return BudgetRollover{}, err
+	}
+
+	return result, nil
+}
+
+// LockBudgetRollover will take the lock of rolling over budgets of a user, waiting while another transaction holds it.
+// The lock is held until tx is committed or rolled back.
+func (repo *DBRepository) LockBudgetRollover(ctx context.Context, tx *sql.Tx, userID int64) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryLockBudgetRollover, namedParam)
+	if err != nil {
+		log.Printf("[LockBudgetRollover] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[LockBudgetRollover] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// UpsertBudgetRollover will save the latest period budgets of a user are rolled over into.
+// A period before the one that is already saved is ignored.
+func (repo *DBRepository) UpsertBudgetRollover(ctx context.Context, tx *sql.Tx, param UpsertBudgetRolloverParam) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"created_at":   repo.infra.GetTimeGMT7(),
+		"period_start": param.PeriodStart.Format(periodDateLayout),
+		"user_id":      param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpsertBudgetRollover, namedParam)
+	if err != nil {
+		log.Printf("[UpsertBudgetRollover] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpsertBudgetRollover] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package pgsql
+
+const (
+	queryGetBudgetRolloverByUserID = `
+		SELECT
+			period_start,
+			user_id
+		FROM
+			budget_rollover
+		WHERE
+			user_id = :user_id
+	`
+
+	queryLockBudgetRollover = `
+		SELECT
+			pg_advisory_xact_lock(:user_id)
+	`
+
+	queryUpsertBudgetRollover = `
+		INSERT INTO
+			budget_rollover(user_id,period_start,created_at)
+		VALUES (
+			:user_id,
+			:period_start,
+			:created_at
+		)
+		ON CONFLICT (user_id) DO UPDATE SET
+			period_start = EXCLUDED.period_start,
+			updated_at = EXCLUDED.created_at
+		WHERE
+			budget_rollover.period_start < EXCLUDED.period_start
+	`
+)
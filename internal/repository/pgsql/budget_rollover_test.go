@@ -0,0 +1,298 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBRepository_GetBudgetRolloverByUserID(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockStart := time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			period_start,
+			user_id
+		FROM
+			budget_rollover
+		WHERE
+			user_id = $1
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       BudgetRollover
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_never_rolled_over_then_return_empty_result",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(int64(123)).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_budget_rollover",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"period_start", "user_id"}).AddRow(mockStart, "123")
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(int64(123)).WillReturnRows(rows)
+			},
+			want: BudgetRollover{
+				PeriodStart: mockStart,
+				UserID:      123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetBudgetRolloverByUserID(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_LockBudgetRollover(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+
+	expectedQuery := `
+		SELECT
+			pg_advisory_xact_lock($1)
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.LockBudgetRollover(context.Background(), tx, 123)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpsertBudgetRollover(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO
+			budget_rollover(user_id,period_start,created_at)
+		VALUES (
+			$1,
+			$2,
+			$3
+		)
+		ON CONFLICT (user_id) DO UPDATE SET
+			period_start = EXCLUDED.period_start,
+			updated_at = EXCLUDED.created_at
+		WHERE
+			budget_rollover.period_start < EXCLUDED.period_start
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(123), "2023-01-25", mockTime).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			err = r.UpsertBudgetRollover(context.Background(), tx, UpsertBudgetRolloverParam{
+				PeriodStart: time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+				UserID:      123,
+			})
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
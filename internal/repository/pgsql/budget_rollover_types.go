@@ -0,0 +1,19 @@
+package pgsql
+
+import (
+	// golang package
+	"time"
+)
+
+// BudgetRollover holds the latest period budgets of a user are rolled over into.
+type BudgetRollover struct {
+	PeriodStart time.Time `db:"period_start"`
+	UserID      int64     `db:"user_id"`
+}
+
+// UpsertBudgetRolloverParam represents parameters needed to save the latest period budgets of a user
+// are rolled over into. Only the date of PeriodStart is used.
+type UpsertBudgetRolloverParam struct {
+	PeriodStart time.Time
+	UserID      int64
+}
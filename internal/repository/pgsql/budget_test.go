@@ -0,0 +1,1379 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBRepository_DeleteBudget(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	expectedQuery := `
+		DELETE FROM
+			budget
+		WHERE
+			category_id = $1
+			AND period_start = $2
+			AND user_id = $3
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1), "2023-01-25", int64(123)).
+					WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_not_exist_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1), "2023-01-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_budget_deleted_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1), "2023-01-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.DeleteBudget(context.Background(), tx, DeleteBudgetParam{
+				CategoryID:  1,
+				PeriodStart: time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+				UserID:      123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_GetAvailableToAssign(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockStart := time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC)
+	mockEnd := time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			envelope.currency,
+			SUM(envelope.amount) AS amount
+		FROM (
+			SELECT
+				wallet.currency,
+				transaction.amount
+			FROM
+				transaction
+				JOIN wallet ON wallet.id = transaction.wallet_id
+			WHERE
+				transaction.user_id = $1
+				AND transaction.type = 'income'
+				AND transaction.transacted_at < $2
+			UNION ALL
+			SELECT
+				budget.currency,
+				CASE WHEN budget.period_start < $3 THEN COALESCE(budget.released, 0) ELSE 0 END - budget.amount
+			FROM
+				budget
+			WHERE
+				budget.user_id = $4
+				AND budget.envelope
+				AND budget.period_start <= $5
+		) AS envelope
+		GROUP BY
+			envelope.currency
+		ORDER BY
+			envelope.currency
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AvailableToAssign
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SelectContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_available_to_assign",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"currency", "amount"}).
+					AddRow("IDR", "2500000").
+					AddRow("USD", "-1000")
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(int64(123), mockEnd, "2023-01-25", int64(123), "2023-01-25").WillReturnRows(rows)
+			},
+			want: []AvailableToAssign{
+				{
+					Amount:   2500000,
+					Currency: "IDR",
+				},
+				{
+					Amount:   -1000,
+					Currency: "USD",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetAvailableToAssign(context.Background(), GetAvailableToAssignParam{
+				End:         mockEnd,
+				PeriodStart: mockStart,
+				UserID:      123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_GetBudgetsByPeriod(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockStart := time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC)
+	mockEnd := time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			budget.amount,
+			budget.carried_over,
+			budget.carry_pending,
+			budget.category_id,
+			budget.created_at,
+			budget.currency,
+			budget.envelope,
+			budget.id,
+			budget.period_start,
+			budget.rollover_mode,
+			COALESCE((
+				SELECT
+					SUM(transaction.amount)
+				FROM
+					transaction
+					JOIN category ON category.id = transaction.category_id
+					JOIN wallet ON wallet.id = transaction.wallet_id
+				WHERE
+					transaction.user_id = budget.user_id
+					AND transaction.type = 'expense'
+					AND transaction.transacted_at >= $1
+					AND transaction.transacted_at < $2
+					AND wallet.currency = budget.currency
+					AND (category.id = budget.category_id OR category.parent_id = budget.category_id)
+			), 0) AS spent,
+			budget.updated_at,
+			budget.user_id
+		FROM
+			budget
+		WHERE
+			budget.user_id = $3
+			AND budget.period_start = $4
+		ORDER BY
+			budget.category_id
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Budget
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SelectContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_budgets",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows([]string{"amount", "carried_over", "carry_pending", "category_id", "created_at", "currency", "envelope", "id", "period_start", "rollover_mode", "spent", "updated_at", "user_id"}).
+					AddRow("1500000", "-25000", false, "1", mockTime, "IDR", true, "10", mockStart, "both", "250000", nil, "123").
+					AddRow("500000", "0", true, "2", mockTime, "IDR", false, "11", mockStart, "none", "0", mockTime, "123")
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(mockStart, mockEnd, int64(123), "2023-01-25").WillReturnRows(rows)
+			},
+			want: []Budget{
+				{
+					Amount:       1500000,
+					CarriedOver:  -25000,
+					CategoryID:   1,
+					CreatedAt:    mockTime,
+					Currency:     "IDR",
+					Envelope:     true,
+					ID:           10,
+					PeriodStart:  mockStart,
+					RolloverMode: "both",
+					Spent:        250000,
+					UserID:       123,
+				},
+				{
+					Amount:       500000,
+					CarryPending: true,
+					CategoryID:   2,
+					CreatedAt:    mockTime,
+					Currency:     "IDR",
+					ID:           11,
+					PeriodStart:  mockStart,
+					RolloverMode: "none",
+					UpdatedAt:    sql.NullTime{Time: mockTime, Valid: true},
+					UserID:       123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetBudgetsByPeriod(context.Background(), GetBudgetsParam{
+				End:    mockEnd,
+				Start:  mockStart,
+				UserID: 123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_GetBudgetsForUpdate(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockStart := time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC)
+	mockEnd := time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		SELECT
+			budget.amount,
+			budget.carried_over,
+			budget.carry_pending,
+			budget.category_id,
+			budget.created_at,
+			budget.currency,
+			budget.envelope,
+			budget.id,
+			budget.period_start,
+			budget.rollover_mode,
+			COALESCE((
+				SELECT
+					SUM(transaction.amount)
+				FROM
+					transaction
+					JOIN category ON category.id = transaction.category_id
+					JOIN wallet ON wallet.id = transaction.wallet_id
+				WHERE
+					transaction.user_id = budget.user_id
+					AND transaction.type = 'expense'
+					AND transaction.transacted_at >= $1
+					AND transaction.transacted_at < $2
+					AND wallet.currency = budget.currency
+					AND (category.id = budget.category_id OR category.parent_id = budget.category_id)
+			), 0) AS spent,
+			budget.updated_at,
+			budget.user_id
+		FROM
+			budget
+		WHERE
+			budget.user_id = $3
+			AND budget.period_start = $4
+			AND budget.category_id IN ($5, $6)
+		ORDER BY
+			budget.category_id
+		FOR UPDATE OF budget
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	columns := []string{"amount", "carried_over", "carry_pending", "category_id", "created_at", "currency", "envelope", "id", "period_start", "rollover_mode", "spent", "updated_at", "user_id"}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Budget
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_QueryContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_budgets",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+
+				rows := sqlmock.NewRows(columns).
+					AddRow("1500000", "-25000", false, "1", mockTime, "IDR", true, "10", mockStart, "both", "250000", nil, "123").
+					AddRow("500000", "0", true, "2", mockTime, "IDR", false, "11", mockStart, "none", "0", mockTime, "123")
+				mf.sql.ExpectQuery(expectedQuery).WithArgs(mockStart, mockEnd, int64(123), "2023-01-25", int64(1), int64(2)).WillReturnRows(rows)
+			},
+			want: []Budget{
+				{
+					Amount:       1500000,
+					CarriedOver:  -25000,
+					CategoryID:   1,
+					CreatedAt:    mockTime,
+					Currency:     "IDR",
+					Envelope:     true,
+					ID:           10,
+					PeriodStart:  mockStart,
+					RolloverMode: "both",
+					Spent:        250000,
+					UserID:       123,
+				},
+				{
+					Amount:       500000,
+					CarryPending: true,
+					CategoryID:   2,
+					CreatedAt:    mockTime,
+					Currency:     "IDR",
+					ID:           11,
+					PeriodStart:  mockStart,
+					RolloverMode: "none",
+					UpdatedAt:    sql.NullTime{Time: mockTime, Valid: true},
+					UserID:       123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.GetBudgetsForUpdate(context.Background(), tx, GetBudgetsForUpdateParam{
+				End:            mockEnd,
+				FromCategoryID: 1,
+				Start:          mockStart,
+				ToCategoryID:   2,
+				UserID:         123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_InsertBudget(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO
+			budget(user_id,category_id,period_start,currency,amount,rollover_mode,carried_over,carry_pending,envelope,created_at)
+		SELECT
+			$1,
+			category.id,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			$7,
+			$8,
+			$9
+		FROM
+			category
+		WHERE
+			category.id = $10
+			AND category.user_id = $11
+			AND category.archived_at IS NULL
+		ON CONFLICT (user_id, category_id, period_start) DO NOTHING
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(123), "2023-02-25", "IDR", int64(1500000), "positive", int64(-25000), false, true, mockTime, int64(1), int64(123)).
+					WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_not_inserted_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(123), "2023-02-25", "IDR", int64(1500000), "positive", int64(-25000), false, true, mockTime, int64(1), int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(123), "2023-02-25", "IDR", int64(1500000), "positive", int64(-25000), false, true, mockTime, int64(1), int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.InsertBudget(context.Background(), tx, InsertBudgetParam{
+				Amount:       1500000,
+				CarriedOver:  -25000,
+				CategoryID:   1,
+				Currency:     "IDR",
+				Envelope:     true,
+				PeriodStart:  time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+				RolloverMode: "positive",
+				UserID:       123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_MergeBudgets(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		WITH source AS (
+			SELECT
+				budget.amount,
+				budget.carried_over,
+				budget.carry_pending,
+				budget.currency,
+				budget.envelope,
+				budget.period_start,
+				budget.released
+			FROM
+				budget
+			WHERE
+				budget.user_id = $1
+				AND budget.category_id = $2
+		), summed AS (
+			UPDATE
+				budget
+			SET
+				amount = budget.amount + source.amount,
+				carried_over = budget.carried_over + source.carried_over,
+				carry_pending = budget.carry_pending OR source.carry_pending,
+				envelope = budget.envelope OR source.envelope,
+				released = CASE
+					WHEN budget.released IS NULL AND source.released IS NULL THEN NULL
+					ELSE COALESCE(budget.released, 0) + COALESCE(source.released, 0)
+				END,
+				updated_at = $3
+			FROM
+				source
+			WHERE
+				budget.user_id = $4
+				AND budget.category_id = $5
+				AND budget.period_start = source.period_start
+				AND budget.currency = source.currency
+			RETURNING
+				budget.period_start
+		), moved AS (
+			UPDATE
+				budget
+			SET
+				category_id = $6,
+				updated_at = $7
+			WHERE
+				budget.user_id = $8
+				AND budget.category_id = $9
+				AND NOT EXISTS (
+					SELECT
+						1
+					FROM
+						budget AS target
+					WHERE
+						target.user_id = $10
+						AND target.category_id = $11
+						AND target.period_start = budget.period_start
+				)
+			RETURNING
+				budget.period_start
+		), deleted AS (
+			DELETE FROM
+				budget
+			WHERE
+				budget.user_id = $12
+				AND budget.category_id = $13
+				AND budget.period_start IN (SELECT summed.period_start FROM summed)
+			RETURNING
+				budget.period_start
+		)
+		SELECT
+			COUNT(*)
+		FROM
+			source
+		WHERE
+			source.period_start NOT IN (SELECT moved.period_start FROM moved)
+			AND source.period_start NOT IN (SELECT deleted.period_start FROM deleted)
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_QueryRowContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budgets_in_another_currency_then_return_unmerged_count",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectQuery(expectedQuery).
+					WithArgs(int64(123), int64(3), mockTime, int64(123), int64(5), int64(5), mockTime, int64(123), int64(3), int64(123), int64(5), int64(123), int64(3)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow("2"))
+			},
+			want: 2,
+		},
+		{
+			name: "when_no_error_occured_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectQuery(expectedQuery).
+					WithArgs(int64(123), int64(3), mockTime, int64(123), int64(5), int64(5), mockTime, int64(123), int64(3), int64(123), int64(5), int64(123), int64(3)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow("0"))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.MergeBudgets(context.Background(), tx, MergeBudgetsParam{
+				FromCategoryID: 3,
+				ToCategoryID:   5,
+				UserID:         123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_ReleaseBudget(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			budget
+		SET
+			released = $1,
+			updated_at = $2
+		WHERE
+			category_id = $3
+			AND period_start = $4
+			AND user_id = $5
+			AND released IS NULL
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_already_released_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_budget_released_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.ReleaseBudget(context.Background(), tx, ReleaseBudgetParam{
+				CategoryID:  1,
+				PeriodStart: time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+				Released:    -25000,
+				UserID:      123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_SettleBudgetCarry(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			budget
+		SET
+			carried_over = carried_over + $1,
+			carry_pending = FALSE,
+			updated_at = $2
+		WHERE
+			category_id = $3
+			AND period_start = $4
+			AND user_id = $5
+			AND carry_pending
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_carry_already_fixed_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_carry_fixed_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(-25000), mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.SettleBudgetCarry(context.Background(), tx, SettleBudgetCarryParam{
+				CarriedOver: -25000,
+				CategoryID:  1,
+				PeriodStart: time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+				UserID:      123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpdateBudgetAmount(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		UPDATE
+			budget
+		SET
+			amount = $1,
+			carried_over = $2,
+			envelope = $3,
+			updated_at = $4
+		WHERE
+			category_id = $5
+			AND period_start = $6
+			AND user_id = $7
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ExecContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RowsAffected_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1500000), int64(-25000), true, mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewErrorResult(assert.AnError))
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_not_found_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1500000), int64(-25000), true, mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectExec(expectedQuery).
+					WithArgs(int64(1500000), int64(-25000), true, mockTime, int64(1), "2023-02-25", int64(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.UpdateBudgetAmount(context.Background(), tx, UpdateBudgetAmountParam{
+				Amount:      1500000,
+				CarriedOver: -25000,
+				CategoryID:  1,
+				Envelope:    true,
+				PeriodStart: time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+				UserID:      123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBRepository_UpsertBudget(t *testing.T) {
+	funcSQLXNamedOri := sqlx.Named
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := `
+		INSERT INTO
+			budget(user_id,category_id,period_start,currency,amount,rollover_mode,carried_over,carry_pending,envelope,created_at)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			$7,
+			$8,
+			$9,
+			$10
+		)
+		ON CONFLICT (user_id, category_id, period_start) DO UPDATE SET
+			amount = EXCLUDED.amount,
+			currency = EXCLUDED.currency,
+			rollover_mode = EXCLUDED.rollover_mode,
+			envelope = EXCLUDED.envelope,
+			updated_at = EXCLUDED.created_at
+		RETURNING id
+	`
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		sql   sqlmock.Sqlmock
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_funcSQLXNamed_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				funcSQLXNamed = func(query string, arg interface{}) (string, []interface{}, error) {
+					return "", nil, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_QueryRowContext_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectQuery(expectedQuery).WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+
+				mf.sql.ExpectQuery(expectedQuery).
+					WithArgs(int64(123), int64(1), "2023-01-25", "IDR", int64(1500000), "both", int64(0), true, false, mockTime).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("10"))
+			},
+			want: 10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDB, mockSQL, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			defer func() {
+				mockDB.Close()
+				funcSQLXNamed = funcSQLXNamedOri
+			}()
+
+			mockSQL.ExpectBegin().WillReturnError(nil)
+			tx, _ := mockDB.Begin()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				sql:   mockSQL,
+			}
+			test.mockFields(mockFields)
+
+			r := DBRepository{
+				infra: mockFields.infra,
+				db:    sqlx.NewDb(mockDB, "postgres"),
+			}
+
+			got, err := r.UpsertBudget(context.Background(), tx, UpsertBudgetParam{
+				Amount:       1500000,
+				CarryPending: true,
+				CategoryID:   1,
+				Currency:     "IDR",
+				PeriodStart:  time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+				RolloverMode: "both",
+				UserID:       123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package pgsql
+
+import (
+	// golang package
+	"database/sql"
+	"time"
+)
+
+// AvailableToAssign holds how much of the income of a user in a currency isn't assigned to any budget yet.
+type AvailableToAssign struct {
+	Amount   int64  `db:"amount"`
+	Currency string `db:"currency"`
+}
+
+// Budget holds the spending limit of a user for a category in a period.
+// Spent is the total expense of the category and its subcategories in the period,
+// counted from wallets with the same currency as the budget.
+// CarryPending is true while CarriedOver waits for the previous period to end.
+// Envelope is true when the budget is assigned from income in envelope budgeting mode.
+type Budget struct {
+	Amount       int64        `db:"amount"`
+	CarriedOver  int64        `db:"carried_over"`
+	CarryPending bool         `db:"carry_pending"`
+	CategoryID   int64        `db:"category_id"`
+	CreatedAt    time.Time    `db:"created_at"`
+	Currency     string       `db:"currency"`
+	Envelope     bool         `db:"envelope"`
+	ID           int64        `db:"id"`
+	PeriodStart  time.Time    `db:"period_start"`
+	RolloverMode string       `db:"rollover_mode"`
+	Spent        int64        `db:"spent"`
+	UpdatedAt    sql.NullTime `db:"updated_at"`
+	UserID       int64        `db:"user_id"`
+}
+
+// DeleteBudgetParam represents parameters needed to delete a budget of a category in a period.
+// Only the date of PeriodStart is used.
+type DeleteBudgetParam struct {
+	CategoryID  int64
+	PeriodStart time.Time
+	UserID      int64
+}
+
+// GetAvailableToAssignParam represents parameters needed to count what a user has left to assign up to a period.
+// Income is counted until, but not including, End. Budgets are counted up to the period that starts on the date of PeriodStart.
+type GetAvailableToAssignParam struct {
+	End         time.Time
+	PeriodStart time.Time
+	UserID      int64
+}
+
+// GetBudgetsParam represents parameters needed to fetch budgets of a user in a period.
+// Transactions are counted from Start until, but not including, End. Only the date of Start identifies the period.
+type GetBudgetsParam struct {
+	End    time.Time
+	Start  time.Time
+	UserID int64
+}
+
+// GetBudgetsForUpdateParam represents parameters needed to fetch and lock the budgets of two categories
+// of a user in a period. Transactions are counted from Start until, but not including, End.
+// Only the date of Start identifies the period.
+type GetBudgetsForUpdateParam struct {
+	End            time.Time
+	FromCategoryID int64
+	Start          time.Time
+	ToCategoryID   int64
+	UserID         int64
+}
+
+// InsertBudgetParam represents parameters needed to create the budget of a category in a period.
+// Only the date of PeriodStart is used.
+type InsertBudgetParam struct {
+	Amount       int64
+	CarriedOver  int64
+	CarryPending bool
+	CategoryID   int64
+	Currency     string
+	Envelope     bool
+	PeriodStart  time.Time
+	RolloverMode string
+	UserID       int64
+}
+
+// MergeBudgetsParam represents parameters needed to merge every budget of a category into the budgets of another category.
+type MergeBudgetsParam struct {
+	FromCategoryID int64
+	ToCategoryID   int64
+	UserID         int64
+}
+
+// ReleaseBudgetParam represents parameters needed to save what the budget of a category in a period
+// gives back to what is left to assign once the period has ended. Only the date of PeriodStart is used.
+type ReleaseBudgetParam struct {
+	CategoryID  int64
+	PeriodStart time.Time
+	Released    int64
+	UserID      int64
+}
+
+// SettleBudgetCarryParam represents parameters needed to fix what the budget of a category in a period
+// carries over from the previous period. Only the date of PeriodStart is used.
+type SettleBudgetCarryParam struct {
+	CarriedOver int64
+	CategoryID  int64
+	PeriodStart time.Time
+	UserID      int64
+}
+
+// UpdateBudgetAmountParam represents parameters needed to change the money of the budget of a category in a period.
+// Only the date of PeriodStart is used.
+type UpdateBudgetAmountParam struct {
+	Amount      int64
+	CarriedOver int64
+	CategoryID  int64
+	Envelope    bool
+	PeriodStart time.Time
+	UserID      int64
+}
+
+// UpsertBudgetParam represents parameters needed to set the budget of a category in a period.
+// Only the date of PeriodStart is used. CarriedOver and CarryPending are only saved when the budget is created.
+type UpsertBudgetParam struct {
+	Amount       int64
+	CarriedOver  int64
+	CarryPending bool
+	CategoryID   int64
+	Currency     string
+	Envelope     bool
+	PeriodStart  time.Time
+	RolloverMode string
+	UserID       int64
+}
@@ -0,0 +1,223 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// ArchiveSubcategories will archive every subcategory of a category of a user that isn't archived yet.
+func (repo *DBRepository) ArchiveSubcategories(ctx context.Context, tx *sql.Tx, userID, parentID int64) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"archived_at": repo.infra.GetTimeGMT7(),
+		"parent_id":   parentID,
+		"user_id":     userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryArchiveSubcategories, namedParam)
+	if err != nil {
+		log.Printf("[ArchiveSubcategories] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[ArchiveSubcategories] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCategory will delete a category of a user.
+// It returns false if the user doesn't have the category.
+func (repo *DBRepository) DeleteCategory(ctx context.Context, tx *sql.Tx, userID, categoryID int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      categoryID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteCategory, namedParam)
+	if err != nil {
+		log.Printf("[DeleteCategory] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteCategory] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeleteCategory] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetCategoriesByUserID will fetch every category of a user, ordered by their type and name.
+func (repo *DBRepository) GetCategoriesByUserID(ctx context.Context, userID int64) ([]Category, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetCategoriesByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetCategoriesByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []Category
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetCategoriesByUserID] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCategoryByID will fetch a category of a user based on its id.
+// It returns an empty category if the user doesn't have the category.
+func (repo *DBRepository) GetCategoryByID(ctx context.Context, userID, categoryID int64) (Category, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      categoryID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetCategoryByID, namedParam)
+	if err != nil {
+		log.Printf("[GetCategoryByID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Category{}, err
+	}
+
+	var result Category
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetCategoryByID] repo.db.GetContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Category{}, err
+	}
+
+	return result, nil
+}
+
+// InsertCategory will create a new entry in table category in database.
+// It returns id of the new entry.
+func (repo *DBRepository) InsertCategory(ctx context.Context, tx *sql.Tx, param InsertCategoryParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"color":      param.Color,
+		"created_at": repo.infra.GetTimeGMT7(),
+		"icon":       param.Icon,
+		"name":       param.Name,
+		"parent_id":  param.ParentID,
+		"type":       param.Type,
+		"user_id":    param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertCategory, namedParam)
+	if err != nil {
+		log.Printf("[InsertCategory] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&id)
+	if err != nil {
+		log.Printf("[InsertCategory] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// MoveSubcategories will move every subcategory of a category of a user under another category.
+func (repo *DBRepository) MoveSubcategories(ctx context.Context, tx *sql.Tx, param MoveSubcategoriesParam) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"from_parent_id": param.FromParentID,
+		"to_parent_id":   param.ToParentID,
+		"updated_at":     repo.infra.GetTimeGMT7(),
+		"user_id":        param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryMoveSubcategories, namedParam)
+	if err != nil {
+		log.Printf("[MoveSubcategories] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[MoveSubcategories] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCategory will update a category of a user.
+// It returns false if the user doesn't have the category.
+func (repo *DBRepository) UpdateCategory(ctx context.Context, tx *sql.Tx, param UpdateCategoryParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"archived":   param.Archived,
+		"color":      param.Color,
+		"icon":       param.Icon,
+		"id":         param.ID,
+		"name":       param.Name,
+		"parent_id":  param.ParentID,
+		"updated_at": repo.infra.GetTimeGMT7(),
+		"user_id":    param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateCategory, namedParam)
+	if err != nil {
+		log.Printf("[UpdateCategory] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateCategory] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateCategory] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,105 @@
+package pgsql
+
+const (
+	queryArchiveSubcategories = `
+		UPDATE
+			category
+		SET
+			archived_at = :archived_at,
+			updated_at = :archived_at
+		WHERE
+			parent_id = :parent_id
+			AND user_id = :user_id
+			AND archived_at IS NULL
+	`
+
+	queryDeleteCategory = `
+		DELETE FROM
+			category
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetCategoriesByUserID = `
+		SELECT
+			archived_at,
+			color,
+			created_at,
+			icon,
+			id,
+			name,
+			parent_id,
+			type,
+			updated_at,
+			user_id
+		FROM
+			category
+		WHERE
+			user_id = :user_id
+		ORDER BY
+			type,
+			name,
+			id
+	`
+
+	queryGetCategoryByID = `
+		SELECT
+			archived_at,
+			color,
+			created_at,
+			icon,
+			id,
+			name,
+			parent_id,
+			type,
+			updated_at,
+			user_id
+		FROM
+			category
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryInsertCategory = `
+		INSERT INTO
+			category(user_id,parent_id,name,type,icon,color,created_at)
+		VALUES (
+			:user_id,
+			:parent_id,
+			:name,
+			:type,
+			:icon,
+			:color,
+			:created_at
+		)
+		RETURNING id
+	`
+
+	queryMoveSubcategories = `
+		UPDATE
+			category
+		SET
+			parent_id = :to_parent_id,
+			updated_at = :updated_at
+		WHERE
+			parent_id = :from_parent_id
+			AND user_id = :user_id
+	`
+
+	queryUpdateCategory = `
+		UPDATE
+			category
+		SET
+			archived_at = CASE WHEN :archived THEN COALESCE(archived_at, :updated_at) ELSE NULL END,
+			color = :color,
+			icon = :icon,
+			name = :name,
+			parent_id = :parent_id,
+			updated_at = :updated_at
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+)
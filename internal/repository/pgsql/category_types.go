@@ -0,0 +1,52 @@
+package pgsql
+
+import (
+	// golang package
+	"database/sql"
+	"time"
+)
+
+// Category holds information about a category of transactions of a user.
+type Category struct {
+	ArchivedAt sql.NullTime  `db:"archived_at"`
+	Color      string        `db:"color"`
+	CreatedAt  time.Time     `db:"created_at"`
+	Icon       string        `db:"icon"`
+	ID         int64         `db:"id"`
+	Name       string        `db:"name"`
+	ParentID   sql.NullInt64 `db:"parent_id"`
+	Type       string        `db:"type"`
+	UpdatedAt  sql.NullTime  `db:"updated_at"`
+	UserID     int64         `db:"user_id"`
+}
+
+// InsertCategoryParam represents parameters needed to create a category.
+// A ParentID that isn't valid means the category is a top level category.
+type InsertCategoryParam struct {
+	Color    string
+	Icon     string
+	Name     string
+	ParentID sql.NullInt64
+	Type     string
+	UserID   int64
+}
+
+// MoveSubcategoriesParam represents parameters needed to move every subcategory of a category under another category.
+type MoveSubcategoriesParam struct {
+	FromParentID int64
+	ToParentID   int64
+	UserID       int64
+}
+
+// UpdateCategoryParam represents parameters needed to update a category.
+// An archived category keeps the time it was first archived,
+// and a ParentID that isn't valid means the category is a top level category.
+type UpdateCategoryParam struct {
+	Archived bool
+	Color    string
+	Icon     string
+	ID       int64
+	Name     string
+	ParentID sql.NullInt64
+	UserID   int64
+}
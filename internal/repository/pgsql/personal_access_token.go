@@ -0,0 +1,133 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// DeletePersonalAccessToken will delete a personal access token of a user.
+// It returns false if the user doesn't have the token.
+func (repo *DBRepository) DeletePersonalAccessToken(ctx context.Context, tx *sql.Tx, userID, tokenID int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      tokenID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeletePersonalAccessToken, namedParam)
+	if err != nil {
+		log.Printf("[DeletePersonalAccessToken] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeletePersonalAccessToken] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeletePersonalAccessToken] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetPersonalAccessTokenByHash will fetch a personal access token alongside email of its owner
+// based of hash of the token.
+func (repo *DBRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (PersonalAccessToken, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"token_hash": tokenHash,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetPersonalAccessTokenByHash, namedParam)
+	if err != nil {
+		log.Printf("[GetPersonalAccessTokenByHash] funcSQLXNamed got an error: %+v\n", err)
+		return PersonalAccessToken{}, err
+	}
+
+	var result PersonalAccessToken
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetPersonalAccessTokenByHash] repo.db.GetContext() got an error: %+v\n", err)
+		return PersonalAccessToken{}, err
+	}
+
+	return result, nil
+}
+
+// GetPersonalAccessTokensByUserID will fetch every personal access token of a user,
+// ordered from the most recently created token.
+func (repo *DBRepository) GetPersonalAccessTokensByUserID(ctx context.Context, userID int64) ([]PersonalAccessToken, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetPersonalAccessTokensByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetPersonalAccessTokensByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []PersonalAccessToken
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetPersonalAccessTokensByUserID] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InsertPersonalAccessToken will create a new entry in table personal_access_token in database.
+// It returns id of the new entry.
+func (repo *DBRepository) InsertPersonalAccessToken(ctx context.Context, tx *sql.Tx, param InsertPersonalAccessTokenParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"created_at": repo.infra.GetTimeGMT7(),
+		"expires_at": param.ExpiresAt,
+		"name":       param.Name,
+		"scopes":     param.Scopes,
+		"token_hash": param.TokenHash,
+		"user_id":    param.UserID,
+	}
+
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertPersonalAccessToken, namedParam)
+	if err != nil {
+		log.Printf("[InsertPersonalAccessToken] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&id)
+	if err != nil {
+		log.Printf("[InsertPersonalAccessToken] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return id, nil
+}
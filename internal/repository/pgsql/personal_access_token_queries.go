@@ -0,0 +1,59 @@
+package pgsql
+
+const (
+	queryDeletePersonalAccessToken = `
+		DELETE FROM
+			personal_access_token
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetPersonalAccessTokenByHash = `
+		SELECT
+			pat.created_at,
+			ua.email,
+			pat.expires_at,
+			pat.id,
+			pat.name,
+			pat.scopes,
+			pat.user_id
+		FROM
+			personal_access_token pat
+			JOIN user_account ua ON ua.id = pat.user_id
+		WHERE
+			pat.token_hash = :token_hash
+			AND ua.deletion_requested_at IS NULL
+			AND ua.disabled_at IS NULL
+	`
+
+	queryGetPersonalAccessTokensByUserID = `
+		SELECT
+			created_at,
+			expires_at,
+			id,
+			name,
+			scopes,
+			user_id
+		FROM
+			personal_access_token
+		WHERE
+			user_id = :user_id
+		ORDER BY
+			created_at DESC
+	`
+
+	queryInsertPersonalAccessToken = `
+		INSERT INTO
+			personal_access_token(user_id,name,token_hash,scopes,expires_at,created_at)
+		VALUES (
+			:user_id,
+			:name,
+			:token_hash,
+			:scopes,
+			:expires_at,
+			:created_at
+		)
+		RETURNING id
+	`
+)
@@ -0,0 +1,29 @@
+package pgsql
+
+import (
+	// golang package
+	"database/sql"
+	"time"
+)
+
+// PersonalAccessToken holds information about a personal access token of a user.
+// Email of the owner is only filled when the token is fetched by its hash.
+type PersonalAccessToken struct {
+	CreatedAt time.Time    `db:"created_at"`
+	Email     string       `db:"email"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	ID        int64        `db:"id"`
+	Name      string       `db:"name"`
+	Scopes    string       `db:"scopes"`
+	UserID    int64        `db:"user_id"`
+}
+
+// InsertPersonalAccessTokenParam represents parameters needed to create a personal access token.
+// Scopes is a comma separated list, and an ExpiresAt that isn't valid means the token never expires.
+type InsertPersonalAccessTokenParam struct {
+	ExpiresAt sql.NullTime
+	Name      string
+	Scopes    string
+	TokenHash string
+	UserID    int64
+}
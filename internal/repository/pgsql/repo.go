@@ -49,6 +49,10 @@ type psqlProvider interface {
 
 	// Rebind a query within a Conn's bindvar type.
 	Rebind(query string) string
+
+	// SelectContext using this Conn.
+	// Any placeholder parameters are replaced with supplied args.
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 }
 
 // DBRepoParam holds all parameters needed to instansiate new
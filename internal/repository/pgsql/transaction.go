@@ -0,0 +1,246 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// DeleteTransaction will delete a transaction of a user.
+// It returns false if the user doesn't have the transaction.
+func (repo *DBRepository) DeleteTransaction(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      transactionID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteTransaction, namedParam)
+	if err != nil {
+		log.Printf("[DeleteTransaction] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteTransaction] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeleteTransaction] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetTransactionAmountForUpdate will fetch amount, type and wallet of a transaction of a user,
+// and lock the transaction until tx is over so its balance change can't be applied twice.
+// It returns an empty result if the user doesn't have the transaction.
+func (repo *DBRepository) GetTransactionAmountForUpdate(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (TransactionAmount, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      transactionID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetTransactionAmountForUpdate, namedParam)
+	if err != nil {
+		log.Printf("[GetTransactionAmountForUpdate] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return TransactionAmount{}, err
+	}
+
+	var result TransactionAmount
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&result.Amount, &result.Type, &result.WalletID)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetTransactionAmountForUpdate] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return TransactionAmount{}, err
+	}
+
+	return result, nil
+}
+
+// GetTransactionByID will fetch a transaction of a user based on its id.
+// It returns an empty transaction if the user doesn't have the transaction.
+func (repo *DBRepository) GetTransactionByID(ctx context.Context, userID, transactionID int64) (Transaction, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      transactionID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetTransactionByID, namedParam)
+	if err != nil {
+		log.Printf("[GetTransactionByID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Transaction{}, err
+	}
+
+	var result Transaction
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetTransactionByID] repo.db.GetContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Transaction{}, err
+	}
+
+	return result, nil
+}
+
+// GetTransactionsByUserID will fetch transactions of a user,
+// ordered from the most recent transaction.
+func (repo *DBRepository) GetTransactionsByUserID(ctx context.Context, param GetTransactionsParam) ([]Transaction, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"limit":     param.Limit,
+		"offset":    param.Offset,
+		"user_id":   param.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetTransactionsByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetTransactionsByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []Transaction
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetTransactionsByUserID] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InsertTransaction will create a new entry in table transaction in database.
+// It returns id of the new entry.
+func (repo *DBRepository) InsertTransaction(ctx context.Context, tx *sql.Tx, param InsertTransactionParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":        param.Amount,
+		"category_id":   param.CategoryID,
+		"created_at":    repo.infra.GetTimeGMT7(),
+		"note":          param.Note,
+		"payee":         param.Payee,
+		"tags":          param.Tags,
+		"transacted_at": param.TransactedAt,
+		"type":          param.Type,
+		"user_id":       param.UserID,
+		"wallet_id":     param.WalletID,
+	}
+
+	meta := map[string]interface{}{
+		"user_id":   param.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertTransaction, namedParam)
+	if err != nil {
+		log.Printf("[InsertTransaction] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&id)
+	if err != nil {
+		log.Printf("[InsertTransaction] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateTransaction will update a transaction of a user.
+// It returns false if the user doesn't have the transaction.
+func (repo *DBRepository) UpdateTransaction(ctx context.Context, tx *sql.Tx, param UpdateTransactionParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":        param.Amount,
+		"category_id":   param.CategoryID,
+		"id":            param.ID,
+		"note":          param.Note,
+		"payee":         param.Payee,
+		"tags":          param.Tags,
+		"transacted_at": param.TransactedAt,
+		"type":          param.Type,
+		"updated_at":    repo.infra.GetTimeGMT7(),
+		"user_id":       param.UserID,
+		"wallet_id":     param.WalletID,
+	}
+
+	meta := map[string]interface{}{
+		"id":        param.ID,
+		"user_id":   param.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateTransaction, namedParam)
+	if err != nil {
+		log.Printf("[UpdateTransaction] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateTransaction] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateTransaction] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateTransactionsCategory will move every transaction of a user in a category into another category.
+func (repo *DBRepository) UpdateTransactionsCategory(ctx context.Context, tx *sql.Tx, userID, fromCategoryID, toCategoryID int64) error {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"from_category_id": fromCategoryID,
+		"to_category_id":   toCategoryID,
+		"updated_at":       repo.infra.GetTimeGMT7(),
+		"user_id":          userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateTransactionsCategory, namedParam)
+	if err != nil {
+		log.Printf("[UpdateTransactionsCategory] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateTransactionsCategory] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return err
+	}
+
+	return nil
+}
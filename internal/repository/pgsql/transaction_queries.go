@@ -0,0 +1,118 @@
+package pgsql
+
+const (
+	queryDeleteTransaction = `
+		DELETE FROM
+			transaction
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetTransactionAmountForUpdate = `
+		SELECT
+			amount,
+			type,
+			wallet_id
+		FROM
+			transaction
+		WHERE
+			id = :id
+			AND user_id = :user_id
+		FOR UPDATE
+	`
+
+	queryGetTransactionByID = `
+		SELECT
+			amount,
+			category_id,
+			created_at,
+			id,
+			note,
+			payee,
+			tags,
+			transacted_at,
+			type,
+			updated_at,
+			user_id,
+			wallet_id
+		FROM
+			transaction
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetTransactionsByUserID = `
+		SELECT
+			amount,
+			category_id,
+			created_at,
+			id,
+			note,
+			payee,
+			tags,
+			transacted_at,
+			type,
+			updated_at,
+			user_id,
+			wallet_id
+		FROM
+			transaction
+		WHERE
+			user_id = :user_id
+			AND (:wallet_id = 0 OR wallet_id = :wallet_id)
+		ORDER BY
+			transacted_at DESC,
+			id DESC
+		LIMIT :limit
+		OFFSET :offset
+	`
+
+	queryInsertTransaction = `
+		INSERT INTO
+			transaction(user_id,wallet_id,category_id,type,amount,transacted_at,payee,note,tags,created_at)
+		VALUES (
+			:user_id,
+			:wallet_id,
+			:category_id,
+			:type,
+			:amount,
+			:transacted_at,
+			:payee,
+			:note,
+			:tags,
+			:created_at
+		)
+		RETURNING id
+	`
+
+	queryUpdateTransaction = `
+		UPDATE
+			transaction
+		SET
+			amount = :amount,
+			category_id = :category_id,
+			note = :note,
+			payee = :payee,
+			tags = :tags,
+			transacted_at = :transacted_at,
+			type = :type,
+			updated_at = :updated_at,
+			wallet_id = :wallet_id
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryUpdateTransactionsCategory = `
+		UPDATE
+			transaction
+		SET
+			category_id = :to_category_id,
+			updated_at = :updated_at
+		WHERE
+			category_id = :from_category_id
+			AND user_id = :user_id
+	`
+)
@@ -0,0 +1,69 @@
+package pgsql
+
+import (
+	// golang package
+	"database/sql"
+	"time"
+)
+
+// Transaction holds information about money that comes into or goes out of a wallet of a user.
+// Tags is a comma separated list.
+type Transaction struct {
+	Amount       int64         `db:"amount"`
+	CategoryID   sql.NullInt64 `db:"category_id"`
+	CreatedAt    time.Time     `db:"created_at"`
+	ID           int64         `db:"id"`
+	Note         string        `db:"note"`
+	Payee        string        `db:"payee"`
+	Tags         string        `db:"tags"`
+	TransactedAt time.Time     `db:"transacted_at"`
+	Type         string        `db:"type"`
+	UpdatedAt    sql.NullTime  `db:"updated_at"`
+	UserID       int64         `db:"user_id"`
+	WalletID     int64         `db:"wallet_id"`
+}
+
+// TransactionAmount holds the part of a transaction that changes the balance of its wallet.
+type TransactionAmount struct {
+	Amount   int64
+	Type     string
+	WalletID int64
+}
+
+// GetTransactionsParam represents parameters needed to fetch transactions of a user.
+// Transactions of every wallet are fetched when WalletID is zero.
+type GetTransactionsParam struct {
+	Limit    int
+	Offset   int
+	UserID   int64
+	WalletID int64
+}
+
+// InsertTransactionParam represents parameters needed to record a transaction.
+// Tags is a comma separated list, and a CategoryID that isn't valid means the transaction isn't categorized.
+type InsertTransactionParam struct {
+	Amount       int64
+	CategoryID   sql.NullInt64
+	Note         string
+	Payee        string
+	Tags         string
+	TransactedAt time.Time
+	Type         string
+	UserID       int64
+	WalletID     int64
+}
+
+// UpdateTransactionParam represents parameters needed to update a transaction.
+// Tags is a comma separated list, and a CategoryID that isn't valid means the transaction isn't categorized.
+type UpdateTransactionParam struct {
+	Amount       int64
+	CategoryID   sql.NullInt64
+	ID           int64
+	Note         string
+	Payee        string
+	Tags         string
+	TransactedAt time.Time
+	Type         string
+	UserID       int64
+	WalletID     int64
+}
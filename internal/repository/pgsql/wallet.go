@@ -0,0 +1,233 @@
+package pgsql
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// AdjustWalletBalance will add param.Amount to the balance of a wallet of a user.
+// It returns false if the user doesn't have the wallet.
+func (repo *DBRepository) AdjustWalletBalance(ctx context.Context, tx *sql.Tx, param AdjustWalletBalanceParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"amount":     param.Amount,
+		"id":         param.ID,
+		"updated_at": repo.infra.GetTimeGMT7(),
+		"user_id":    param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryAdjustWalletBalance, namedParam)
+	if err != nil {
+		log.Printf("[AdjustWalletBalance] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[AdjustWalletBalance] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[AdjustWalletBalance] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteWallet will delete a wallet of a user.
+// It returns false if the user doesn't have the wallet.
+func (repo *DBRepository) DeleteWallet(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      walletID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryDeleteWallet, namedParam)
+	if err != nil {
+		log.Printf("[DeleteWallet] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[DeleteWallet] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DeleteWallet] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetWalletByID will fetch a wallet of a user based on its id.
+// It returns an empty wallet if the user doesn't have the wallet.
+func (repo *DBRepository) GetWalletByID(ctx context.Context, userID, walletID int64) (Wallet, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      walletID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetWalletByID, namedParam)
+	if err != nil {
+		log.Printf("[GetWalletByID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Wallet{}, err
+	}
+
+	var result Wallet
+	err = repo.db.GetContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[GetWalletByID] repo.db.GetContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return Wallet{}, err
+	}
+
+	return result, nil
+}
+
+// GetWalletsByUserID will fetch every wallet of a user, ordered by their display order.
+func (repo *DBRepository) GetWalletsByUserID(ctx context.Context, userID int64) ([]Wallet, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryGetWalletsByUserID, namedParam)
+	if err != nil {
+		log.Printf("[GetWalletsByUserID] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	var result []Wallet
+	err = repo.db.SelectContext(ctxQuery, &result, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[GetWalletsByUserID] repo.db.SelectContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// HasWalletTransactionsForUpdate will check whether a wallet of a user has any transaction,
+// and lock the wallet until tx is over so no transaction can be booked to it meanwhile.
+// It returns false if the user doesn't have the wallet.
+func (repo *DBRepository) HasWalletTransactionsForUpdate(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"id":      walletID,
+		"user_id": userID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryHasWalletTransactionsForUpdate, namedParam)
+	if err != nil {
+		log.Printf("[HasWalletTransactionsForUpdate] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	var result bool
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&result)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("[HasWalletTransactionsForUpdate] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// InsertWallet will create a new entry in table wallet in database.
+// The new wallet is placed after every other wallet of the user.
+// It returns id of the new entry.
+func (repo *DBRepository) InsertWallet(ctx context.Context, tx *sql.Tx, param InsertWalletParam) (int64, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"created_at":      repo.infra.GetTimeGMT7(),
+		"currency":        param.Currency,
+		"name":            param.Name,
+		"opening_balance": param.OpeningBalance,
+		"type":            param.Type,
+		"user_id":         param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryInsertWallet, namedParam)
+	if err != nil {
+		log.Printf("[InsertWallet] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctxQuery, repo.db.Rebind(namedQuery), args...).Scan(&id)
+	if err != nil {
+		log.Printf("[InsertWallet] tx.QueryRowContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateWallet will update a wallet of a user.
+// It returns false if the user doesn't have the wallet.
+func (repo *DBRepository) UpdateWallet(ctx context.Context, tx *sql.Tx, param UpdateWalletParam) (bool, error) {
+	timeout := time.Duration(repo.infra.GetConfig().Database.DefaultTimeout) * time.Second
+	ctxQuery, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namedParam := map[string]interface{}{
+		"archived":        param.Archived,
+		"currency":        param.Currency,
+		"display_order":   param.DisplayOrder,
+		"id":              param.ID,
+		"name":            param.Name,
+		"opening_balance": param.OpeningBalance,
+		"type":            param.Type,
+		"updated_at":      repo.infra.GetTimeGMT7(),
+		"user_id":         param.UserID,
+	}
+
+	namedQuery, args, err := funcSQLXNamed(queryUpdateWallet, namedParam)
+	if err != nil {
+		log.Printf("[UpdateWallet] funcSQLXNamed got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctxQuery, repo.db.Rebind(namedQuery), args...)
+	if err != nil {
+		log.Printf("[UpdateWallet] tx.ExecContext() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[UpdateWallet] result.RowsAffected() got an error: %+v\nMeta:%+v\n", err, namedParam)
+		return false, err
+	}
+
+	return affected > 0, nil
+}
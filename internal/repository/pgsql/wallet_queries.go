@@ -0,0 +1,115 @@
+package pgsql
+
+const (
+	queryAdjustWalletBalance = `
+		UPDATE
+			wallet
+		SET
+			balance = balance + :amount,
+			updated_at = :updated_at
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryDeleteWallet = `
+		DELETE FROM
+			wallet
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetWalletByID = `
+		SELECT
+			archived_at,
+			balance,
+			created_at,
+			currency,
+			display_order,
+			id,
+			name,
+			opening_balance,
+			type,
+			updated_at,
+			user_id
+		FROM
+			wallet
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+
+	queryGetWalletsByUserID = `
+		SELECT
+			archived_at,
+			balance,
+			created_at,
+			currency,
+			display_order,
+			id,
+			name,
+			opening_balance,
+			type,
+			updated_at,
+			user_id
+		FROM
+			wallet
+		WHERE
+			user_id = :user_id
+		ORDER BY
+			display_order,
+			id
+	`
+
+	queryHasWalletTransactionsForUpdate = `
+		SELECT
+			EXISTS (
+				SELECT
+					1
+				FROM
+					transaction
+				WHERE
+					wallet_id = wallet.id
+			)
+		FROM
+			wallet
+		WHERE
+			id = :id
+			AND user_id = :user_id
+		FOR UPDATE
+	`
+
+	queryInsertWallet = `
+		INSERT INTO
+			wallet(user_id,name,type,currency,opening_balance,balance,display_order,created_at)
+		VALUES (
+			:user_id,
+			:name,
+			:type,
+			:currency,
+			:opening_balance,
+			:opening_balance,
+			(SELECT COALESCE(MAX(display_order) + 1, 0) FROM wallet WHERE user_id = :user_id),
+			:created_at
+		)
+		RETURNING id
+	`
+
+	queryUpdateWallet = `
+		UPDATE
+			wallet
+		SET
+			archived_at = CASE WHEN :archived THEN COALESCE(archived_at, :updated_at) ELSE NULL END,
+			balance = balance + :opening_balance - opening_balance,
+			currency = :currency,
+			display_order = :display_order,
+			name = :name,
+			opening_balance = :opening_balance,
+			type = :type,
+			updated_at = :updated_at
+		WHERE
+			id = :id
+			AND user_id = :user_id
+	`
+)
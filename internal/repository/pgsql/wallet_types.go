@@ -0,0 +1,53 @@
+package pgsql
+
+import (
+	// golang package
+	"database/sql"
+	"time"
+)
+
+// Wallet holds information about a wallet of a user.
+type Wallet struct {
+	ArchivedAt     sql.NullTime `db:"archived_at"`
+	Balance        int64        `db:"balance"`
+	CreatedAt      time.Time    `db:"created_at"`
+	Currency       string       `db:"currency"`
+	DisplayOrder   int          `db:"display_order"`
+	ID             int64        `db:"id"`
+	Name           string       `db:"name"`
+	OpeningBalance int64        `db:"opening_balance"`
+	Type           string       `db:"type"`
+	UpdatedAt      sql.NullTime `db:"updated_at"`
+	UserID         int64        `db:"user_id"`
+}
+
+// InsertWalletParam represents parameters needed to create a wallet.
+type InsertWalletParam struct {
+	Currency       string
+	Name           string
+	OpeningBalance int64
+	Type           string
+	UserID         int64
+}
+
+// UpdateWalletParam represents parameters needed to update a wallet.
+// An archived wallet keeps the time it was first archived.
+// Changing OpeningBalance shifts the balance of the wallet by the same amount.
+type UpdateWalletParam struct {
+	Archived       bool
+	Currency       string
+	DisplayOrder   int
+	ID             int64
+	Name           string
+	OpeningBalance int64
+	Type           string
+	UserID         int64
+}
+
+// AdjustWalletBalanceParam represents parameters needed to adjust the balance of a wallet.
+// Amount is added to the balance, a negative Amount lowers it.
+type AdjustWalletBalanceParam struct {
+	Amount int64
+	ID     int64
+	UserID int64
+}
@@ -5,8 +5,49 @@ import (
 	"context"
 	"log"
 	"time"
+
+	// external package
+	"github.com/redis/go-redis/v9"
 )
 
+// compareAndSwapScript will replace the value of KEYS[1] with ARGV[2] keeping its time to live,
+// only if KEYS[1] still holds ARGV[1].
+const compareAndSwapScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "KEEPTTL")
+	return 1
+end
+return 0`
+
+// CompareAndSwap will replace the value of a redis key with value atomically,
+// only if the key still holds old. The key keeps its time to live.
+// It returns true if the value is replaced, so a key that doesn't exist is never created.
+func (repo *RedisRepository) CompareAndSwap(ctx context.Context, key string, old, value interface{}) (bool, error) {
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	oldBytes, err := repo.infra.JsonMarshal(old)
+	if err != nil {
+		log.Printf("[CompareAndSwap] repo.infra.JsonMarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	bytes, err := repo.infra.JsonMarshal(value)
+	if err != nil {
+		log.Printf("[CompareAndSwap] repo.infra.JsonMarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	redisCmd := repo.redis.Eval(ctx, compareAndSwapScript, []string{key}, oldBytes, bytes)
+	result, err := redisCmd.Int64()
+	if err != nil {
+		log.Printf("[CompareAndSwap] redisCmd.Int64() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
 // Del will delete a key in redis.
 func (repo *RedisRepository) Del(ctx context.Context, key string) error {
 	redisInt := repo.redis.Del(ctx, key)
@@ -23,6 +64,23 @@ func (repo *RedisRepository) Del(ctx context.Context, key string) error {
 	return nil
 }
 
+// Expire will set a timeout on a redis key.
+func (repo *RedisRepository) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	redisBool := repo.redis.Expire(ctx, key, expiration)
+	_, err := redisBool.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key":        key,
+			"expiration": expiration,
+		}
+
+		log.Printf("[Expire] redisBool.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
 // Get will get the value of a redis key.
 // First it will check whether the key exist or not.
 // If it exists, then it will return the value.
@@ -53,6 +111,126 @@ func (repo *RedisRepository) Get(ctx context.Context, key string) (string, error
 	return result, nil
 }
 
+// GetDel will get the value of a redis key and delete the key atomically.
+// If the key doesn't exist, it returns empty string.
+func (repo *RedisRepository) GetDel(ctx context.Context, key string) (string, error) {
+	redisString := repo.redis.GetDel(ctx, key)
+	result, err := redisString.Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[GetDel] redisString.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	return result, nil
+}
+
+// GetSet will save the value of a key to redis and return its previous value atomically.
+// If the key doesn't exist before, it returns empty string.
+func (repo *RedisRepository) GetSet(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error) {
+	meta := map[string]interface{}{
+		"key":        key,
+		"expiration": expiration,
+	}
+
+	bytes, err := repo.infra.JsonMarshal(value)
+	if err != nil {
+		log.Printf("[GetSet] repo.infra.JsonMarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	redisStatus := repo.redis.SetArgs(ctx, key, bytes, redis.SetArgs{
+		Get: true,
+		TTL: expiration,
+	})
+	result, err := redisStatus.Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	if err != nil {
+		log.Printf("[GetSet] redisStatus.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	return result, nil
+}
+
+// Incr will increment the integer value of a redis key by one and return the new value.
+// If the key doesn't exist, it is set to 0 before the increment.
+func (repo *RedisRepository) Incr(ctx context.Context, key string) (int64, error) {
+	redisInt := repo.redis.Incr(ctx, key)
+	result, err := redisInt.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[Incr] redisInt.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// SAdd will add a member to a redis set.
+func (repo *RedisRepository) SAdd(ctx context.Context, key, member string) error {
+	redisInt := repo.redis.SAdd(ctx, key, member)
+	_, err := redisInt.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key":    key,
+			"member": member,
+		}
+
+		log.Printf("[SAdd] redisInt.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SMembers will get all members of a redis set.
+// If the key doesn't exist, it returns empty slice.
+func (repo *RedisRepository) SMembers(ctx context.Context, key string) ([]string, error) {
+	redisStringSlice := repo.redis.SMembers(ctx, key)
+	result, err := redisStringSlice.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[SMembers] redisStringSlice.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SRem will remove a member from a redis set.
+func (repo *RedisRepository) SRem(ctx context.Context, key, member string) error {
+	redisInt := repo.redis.SRem(ctx, key, member)
+	_, err := redisInt.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key":    key,
+			"member": member,
+		}
+
+		log.Printf("[SRem] redisInt.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
 // Set will save the value of a key to redis.
 func (repo *RedisRepository) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
 	meta := map[string]interface{}{
@@ -75,3 +253,48 @@ func (repo *RedisRepository) Set(ctx context.Context, key string, value interfac
 
 	return nil
 }
+
+// SetNX will save the value of a key to redis only if the key doesn't exist.
+// It returns true if the value is saved.
+func (repo *RedisRepository) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	meta := map[string]interface{}{
+		"key":        key,
+		"expiration": expiration,
+	}
+
+	bytes, err := repo.infra.JsonMarshal(value)
+	if err != nil {
+		log.Printf("[SetNX] repo.infra.JsonMarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	redisBool := repo.redis.SetNX(ctx, key, bytes, expiration)
+	result, err := redisBool.Result()
+	if err != nil {
+		log.Printf("[SetNX] redisBool.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// TTL will get the remaining time to live of a redis key.
+// If the key doesn't exist or has no timeout, it returns 0.
+func (repo *RedisRepository) TTL(ctx context.Context, key string) (time.Duration, error) {
+	redisDuration := repo.redis.TTL(ctx, key)
+	result, err := redisDuration.Result()
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[TTL] redisDuration.Result() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	if result < 0 {
+		return 0, nil
+	}
+
+	return result, nil
+}
@@ -9,6 +9,7 @@ import (
 	// external package
 	"github.com/go-redis/redismock/v9"
 	"github.com/golang/mock/gomock"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -200,3 +201,606 @@ func TestRedisRepository_Set(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisRepository_GetSet(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+		infra *MockinfraProvider
+	}
+
+	type args struct {
+		key        string
+		value      interface{}
+		expiration time.Duration
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "when_JsonMarshal_error_then_return_error",
+			args: args{value: "abcd"},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetArgs_error_then_return_error",
+			args: args{
+				key:        "keys",
+				value:      "abcd",
+				expiration: time.Second,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetArgs("keys", []byte("abcd"), redis.SetArgs{Get: true, TTL: time.Second}).SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_before_then_return_empty_string",
+			args: args{
+				key:        "keys",
+				value:      "abcd",
+				expiration: time.Second,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetArgs("keys", []byte("abcd"), redis.SetArgs{Get: true, TTL: time.Second}).RedisNil()
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_previous_value",
+			args: args{
+				key:        "keys",
+				value:      "abcd",
+				expiration: time.Second,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetArgs("keys", []byte("abcd"), redis.SetArgs{Get: true, TTL: time.Second}).SetVal("efgh")
+			},
+			want: "efgh",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			redis, mock := redismock.NewClientMock()
+
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				redis: mock,
+			}
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.GetSet(context.Background(), test.args.key, test.args.value, test.args.expiration)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_SAdd(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	type args struct {
+		key    string
+		member string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SAdd_error_then_return_error",
+			args: args{key: "key", member: "member"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSAdd("key", "member").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{key: "key", member: "member"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSAdd("key", "member").SetVal(1)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			err := r.SAdd(context.Background(), test.args.key, test.args.member)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_SMembers(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       []string
+		wantErr    error
+	}{
+		{
+			name: "when_SMembers_error_then_return_error",
+			args: args{key: "key"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSMembers("key").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_members",
+			args: args{key: "key"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSMembers("key").SetVal([]string{"a", "b"})
+			},
+			want: []string{"a", "b"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			got, err := r.SMembers(context.Background(), test.args.key)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_SRem(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	type args struct {
+		key    string
+		member string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SRem_error_then_return_error",
+			args: args{key: "key", member: "member"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSRem("key", "member").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{key: "key", member: "member"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectSRem("key", "member").SetVal(1)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			err := r.SRem(context.Background(), test.args.key, test.args.member)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_GetDel(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			args: args{key: "key"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectGetDel("key").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_string",
+			args: args{key: "key"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectGetDel("key").RedisNil()
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_value",
+			args: args{key: "key"},
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectGetDel("key").SetVal("value")
+			},
+			want: "value",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			got, err := r.GetDel(context.Background(), test.args.key)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_SetNX(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+		infra *MockinfraProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_JsonMarshal_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetNX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetNX("keys", []byte("abcd"), time.Minute).SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_exist_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetNX("keys", []byte("abcd"), time.Minute).SetVal(false)
+			},
+			want: false,
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.redis.ExpectSetNX("keys", []byte("abcd"), time.Minute).SetVal(true)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			redis, mock := redismock.NewClientMock()
+
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				redis: mock,
+			}
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.SetNX(context.Background(), "keys", "abcd", time.Minute)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_Expire(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Expire_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectExpire("keys", time.Minute).SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectExpire("keys", time.Minute).SetVal(true)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			err := r.Expire(context.Background(), "keys", time.Minute)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_Incr(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_Incr_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectIncr("keys").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_new_value",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectIncr("keys").SetVal(3)
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			got, err := r.Incr(context.Background(), "keys")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_TTL(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       time.Duration
+		wantErr    error
+	}{
+		{
+			name: "when_TTL_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectTTL("keys").SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectTTL("keys").SetVal(-2)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_ttl",
+			mockFields: func(mf mockFields) {
+				mf.redis.ExpectTTL("keys").SetVal(time.Minute)
+			},
+			want: time.Minute,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis, mock := redismock.NewClientMock()
+			mockFields := mockFields{
+				redis: mock,
+			}
+
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+			}
+
+			got, err := r.TTL(context.Background(), "keys")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestRedisRepository_CompareAndSwap(t *testing.T) {
+	type mockFields struct {
+		redis redismock.ClientMock
+		infra *MockinfraProvider
+	}
+
+	type args struct {
+		key   string
+		old   interface{}
+		value interface{}
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_JsonMarshal_old_error_then_return_error",
+			args: args{old: "abcd"},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_JsonMarshal_value_error_then_return_error",
+			args: args{
+				old:   "abcd",
+				value: "efgh",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.infra.EXPECT().JsonMarshal("efgh").Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Eval_error_then_return_error",
+			args: args{
+				key:   "keys",
+				old:   "abcd",
+				value: "efgh",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.infra.EXPECT().JsonMarshal("efgh").Return([]byte("efgh"), nil)
+				mf.redis.ExpectEval(compareAndSwapScript, []string{"keys"}, []byte("abcd"), []byte("efgh")).SetErr(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_no_longer_holds_old_then_return_false",
+			args: args{
+				key:   "keys",
+				old:   "abcd",
+				value: "efgh",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.infra.EXPECT().JsonMarshal("efgh").Return([]byte("efgh"), nil)
+				mf.redis.ExpectEval(compareAndSwapScript, []string{"keys"}, []byte("abcd"), []byte("efgh")).SetVal(int64(0))
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			args: args{
+				key:   "keys",
+				old:   "abcd",
+				value: "efgh",
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().JsonMarshal("abcd").Return([]byte("abcd"), nil)
+				mf.infra.EXPECT().JsonMarshal("efgh").Return([]byte("efgh"), nil)
+				mf.redis.ExpectEval(compareAndSwapScript, []string{"keys"}, []byte("abcd"), []byte("efgh")).SetVal(int64(1))
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			redis, mock := redismock.NewClientMock()
+
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				redis: mock,
+			}
+			test.mockFields(mockFields)
+
+			r := &RedisRepository{
+				redis: redis,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.CompareAndSwap(context.Background(), test.args.key, test.args.old, test.args.value)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
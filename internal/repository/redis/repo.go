@@ -22,14 +22,44 @@ type redisProvider interface {
 	// Del will delete a key in redis.
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
 
+	// Eval will run a lua script in redis.
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+
 	// Exists will check whether a key is exist in redis.
 	Exists(ctx context.Context, keys ...string) *redis.IntCmd
 
+	// Expire will set a timeout on a redis key.
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+
 	// Get will get the value of a redis key.
 	Get(ctx context.Context, key string) *redis.StringCmd
 
+	// Incr will increment the integer value of a redis key by one.
+	Incr(ctx context.Context, key string) *redis.IntCmd
+
+	// SAdd will add members to a redis set.
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+
+	// SMembers will get all members of a redis set.
+	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+
+	// SRem will remove members from a redis set.
+	SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+
+	// GetDel will get the value of a redis key and delete the key.
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+
 	// Set will save the value of a key to redis.
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+
+	// SetNX will save the value of a key to redis only if the key doesn't exist.
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd
+
+	// SetArgs will save the value of a key to redis with the given arguments.
+	SetArgs(ctx context.Context, key string, value interface{}, a redis.SetArgs) *redis.StatusCmd
+
+	// TTL will get the remaining time to live of a redis key.
+	TTL(ctx context.Context, key string) *redis.DurationCmd
 }
 
 // RedisRepositoryParam holds all parameters needed to instansiate new
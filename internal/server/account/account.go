@@ -2,33 +2,38 @@ package account
 
 import (
 	// golang package
-	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
 
-	// golang package
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/usecase/account"
 )
 
 const (
+	deviceNameKey   = "device_name"
 	emailKey        = "email"
 	firstNameKey    = "first_name"
 	lastNameKey     = "last_name"
 	passwordKey     = "password"
 	recordPeriodKey = "record_period"
-	userIDKey       = "user_id"
 )
 
 var (
-	errEmailEmpty          = errors.New("email is empty")
-	errNameEmpty           = errors.New("name is empty")
-	errPasswordEmpty       = errors.New("password is empty")
-	errRecordPeriodInvalid = errors.New("record_period not valid")
-	errUserExist           = errors.New("user already exist!")
-	errUserIDInvalid       = errors.New("user_id not valid")
+	errBudgetingModeInvalid = errors.New("budgeting_mode not valid")
+	errEmailEmpty           = errors.New("email is empty")
+	errNameEmpty            = errors.New("name is empty")
+	errPasswordEmpty        = errors.New("password is empty")
+	errRefreshTokenEmpty    = errors.New("refresh_token is empty")
+	errRecordPeriodInvalid  = errors.New("record_period not valid")
+	errTimezoneInvalid      = errors.New("timezone not valid")
+	errTokenEmpty           = errors.New("token is empty")
+	errUnauthorized         = errors.New("unauthorized!")
+	errUserExist            = errors.New("user already exist!")
 )
 
 // HandleUserLogIn handles user login process.
@@ -55,9 +60,31 @@ func (h *Handler) HandleUserLogIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.account.LogIn(context.Background(), strings.ToLower(email), password)
+	token, err := h.account.LogIn(r.Context(), account.LogInParam{
+		DeviceName: r.FormValue(deviceNameKey),
+		Email:      strings.ToLower(email),
+		IPAddress:  entity.GetRequestMetadataFromContext(r.Context()).IPAddress,
+		Password:   password,
+		UserAgent:  r.UserAgent(),
+	})
 	if err != nil {
 		result.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrEmailNotVerified) || errors.Is(err, account.ErrAccountDisabled) {
+			result.Code = http.StatusForbidden
+		}
+
+		var blocked *account.LoginBlockedError
+		if errors.As(err, &blocked) {
+			result.Code = http.StatusTooManyRequests
+			if errors.Is(err, account.ErrAccountLocked) {
+				result.Code = http.StatusLocked
+			}
+
+			retryAfter := int(math.Ceil(blocked.RetryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		}
+
+		w.WriteHeader(result.Code)
 		result.Error = err.Error()
 
 		json.NewEncoder(w).Encode(result)
@@ -65,21 +92,30 @@ func (h *Handler) HandleUserLogIn(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result.Code = http.StatusOK
-	result.Token = token
+	if token.MFAChallengeToken != "" {
+		result.MFAChallengeToken = token.MFAChallengeToken
+		result.MFARequired = true
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	result.RefreshToken = token.RefreshToken
+	result.Token = token.Token
 	json.NewEncoder(w).Encode(result)
 }
 
 // HandlerUserLogOut handles user logout process.
+// Only the session used by the request is logged out.
 func (h *Handler) HandlerUserLogOut(w http.ResponseWriter, r *http.Request) {
-	userIDStr := r.FormValue(userIDKey)
-
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
-	if err != nil {
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
 		json.NewEncoder(w).Encode("failed to log out!")
 		return
 	}
 
-	err = h.account.LogOut(context.Background(), userID)
+	err := h.account.LogOut(r.Context())
 	if err != nil {
 		json.NewEncoder(w).Encode("failed to log out!")
 		return
@@ -88,6 +124,62 @@ func (h *Handler) HandlerUserLogOut(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode("success!")
 }
 
+// HandleRefreshToken will exchange user's refresh token with a new JWT and refresh token.
+func (h *Handler) HandleRefreshToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var result userLogInResponse
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = err.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	var request refreshTokenParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = err.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	if request.RefreshToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = errRefreshTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	token, err := h.account.RefreshToken(r.Context(), request.RefreshToken)
+	if err != nil {
+		result.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrRefreshTokenInvalid) {
+			result.Code = http.StatusUnauthorized
+		}
+
+		w.WriteHeader(result.Code)
+		result.Error = err.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	result.Code = http.StatusOK
+	result.RefreshToken = token.RefreshToken
+	result.Token = token.Token
+	json.NewEncoder(w).Encode(result)
+}
+
 // HandleUpdateUserAccount will perform an update on user account
 func (h *Handler) HandleUpdateUserAccount(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -97,6 +189,16 @@ func (h *Handler) HandleUpdateUserAccount(w http.ResponseWriter, r *http.Request
 		Code:  http.StatusBadRequest,
 	}
 
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
 	bytes, err := h.infra.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -125,7 +227,7 @@ func (h *Handler) HandleUpdateUserAccount(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if request.RecordPeriod <= 0 {
+	if request.RecordPeriod <= 0 || request.RecordPeriod > entity.MaxPeriodStartDay {
 		w.WriteHeader(http.StatusBadRequest)
 		response.Error = errRecordPeriodInvalid.Error()
 		json.NewEncoder(w).Encode(response)
@@ -133,19 +235,28 @@ func (h *Handler) HandleUpdateUserAccount(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if request.UserID <= 0 {
+	if request.Timezone != "" && !entity.IsTimezone(request.Timezone) {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Error = errTimezoneInvalid.Error()
+		json.NewEncoder(w).Encode(response)
+
+		return
+	}
+
+	if request.BudgetingMode != "" && !entity.IsBudgetingMode(request.BudgetingMode) {
 		w.WriteHeader(http.StatusBadRequest)
-		response.Error = errUserIDInvalid.Error()
+		response.Error = errBudgetingModeInvalid.Error()
 		json.NewEncoder(w).Encode(response)
 
 		return
 	}
 
-	err = h.account.UpdateUserAccount(context.Background(), account.UpdateUserAccountParam{
-		FirstName:    request.FirstName,
-		LastName:     request.LastName,
-		RecordPeriod: request.RecordPeriod,
-		UserID:       request.UserID,
+	err = h.account.UpdateUserAccount(r.Context(), account.UpdateUserAccountParam{
+		BudgetingMode: request.BudgetingMode,
+		FirstName:     request.FirstName,
+		LastName:      request.LastName,
+		RecordPeriod:  request.RecordPeriod,
+		Timezone:      request.Timezone,
 	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -166,23 +277,24 @@ func (h *Handler) HandleUpdateUserAccount(w http.ResponseWriter, r *http.Request
 func (h *Handler) HandleUpdateUserPassword(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	response := defaultResponse{
-		Error: "",
-		Code:  http.StatusBadRequest,
+	response := passwordResponse{
+		defaultResponse: defaultResponse{
+			Error: "",
+			Code:  http.StatusBadRequest,
+		},
 	}
 
-	bytes, err := h.infra.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		response.Code = http.StatusBadRequest
-		response.Error = err.Error()
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
 
 		json.NewEncoder(w).Encode(response)
 		return
 	}
 
-	var request updateUserPassword
-	err = h.infra.JsonUnmarshal(bytes, &request)
+	bytes, err := h.infra.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		response.Code = http.StatusBadRequest
@@ -192,10 +304,12 @@ func (h *Handler) HandleUpdateUserPassword(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if request.Email == "" {
+	var request updateUserPassword
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		response.Code = http.StatusBadRequest
-		response.Error = errEmailEmpty.Error()
+		response.Error = err.Error()
 
 		json.NewEncoder(w).Encode(response)
 		return
@@ -219,24 +333,20 @@ func (h *Handler) HandleUpdateUserPassword(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if request.UserID <= 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		response.Code = http.StatusBadRequest
-		response.Error = errUserIDInvalid.Error()
-
-		json.NewEncoder(w).Encode(response)
-		return
-	}
-
-	err = h.account.UpdatePassword(context.Background(), account.UpdatePasswordParam{
-		Email:       request.Email,
+	err = h.account.UpdatePassword(r.Context(), account.UpdatePasswordParam{
 		OldPassword: request.OldPassword,
 		Password:    request.Password,
-		UserID:      request.UserID,
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
 		response.Code = http.StatusInternalServerError
+
+		var validationErr *account.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
 		response.Error = err.Error()
 		json.NewEncoder(w).Encode(response)
 
@@ -291,8 +401,18 @@ func (h *Handler) HandleUserSignUp(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.account.UserSignUp(context.Background(), strings.ToLower(request.Email), request.Password)
+	err = h.account.UserSignUp(r.Context(), strings.ToLower(request.Email), request.Password, strings.ToLower(request.Language))
 	if err != nil {
+		var validationErr *account.ValidationError
+		if errors.As(err, &validationErr) {
+			result.Code = http.StatusBadRequest
+			result.Error = err.Error()
+			result.Fields = validationErr.Fields
+
+			json.NewEncoder(w).Encode(result)
+			return
+		}
+
 		if err == errUserExist {
 			result.Code = http.StatusBadRequest
 			result.Error = err.Error()
@@ -0,0 +1,79 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// HandleDeleteAccount will schedule user's account to be deleted once the grace period is over.
+// User's current password is needed to do so.
+// Logging back in before the grace period is over cancels the deletion.
+func (h *Handler) HandleDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response accountDeletionResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request deleteAccountParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errPasswordEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	purgeAt, err := h.account.DeleteAccount(r.Context(), request.Password)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			response.Code = http.StatusForbidden
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.PurgeAt = purgeAt
+	json.NewEncoder(w).Encode(response)
+}
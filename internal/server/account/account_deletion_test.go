@@ -0,0 +1,145 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleDeleteAccount(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockPurgeAt := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	mockUnmarshal := func(request deleteAccountParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*deleteAccountParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockFields  func(mockFields)
+		wantCode    int
+		wantPurgeAt time.Time
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest deleteAccountParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest deleteAccountParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_incorrect_then_return_forbidden",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest deleteAccountParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(deleteAccountParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DeleteAccount(ctx, "pass").Return(time.Time{}, account.ErrIncorrectPassword)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "when_DeleteAccount_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest deleteAccountParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(deleteAccountParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DeleteAccount(ctx, "pass").Return(time.Time{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest deleteAccountParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(deleteAccountParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DeleteAccount(ctx, "pass").Return(mockPurgeAt, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantPurgeAt: mockPurgeAt,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/account", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleDeleteAccount(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+
+			var got accountDeletionResponse
+			json.NewDecoder(w.Body).Decode(&got)
+			assert.True(t, test.wantPurgeAt.Equal(got.PurgeAt))
+		})
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	// external package
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/usecase/account"
 )
 
@@ -22,38 +24,109 @@ func TestHandler_HandleUserLogIn(t *testing.T) {
 		accountUC *MockaccountUCManager
 		infra     *MockinfraProvider
 	}
+
+	mockCtx := entity.NewContextWithRequestMetadata(context.Background(), entity.RequestMetadata{
+		IPAddress: "192.0.2.1",
+	})
+	mockParam := account.LogInParam{
+		DeviceName: "phone",
+		Email:      "email",
+		IPAddress:  "192.0.2.1",
+		Password:   "password",
+		UserAgent:  "agent",
+	}
+
 	tests := []struct {
 		name          string
 		emailValid    bool
 		passwordValid bool
 		mockFields    func(mockFields)
+		wantCode      int
+		wantRetry     string
 	}{
 		{
 			name:          "when_email_empty_then_return_bad_request",
 			emailValid:    false,
 			passwordValid: true,
 			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusBadRequest,
 		},
 		{
 			name:       "when_password_empty_then_return_bad_request",
 			emailValid: true,
 			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:          "when_email_not_verified_then_return_forbidden",
+			emailValid:    true,
+			passwordValid: true,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{}, account.ErrEmailNotVerified)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "when_account_disabled_then_return_forbidden",
+			emailValid:    true,
+			passwordValid: true,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{}, account.ErrAccountDisabled)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "when_account_locked_then_return_locked",
+			emailValid:    true,
+			passwordValid: true,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{}, &account.LoginBlockedError{
+					Err:        account.ErrAccountLocked,
+					RetryAfter: 15 * time.Minute,
+				})
+			},
+			wantCode:  http.StatusLocked,
+			wantRetry: "900",
+		},
+		{
+			name:          "when_login_throttled_then_return_too_many_requests",
+			emailValid:    true,
+			passwordValid: true,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{}, &account.LoginBlockedError{
+					Err:        account.ErrLoginThrottled,
+					RetryAfter: 1500 * time.Millisecond,
+				})
+			},
+			wantCode:  http.StatusTooManyRequests,
+			wantRetry: "2",
 		},
 		{
 			name:          "when_LogIn_error_then_return_internal_server_error",
 			emailValid:    true,
 			passwordValid: true,
 			mockFields: func(mf mockFields) {
-				mf.accountUC.EXPECT().LogIn(context.Background(), "email", "password").Return("", assert.AnError)
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "when_mfa_required_then_return_status_ok",
+			emailValid:    true,
+			passwordValid: true,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{MFAChallengeToken: "challenge"}, nil)
 			},
+			wantCode: http.StatusOK,
 		},
 		{
 			name:          "when_no_error_occured_then_return_status_ok",
 			emailValid:    true,
 			passwordValid: true,
 			mockFields: func(mf mockFields) {
-				mf.accountUC.EXPECT().LogIn(context.Background(), "email", "password").Return("", nil)
+				mf.accountUC.EXPECT().LogIn(mockCtx, mockParam).Return(account.JWT{Token: "token", RefreshToken: "refresh"}, nil)
 			},
+			wantCode: http.StatusOK,
 		},
 	}
 	for _, test := range tests {
@@ -70,10 +143,12 @@ func TestHandler_HandleUserLogIn(t *testing.T) {
 				infra:   mockFields.infra,
 			}
 
-			req := httptest.NewRequest(http.MethodPost, "/account/login", nil)
+			req := httptest.NewRequest(http.MethodPost, "/account/login", nil).WithContext(mockCtx)
+			req.Header.Set("User-Agent", "agent")
 			req.Form = url.Values{
-				"email":    []string{"email"},
-				"password": []string{"password"},
+				"device_name": []string{"phone"},
+				"email":       []string{"email"},
+				"password":    []string{"password"},
 			}
 
 			if !test.emailValid {
@@ -92,6 +167,11 @@ func TestHandler_HandleUserLogIn(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			h.HandleUserLogIn(w, req)
+
+			var result userLogInResponse
+			json.NewDecoder(w.Body).Decode(&result)
+			assert.Equal(t, test.wantCode, result.Code)
+			assert.Equal(t, test.wantRetry, w.Header().Get("Retry-After"))
 		})
 	}
 }
@@ -101,28 +181,34 @@ func TestHandler_HandlerUserLogOut(t *testing.T) {
 		accountUC *MockaccountUCManager
 		infra     *MockinfraProvider
 	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 1234,
+	})
+
 	tests := []struct {
-		name        string
-		userIDValid bool
-		mockFields  func(mockFields)
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
 	}{
 		{
-			name:        "when_user_id_invalid_then_return_immediately",
-			userIDValid: false,
-			mockFields:  func(mf mockFields) {},
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
 		},
 		{
-			name:        "when_LogOut_error_then_return_immediately",
-			userIDValid: true,
+			name: "when_LogOut_error_then_return_immediately",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
-				mf.accountUC.EXPECT().LogOut(context.Background(), int64(1234)).Return(assert.AnError)
+				mf.accountUC.EXPECT().LogOut(ctx).Return(assert.AnError)
 			},
 		},
 		{
-			name:        "when_no_error_occured_then_return_success",
-			userIDValid: true,
+			name: "when_no_error_occured_then_return_success",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
-				mf.accountUC.EXPECT().LogOut(context.Background(), int64(1234)).Return(nil)
+				mf.accountUC.EXPECT().LogOut(ctx).Return(nil)
 			},
 		},
 	}
@@ -140,19 +226,115 @@ func TestHandler_HandlerUserLogOut(t *testing.T) {
 				infra:   mockFields.infra,
 			}
 
-			req := httptest.NewRequest(http.MethodPost, "/account/logout", nil)
-			req.Form = url.Values{
-				"user_id": []string{"abc"},
+			req := httptest.NewRequest(http.MethodPost, "/account/logout", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandlerUserLogOut(w, req)
+		})
+	}
+}
+
+func TestHandler_HandleRefreshToken(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockUnmarshal := func(request refreshTokenParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*refreshTokenParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest refreshTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_refresh_token_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest refreshTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_refresh_token_invalid_then_return_unauthorized",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest refreshTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(refreshTokenParam{RefreshToken: "refresh"}))
+				mf.accountUC.EXPECT().RefreshToken(context.Background(), "refresh").Return(account.JWT{}, account.ErrRefreshTokenInvalid)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "when_RefreshToken_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest refreshTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(refreshTokenParam{RefreshToken: "refresh"}))
+				mf.accountUC.EXPECT().RefreshToken(context.Background(), "refresh").Return(account.JWT{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest refreshTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(refreshTokenParam{RefreshToken: "refresh"}))
+				mf.accountUC.EXPECT().RefreshToken(context.Background(), "refresh").Return(account.JWT{
+					RefreshToken: "new_refresh",
+					Token:        "token",
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
 			}
-			if test.userIDValid {
-				req.Form = url.Values{
-					"user_id": []string{"1234"},
-				}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
 			}
 
+			req := httptest.NewRequest(http.MethodPost, "/account/token/refresh", nil)
 			w := httptest.NewRecorder()
 
-			h.HandlerUserLogOut(w, req)
+			h.HandleRefreshToken(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
 		})
 	}
 }
@@ -162,18 +344,32 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 		accountUC *MockaccountUCManager
 		infra     *MockinfraProvider
 	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 1234,
+	})
+
 	tests := []struct {
 		name       string
+		ctx        context.Context
 		mockFields func(mockFields)
 	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+		},
 		{
 			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
 			},
 		},
 		{
 			name: "when_JsonUnmarshal_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -183,6 +379,7 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_first_name_is_empty_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -192,6 +389,7 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_record_period_0_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -209,7 +407,27 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 			},
 		},
 		{
-			name: "when_user_id_not_valid_then_return_bad_request",
+			name: "when_record_period_more_than_31_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var destination updateUserAccount
+				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*updateUserAccount) = updateUserAccount{
+							FirstName:    "Ji Eun",
+							LastName:     "Lee",
+							RecordPeriod: 32,
+						}
+
+						return nil
+					})
+			},
+		},
+		{
+			name: "when_timezone_invalid_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -220,7 +438,27 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 							FirstName:    "Ji Eun",
 							LastName:     "Lee",
 							RecordPeriod: 1,
-							UserID:       0,
+							Timezone:     "Asia/Bandung",
+						}
+
+						return nil
+					})
+			},
+		},
+		{
+			name: "when_budgeting_mode_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var destination updateUserAccount
+				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*updateUserAccount) = updateUserAccount{
+							BudgetingMode: "zero",
+							FirstName:     "Ji Eun",
+							LastName:      "Lee",
+							RecordPeriod:  1,
 						}
 
 						return nil
@@ -229,6 +467,7 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 		},
 		{
 			name: "when_UpdateUserAccount_error_then_return_internal_server_error",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -239,22 +478,21 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 							FirstName:    "Ji Eun",
 							LastName:     "Lee",
 							RecordPeriod: 1,
-							UserID:       1234,
 						}
 
 						return nil
 					})
 
-				mf.accountUC.EXPECT().UpdateUserAccount(context.Background(), account.UpdateUserAccountParam{
+				mf.accountUC.EXPECT().UpdateUserAccount(ctx, account.UpdateUserAccountParam{
 					FirstName:    "Ji Eun",
 					LastName:     "Lee",
 					RecordPeriod: 1,
-					UserID:       1234,
 				}).Return(assert.AnError)
 			},
 		},
 		{
 			name: "when_no_error_then_return_status_ok",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -262,27 +500,29 @@ func TestHandler_HandleUpdateUserAccount(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
 						*dest.(*updateUserAccount) = updateUserAccount{
-							FirstName:    "Ji Eun",
-							LastName:     "Lee",
-							RecordPeriod: 1,
-							UserID:       1234,
+							BudgetingMode: "envelope",
+							FirstName:     "Ji Eun",
+							LastName:      "Lee",
+							RecordPeriod:  1,
+							Timezone:      "Asia/Makassar",
 						}
 
 						return nil
 					})
 
-				mf.accountUC.EXPECT().UpdateUserAccount(context.Background(), account.UpdateUserAccountParam{
-					FirstName:    "Ji Eun",
-					LastName:     "Lee",
-					RecordPeriod: 1,
-					UserID:       1234,
+				mf.accountUC.EXPECT().UpdateUserAccount(ctx, account.UpdateUserAccountParam{
+					BudgetingMode: "envelope",
+					FirstName:     "Ji Eun",
+					LastName:      "Lee",
+					RecordPeriod:  1,
+					Timezone:      "Asia/Makassar",
 				}).Return(nil)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodPost, "/account/update", nil)
+			req := httptest.NewRequest(http.MethodPost, "/account/update", nil).WithContext(test.ctx)
 
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
@@ -308,18 +548,32 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 		accountUC *MockaccountUCManager
 		infra     *MockinfraProvider
 	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
 	tests := []struct {
 		name       string
+		ctx        context.Context
 		mockFields func(mockFields)
 	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+		},
 		{
 			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
 			},
 		},
 		{
 			name: "when_JsonUnmarshal_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -327,26 +581,16 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
 			},
 		},
-		{
-			name: "when_email_empty_then_return_bad_request",
-			mockFields: func(mf mockFields) {
-				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
-
-				var dest updateUserPassword
-				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
-			},
-		},
 		{
 			name: "when_old_password_empty_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
 				var destination updateUserPassword
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
-						*dest.(*updateUserPassword) = updateUserPassword{
-							Email: "email",
-						}
+						*dest.(*updateUserPassword) = updateUserPassword{}
 
 						return nil
 					})
@@ -354,6 +598,7 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 		},
 		{
 			name: "when_password_empty_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -361,7 +606,6 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
 						*dest.(*updateUserPassword) = updateUserPassword{
-							Email:       "email",
 							OldPassword: "old_pass",
 						}
 
@@ -370,7 +614,8 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 			},
 		},
 		{
-			name: "when_user_id_invalid_then_return_bad_request",
+			name: "when_UpdatePassword_error_then_return_internal_server_error",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -378,17 +623,21 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
 						*dest.(*updateUserPassword) = updateUserPassword{
-							Email:       "email",
 							OldPassword: "old_pass",
 							Password:    "password",
 						}
 
 						return nil
 					})
+				mf.accountUC.EXPECT().UpdatePassword(ctx, account.UpdatePasswordParam{
+					OldPassword: "old_pass",
+					Password:    "password",
+				}).Return(assert.AnError)
 			},
 		},
 		{
-			name: "when_UpdatePassword_error_then_return_internal_server_error",
+			name: "when_password_violates_policy_then_return_bad_request",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -396,24 +645,23 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
 						*dest.(*updateUserPassword) = updateUserPassword{
-							Email:       "email",
 							OldPassword: "old_pass",
 							Password:    "password",
-							UserID:      123,
 						}
 
 						return nil
 					})
-				mf.accountUC.EXPECT().UpdatePassword(context.Background(), account.UpdatePasswordParam{
-					Email:       "email",
+				mf.accountUC.EXPECT().UpdatePassword(ctx, account.UpdatePasswordParam{
 					OldPassword: "old_pass",
 					Password:    "password",
-					UserID:      123,
-				}).Return(assert.AnError)
+				}).Return(&account.ValidationError{
+					Fields: []account.FieldError{{Code: "breached", Field: "password", Message: "breached"}},
+				})
 			},
 		},
 		{
 			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
 
@@ -421,26 +669,22 @@ func TestHandler_HandleUpdateUserPassword(t *testing.T) {
 				mf.infra.EXPECT().JsonUnmarshal(nil, &destination).DoAndReturn(
 					func(input []byte, dest interface{}) error {
 						*dest.(*updateUserPassword) = updateUserPassword{
-							Email:       "email",
 							OldPassword: "old_pass",
 							Password:    "password",
-							UserID:      123,
 						}
 
 						return nil
 					})
-				mf.accountUC.EXPECT().UpdatePassword(context.Background(), account.UpdatePasswordParam{
-					Email:       "email",
+				mf.accountUC.EXPECT().UpdatePassword(ctx, account.UpdatePasswordParam{
 					OldPassword: "old_pass",
 					Password:    "password",
-					UserID:      123,
 				}).Return(nil)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodPost, "/account/update_password", nil)
+			req := httptest.NewRequest(http.MethodPost, "/account/update_password", nil).WithContext(test.ctx)
 
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
@@ -539,12 +783,39 @@ func TestHandler_HandleUserSignUp(t *testing.T) {
 					func(input []byte, dest interface{}) error {
 						*dest.(*userSignUpParam) = userSignUpParam{
 							Email:    "email",
+							Language: "EN",
+							Password: "password",
+						}
+						return nil
+					})
+
+				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password", "en").Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_password_violates_policy_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mockParam := userSignUpParam{
+					Email:    "email",
+					Password: "password",
+				}
+				bytesParam, _ := json.Marshal(mockParam)
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(bytesParam, nil)
+
+				var destination userSignUpParam
+				mf.infra.EXPECT().JsonUnmarshal(bytesParam, &destination).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*userSignUpParam) = userSignUpParam{
+							Email:    "email",
+							Language: "EN",
 							Password: "password",
 						}
 						return nil
 					})
 
-				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password").Return(assert.AnError)
+				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password", "en").Return(&account.ValidationError{
+					Fields: []account.FieldError{{Code: "too_short", Field: "password", Message: "too short"}},
+				})
 			},
 		},
 		{
@@ -562,12 +833,13 @@ func TestHandler_HandleUserSignUp(t *testing.T) {
 					func(input []byte, dest interface{}) error {
 						*dest.(*userSignUpParam) = userSignUpParam{
 							Email:    "email",
+							Language: "EN",
 							Password: "password",
 						}
 						return nil
 					})
 
-				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password").Return(errUserExist)
+				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password", "en").Return(errUserExist)
 			},
 		},
 		{
@@ -585,12 +857,13 @@ func TestHandler_HandleUserSignUp(t *testing.T) {
 					func(input []byte, dest interface{}) error {
 						*dest.(*userSignUpParam) = userSignUpParam{
 							Email:    "email",
+							Language: "EN",
 							Password: "password",
 						}
 						return nil
 					})
 
-				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password").Return(nil)
+				mf.accountUC.EXPECT().UserSignUp(context.Background(), "email", "password", "en").Return(nil)
 			},
 		},
 	}
@@ -0,0 +1,167 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+const (
+	limitKey  = "limit"
+	offsetKey = "offset"
+	queryKey  = "query"
+	userIDKey = "user_id"
+)
+
+var (
+	errLimitInvalid  = errors.New("limit not valid")
+	errOffsetInvalid = errors.New("offset not valid")
+	errUserIDInvalid = errors.New("user_id not valid")
+)
+
+// HandleDisableAccount will disable the account of a user, so they can't log in
+// until the account is enabled again. Every session of the user is revoked.
+func (h *Handler) HandleDisableAccount(w http.ResponseWriter, r *http.Request) {
+	h.handleAdminAction(w, r, h.account.DisableAccount)
+}
+
+// HandleEnableAccount will enable the account of a user that had been disabled.
+func (h *Handler) HandleEnableAccount(w http.ResponseWriter, r *http.Request) {
+	h.handleAdminAction(w, r, h.account.EnableAccount)
+}
+
+// HandleForceLogOut will revoke every session of a user.
+func (h *Handler) HandleForceLogOut(w http.ResponseWriter, r *http.Request) {
+	h.handleAdminAction(w, r, h.account.ForceLogOut)
+}
+
+// HandleResetMFA will turn off two-factor authentication of a user
+// who lost access to their authenticator.
+func (h *Handler) HandleResetMFA(w http.ResponseWriter, r *http.Request) {
+	h.handleAdminAction(w, r, h.account.ResetMFA)
+}
+
+// HandleSearchAccounts will search accounts of users by their email, first name or last name.
+// The result is paginated using limit and offset.
+func (h *Handler) HandleSearchAccounts(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response accountsResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	query := r.URL.Query()
+	limit, err := parseOptionalInt(query.Get(limitKey))
+	if err != nil || limit < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errLimitInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOptionalInt(query.Get(offsetKey))
+	if err != nil || offset < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	accounts, err := h.account.SearchAccounts(r.Context(), account.SearchAccountsParam{
+		Limit:  limit,
+		Offset: offset,
+		Query:  query.Get(queryKey),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Accounts = accounts
+	json.NewEncoder(w).Encode(response)
+}
+
+// handleAdminAction will perform an admin action on the user identified by the path of the request.
+func (h *Handler) handleAdminAction(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, userID int64) error) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	userID, err := strconv.ParseInt(mux.Vars(r)[userIDKey], 10, 64)
+	if err != nil || userID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errUserIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = action(r.Context(), userID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrUserNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		if errors.Is(err, account.ErrAdminActionRefused) {
+			response.Code = http.StatusForbidden
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseOptionalInt will parse an optional integer query parameter.
+// An empty value is parsed as zero.
+func parseOptionalInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,356 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleDisableAccount(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Role:   entity.RoleAdmin,
+		UserID: 1,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		userID     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			userID:     "123",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_user_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			userID:     "abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_user_not_found_then_return_not_found",
+			ctx:    ctx,
+			userID: "123",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().DisableAccount(gomock.Any(), int64(123)).Return(account.ErrUserNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "when_action_refused_then_return_forbidden",
+			ctx:    ctx,
+			userID: "123",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().DisableAccount(gomock.Any(), int64(123)).Return(account.ErrAdminActionRefused)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:   "when_DisableAccount_error_then_return_internal_server_error",
+			ctx:    ctx,
+			userID: "123",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().DisableAccount(gomock.Any(), int64(123)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			userID: "123",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().DisableAccount(gomock.Any(), int64(123)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/"+test.userID+"/disable", nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"user_id": test.userID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleDisableAccount(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleEnableAccount(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Role:   entity.RoleAdmin,
+		UserID: 1,
+	})
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_EnableAccount_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().EnableAccount(gomock.Any(), int64(123)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().EnableAccount(gomock.Any(), int64(123)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/123/enable", nil).WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"user_id": "123",
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleEnableAccount(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleForceLogOut(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Role:   entity.RoleSupport,
+		UserID: 2,
+	})
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ForceLogOut_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ForceLogOut(gomock.Any(), int64(123)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ForceLogOut(gomock.Any(), int64(123)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/123/logout", nil).WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"user_id": "123",
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleForceLogOut(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleResetMFA(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Role:   entity.RoleSupport,
+		UserID: 2,
+	})
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ResetMFA_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ResetMFA(gomock.Any(), int64(123)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ResetMFA(gomock.Any(), int64(123)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/123/mfa/reset", nil).WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"user_id": "123",
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleResetMFA(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleSearchAccounts(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Role:   entity.RoleSupport,
+		UserID: 2,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/admin/users",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_limit_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/admin/users?limit=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_offset_negative_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/admin/users?offset=-1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_SearchAccounts_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/admin/users?query=lee",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().SearchAccounts(gomock.Any(), account.SearchAccountsParam{
+					Query: "lee",
+				}).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/admin/users?query=lee&limit=10&offset=20",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().SearchAccounts(gomock.Any(), account.SearchAccountsParam{
+					Limit:  10,
+					Offset: 20,
+					Query:  "lee",
+				}).Return([]account.AccountSummary{{ID: 123}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleSearchAccounts(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
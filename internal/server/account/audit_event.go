@@ -0,0 +1,68 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"net/http"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// HandleGetActivity will fetch security events of the user acting on the request,
+// such as log ins, password changes and revoked tokens.
+// The result is paginated using limit and offset.
+func (h *Handler) HandleGetActivity(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response activityResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	query := r.URL.Query()
+	limit, err := parseOptionalInt(query.Get(limitKey))
+	if err != nil || limit < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errLimitInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOptionalInt(query.Get(offsetKey))
+	if err != nil || offset < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	events, err := h.account.ListActivity(r.Context(), account.ListActivityParam{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Events = events
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,98 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleGetActivity(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/account/activity",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_limit_negative_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/account/activity?limit=-1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/account/activity?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_ListActivity_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/account/activity",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListActivity(gomock.Any(), account.ListActivityParam{}).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/account/activity?limit=10&offset=20",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListActivity(gomock.Any(), account.ListActivityParam{
+					Limit:  10,
+					Offset: 20,
+				}).Return([]account.AuditEvent{{Event: "logout"}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetActivity(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// HandleConfirmEmailChange will change user's email using the token sent to the new email.
+// The link in the email opens a page that posts the token here, so merely opening
+// the link, like a mail scanner prefetching it, doesn't change anything.
+func (h *Handler) HandleConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	token := r.FormValue(tokenKey)
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err := h.account.ConfirmEmailChange(r.Context(), token)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrEmailChangeTokenInvalid) || errors.Is(err, account.ErrEmailAlreadyUsed) {
+			response.Code = http.StatusBadRequest
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleRequestEmailChange will send a confirmation link to the new email of user
+// and a notice to the current email. User's current password is needed to do so.
+// The email is only changed once the page opened by the link confirms it.
+func (h *Handler) HandleRequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request requestEmailChangeParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Email == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errEmailEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errPasswordEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.RequestEmailChange(r.Context(), account.RequestEmailChangeParam{
+		Email:    strings.ToLower(request.Email),
+		Password: request.Password,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		switch {
+		case errors.Is(err, account.ErrIncorrectPassword):
+			response.Code = http.StatusForbidden
+		case errors.Is(err, account.ErrEmailAlreadyUsed), errors.Is(err, account.ErrEmailUnchanged):
+			response.Code = http.StatusBadRequest
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,240 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleConfirmEmailChange(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_token_empty_then_return_bad_request",
+			form:       url.Values{},
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name: "when_token_invalid_then_return_bad_request",
+			form: url.Values{"token": []string{"token"}},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ConfirmEmailChange(context.Background(), "token").Return(account.ErrEmailChangeTokenInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_email_already_used_then_return_bad_request",
+			form: url.Values{"token": []string{"token"}},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ConfirmEmailChange(context.Background(), "token").Return(account.ErrEmailAlreadyUsed)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_ConfirmEmailChange_error_then_return_internal_server_error",
+			form: url.Values{"token": []string{"token"}},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ConfirmEmailChange(context.Background(), "token").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			form: url.Values{"token": []string{"token"}},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ConfirmEmailChange(context.Background(), "token").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/email/confirm", nil)
+			req.Form = test.form
+			w := httptest.NewRecorder()
+
+			h.HandleConfirmEmailChange(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleRequestEmailChange(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "old@mail.com",
+		UserID: 123,
+	})
+	mockRequest := requestEmailChangeParam{
+		Email:    "New@Mail.com",
+		Password: "pass",
+	}
+	mockParam := account.RequestEmailChangeParam{
+		Email:    "new@mail.com",
+		Password: "pass",
+	}
+	mockUnmarshal := func(request requestEmailChangeParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*requestEmailChangeParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_email_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(requestEmailChangeParam{Password: "pass"}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(requestEmailChangeParam{Email: "new@mail.com"}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_incorrect_then_return_forbidden",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().RequestEmailChange(ctx, mockParam).Return(account.ErrIncorrectPassword)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "when_email_already_used_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().RequestEmailChange(ctx, mockParam).Return(account.ErrEmailAlreadyUsed)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_RequestEmailChange_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().RequestEmailChange(ctx, mockParam).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest requestEmailChangeParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().RequestEmailChange(ctx, mockParam).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/email", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleRequestEmailChange(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
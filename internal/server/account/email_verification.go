@@ -0,0 +1,105 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+const (
+	tokenKey = "token"
+)
+
+// HandleResendEmailVerification will send a new verification link to user's email.
+// It always succeeds for a valid email, whether the account exists or not.
+func (h *Handler) HandleResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request resendEmailVerificationParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Email == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errEmailEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.ResendEmailVerification(r.Context(), strings.ToLower(request.Email))
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrEmailVerificationThrottled) {
+			response.Code = http.StatusTooManyRequests
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleVerifyEmail will mark user's email as verified using the token sent to that email.
+func (h *Handler) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	token := r.URL.Query().Get(tokenKey)
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err := h.account.VerifyEmail(r.Context(), token)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrEmailVerificationTokenInvalid) {
+			response.Code = http.StatusBadRequest
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
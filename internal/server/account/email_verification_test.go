@@ -0,0 +1,181 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleResendEmailVerification(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockUnmarshal := func(request resendEmailVerificationParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*resendEmailVerificationParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resendEmailVerificationParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_email_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resendEmailVerificationParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_throttled_then_return_too_many_requests",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resendEmailVerificationParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resendEmailVerificationParam{Email: "Email"}))
+				mf.accountUC.EXPECT().ResendEmailVerification(context.Background(), "email").Return(account.ErrEmailVerificationThrottled)
+			},
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name: "when_ResendEmailVerification_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resendEmailVerificationParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resendEmailVerificationParam{Email: "Email"}))
+				mf.accountUC.EXPECT().ResendEmailVerification(context.Background(), "email").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resendEmailVerificationParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resendEmailVerificationParam{Email: "Email"}))
+				mf.accountUC.EXPECT().ResendEmailVerification(context.Background(), "email").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/verify/resend", nil)
+			w := httptest.NewRecorder()
+
+			h.HandleResendEmailVerification(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleVerifyEmail(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	tests := []struct {
+		name       string
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_token_empty_then_return_bad_request",
+			target:     "/account/verify",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_token_invalid_then_return_bad_request",
+			target: "/account/verify?token=token",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().VerifyEmail(context.Background(), "token").Return(account.ErrEmailVerificationTokenInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "when_VerifyEmail_error_then_return_internal_server_error",
+			target: "/account/verify?token=token",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().VerifyEmail(context.Background(), "token").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			target: "/account/verify?token=token",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().VerifyEmail(context.Background(), "token").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			w := httptest.NewRecorder()
+
+			h.HandleVerifyEmail(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
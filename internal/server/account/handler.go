@@ -4,6 +4,7 @@ import (
 	// golang package
 	"context"
 	"io"
+	"time"
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/usecase/account"
@@ -13,27 +14,143 @@ import (
 
 // accountUCManager holds all methods served by usecase account that will be needed by account handler.
 type accountUCManager interface {
+	// ConfirmEmailChange will replace email of the owner of an email change confirmation token
+	// with the email the token was issued for. Every session of the user will be revoked afterward.
+	ConfirmEmailChange(ctx context.Context, token string) error
+
+	// ConfirmTOTP will enable TOTP of the user acting on ctx
+	// once the first code generated by user's authenticator is verified.
+	ConfirmTOTP(ctx context.Context, code string) error
+
+	// CreatePersonalAccessToken will create a new personal access token for the user acting on ctx.
+	// The token is only returned this once.
+	CreatePersonalAccessToken(ctx context.Context, param account.CreatePersonalAccessTokenParam) (account.PersonalAccessTokenCreated, error)
+
+	// DeleteAccount will schedule the account of the user acting on ctx to be deleted
+	// once the grace period is over. User's current password is needed to do so.
+	// It returns the time the account will be permanently deleted.
+	DeleteAccount(ctx context.Context, password string) (time.Time, error)
+
+	// DisableAccount will disable the account of a user on behalf of the admin acting on ctx.
+	// Every session of the user is revoked and they can't log in until the account is enabled again.
+	// An account whose role isn't lower than the role of the admin is refused with ErrAdminActionRefused.
+	DisableAccount(ctx context.Context, userID int64) error
+
+	// DisableTOTP will turn off TOTP of the user acting on ctx.
+	// User's current password is needed to do so.
+	DisableTOTP(ctx context.Context, password string) error
+
+	// EnableAccount will enable the account of a user on behalf of the admin acting on ctx.
+	// An account whose role isn't lower than the role of the admin is refused with ErrAdminActionRefused.
+	EnableAccount(ctx context.Context, userID int64) error
+
+	// EnrollTOTP will start TOTP enrollment of the user acting on ctx.
+	// TOTP is enabled once the enrollment is confirmed by ConfirmTOTP.
+	EnrollTOTP(ctx context.Context) (account.TOTPEnrollment, error)
+
+	// ForceLogOut will revoke every session of a user on behalf of the admin acting on ctx.
+	// An account whose role isn't lower than the role of the admin is refused with ErrAdminActionRefused.
+	ForceLogOut(ctx context.Context, userID int64) error
+
+	// ForgotPassword will send a password reset link to the email of an account.
+	// To avoid disclosing which emails are registered,
+	// it won't return an error when the account doesn't exist.
+	ForgotPassword(ctx context.Context, email string) error
+
+	// GetProfile will fetch account information of the user acting on ctx.
+	// Secrets of the account, such as its password hash, are never returned.
+	GetProfile(ctx context.Context) (account.Profile, error)
+
+	// ListPersonalAccessTokens will fetch every personal access token of the user acting on ctx.
+	ListPersonalAccessTokens(ctx context.Context) ([]account.PersonalAccessToken, error)
+
+	// ListSessions will fetch every active session of the user acting on ctx.
+	ListSessions(ctx context.Context) ([]account.Session, error)
+
+	// ListActivity will fetch security events of the user acting on ctx, ordered from the most recent event.
+	ListActivity(ctx context.Context, param account.ListActivityParam) ([]account.AuditEvent, error)
+
 	// LogIn handles the log in process for a user.
 	// It will check the existence of a user first.
-	// If it exist, then it will continue the log in process.
-	LogIn(ctx context.Context, email, password string) (string, error)
+	// If it exist, then it will continue the log in process
+	// by starting a new session for the device and issuing
+	// a short-lived JWT and a refresh token for that session.
+	// If the account has TOTP enabled, it only returns an MFA challenge token.
+	LogIn(ctx context.Context, param account.LogInParam) (account.JWT, error)
 
-	// LogOut handles the log out process for a user.
-	LogOut(ctx context.Context, userID int64) error
+	// LogInMFA will finish a log in that is waiting for a TOTP code.
+	// It exchanges the MFA challenge token returned by LogIn and a TOTP or recovery code
+	// with a new session, a short-lived JWT and a refresh token.
+	LogInMFA(ctx context.Context, param account.LogInMFAParam) (account.JWT, error)
 
-	// UpdateUserAccount will update information of a user account.
+	// LogInMagicLink will log in the owner of a magic link login token.
+	// If the account has TOTP enabled, it only returns an MFA challenge token.
+	LogInMagicLink(ctx context.Context, param account.LogInMagicLinkParam) (account.JWT, error)
+
+	// LogOut handles the log out process for the user acting on ctx.
+	// Only the session used by the request is revoked,
+	// other devices of the user stay logged in.
+	LogOut(ctx context.Context) error
+
+	// RefreshToken will exchange a refresh token with a new JWT and refresh token.
+	// The given refresh token can't be used again afterward.
+	RefreshToken(ctx context.Context, refreshToken string) (account.JWT, error)
+
+	// RequestEmailChange will send a confirmation link to the new email of the user acting on ctx
+	// and a notice to the current email. User's current password is needed to do so.
+	RequestEmailChange(ctx context.Context, param account.RequestEmailChangeParam) error
+
+	// RequestMagicLink will send a magic link to the email of an account.
+	// To avoid disclosing which emails are registered,
+	// it won't return an error when the account doesn't exist.
+	RequestMagicLink(ctx context.Context, email string) error
+
+	// ResetMFA will turn off TOTP of a user on behalf of the admin acting on ctx.
+	// An account whose role isn't lower than the role of the admin is refused with ErrAdminActionRefused.
+	ResetMFA(ctx context.Context, userID int64) error
+
+	// ResetPassword will set a new password for the owner of a password reset token.
+	// Every session of the user will be revoked afterward.
+	ResetPassword(ctx context.Context, token, password string) error
+
+	// ResendEmailVerification will send a new verification link to the email of an account.
+	// To avoid disclosing which emails are registered,
+	// it won't return an error when the account doesn't exist or is already verified.
+	ResendEmailVerification(ctx context.Context, email string) error
+
+	// RevokeOtherSessions will revoke every session of the user acting on ctx,
+	// except the session used by the request.
+	RevokeOtherSessions(ctx context.Context) error
+
+	// RevokePersonalAccessToken will revoke a personal access token of the user acting on ctx.
+	// A user can only revoke their own token.
+	RevokePersonalAccessToken(ctx context.Context, tokenID int64) error
+
+	// RevokeSession will revoke a session of the user acting on ctx.
+	// A user can only revoke their own session.
+	RevokeSession(ctx context.Context, sessionID string) error
+
+	// SearchAccounts will fetch accounts of users whose email, first name or last name contains param.Query.
+	SearchAccounts(ctx context.Context, param account.SearchAccountsParam) ([]account.AccountSummary, error)
+
+	// UpdateUserAccount will update information of the user acting on ctx.
 	// Field that will be updated are: first_name, last_name, and record_period.
 	UpdateUserAccount(ctx context.Context, param account.UpdateUserAccountParam) error
 
-	// UpdatePassword will update user's password.
+	// UpdatePassword will update password of the user acting on ctx.
 	// It will check whether the old password correct or not.
-	// If it correct, then it will continue the update password process.
+	// If it correct, then it will continue the update password process
+	// and revoke every session of the user.
 	UpdatePassword(ctx context.Context, param account.UpdatePasswordParam) error
 
 	// UserSignUp will process the creation of user account.
 	// Before creating a new account, it'll check whether that account exist or not.
-	// If it's a new account, then it'll create a new user account.
-	UserSignUp(ctx context.Context, email, password string) error
+	// If it's a new account, then it'll create a new user account with the default
+	// categories named in language, and send a verification link to its email.
+	UserSignUp(ctx context.Context, email, password, language string) error
+
+	// VerifyEmail will mark email of the owner of an email verification token as verified.
+	VerifyEmail(ctx context.Context, token string) error
 }
 
 // infraProvider holds all methods served by infra that will be needed by account handler.
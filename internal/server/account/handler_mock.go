@@ -8,6 +8,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	account "github.com/arifinhermawan/bubi/internal/usecase/account"
 	gomock "github.com/golang/mock/gomock"
@@ -36,33 +37,408 @@ func (m *MockaccountUCManager) EXPECT() *MockaccountUCManagerMockRecorder {
 	return m.recorder
 }
 
+// ConfirmEmailChange mocks base method.
+func (m *MockaccountUCManager) ConfirmEmailChange(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockaccountUCManagerMockRecorder) ConfirmEmailChange(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockaccountUCManager)(nil).ConfirmEmailChange), ctx, token)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockaccountUCManager) ConfirmTOTP(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockaccountUCManagerMockRecorder) ConfirmTOTP(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockaccountUCManager)(nil).ConfirmTOTP), ctx, code)
+}
+
+// CreatePersonalAccessToken mocks base method.
+func (m *MockaccountUCManager) CreatePersonalAccessToken(ctx context.Context, param account.CreatePersonalAccessTokenParam) (account.PersonalAccessTokenCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", ctx, param)
+	ret0, _ := ret[0].(account.PersonalAccessTokenCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockaccountUCManagerMockRecorder) CreatePersonalAccessToken(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockaccountUCManager)(nil).CreatePersonalAccessToken), ctx, param)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockaccountUCManager) DeleteAccount(ctx context.Context, password string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, password)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockaccountUCManagerMockRecorder) DeleteAccount(ctx, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockaccountUCManager)(nil).DeleteAccount), ctx, password)
+}
+
+// DisableAccount mocks base method.
+func (m *MockaccountUCManager) DisableAccount(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableAccount indicates an expected call of DisableAccount.
+func (mr *MockaccountUCManagerMockRecorder) DisableAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockaccountUCManager)(nil).DisableAccount), ctx, userID)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockaccountUCManager) DisableTOTP(ctx context.Context, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockaccountUCManagerMockRecorder) DisableTOTP(ctx, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockaccountUCManager)(nil).DisableTOTP), ctx, password)
+}
+
+// EnableAccount mocks base method.
+func (m *MockaccountUCManager) EnableAccount(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableAccount indicates an expected call of EnableAccount.
+func (mr *MockaccountUCManagerMockRecorder) EnableAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccount", reflect.TypeOf((*MockaccountUCManager)(nil).EnableAccount), ctx, userID)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockaccountUCManager) EnrollTOTP(ctx context.Context) (account.TOTPEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx)
+	ret0, _ := ret[0].(account.TOTPEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockaccountUCManagerMockRecorder) EnrollTOTP(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockaccountUCManager)(nil).EnrollTOTP), ctx)
+}
+
+// ForceLogOut mocks base method.
+func (m *MockaccountUCManager) ForceLogOut(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceLogOut", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceLogOut indicates an expected call of ForceLogOut.
+func (mr *MockaccountUCManagerMockRecorder) ForceLogOut(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceLogOut", reflect.TypeOf((*MockaccountUCManager)(nil).ForceLogOut), ctx, userID)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockaccountUCManager) ForgotPassword(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockaccountUCManagerMockRecorder) ForgotPassword(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockaccountUCManager)(nil).ForgotPassword), ctx, email)
+}
+
+// GetProfile mocks base method.
+func (m *MockaccountUCManager) GetProfile(ctx context.Context) (account.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx)
+	ret0, _ := ret[0].(account.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockaccountUCManagerMockRecorder) GetProfile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockaccountUCManager)(nil).GetProfile), ctx)
+}
+
+// ListActivity mocks base method.
+func (m *MockaccountUCManager) ListActivity(ctx context.Context, param account.ListActivityParam) ([]account.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, param)
+	ret0, _ := ret[0].([]account.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockaccountUCManagerMockRecorder) ListActivity(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockaccountUCManager)(nil).ListActivity), ctx, param)
+}
+
+// ListPersonalAccessTokens mocks base method.
+func (m *MockaccountUCManager) ListPersonalAccessTokens(ctx context.Context) ([]account.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonalAccessTokens", ctx)
+	ret0, _ := ret[0].([]account.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalAccessTokens indicates an expected call of ListPersonalAccessTokens.
+func (mr *MockaccountUCManagerMockRecorder) ListPersonalAccessTokens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalAccessTokens", reflect.TypeOf((*MockaccountUCManager)(nil).ListPersonalAccessTokens), ctx)
+}
+
+// ListSessions mocks base method.
+func (m *MockaccountUCManager) ListSessions(ctx context.Context) ([]account.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx)
+	ret0, _ := ret[0].([]account.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockaccountUCManagerMockRecorder) ListSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockaccountUCManager)(nil).ListSessions), ctx)
+}
+
 // LogIn mocks base method.
-func (m *MockaccountUCManager) LogIn(ctx context.Context, email, password string) (string, error) {
+func (m *MockaccountUCManager) LogIn(ctx context.Context, param account.LogInParam) (account.JWT, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogIn", ctx, email, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "LogIn", ctx, param)
+	ret0, _ := ret[0].(account.JWT)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LogIn indicates an expected call of LogIn.
-func (mr *MockaccountUCManagerMockRecorder) LogIn(ctx, email, password interface{}) *gomock.Call {
+func (mr *MockaccountUCManagerMockRecorder) LogIn(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogIn", reflect.TypeOf((*MockaccountUCManager)(nil).LogIn), ctx, param)
+}
+
+// LogInMFA mocks base method.
+func (m *MockaccountUCManager) LogInMFA(ctx context.Context, param account.LogInMFAParam) (account.JWT, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogInMFA", ctx, param)
+	ret0, _ := ret[0].(account.JWT)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogInMFA indicates an expected call of LogInMFA.
+func (mr *MockaccountUCManagerMockRecorder) LogInMFA(ctx, param interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogIn", reflect.TypeOf((*MockaccountUCManager)(nil).LogIn), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogInMFA", reflect.TypeOf((*MockaccountUCManager)(nil).LogInMFA), ctx, param)
+}
+
+// LogInMagicLink mocks base method.
+func (m *MockaccountUCManager) LogInMagicLink(ctx context.Context, param account.LogInMagicLinkParam) (account.JWT, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogInMagicLink", ctx, param)
+	ret0, _ := ret[0].(account.JWT)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogInMagicLink indicates an expected call of LogInMagicLink.
+func (mr *MockaccountUCManagerMockRecorder) LogInMagicLink(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogInMagicLink", reflect.TypeOf((*MockaccountUCManager)(nil).LogInMagicLink), ctx, param)
 }
 
 // LogOut mocks base method.
-func (m *MockaccountUCManager) LogOut(ctx context.Context, userID int64) error {
+func (m *MockaccountUCManager) LogOut(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogOut", ctx, userID)
+	ret := m.ctrl.Call(m, "LogOut", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogOut indicates an expected call of LogOut.
-func (mr *MockaccountUCManagerMockRecorder) LogOut(ctx, userID interface{}) *gomock.Call {
+func (mr *MockaccountUCManagerMockRecorder) LogOut(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogOut", reflect.TypeOf((*MockaccountUCManager)(nil).LogOut), ctx)
+}
+
+// RefreshToken mocks base method.
+func (m *MockaccountUCManager) RefreshToken(ctx context.Context, refreshToken string) (account.JWT, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(account.JWT)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockaccountUCManagerMockRecorder) RefreshToken(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockaccountUCManager)(nil).RefreshToken), ctx, refreshToken)
+}
+
+// RequestEmailChange mocks base method.
+func (m *MockaccountUCManager) RequestEmailChange(ctx context.Context, param account.RequestEmailChangeParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailChange", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestEmailChange indicates an expected call of RequestEmailChange.
+func (mr *MockaccountUCManagerMockRecorder) RequestEmailChange(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailChange", reflect.TypeOf((*MockaccountUCManager)(nil).RequestEmailChange), ctx, param)
+}
+
+// RequestMagicLink mocks base method.
+func (m *MockaccountUCManager) RequestMagicLink(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestMagicLink", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestMagicLink indicates an expected call of RequestMagicLink.
+func (mr *MockaccountUCManagerMockRecorder) RequestMagicLink(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestMagicLink", reflect.TypeOf((*MockaccountUCManager)(nil).RequestMagicLink), ctx, email)
+}
+
+// ResendEmailVerification mocks base method.
+func (m *MockaccountUCManager) ResendEmailVerification(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendEmailVerification", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendEmailVerification indicates an expected call of ResendEmailVerification.
+func (mr *MockaccountUCManagerMockRecorder) ResendEmailVerification(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendEmailVerification", reflect.TypeOf((*MockaccountUCManager)(nil).ResendEmailVerification), ctx, email)
+}
+
+// ResetMFA mocks base method.
+func (m *MockaccountUCManager) ResetMFA(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetMFA", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetMFA indicates an expected call of ResetMFA.
+func (mr *MockaccountUCManagerMockRecorder) ResetMFA(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetMFA", reflect.TypeOf((*MockaccountUCManager)(nil).ResetMFA), ctx, userID)
+}
+
+// ResetPassword mocks base method.
+func (m *MockaccountUCManager) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockaccountUCManagerMockRecorder) ResetPassword(ctx, token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockaccountUCManager)(nil).ResetPassword), ctx, token, password)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockaccountUCManager) RevokeOtherSessions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockaccountUCManagerMockRecorder) RevokeOtherSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockaccountUCManager)(nil).RevokeOtherSessions), ctx)
+}
+
+// RevokePersonalAccessToken mocks base method.
+func (m *MockaccountUCManager) RevokePersonalAccessToken(ctx context.Context, tokenID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", ctx, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockaccountUCManagerMockRecorder) RevokePersonalAccessToken(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockaccountUCManager)(nil).RevokePersonalAccessToken), ctx, tokenID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockaccountUCManager) RevokeSession(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockaccountUCManagerMockRecorder) RevokeSession(ctx, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogOut", reflect.TypeOf((*MockaccountUCManager)(nil).LogOut), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockaccountUCManager)(nil).RevokeSession), ctx, sessionID)
+}
+
+// SearchAccounts mocks base method.
+func (m *MockaccountUCManager) SearchAccounts(ctx context.Context, param account.SearchAccountsParam) ([]account.AccountSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccounts", ctx, param)
+	ret0, _ := ret[0].([]account.AccountSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccounts indicates an expected call of SearchAccounts.
+func (mr *MockaccountUCManagerMockRecorder) SearchAccounts(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockaccountUCManager)(nil).SearchAccounts), ctx, param)
 }
 
 // UpdatePassword mocks base method.
@@ -94,17 +470,31 @@ func (mr *MockaccountUCManagerMockRecorder) UpdateUserAccount(ctx, param interfa
 }
 
 // UserSignUp mocks base method.
-func (m *MockaccountUCManager) UserSignUp(ctx context.Context, email, password string) error {
+func (m *MockaccountUCManager) UserSignUp(ctx context.Context, email, password, language string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserSignUp", ctx, email, password)
+	ret := m.ctrl.Call(m, "UserSignUp", ctx, email, password, language)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UserSignUp indicates an expected call of UserSignUp.
-func (mr *MockaccountUCManagerMockRecorder) UserSignUp(ctx, email, password interface{}) *gomock.Call {
+func (mr *MockaccountUCManagerMockRecorder) UserSignUp(ctx, email, password, language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignUp", reflect.TypeOf((*MockaccountUCManager)(nil).UserSignUp), ctx, email, password, language)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockaccountUCManager) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockaccountUCManagerMockRecorder) VerifyEmail(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignUp", reflect.TypeOf((*MockaccountUCManager)(nil).UserSignUp), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockaccountUCManager)(nil).VerifyEmail), ctx, token)
 }
 
 // MockinfraProvider is a mock of infraProvider interface.
@@ -0,0 +1,118 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// HandleConsumeMagicLink will log in user using a magic link login token.
+// The token is exchanged with a JWT and a refresh token,
+// or with an MFA challenge token when user has TOTP enabled.
+func (h *Handler) HandleConsumeMagicLink(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var result userLogInResponse
+	token := r.FormValue(tokenKey)
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = errTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	jwt, err := h.account.LogInMagicLink(r.Context(), account.LogInMagicLinkParam{
+		DeviceName: r.FormValue(deviceNameKey),
+		IPAddress:  entity.GetRequestMetadataFromContext(r.Context()).IPAddress,
+		Token:      token,
+		UserAgent:  r.UserAgent(),
+	})
+	if err != nil {
+		result.Code = http.StatusInternalServerError
+		switch {
+		case errors.Is(err, account.ErrMagicLinkTokenInvalid):
+			result.Code = http.StatusUnauthorized
+		case errors.Is(err, account.ErrEmailNotVerified), errors.Is(err, account.ErrAccountDisabled):
+			result.Code = http.StatusForbidden
+		}
+
+		w.WriteHeader(result.Code)
+		result.Error = err.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	result.Code = http.StatusOK
+	if jwt.MFAChallengeToken != "" {
+		result.MFAChallengeToken = jwt.MFAChallengeToken
+		result.MFARequired = true
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	result.RefreshToken = jwt.RefreshToken
+	result.Token = jwt.Token
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleRequestMagicLink will send a magic link to log in to user's email.
+// It always succeeds for a valid email, whether the account exists or not.
+func (h *Handler) HandleRequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request magicLinkParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Email == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errEmailEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.RequestMagicLink(r.Context(), strings.ToLower(request.Email))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,259 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleConsumeMagicLink(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	mockCtx := entity.NewContextWithRequestMetadata(context.Background(), entity.RequestMetadata{
+		IPAddress: "192.0.2.1",
+	})
+	mockParam := account.LogInMagicLinkParam{
+		DeviceName: "phone",
+		IPAddress:  "192.0.2.1",
+		Token:      "magic",
+		UserAgent:  "agent",
+	}
+	mockForm := url.Values{
+		"device_name": []string{"phone"},
+		"token":       []string{"magic"},
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		mockFields func(mockFields)
+		want       userLogInResponse
+	}{
+		{
+			name:       "when_token_empty_then_return_bad_request",
+			form:       url.Values{"device_name": []string{"phone"}},
+			mockFields: func(mf mockFields) {},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusBadRequest,
+					Error: errTokenEmpty.Error(),
+				},
+			},
+		},
+		{
+			name: "when_token_invalid_then_return_unauthorized",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{}, account.ErrMagicLinkTokenInvalid)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusUnauthorized,
+					Error: account.ErrMagicLinkTokenInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "when_email_not_verified_then_return_forbidden",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{}, account.ErrEmailNotVerified)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusForbidden,
+					Error: account.ErrEmailNotVerified.Error(),
+				},
+			},
+		},
+		{
+			name: "when_account_disabled_then_return_forbidden",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{}, account.ErrAccountDisabled)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusForbidden,
+					Error: account.ErrAccountDisabled.Error(),
+				},
+			},
+		},
+		{
+			name: "when_LogInMagicLink_error_then_return_internal_server_error",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{}, assert.AnError)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusInternalServerError,
+					Error: assert.AnError.Error(),
+				},
+			},
+		},
+		{
+			name: "when_totp_enabled_then_return_mfa_challenge_token",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{
+					MFAChallengeToken: "challenge",
+				}, nil)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code: http.StatusOK,
+				},
+				MFAChallengeToken: "challenge",
+				MFARequired:       true,
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_token",
+			form: mockForm,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMagicLink(mockCtx, mockParam).Return(account.JWT{
+					RefreshToken: "refresh",
+					Token:        "token",
+				}, nil)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code: http.StatusOK,
+				},
+				RefreshToken: "refresh",
+				Token:        "token",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/login/magic/consume", nil).WithContext(mockCtx)
+			req.Header.Set("User-Agent", "agent")
+			req.Form = test.form
+			w := httptest.NewRecorder()
+
+			h.HandleConsumeMagicLink(w, req)
+
+			var got userLogInResponse
+			json.NewDecoder(w.Body).Decode(&got)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want.Code, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleRequestMagicLink(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockUnmarshal := func(request magicLinkParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*magicLinkParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest magicLinkParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_email_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest magicLinkParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_RequestMagicLink_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest magicLinkParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(magicLinkParam{Email: "Email"}))
+				mf.accountUC.EXPECT().RequestMagicLink(context.Background(), "email").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest magicLinkParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(magicLinkParam{Email: "Email"}))
+				mf.accountUC.EXPECT().RequestMagicLink(context.Background(), "email").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/login/magic", nil)
+			w := httptest.NewRecorder()
+
+			h.HandleRequestMagicLink(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
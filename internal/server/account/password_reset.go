@@ -0,0 +1,132 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+// HandleForgotPassword will send a password reset link to user's email.
+// It always succeeds for a valid email, whether the account exists or not.
+func (h *Handler) HandleForgotPassword(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request forgotPasswordParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Email == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errEmailEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.ForgotPassword(r.Context(), strings.ToLower(request.Email))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleResetPassword will set a new password for user using a password reset token.
+func (h *Handler) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response passwordResponse
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request resetPasswordParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errPasswordEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.ResetPassword(r.Context(), request.Token, request.Password)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrPasswordResetTokenInvalid) {
+			response.Code = http.StatusBadRequest
+		}
+
+		var validationErr *account.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,232 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleForgotPassword(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockUnmarshal := func(request forgotPasswordParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*forgotPasswordParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest forgotPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_email_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest forgotPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_ForgotPassword_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest forgotPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(forgotPasswordParam{Email: "Email"}))
+				mf.accountUC.EXPECT().ForgotPassword(context.Background(), "email").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest forgotPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(forgotPasswordParam{Email: "Email"}))
+				mf.accountUC.EXPECT().ForgotPassword(context.Background(), "email").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/password/forgot", nil)
+			w := httptest.NewRecorder()
+
+			h.HandleForgotPassword(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleResetPassword(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockUnmarshal := func(request resetPasswordParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*resetPasswordParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_token_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Password: "pass"}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_empty_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Token: "token"}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_token_invalid_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Password: "pass", Token: "token"}))
+				mf.accountUC.EXPECT().ResetPassword(context.Background(), "token", "pass").Return(account.ErrPasswordResetTokenInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_violates_policy_then_return_bad_request",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Password: "pass", Token: "token"}))
+				mf.accountUC.EXPECT().ResetPassword(context.Background(), "token", "pass").Return(&account.ValidationError{
+					Fields: []account.FieldError{{Code: "too_short", Field: "password", Message: "too short"}},
+				})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_ResetPassword_error_then_return_internal_server_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Password: "pass", Token: "token"}))
+				mf.accountUC.EXPECT().ResetPassword(context.Background(), "token", "pass").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest resetPasswordParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(resetPasswordParam{Password: "pass", Token: "token"}))
+				mf.accountUC.EXPECT().ResetPassword(context.Background(), "token", "pass").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/password/reset", nil)
+			w := httptest.NewRecorder()
+
+			h.HandleResetPassword(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
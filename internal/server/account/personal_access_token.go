@@ -0,0 +1,187 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+const (
+	tokenIDKey = "token_id"
+)
+
+var (
+	errScopesEmpty    = errors.New("scopes is empty")
+	errTokenIDInvalid = errors.New("token_id not valid")
+)
+
+// HandleCreatePersonalAccessToken will create a named personal access token for user,
+// so scripts can call the API without logging in.
+// The token is only shown in this response.
+func (h *Handler) HandleCreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response personalAccessTokenResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request createPersonalAccessTokenParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if request.Name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errNameEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if len(request.Scopes) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errScopesEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	param := account.CreatePersonalAccessTokenParam{
+		Name:   request.Name,
+		Scopes: request.Scopes,
+	}
+	if request.ExpiresAt != nil {
+		param.ExpiresAt = *request.ExpiresAt
+	}
+
+	token, err := h.account.CreatePersonalAccessToken(r.Context(), param)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrPersonalAccessTokenExpiryInvalid) || errors.Is(err, account.ErrPersonalAccessTokenScopeInvalid) {
+			response.Code = http.StatusBadRequest
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	response.Code = http.StatusCreated
+	response.PersonalAccessTokenCreated = token
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetPersonalAccessTokens will list every personal access token of user.
+// The tokens themselves are never shown again.
+func (h *Handler) HandleGetPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response personalAccessTokensResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	tokens, err := h.account.ListPersonalAccessTokens(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Tokens = tokens
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleRevokePersonalAccessToken will revoke a personal access token of user.
+func (h *Handler) HandleRevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	tokenID, err := strconv.ParseInt(mux.Vars(r)[tokenIDKey], 10, 64)
+	if err != nil || tokenID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTokenIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.RevokePersonalAccessToken(r.Context(), tokenID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrPersonalAccessTokenNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,337 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleCreatePersonalAccessToken(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	mockExpiresAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockUnmarshal := func(request createPersonalAccessTokenParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*createPersonalAccessTokenParam) = request
+			return nil
+		}
+	}
+	mockRequest := createPersonalAccessTokenParam{
+		ExpiresAt: &mockExpiresAt,
+		Name:      " ci ",
+		Scopes:    []string{"read"},
+	}
+	mockParam := account.CreatePersonalAccessTokenParam{
+		ExpiresAt: mockExpiresAt,
+		Name:      "ci",
+		Scopes:    []string{"read"},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_name_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(createPersonalAccessTokenParam{
+					Name:   " ",
+					Scopes: []string{"read"},
+				}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_scopes_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(createPersonalAccessTokenParam{
+					Name: "ci",
+				}))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_scope_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return(account.PersonalAccessTokenCreated{}, account.ErrPersonalAccessTokenScopeInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_expiry_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return(account.PersonalAccessTokenCreated{}, account.ErrPersonalAccessTokenExpiryInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_CreatePersonalAccessToken_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.accountUC.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return(account.PersonalAccessTokenCreated{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_created",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createPersonalAccessTokenParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(createPersonalAccessTokenParam{
+					Name:   "ci",
+					Scopes: []string{"read"},
+				}))
+				mf.accountUC.EXPECT().CreatePersonalAccessToken(ctx, account.CreatePersonalAccessTokenParam{
+					Name:   "ci",
+					Scopes: []string{"read"},
+				}).Return(account.PersonalAccessTokenCreated{
+					PersonalAccessToken: account.PersonalAccessToken{
+						ID:     1,
+						Name:   "ci",
+						Scopes: []string{"read"},
+					},
+					Token: "bubi_pat_token",
+				}, nil)
+			},
+			wantCode: http.StatusCreated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/tokens", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleCreatePersonalAccessToken(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetPersonalAccessTokens(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ListPersonalAccessTokens_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListPersonalAccessTokens(ctx).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListPersonalAccessTokens(ctx).Return([]account.PersonalAccessToken{
+					{
+						ID:     1,
+						Name:   "ci",
+						Scopes: []string{"read"},
+					},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/account/tokens", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetPersonalAccessTokens(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleRevokePersonalAccessToken(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		tokenID    string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			tokenID:    "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_token_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			tokenID:    "abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:    "when_token_not_found_then_return_not_found",
+			ctx:     ctx,
+			tokenID: "1",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokePersonalAccessToken(gomock.Any(), int64(1)).Return(account.ErrPersonalAccessTokenNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:    "when_RevokePersonalAccessToken_error_then_return_internal_server_error",
+			ctx:     ctx,
+			tokenID: "1",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokePersonalAccessToken(gomock.Any(), int64(1)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:    "when_no_error_occured_then_return_ok",
+			ctx:     ctx,
+			tokenID: "1",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokePersonalAccessToken(gomock.Any(), int64(1)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/account/tokens/"+test.tokenID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"token_id": test.tokenID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleRevokePersonalAccessToken(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
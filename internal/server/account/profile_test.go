@@ -0,0 +1,89 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleGetProfile(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_account_not_found_then_return_not_found",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().GetProfile(ctx).Return(account.Profile{}, account.ErrAccountNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "when_GetProfile_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().GetProfile(ctx).Return(account.Profile{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().GetProfile(ctx).Return(account.Profile{
+					Email: "email",
+					ID:    1234,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/account/me", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetProfile(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+			assert.False(t, strings.Contains(w.Body.String(), "password"))
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleGetSessions(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "session",
+		UserID:    1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ListSessions_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListSessions(ctx).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().ListSessions(ctx).Return([]account.Session{
+					{
+						Current:   true,
+						SessionID: "session",
+					},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/account/sessions", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetSessions(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleRevokeOtherSessions(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "session",
+		UserID:    1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_RevokeOtherSessions_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokeOtherSessions(ctx).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokeOtherSessions(ctx).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/sessions/revoke_others", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleRevokeOtherSessions(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleRevokeSession(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "session",
+		UserID:    1234,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		sessionID  string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			sessionID:  "other",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_session_id_empty_then_return_bad_request",
+			ctx:        ctx,
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:      "when_session_not_found_then_return_not_found",
+			ctx:       ctx,
+			sessionID: "other",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokeSession(gomock.Any(), "other").Return(account.ErrSessionNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "when_RevokeSession_error_then_return_internal_server_error",
+			ctx:       ctx,
+			sessionID: "other",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokeSession(gomock.Any(), "other").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:      "when_no_error_occured_then_return_ok",
+			ctx:       ctx,
+			sessionID: "other",
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().RevokeSession(gomock.Any(), "other").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/account/sessions/"+test.sessionID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				"session_id": test.sessionID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleRevokeSession(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package account
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+const (
+	challengeTokenKey = "challenge_token"
+	codeKey           = "code"
+)
+
+var (
+	errChallengeTokenEmpty = errors.New("challenge_token is empty")
+	errCodeEmpty           = errors.New("code is empty")
+)
+
+// HandleConfirmTOTP will enable two-factor authentication of user
+// using the first code generated by user's authenticator.
+func (h *Handler) HandleConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request confirmTOTPParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errCodeEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.ConfirmTOTP(r.Context(), request.Code)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		switch {
+		case errors.Is(err, account.ErrTOTPAlreadyEnabled):
+			response.Code = http.StatusConflict
+		case errors.Is(err, account.ErrTOTPNotEnrolled), errors.Is(err, account.ErrTOTPCodeInvalid):
+			response.Code = http.StatusBadRequest
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleDisableTOTP will turn off two-factor authentication of user.
+// User's current password is needed to do so.
+func (h *Handler) HandleDisableTOTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request disableTOTPParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if request.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errPasswordEmpty.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.account.DisableTOTP(r.Context(), request.Password)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			response.Code = http.StatusForbidden
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleEnrollTOTP will start two-factor authentication enrollment of user.
+// It returns the provisioning URI for user's authenticator alongside recovery codes,
+// which are only shown this once.
+func (h *Handler) HandleEnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response totpEnrollmentResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	enrollment, err := h.account.EnrollTOTP(r.Context())
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, account.ErrTOTPAlreadyEnabled) {
+			response.Code = http.StatusConflict
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.ProvisioningURI = enrollment.ProvisioningURI
+	response.RecoveryCodes = enrollment.RecoveryCodes
+	response.Secret = enrollment.Secret
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleUserLogInMFA will finish user login process that is waiting for a TOTP code.
+// The challenge token returned by login is exchanged with a JWT and a refresh token.
+func (h *Handler) HandleUserLogInMFA(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var result userLogInResponse
+	challengeToken := r.FormValue(challengeTokenKey)
+	code := r.FormValue(codeKey)
+
+	if challengeToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = errChallengeTokenEmpty.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	if code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		result.Code = http.StatusBadRequest
+		result.Error = errCodeEmpty.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	token, err := h.account.LogInMFA(r.Context(), account.LogInMFAParam{
+		ChallengeToken: challengeToken,
+		Code:           code,
+		DeviceName:     r.FormValue(deviceNameKey),
+		IPAddress:      entity.GetRequestMetadataFromContext(r.Context()).IPAddress,
+		UserAgent:      r.UserAgent(),
+	})
+	if err != nil {
+		result.Code = http.StatusInternalServerError
+		switch {
+		case errors.Is(err, account.ErrMFAChallengeInvalid), errors.Is(err, account.ErrTOTPCodeInvalid):
+			result.Code = http.StatusUnauthorized
+		case errors.Is(err, account.ErrAccountDisabled):
+			result.Code = http.StatusForbidden
+		}
+
+		w.WriteHeader(result.Code)
+		result.Error = err.Error()
+
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	result.Code = http.StatusOK
+	result.RefreshToken = token.RefreshToken
+	result.Token = token.Token
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,528 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
+func TestHandler_HandleConfirmTOTP(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockUnmarshal := func(request confirmTOTPParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*confirmTOTPParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_code_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_totp_already_enabled_then_return_conflict",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(confirmTOTPParam{Code: "123456"}))
+				mf.accountUC.EXPECT().ConfirmTOTP(ctx, "123456").Return(account.ErrTOTPAlreadyEnabled)
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "when_code_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(confirmTOTPParam{Code: "123456"}))
+				mf.accountUC.EXPECT().ConfirmTOTP(ctx, "123456").Return(account.ErrTOTPCodeInvalid)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_ConfirmTOTP_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(confirmTOTPParam{Code: "123456"}))
+				mf.accountUC.EXPECT().ConfirmTOTP(ctx, "123456").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest confirmTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(confirmTOTPParam{Code: "123456"}))
+				mf.accountUC.EXPECT().ConfirmTOTP(ctx, "123456").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/totp/confirm", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleConfirmTOTP(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleDisableTOTP(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+		infra     *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockUnmarshal := func(request disableTOTPParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*disableTOTPParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest disableTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_empty_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest disableTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_password_incorrect_then_return_forbidden",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest disableTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(disableTOTPParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DisableTOTP(ctx, "pass").Return(account.ErrIncorrectPassword)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "when_DisableTOTP_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest disableTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(disableTOTPParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DisableTOTP(ctx, "pass").Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest disableTOTPParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(disableTOTPParam{Password: "pass"}))
+				mf.accountUC.EXPECT().DisableTOTP(ctx, "pass").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+				infra:     NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+				infra:   mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/totp/disable", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleDisableTOTP(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleEnrollTOTP(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       totpEnrollmentResponse
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			want: totpEnrollmentResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusUnauthorized,
+					Error: errUnauthorized.Error(),
+				},
+			},
+		},
+		{
+			name: "when_totp_already_enabled_then_return_conflict",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().EnrollTOTP(ctx).Return(account.TOTPEnrollment{}, account.ErrTOTPAlreadyEnabled)
+			},
+			want: totpEnrollmentResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusConflict,
+					Error: account.ErrTOTPAlreadyEnabled.Error(),
+				},
+			},
+		},
+		{
+			name: "when_EnrollTOTP_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().EnrollTOTP(ctx).Return(account.TOTPEnrollment{}, assert.AnError)
+			},
+			want: totpEnrollmentResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusInternalServerError,
+					Error: assert.AnError.Error(),
+				},
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_enrollment",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().EnrollTOTP(ctx).Return(account.TOTPEnrollment{
+					ProvisioningURI: "otpauth://totp/bubi:email",
+					RecoveryCodes:   []string{"ababa-babab"},
+					Secret:          "SECRET",
+				}, nil)
+			},
+			want: totpEnrollmentResponse{
+				defaultResponse: defaultResponse{
+					Code: http.StatusOK,
+				},
+				ProvisioningURI: "otpauth://totp/bubi:email",
+				RecoveryCodes:   []string{"ababa-babab"},
+				Secret:          "SECRET",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/totp/enroll", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleEnrollTOTP(w, req)
+
+			var got totpEnrollmentResponse
+			json.NewDecoder(w.Body).Decode(&got)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want.Code, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleUserLogInMFA(t *testing.T) {
+	type mockFields struct {
+		accountUC *MockaccountUCManager
+	}
+
+	mockCtx := entity.NewContextWithRequestMetadata(context.Background(), entity.RequestMetadata{
+		IPAddress: "192.0.2.1",
+	})
+	mockParam := account.LogInMFAParam{
+		ChallengeToken: "challenge",
+		Code:           "123456",
+		DeviceName:     "phone",
+		IPAddress:      "192.0.2.1",
+		UserAgent:      "agent",
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		mockFields func(mockFields)
+		want       userLogInResponse
+	}{
+		{
+			name:       "when_challenge_token_empty_then_return_bad_request",
+			form:       url.Values{"code": []string{"123456"}},
+			mockFields: func(mf mockFields) {},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusBadRequest,
+					Error: errChallengeTokenEmpty.Error(),
+				},
+			},
+		},
+		{
+			name:       "when_code_empty_then_return_bad_request",
+			form:       url.Values{"challenge_token": []string{"challenge"}},
+			mockFields: func(mf mockFields) {},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusBadRequest,
+					Error: errCodeEmpty.Error(),
+				},
+			},
+		},
+		{
+			name: "when_code_invalid_then_return_unauthorized",
+			form: url.Values{
+				"challenge_token": []string{"challenge"},
+				"code":            []string{"123456"},
+				"device_name":     []string{"phone"},
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMFA(mockCtx, mockParam).Return(account.JWT{}, account.ErrTOTPCodeInvalid)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusUnauthorized,
+					Error: account.ErrTOTPCodeInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "when_challenge_invalid_then_return_unauthorized",
+			form: url.Values{
+				"challenge_token": []string{"challenge"},
+				"code":            []string{"123456"},
+				"device_name":     []string{"phone"},
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMFA(mockCtx, mockParam).Return(account.JWT{}, account.ErrMFAChallengeInvalid)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusUnauthorized,
+					Error: account.ErrMFAChallengeInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "when_account_disabled_then_return_forbidden",
+			form: url.Values{
+				"challenge_token": []string{"challenge"},
+				"code":            []string{"123456"},
+				"device_name":     []string{"phone"},
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMFA(mockCtx, mockParam).Return(account.JWT{}, account.ErrAccountDisabled)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusForbidden,
+					Error: account.ErrAccountDisabled.Error(),
+				},
+			},
+		},
+		{
+			name: "when_LogInMFA_error_then_return_internal_server_error",
+			form: url.Values{
+				"challenge_token": []string{"challenge"},
+				"code":            []string{"123456"},
+				"device_name":     []string{"phone"},
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMFA(mockCtx, mockParam).Return(account.JWT{}, assert.AnError)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code:  http.StatusInternalServerError,
+					Error: assert.AnError.Error(),
+				},
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_token",
+			form: url.Values{
+				"challenge_token": []string{"challenge"},
+				"code":            []string{"123456"},
+				"device_name":     []string{"phone"},
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountUC.EXPECT().LogInMFA(mockCtx, mockParam).Return(account.JWT{
+					RefreshToken: "refresh",
+					Token:        "token",
+				}, nil)
+			},
+			want: userLogInResponse{
+				defaultResponse: defaultResponse{
+					Code: http.StatusOK,
+				},
+				RefreshToken: "refresh",
+				Token:        "token",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountUC: NewMockaccountUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				account: mockFields.accountUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/account/login/mfa", nil).WithContext(mockCtx)
+			req.Header.Set("User-Agent", "agent")
+			req.Form = test.form
+			w := httptest.NewRecorder()
+
+			h.HandleUserLogInMFA(w, req)
+
+			var got userLogInResponse
+			json.NewDecoder(w.Body).Decode(&got)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want.Code, w.Code)
+		})
+	}
+}
@@ -1,5 +1,13 @@
 package account
 
+import (
+	// golang package
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/account"
+)
+
 // -------------------------
 // | structs for parameter |
 // -------------------------
@@ -10,26 +18,83 @@ type userLogInParam struct {
 	Password string `json:"password"`
 }
 
+// confirmTOTPParam represents parameters needed to confirm TOTP enrollment.
+type confirmTOTPParam struct {
+	Code string `json:"code"`
+}
+
+// deleteAccountParam represents parameters needed to delete user's account.
+type deleteAccountParam struct {
+	Password string `json:"password"`
+}
+
+// disableTOTPParam represents parameters needed to disable TOTP.
+type disableTOTPParam struct {
+	Password string `json:"password"`
+}
+
+// createPersonalAccessTokenParam represents parameters needed to create a personal access token.
+// ExpiresAt is optional, a token without it never expires.
+type createPersonalAccessTokenParam struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+}
+
+// forgotPasswordParam represents parameters needed to request a password reset.
+type forgotPasswordParam struct {
+	Email string `json:"email"`
+}
+
+// magicLinkParam represents parameters needed to request a magic link.
+type magicLinkParam struct {
+	Email string `json:"email"`
+}
+
+// requestEmailChangeParam represents parameters needed to request a change of user's email.
+type requestEmailChangeParam struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// resendEmailVerificationParam represents parameters needed to resend the verification email.
+type resendEmailVerificationParam struct {
+	Email string `json:"email"`
+}
+
+// resetPasswordParam represents parameters needed to reset user's password.
+type resetPasswordParam struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// refreshTokenParam represents parameters needed to refresh user's token.
+type refreshTokenParam struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // userSignUpParam represents parameters needed to create a new user sign up.
+// Language picks the language of the default categories, either "id" or "en".
 type userSignUpParam struct {
 	Email    string `json:"email"`
+	Language string `json:"language"`
 	Password string `json:"password"`
 }
 
 // updateUserAccount represents parameters needed to update user account.
+// BudgetingMode and Timezone are optional, the current one is kept when it's empty.
 type updateUserAccount struct {
-	FirstName    string `json:"first_name"`
-	LastName     string `json:"last_name"`
-	RecordPeriod int    `json:"record_period"`
-	UserID       int64  `json:"user_id"`
+	BudgetingMode string `json:"budgeting_mode"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	RecordPeriod  int    `json:"record_period"`
+	Timezone      string `json:"timezone"`
 }
 
 // updateUserPassword represents parameters needed to update user's password.
 type updateUserPassword struct {
-	Email       string `json:"email"`
 	OldPassword string `json:"old_password"`
 	Password    string `json:"password"`
-	UserID      int64  `json:"user_id"`
 }
 
 // ------------------------
@@ -42,14 +107,79 @@ type defaultResponse struct {
 	Error string `json:"error"`
 }
 
-// userSignUpResponse represents response that will be given by endpoint /account/signup
+// accountsResponse represents response that will be given by endpoint GET /admin/users
+type accountsResponse struct {
+	defaultResponse
+	Accounts []account.AccountSummary `json:"accounts"`
+}
+
+// activityResponse represents response that will be given by endpoint GET /account/activity
+type activityResponse struct {
+	defaultResponse
+	Events []account.AuditEvent `json:"events"`
+}
+
+// accountDeletionResponse represents response that will be given by endpoint DELETE /account
+type accountDeletionResponse struct {
+	defaultResponse
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// passwordResponse represents response that will be given by endpoint /account/update_password
+// and /account/password/reset.
+// Fields is only filled when the new password violates the password policy.
+type passwordResponse struct {
+	defaultResponse
+	Fields []account.FieldError `json:"fields,omitempty"`
+}
+
+// personalAccessTokenResponse represents response that will be given by endpoint POST /account/tokens
+type personalAccessTokenResponse struct {
+	defaultResponse
+	account.PersonalAccessTokenCreated
+}
+
+// personalAccessTokensResponse represents response that will be given by endpoint GET /account/tokens
+type personalAccessTokensResponse struct {
+	defaultResponse
+	Tokens []account.PersonalAccessToken `json:"tokens"`
+}
+
+// profileResponse represents response that will be given by endpoint /account/me
+type profileResponse struct {
+	defaultResponse
+	account.Profile
+}
+
+// sessionsResponse represents response that will be given by endpoint /account/sessions
+type sessionsResponse struct {
+	defaultResponse
+	Sessions []account.Session `json:"sessions"`
+}
+
+// totpEnrollmentResponse represents response that will be given by endpoint /account/totp/enroll
+type totpEnrollmentResponse struct {
+	defaultResponse
+	ProvisioningURI string   `json:"provisioning_uri"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+	Secret          string   `json:"secret"`
+}
+
+// userLogInResponse represents response that will be given by endpoint /account/login,
+// /account/login/mfa and /account/token/refresh.
+// When a TOTP code is still needed, only MFARequired and MFAChallengeToken are filled.
 type userLogInResponse struct {
 	defaultResponse
-	Token string `json:"token"`
+	MFAChallengeToken string `json:"mfa_challenge_token,omitempty"`
+	MFARequired       bool   `json:"mfa_required,omitempty"`
+	RefreshToken      string `json:"refresh_token"`
+	Token             string `json:"token"`
 }
 
-// userSignUpResponse represents response that will be given by endpoint /account/signup
+// userSignUpResponse represents response that will be given by endpoint /account/signup.
+// Fields is only filled when the password violates the password policy.
 type userSignUpResponse struct {
 	defaultResponse
-	Message string `json:"message"`
+	Fields  []account.FieldError `json:"fields,omitempty"`
+	Message string               `json:"message"`
 }
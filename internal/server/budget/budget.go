@@ -0,0 +1,341 @@
+package budget
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+)
+
+const (
+	categoryIDKey = "category_id"
+	offsetKey     = "offset"
+
+	// maxOffset is how many periods away from the current one can be requested, which is 100 years of monthly periods.
+	maxOffset = 1200
+)
+
+var (
+	errCategoryIDInvalid = errors.New("category_id not valid")
+	errOffsetInvalid     = errors.New("offset not valid")
+	errUnauthorized      = errors.New("unauthorized!")
+)
+
+// HandleCopyBudgets will copy every budget of user from the previous period into the requested one,
+// carrying over what is left of them according to their rollover mode.
+// Categories that are already budgeted are left untouched.
+// The period is taken from query offset, it's the current period when left out.
+func (h *Handler) HandleCopyBudgets(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response budgetSummaryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOffset(r.URL.Query().Get(offsetKey))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.budget.CopyBudgets(r.Context(), offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Summary = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleDeleteBudget will delete the budget of a category of user in the requested period.
+// The period is taken from query offset, it's the current period when left out.
+func (h *Handler) HandleDeleteBudget(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	categoryID, err := strconv.ParseInt(mux.Vars(r)[categoryIDKey], 10, 64)
+	if err != nil || categoryID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errCategoryIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOffset(r.URL.Query().Get(offsetKey))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.budget.DeleteBudget(r.Context(), offset, categoryID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, budget.ErrBudgetNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetBudgets will fetch every budget of user in the requested period alongside how much is spent on them.
+// The period is taken from query offset, it's the current period when left out.
+func (h *Handler) HandleGetBudgets(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response budgetSummaryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOffset(r.URL.Query().Get(offsetKey))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.budget.GetBudgets(r.Context(), offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Summary = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleMoveBudget will move money from the budget of a category of user to the budget of another category
+// in the requested period. It's only available to user that budgets in envelope mode.
+// The period is taken from query offset, it's the current period when left out.
+func (h *Handler) HandleMoveBudget(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response budgetSummaryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOffset(r.URL.Query().Get(offsetKey))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request moveBudgetParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.budget.MoveBudget(r.Context(), offset, budget.MoveBudgetParam{
+		Amount:         request.Amount,
+		FromCategoryID: request.FromCategoryID,
+		ToCategoryID:   request.ToCategoryID,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+
+		var validationErr *budget.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		if errors.Is(err, budget.ErrEnvelopeModeRequired) {
+			response.Code = http.StatusConflict
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Summary = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleSetBudget will set the budget of an expense category of user in the requested period,
+// replacing the budget that is already set for the category.
+// The period is taken from query offset, it's the current period when left out.
+func (h *Handler) HandleSetBudget(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response budgetResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOffset(r.URL.Query().Get(offsetKey))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request setBudgetParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.budget.SetBudget(r.Context(), offset, budget.SetBudgetParam{
+		Amount:       request.Amount,
+		CategoryID:   request.CategoryID,
+		Currency:     request.Currency,
+		RolloverMode: request.RolloverMode,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+
+		var validationErr *budget.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Budget = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseOffset will parse the optional offset query parameter.
+// An empty value is parsed as zero, the current period.
+func parseOffset(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < -maxOffset || offset > maxOffset {
+		return 0, errOffsetInvalid
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,517 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+)
+
+func TestHandler_HandleCopyBudgets(t *testing.T) {
+	type mockFields struct {
+		budgetUC *MockbudgetUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		query      string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			query:      "?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:  "when_CopyBudgets_error_then_return_internal_server_error",
+			ctx:   ctx,
+			query: "?offset=1",
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().CopyBudgets(gomock.Any(), 1).Return(budget.BudgetSummary{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().CopyBudgets(gomock.Any(), 0).Return(budget.BudgetSummary{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				budgetUC: NewMockbudgetUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				budget: mockFields.budgetUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/budgets/copy"+test.query, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleCopyBudgets(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleDeleteBudget(t *testing.T) {
+	type mockFields struct {
+		budgetUC *MockbudgetUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID string
+		query      string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			categoryID: "7",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_category_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_offset_out_of_range_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "7",
+			query:      "?offset=1201",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_budget_not_exist_then_return_not_found",
+			ctx:        ctx,
+			categoryID: "7",
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().DeleteBudget(gomock.Any(), 0, int64(7)).Return(budget.ErrBudgetNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:       "when_DeleteBudget_error_then_return_internal_server_error",
+			ctx:        ctx,
+			categoryID: "7",
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().DeleteBudget(gomock.Any(), 0, int64(7)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "when_no_error_occured_then_return_ok",
+			ctx:        ctx,
+			categoryID: "7",
+			query:      "?offset=-1",
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().DeleteBudget(gomock.Any(), -1, int64(7)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				budgetUC: NewMockbudgetUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				budget: mockFields.budgetUC,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/budgets/"+test.categoryID+test.query, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				categoryIDKey: test.categoryID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleDeleteBudget(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetBudgets(t *testing.T) {
+	type mockFields struct {
+		budgetUC *MockbudgetUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		query      string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			query:      "?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name: "when_GetBudgets_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().GetBudgets(gomock.Any(), 0).Return(budget.BudgetSummary{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_ok",
+			ctx:   ctx,
+			query: "?offset=-2",
+			mockFields: func(mf mockFields) {
+				mf.budgetUC.EXPECT().GetBudgets(gomock.Any(), -2).Return(budget.BudgetSummary{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				budgetUC: NewMockbudgetUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				budget: mockFields.budgetUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/budgets"+test.query, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetBudgets(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleMoveBudget(t *testing.T) {
+	type mockFields struct {
+		budgetUC *MockbudgetUCManager
+		infra    *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockRequest := moveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 7,
+		ToCategoryID:   9,
+	}
+	mockParam := budget.MoveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 7,
+		ToCategoryID:   9,
+	}
+	mockUnmarshal := func(request moveBudgetParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*moveBudgetParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		query      string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			query:      "?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest moveBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_request_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest moveBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().MoveBudget(gomock.Any(), 0, mockParam).Return(budget.BudgetSummary{}, &budget.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_user_not_in_envelope_mode_then_return_conflict",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest moveBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().MoveBudget(gomock.Any(), 0, mockParam).Return(budget.BudgetSummary{}, budget.ErrEnvelopeModeRequired)
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "when_MoveBudget_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest moveBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().MoveBudget(gomock.Any(), 0, mockParam).Return(budget.BudgetSummary{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_ok",
+			ctx:   ctx,
+			query: "?offset=-1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest moveBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().MoveBudget(gomock.Any(), -1, mockParam).Return(budget.BudgetSummary{Budgets: []budget.Budget{}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				budgetUC: NewMockbudgetUCManager(ctrl),
+				infra:    NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				budget: mockFields.budgetUC,
+				infra:  mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/budgets/move"+test.query, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleMoveBudget(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleSetBudget(t *testing.T) {
+	type mockFields struct {
+		budgetUC *MockbudgetUCManager
+		infra    *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockRequest := setBudgetParam{
+		Amount:       1000000,
+		CategoryID:   7,
+		Currency:     "IDR",
+		RolloverMode: "positive",
+	}
+	mockParam := budget.SetBudgetParam{
+		Amount:       1000000,
+		CategoryID:   7,
+		Currency:     "IDR",
+		RolloverMode: "positive",
+	}
+	mockUnmarshal := func(request setBudgetParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*setBudgetParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		query      string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			query:      "?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest setBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_request_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest setBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().SetBudget(gomock.Any(), 0, mockParam).Return(budget.Budget{}, &budget.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_SetBudget_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest setBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().SetBudget(gomock.Any(), 0, mockParam).Return(budget.Budget{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_ok",
+			ctx:   ctx,
+			query: "?offset=1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest setBudgetParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.budgetUC.EXPECT().SetBudget(gomock.Any(), 1, mockParam).Return(budget.Budget{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				budgetUC: NewMockbudgetUCManager(ctrl),
+				infra:    NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				budget: mockFields.budgetUC,
+				infra:  mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/budgets"+test.query, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleSetBudget(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"io"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+)
+
+//go:generate mockgen -source=handler.go -destination=handler_mock.go -package=budget
+
+// budgetUCManager holds all methods served by usecase budget that will be needed by budget handler.
+type budgetUCManager interface {
+	// CopyBudgets will copy every budget of the user acting on ctx from the period before
+	// the one that is offset periods away from the current one into it.
+	CopyBudgets(ctx context.Context, offset int) (budget.BudgetSummary, error)
+
+	// DeleteBudget will delete the budget of a category of the user acting on ctx
+	// in the period that is offset periods away from the current one.
+	DeleteBudget(ctx context.Context, offset int, categoryID int64) error
+
+	// GetBudgets will fetch every budget of the user acting on ctx in the period
+	// that is offset periods away from the current one, alongside how much is spent on them.
+	GetBudgets(ctx context.Context, offset int) (budget.BudgetSummary, error)
+
+	// MoveBudget will move money from the budget of a category of the user acting on ctx to the budget of
+	// another expense category in the period that is offset periods away from the current one.
+	MoveBudget(ctx context.Context, offset int, param budget.MoveBudgetParam) (budget.BudgetSummary, error)
+
+	// SetBudget will set the budget of an expense category of the user acting on ctx
+	// in the period that is offset periods away from the current one.
+	SetBudget(ctx context.Context, offset int, param budget.SetBudgetParam) (budget.Budget, error)
+}
+
+// infraProvider holds all methods served by infra that will be needed by budget handler.
+type infraProvider interface {
+	// JsonUnmarshal parses the JSON-encoded data and stores the result in the value pointed to by dest.
+	JsonUnmarshal(input []byte, dest interface{}) error
+
+	// ReadAll reads from r until an error or EOF and returns the data it read.
+	// A successful call returns err == nil, not err == EOF. Because ReadAll is
+	// defined to read from src until EOF, it does not treat an EOF from Read
+	// as an error to be reported.
+	ReadAll(input io.Reader) ([]byte, error)
+}
+
+// BudgetHandlerParam holds all parameters needed to instantiate a new budget Handler.
+type BudgetHandlerParam struct {
+	Budget budgetUCManager
+	Infra  infraProvider
+}
+
+type Handler struct {
+	budget budgetUCManager
+	infra  infraProvider
+}
+
+// NewHandler instantiate a new instance of Handler.
+func NewHandler(param BudgetHandlerParam) *Handler {
+	return &Handler{
+		budget: param.Budget,
+		infra:  param.Infra,
+	}
+}
@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package budget is a generated GoMock package.
+package budget
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	budget "github.com/arifinhermawan/bubi/internal/usecase/budget"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockbudgetUCManager is a mock of budgetUCManager interface.
+type MockbudgetUCManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockbudgetUCManagerMockRecorder
+}
+
+// MockbudgetUCManagerMockRecorder is the mock recorder for MockbudgetUCManager.
+type MockbudgetUCManagerMockRecorder struct {
+	mock *MockbudgetUCManager
+}
+
+// NewMockbudgetUCManager creates a new mock instance.
+func NewMockbudgetUCManager(ctrl *gomock.Controller) *MockbudgetUCManager {
+	mock := &MockbudgetUCManager{ctrl: ctrl}
+	mock.recorder = &MockbudgetUCManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbudgetUCManager) EXPECT() *MockbudgetUCManagerMockRecorder {
+	return m.recorder
+}
+
+// CopyBudgets mocks base method.
+func (m *MockbudgetUCManager) CopyBudgets(ctx context.Context, offset int) (budget.BudgetSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyBudgets", ctx, offset)
+	ret0, _ := ret[0].(budget.BudgetSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyBudgets indicates an expected call of CopyBudgets.
+func (mr *MockbudgetUCManagerMockRecorder) CopyBudgets(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyBudgets", reflect.TypeOf((*MockbudgetUCManager)(nil).CopyBudgets), ctx, offset)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockbudgetUCManager) DeleteBudget(ctx context.Context, offset int, categoryID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, offset, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockbudgetUCManagerMockRecorder) DeleteBudget(ctx, offset, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockbudgetUCManager)(nil).DeleteBudget), ctx, offset, categoryID)
+}
+
+// GetBudgets mocks base method.
+func (m *MockbudgetUCManager) GetBudgets(ctx context.Context, offset int) (budget.BudgetSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgets", ctx, offset)
+	ret0, _ := ret[0].(budget.BudgetSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgets indicates an expected call of GetBudgets.
+func (mr *MockbudgetUCManagerMockRecorder) GetBudgets(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgets", reflect.TypeOf((*MockbudgetUCManager)(nil).GetBudgets), ctx, offset)
+}
+
+// MoveBudget mocks base method.
+func (m *MockbudgetUCManager) MoveBudget(ctx context.Context, offset int, param budget.MoveBudgetParam) (budget.BudgetSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBudget", ctx, offset, param)
+	ret0, _ := ret[0].(budget.BudgetSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBudget indicates an expected call of MoveBudget.
+func (mr *MockbudgetUCManagerMockRecorder) MoveBudget(ctx, offset, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBudget", reflect.TypeOf((*MockbudgetUCManager)(nil).MoveBudget), ctx, offset, param)
+}
+
+// SetBudget mocks base method.
+func (m *MockbudgetUCManager) SetBudget(ctx context.Context, offset int, param budget.SetBudgetParam) (budget.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudget", ctx, offset, param)
+	ret0, _ := ret[0].(budget.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBudget indicates an expected call of SetBudget.
+func (mr *MockbudgetUCManagerMockRecorder) SetBudget(ctx, offset, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudget", reflect.TypeOf((*MockbudgetUCManager)(nil).SetBudget), ctx, offset, param)
+}
+
+// MockinfraProvider is a mock of infraProvider interface.
+type MockinfraProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfraProviderMockRecorder
+}
+
+// MockinfraProviderMockRecorder is the mock recorder for MockinfraProvider.
+type MockinfraProviderMockRecorder struct {
+	mock *MockinfraProvider
+}
+
+// NewMockinfraProvider creates a new mock instance.
+func NewMockinfraProvider(ctrl *gomock.Controller) *MockinfraProvider {
+	mock := &MockinfraProvider{ctrl: ctrl}
+	mock.recorder = &MockinfraProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
+	return m.recorder
+}
+
+// JsonUnmarshal mocks base method.
+func (m *MockinfraProvider) JsonUnmarshal(input []byte, dest interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JsonUnmarshal", input, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JsonUnmarshal indicates an expected call of JsonUnmarshal.
+func (mr *MockinfraProviderMockRecorder) JsonUnmarshal(input, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JsonUnmarshal", reflect.TypeOf((*MockinfraProvider)(nil).JsonUnmarshal), input, dest)
+}
+
+// ReadAll mocks base method.
+func (m *MockinfraProvider) ReadAll(input io.Reader) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockinfraProviderMockRecorder) ReadAll(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockinfraProvider)(nil).ReadAll), input)
+}
@@ -0,0 +1,26 @@
+package budget
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBudgetUC := NewMockbudgetUCManager(ctrl)
+	mockInfra := NewMockinfraProvider(ctrl)
+
+	want := &Handler{
+		budget: mockBudgetUC,
+		infra:  mockInfra,
+	}
+
+	assert.Equal(t, want, NewHandler(BudgetHandlerParam{
+		Budget: mockBudgetUC,
+		Infra:  mockInfra,
+	}))
+}
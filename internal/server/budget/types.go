@@ -0,0 +1,53 @@
+package budget
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/budget"
+)
+
+// -------------------------
+// | structs for parameter |
+// -------------------------
+
+// moveBudgetParam represents parameters needed to move money from the budget of a category to another one.
+// Amount is in minor unit of the currency of the budgets.
+type moveBudgetParam struct {
+	Amount         int64 `json:"amount"`
+	FromCategoryID int64 `json:"from_category_id"`
+	ToCategoryID   int64 `json:"to_category_id"`
+}
+
+// setBudgetParam represents parameters needed to set the budget of a category.
+// Amount is in minor unit of Currency. RolloverMode is one of both, none or positive, it's none when left out.
+type setBudgetParam struct {
+	Amount       int64  `json:"amount"`
+	CategoryID   int64  `json:"category_id"`
+	Currency     string `json:"currency"`
+	RolloverMode string `json:"rollover_mode"`
+}
+
+// ------------------------
+// | structs for response |
+// ------------------------
+
+// defaultResponse represents default response of an API call
+type defaultResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// budgetResponse represents response that will be given by endpoint POST /budgets.
+// Fields is only filled when the request isn't valid.
+type budgetResponse struct {
+	defaultResponse
+	Budget *budget.Budget      `json:"budget,omitempty"`
+	Fields []budget.FieldError `json:"fields,omitempty"`
+}
+
+// budgetSummaryResponse represents response that will be given by endpoint GET /budgets, POST /budgets/copy
+// and POST /budgets/move. Fields is only filled when the request isn't valid.
+type budgetSummaryResponse struct {
+	defaultResponse
+	Fields  []budget.FieldError   `json:"fields,omitempty"`
+	Summary *budget.BudgetSummary `json:"summary,omitempty"`
+}
@@ -0,0 +1,341 @@
+package category
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+)
+
+const (
+	categoryIDKey      = "category_id"
+	includeArchivedKey = "include_archived"
+)
+
+var (
+	errCategoryIDInvalid      = errors.New("category_id not valid")
+	errIncludeArchivedInvalid = errors.New("include_archived not valid")
+	errUnauthorized           = errors.New("unauthorized!")
+)
+
+// HandleCreateCategory will create a new category for user.
+// A category with parent_id is created as a subcategory of that category.
+func (h *Handler) HandleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response categoryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request createCategoryParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	created, err := h.category.CreateCategory(r.Context(), category.CreateCategoryParam{
+		Color:    request.Color,
+		Icon:     request.Icon,
+		Name:     request.Name,
+		ParentID: request.ParentID,
+		Type:     request.Type,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+
+		var validationErr *category.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	response.Code = http.StatusCreated
+	response.Category = &created
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetCategories will list categories of user, ordered by their type and name.
+// Archived categories are only listed when include_archived is true.
+func (h *Handler) HandleGetCategories(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response categoriesResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var includeArchived bool
+	if value := r.URL.Query().Get(includeArchivedKey); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			response.Code = http.StatusBadRequest
+			response.Error = errIncludeArchivedInvalid.Error()
+
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		includeArchived = parsed
+	}
+
+	categories, err := h.category.ListCategories(r.Context(), category.ListCategoriesParam{
+		IncludeArchived: includeArchived,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Categories = categories
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetCategory will fetch a category of user.
+func (h *Handler) HandleGetCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response categoryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	categoryID, err := strconv.ParseInt(mux.Vars(r)[categoryIDKey], 10, 64)
+	if err != nil || categoryID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errCategoryIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.category.GetCategory(r.Context(), categoryID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Category = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleMergeCategory will merge a category of user into the category given as target_id.
+// Every transaction and subcategory of the merged category is moved to the target
+// category, then the merged category is deleted.
+func (h *Handler) HandleMergeCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response categoryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	categoryID, err := strconv.ParseInt(mux.Vars(r)[categoryIDKey], 10, 64)
+	if err != nil || categoryID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errCategoryIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request mergeCategoryParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	target, err := h.category.MergeCategories(r.Context(), categoryID, request.TargetID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		var validationErr *category.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Category = &target
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleUpdateCategory will update a category of user.
+// Fields left out of the request keep their current value, and
+// archiving a category also archives its subcategories.
+func (h *Handler) HandleUpdateCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response categoryResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	categoryID, err := strconv.ParseInt(mux.Vars(r)[categoryIDKey], 10, 64)
+	if err != nil || categoryID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errCategoryIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request updateCategoryParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	updated, err := h.category.UpdateCategory(r.Context(), categoryID, category.UpdateCategoryParam{
+		Archived: request.Archived,
+		Color:    request.Color,
+		Icon:     request.Icon,
+		Name:     request.Name,
+		ParentID: request.ParentID,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		var validationErr *category.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Category = &updated
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,580 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+)
+
+func TestHandler_HandleCreateCategory(t *testing.T) {
+	type mockFields struct {
+		categoryUC *MockcategoryUCManager
+		infra      *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockRequest := createCategoryParam{
+		Color:    "#FF9800",
+		Icon:     "local_cafe",
+		Name:     "Snacks",
+		ParentID: 1,
+		Type:     entity.TransactionTypeExpense,
+	}
+	mockParam := category.CreateCategoryParam{
+		Color:    "#FF9800",
+		Icon:     "local_cafe",
+		Name:     "Snacks",
+		ParentID: 1,
+		Type:     entity.TransactionTypeExpense,
+	}
+	mockUnmarshal := func(request createCategoryParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*createCategoryParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_request_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().CreateCategory(ctx, mockParam).Return(category.Category{}, &category.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_CreateCategory_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().CreateCategory(ctx, mockParam).Return(category.Category{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_created",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().CreateCategory(ctx, mockParam).Return(category.Category{ID: 1}, nil)
+			},
+			wantCode: http.StatusCreated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categoryUC: NewMockcategoryUCManager(ctrl),
+				infra:      NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				category: mockFields.categoryUC,
+				infra:    mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/categories", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleCreateCategory(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetCategories(t *testing.T) {
+	type mockFields struct {
+		categoryUC *MockcategoryUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/categories",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_include_archived_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/categories?include_archived=maybe",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_ListCategories_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/categories",
+			mockFields: func(mf mockFields) {
+				mf.categoryUC.EXPECT().ListCategories(ctx, category.ListCategoriesParam{}).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/categories?include_archived=true",
+			mockFields: func(mf mockFields) {
+				mf.categoryUC.EXPECT().ListCategories(ctx, category.ListCategoriesParam{
+					IncludeArchived: true,
+				}).Return([]category.Category{{ID: 1}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categoryUC: NewMockcategoryUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				category: mockFields.categoryUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetCategories(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetCategory(t *testing.T) {
+	type mockFields struct {
+		categoryUC *MockcategoryUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			categoryID: "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_category_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "0",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_category_not_exist_then_return_not_found",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.categoryUC.EXPECT().GetCategory(gomock.Any(), int64(1)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:       "when_GetCategory_error_then_return_internal_server_error",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.categoryUC.EXPECT().GetCategory(gomock.Any(), int64(1)).Return(category.Category{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "when_no_error_occured_then_return_ok",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.categoryUC.EXPECT().GetCategory(gomock.Any(), int64(1)).Return(category.Category{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categoryUC: NewMockcategoryUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				category: mockFields.categoryUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/categories/"+test.categoryID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				categoryIDKey: test.categoryID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleGetCategory(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleMergeCategory(t *testing.T) {
+	type mockFields struct {
+		categoryUC *MockcategoryUCManager
+		infra      *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockUnmarshal := func(input []byte, dest interface{}) error {
+		*dest.(*mergeCategoryParam) = mergeCategoryParam{TargetID: 4}
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			categoryID: "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_category_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_ReadAll_error_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest mergeCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_target_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest mergeCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal)
+				mf.categoryUC.EXPECT().MergeCategories(gomock.Any(), int64(1), int64(4)).Return(category.Category{}, &category.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_category_not_exist_then_return_not_found",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest mergeCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal)
+				mf.categoryUC.EXPECT().MergeCategories(gomock.Any(), int64(1), int64(4)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:       "when_MergeCategories_error_then_return_internal_server_error",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest mergeCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal)
+				mf.categoryUC.EXPECT().MergeCategories(gomock.Any(), int64(1), int64(4)).Return(category.Category{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "when_no_error_occured_then_return_ok",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest mergeCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal)
+				mf.categoryUC.EXPECT().MergeCategories(gomock.Any(), int64(1), int64(4)).Return(category.Category{ID: 4}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categoryUC: NewMockcategoryUCManager(ctrl),
+				infra:      NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				category: mockFields.categoryUC,
+				infra:    mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/categories/"+test.categoryID+"/merge", nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				categoryIDKey: test.categoryID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleMergeCategory(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleUpdateCategory(t *testing.T) {
+	type mockFields struct {
+		categoryUC *MockcategoryUCManager
+		infra      *MockinfraProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	name := "Transportation"
+	parentID := int64(0)
+	mockRequest := updateCategoryParam{
+		Name:     &name,
+		ParentID: &parentID,
+	}
+	mockParam := category.UpdateCategoryParam{
+		Name:     &name,
+		ParentID: &parentID,
+	}
+	mockUnmarshal := func(request updateCategoryParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*updateCategoryParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			categoryID: "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_category_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "-1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_ReadAll_error_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_request_invalid_then_return_bad_request",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().UpdateCategory(gomock.Any(), int64(1), mockParam).Return(category.Category{}, &category.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "when_category_not_exist_then_return_not_found",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().UpdateCategory(gomock.Any(), int64(1), mockParam).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:       "when_UpdateCategory_error_then_return_internal_server_error",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().UpdateCategory(gomock.Any(), int64(1), mockParam).Return(category.Category{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "when_no_error_occured_then_return_ok",
+			ctx:        ctx,
+			categoryID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateCategoryParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.categoryUC.EXPECT().UpdateCategory(gomock.Any(), int64(1), mockParam).Return(category.Category{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categoryUC: NewMockcategoryUCManager(ctrl),
+				infra:      NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				category: mockFields.categoryUC,
+				infra:    mockFields.infra,
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/categories/"+test.categoryID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				categoryIDKey: test.categoryID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleUpdateCategory(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
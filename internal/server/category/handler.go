@@ -0,0 +1,65 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"io"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+)
+
+//go:generate mockgen -source=handler.go -destination=handler_mock.go -package=category
+
+// categoryUCManager holds all methods served by usecase category that will be needed by category handler.
+type categoryUCManager interface {
+	// CreateCategory will create a new category for the user acting on ctx.
+	// A subcategory must be placed under a top level category of the same type.
+	CreateCategory(ctx context.Context, param category.CreateCategoryParam) (category.Category, error)
+
+	// GetCategory will fetch a category of the user acting on ctx.
+	GetCategory(ctx context.Context, categoryID int64) (category.Category, error)
+
+	// ListCategories will fetch categories of the user acting on ctx, ordered by their type and name.
+	// Archived categories are left out unless param.IncludeArchived is true.
+	ListCategories(ctx context.Context, param category.ListCategoriesParam) ([]category.Category, error)
+
+	// MergeCategories will merge a category of the user acting on ctx into another category
+	// of the same type and return the target category.
+	MergeCategories(ctx context.Context, categoryID, targetID int64) (category.Category, error)
+
+	// UpdateCategory will update a category of the user acting on ctx and return the updated category.
+	// Only fields given in param are changed.
+	UpdateCategory(ctx context.Context, categoryID int64, param category.UpdateCategoryParam) (category.Category, error)
+}
+
+// infraProvider holds all methods served by infra that will be needed by category handler.
+type infraProvider interface {
+	// JsonUnmarshal parses the JSON-encoded data and stores the result in the value pointed to by dest.
+	JsonUnmarshal(input []byte, dest interface{}) error
+
+	// ReadAll reads from r until an error or EOF and returns the data it read.
+	// A successful call returns err == nil, not err == EOF. Because ReadAll is
+	// defined to read from src until EOF, it does not treat an EOF from Read
+	// as an error to be reported.
+	ReadAll(input io.Reader) ([]byte, error)
+}
+
+// CategoryHandlerParam holds all parameters needed to instantiate a new category Handler.
+type CategoryHandlerParam struct {
+	Category categoryUCManager
+	Infra    infraProvider
+}
+
+type Handler struct {
+	category categoryUCManager
+	infra    infraProvider
+}
+
+// NewHandler instantiate a new instance of Handler.
+func NewHandler(param CategoryHandlerParam) *Handler {
+	return &Handler{
+		category: param.Category,
+		infra:    param.Infra,
+	}
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package category is a generated GoMock package.
+package category
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	category "github.com/arifinhermawan/bubi/internal/usecase/category"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcategoryUCManager is a mock of categoryUCManager interface.
+type MockcategoryUCManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockcategoryUCManagerMockRecorder
+}
+
+// MockcategoryUCManagerMockRecorder is the mock recorder for MockcategoryUCManager.
+type MockcategoryUCManagerMockRecorder struct {
+	mock *MockcategoryUCManager
+}
+
+// NewMockcategoryUCManager creates a new mock instance.
+func NewMockcategoryUCManager(ctrl *gomock.Controller) *MockcategoryUCManager {
+	mock := &MockcategoryUCManager{ctrl: ctrl}
+	mock.recorder = &MockcategoryUCManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcategoryUCManager) EXPECT() *MockcategoryUCManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockcategoryUCManager) CreateCategory(ctx context.Context, param category.CreateCategoryParam) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, param)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockcategoryUCManagerMockRecorder) CreateCategory(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockcategoryUCManager)(nil).CreateCategory), ctx, param)
+}
+
+// GetCategory mocks base method.
+func (m *MockcategoryUCManager) GetCategory(ctx context.Context, categoryID int64) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, categoryID)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockcategoryUCManagerMockRecorder) GetCategory(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockcategoryUCManager)(nil).GetCategory), ctx, categoryID)
+}
+
+// ListCategories mocks base method.
+func (m *MockcategoryUCManager) ListCategories(ctx context.Context, param category.ListCategoriesParam) ([]category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, param)
+	ret0, _ := ret[0].([]category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockcategoryUCManagerMockRecorder) ListCategories(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockcategoryUCManager)(nil).ListCategories), ctx, param)
+}
+
+// MergeCategories mocks base method.
+func (m *MockcategoryUCManager) MergeCategories(ctx context.Context, categoryID, targetID int64) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategories", ctx, categoryID, targetID)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockcategoryUCManagerMockRecorder) MergeCategories(ctx, categoryID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockcategoryUCManager)(nil).MergeCategories), ctx, categoryID, targetID)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockcategoryUCManager) UpdateCategory(ctx context.Context, categoryID int64, param category.UpdateCategoryParam) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, categoryID, param)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockcategoryUCManagerMockRecorder) UpdateCategory(ctx, categoryID, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockcategoryUCManager)(nil).UpdateCategory), ctx, categoryID, param)
+}
+
+// MockinfraProvider is a mock of infraProvider interface.
+type MockinfraProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfraProviderMockRecorder
+}
+
+// MockinfraProviderMockRecorder is the mock recorder for MockinfraProvider.
+type MockinfraProviderMockRecorder struct {
+	mock *MockinfraProvider
+}
+
+// NewMockinfraProvider creates a new mock instance.
+func NewMockinfraProvider(ctrl *gomock.Controller) *MockinfraProvider {
+	mock := &MockinfraProvider{ctrl: ctrl}
+	mock.recorder = &MockinfraProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
+	return m.recorder
+}
+
+// JsonUnmarshal mocks base method.
+func (m *MockinfraProvider) JsonUnmarshal(input []byte, dest interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JsonUnmarshal", input, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JsonUnmarshal indicates an expected call of JsonUnmarshal.
+func (mr *MockinfraProviderMockRecorder) JsonUnmarshal(input, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JsonUnmarshal", reflect.TypeOf((*MockinfraProvider)(nil).JsonUnmarshal), input, dest)
+}
+
+// ReadAll mocks base method.
+func (m *MockinfraProvider) ReadAll(input io.Reader) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockinfraProviderMockRecorder) ReadAll(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockinfraProvider)(nil).ReadAll), input)
+}
@@ -0,0 +1,26 @@
+package category
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCategoryUC := NewMockcategoryUCManager(ctrl)
+	mockInfra := NewMockinfraProvider(ctrl)
+
+	want := &Handler{
+		category: mockCategoryUC,
+		infra:    mockInfra,
+	}
+
+	assert.Equal(t, want, NewHandler(CategoryHandlerParam{
+		Category: mockCategoryUC,
+		Infra:    mockInfra,
+	}))
+}
@@ -0,0 +1,61 @@
+package category
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/category"
+)
+
+// -------------------------
+// | structs for parameter |
+// -------------------------
+
+// createCategoryParam represents parameters needed to create a category.
+// A category without parent_id is a top level category.
+type createCategoryParam struct {
+	Color    string `json:"color"`
+	Icon     string `json:"icon"`
+	Name     string `json:"name"`
+	ParentID int64  `json:"parent_id"`
+	Type     string `json:"type"`
+}
+
+// mergeCategoryParam represents parameters needed to merge a category into another category.
+type mergeCategoryParam struct {
+	TargetID int64 `json:"target_id"`
+}
+
+// updateCategoryParam represents parameters needed to update a category.
+// A field that is left out of the request keeps its current value,
+// and a zero parent_id moves the category to the top level.
+type updateCategoryParam struct {
+	Archived *bool   `json:"archived"`
+	Color    *string `json:"color"`
+	Icon     *string `json:"icon"`
+	Name     *string `json:"name"`
+	ParentID *int64  `json:"parent_id"`
+}
+
+// ------------------------
+// | structs for response |
+// ------------------------
+
+// defaultResponse represents default response of an API call
+type defaultResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// categoryResponse represents response that will be given by endpoint POST /categories,
+// GET /categories/{category_id}, PATCH /categories/{category_id} and POST /categories/{category_id}/merge.
+// Fields is only filled when the request isn't valid.
+type categoryResponse struct {
+	defaultResponse
+	Category *category.Category    `json:"category,omitempty"`
+	Fields   []category.FieldError `json:"fields,omitempty"`
+}
+
+// categoriesResponse represents response that will be given by endpoint GET /categories
+type categoriesResponse struct {
+	defaultResponse
+	Categories []category.Category `json:"categories"`
+}
@@ -0,0 +1,34 @@
+package period
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/period"
+)
+
+//go:generate mockgen -source=handler.go -destination=handler_mock.go -package=period
+
+// periodUCManager holds all methods served by usecase period that will be needed by period handler.
+type periodUCManager interface {
+	// GetPeriod will return the period of the user acting on ctx that is offset periods away from the current one.
+	// Offset 0 is the current period, -1 is the previous one and so on.
+	GetPeriod(ctx context.Context, offset int) (period.Period, error)
+}
+
+// PeriodHandlerParam holds all parameters needed to instantiate a new period Handler.
+type PeriodHandlerParam struct {
+	Period periodUCManager
+}
+
+type Handler struct {
+	period periodUCManager
+}
+
+// NewHandler instantiate a new instance of Handler.
+func NewHandler(param PeriodHandlerParam) *Handler {
+	return &Handler{
+		period: param.Period,
+	}
+}
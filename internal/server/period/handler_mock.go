@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package period is a generated GoMock package.
+package period
+
+import (
+	context "context"
+	reflect "reflect"
+
+	period "github.com/arifinhermawan/bubi/internal/usecase/period"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockperiodUCManager is a mock of periodUCManager interface.
+type MockperiodUCManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockperiodUCManagerMockRecorder
+}
+
+// MockperiodUCManagerMockRecorder is the mock recorder for MockperiodUCManager.
+type MockperiodUCManagerMockRecorder struct {
+	mock *MockperiodUCManager
+}
+
+// NewMockperiodUCManager creates a new mock instance.
+func NewMockperiodUCManager(ctrl *gomock.Controller) *MockperiodUCManager {
+	mock := &MockperiodUCManager{ctrl: ctrl}
+	mock.recorder = &MockperiodUCManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockperiodUCManager) EXPECT() *MockperiodUCManagerMockRecorder {
+	return m.recorder
+}
+
+// GetPeriod mocks base method.
+func (m *MockperiodUCManager) GetPeriod(ctx context.Context, offset int) (period.Period, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeriod", ctx, offset)
+	ret0, _ := ret[0].(period.Period)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeriod indicates an expected call of GetPeriod.
+func (mr *MockperiodUCManagerMockRecorder) GetPeriod(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeriod", reflect.TypeOf((*MockperiodUCManager)(nil).GetPeriod), ctx, offset)
+}
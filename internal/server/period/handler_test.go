@@ -0,0 +1,23 @@
+package period
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPeriodUC := NewMockperiodUCManager(ctrl)
+
+	want := &Handler{
+		period: mockPeriodUC,
+	}
+
+	assert.Equal(t, want, NewHandler(PeriodHandlerParam{
+		Period: mockPeriodUC,
+	}))
+}
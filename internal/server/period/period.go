@@ -0,0 +1,102 @@
+package period
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+const (
+	offsetKey = "offset"
+
+	// maxOffset is how many periods away from the current one can be requested, which is 100 years of monthly periods.
+	maxOffset = 1200
+)
+
+var (
+	errOffsetInvalid = errors.New("offset not valid")
+	errUnauthorized  = errors.New("unauthorized!")
+)
+
+// HandleGetCurrentPeriod will return the period of user that contains today.
+func (h *Handler) HandleGetCurrentPeriod(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response periodResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.period.GetPeriod(r.Context(), 0)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Period = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetPeriod will return the period of user that is offset periods away from the current one.
+// Offset is taken from query offset, it's 0 when left out and -1 is the previous period.
+func (h *Handler) HandleGetPeriod(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response periodResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var offset int
+	if value := r.URL.Query().Get(offsetKey); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < -maxOffset || parsed > maxOffset {
+			w.WriteHeader(http.StatusBadRequest)
+			response.Code = http.StatusBadRequest
+			response.Error = errOffsetInvalid.Error()
+
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		offset = parsed
+	}
+
+	result, err := h.period.GetPeriod(r.Context(), offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Period = &result
+	json.NewEncoder(w).Encode(response)
+}
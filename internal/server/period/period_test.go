@@ -0,0 +1,155 @@
+package period
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/period"
+)
+
+func TestHandler_HandleGetCurrentPeriod(t *testing.T) {
+	type mockFields struct {
+		periodUC *MockperiodUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_GetPeriod_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.periodUC.EXPECT().GetPeriod(ctx, 0).Return(period.Period{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_ok",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.periodUC.EXPECT().GetPeriod(ctx, 0).Return(period.Period{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				periodUC: NewMockperiodUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				period: mockFields.periodUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/period/current", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetCurrentPeriod(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetPeriod(t *testing.T) {
+	type mockFields struct {
+		periodUC *MockperiodUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/period",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_offset_not_a_number_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/period?offset=last",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_offset_too_far_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/period?offset=-1201",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_GetPeriod_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/period",
+			mockFields: func(mf mockFields) {
+				mf.periodUC.EXPECT().GetPeriod(ctx, 0).Return(period.Period{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/period?offset=-1",
+			mockFields: func(mf mockFields) {
+				mf.periodUC.EXPECT().GetPeriod(ctx, -1).Return(period.Period{Offset: -1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				periodUC: NewMockperiodUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				period: mockFields.periodUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetPeriod(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package period
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/period"
+)
+
+// ------------------------
+// | structs for response |
+// ------------------------
+
+// defaultResponse represents default response of an API call
+type defaultResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// periodResponse represents response that will be given by endpoint GET /period/current and GET /period
+type periodResponse struct {
+	defaultResponse
+	Period *period.Period `json:"period,omitempty"`
+}
@@ -0,0 +1,64 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"io"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+)
+
+//go:generate mockgen -source=handler.go -destination=handler_mock.go -package=transaction
+
+// transactionUCManager holds all methods served by usecase transaction that will be needed by transaction handler.
+type transactionUCManager interface {
+	// CreateTransaction will record a new transaction for the user acting on ctx
+	// and adjust the balance of its wallet.
+	CreateTransaction(ctx context.Context, param transaction.CreateTransactionParam) (transaction.Transaction, error)
+
+	// DeleteTransaction will delete a transaction of the user acting on ctx
+	// and take it out of the balance of its wallet.
+	DeleteTransaction(ctx context.Context, transactionID int64) error
+
+	// GetTransaction will fetch a transaction of the user acting on ctx.
+	GetTransaction(ctx context.Context, transactionID int64) (transaction.Transaction, error)
+
+	// ListTransactions will fetch transactions of the user acting on ctx, ordered from the most recent transaction.
+	ListTransactions(ctx context.Context, param transaction.ListTransactionsParam) ([]transaction.Transaction, error)
+
+	// UpdateTransaction will update a transaction of the user acting on ctx and return the updated transaction.
+	// Only fields given in param are changed.
+	UpdateTransaction(ctx context.Context, transactionID int64, param transaction.UpdateTransactionParam) (transaction.Transaction, error)
+}
+
+// infraProvider holds all methods served by infra that will be needed by transaction handler.
+type infraProvider interface {
+	// JsonUnmarshal parses the JSON-encoded data and stores the result in the value pointed to by dest.
+	JsonUnmarshal(input []byte, dest interface{}) error
+
+	// ReadAll reads from r until an error or EOF and returns the data it read.
+	// A successful call returns err == nil, not err == EOF. Because ReadAll is
+	// defined to read from src until EOF, it does not treat an EOF from Read
+	// as an error to be reported.
+	ReadAll(input io.Reader) ([]byte, error)
+}
+
+// TransactionHandlerParam holds all parameters needed to instantiate a new transaction Handler.
+type TransactionHandlerParam struct {
+	Infra       infraProvider
+	Transaction transactionUCManager
+}
+
+type Handler struct {
+	infra       infraProvider
+	transaction transactionUCManager
+}
+
+// NewHandler instantiate a new instance of Handler.
+func NewHandler(param TransactionHandlerParam) *Handler {
+	return &Handler{
+		infra:       param.Infra,
+		transaction: param.Transaction,
+	}
+}
@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package transaction is a generated GoMock package.
+package transaction
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	transaction "github.com/arifinhermawan/bubi/internal/usecase/transaction"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocktransactionUCManager is a mock of transactionUCManager interface.
+type MocktransactionUCManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactionUCManagerMockRecorder
+}
+
+// MocktransactionUCManagerMockRecorder is the mock recorder for MocktransactionUCManager.
+type MocktransactionUCManagerMockRecorder struct {
+	mock *MocktransactionUCManager
+}
+
+// NewMocktransactionUCManager creates a new mock instance.
+func NewMocktransactionUCManager(ctrl *gomock.Controller) *MocktransactionUCManager {
+	mock := &MocktransactionUCManager{ctrl: ctrl}
+	mock.recorder = &MocktransactionUCManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransactionUCManager) EXPECT() *MocktransactionUCManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateTransaction mocks base method.
+func (m *MocktransactionUCManager) CreateTransaction(ctx context.Context, param transaction.CreateTransactionParam) (transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, param)
+	ret0, _ := ret[0].(transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MocktransactionUCManagerMockRecorder) CreateTransaction(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MocktransactionUCManager)(nil).CreateTransaction), ctx, param)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MocktransactionUCManager) DeleteTransaction(ctx context.Context, transactionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MocktransactionUCManagerMockRecorder) DeleteTransaction(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MocktransactionUCManager)(nil).DeleteTransaction), ctx, transactionID)
+}
+
+// GetTransaction mocks base method.
+func (m *MocktransactionUCManager) GetTransaction(ctx context.Context, transactionID int64) (transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MocktransactionUCManagerMockRecorder) GetTransaction(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MocktransactionUCManager)(nil).GetTransaction), ctx, transactionID)
+}
+
+// ListTransactions mocks base method.
+func (m *MocktransactionUCManager) ListTransactions(ctx context.Context, param transaction.ListTransactionsParam) ([]transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, param)
+	ret0, _ := ret[0].([]transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MocktransactionUCManagerMockRecorder) ListTransactions(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MocktransactionUCManager)(nil).ListTransactions), ctx, param)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MocktransactionUCManager) UpdateTransaction(ctx context.Context, transactionID int64, param transaction.UpdateTransactionParam) (transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, transactionID, param)
+	ret0, _ := ret[0].(transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MocktransactionUCManagerMockRecorder) UpdateTransaction(ctx, transactionID, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MocktransactionUCManager)(nil).UpdateTransaction), ctx, transactionID, param)
+}
+
+// MockinfraProvider is a mock of infraProvider interface.
+type MockinfraProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfraProviderMockRecorder
+}
+
+// MockinfraProviderMockRecorder is the mock recorder for MockinfraProvider.
+type MockinfraProviderMockRecorder struct {
+	mock *MockinfraProvider
+}
+
+// NewMockinfraProvider creates a new mock instance.
+func NewMockinfraProvider(ctrl *gomock.Controller) *MockinfraProvider {
+	mock := &MockinfraProvider{ctrl: ctrl}
+	mock.recorder = &MockinfraProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
+	return m.recorder
+}
+
+// JsonUnmarshal mocks base method.
+func (m *MockinfraProvider) JsonUnmarshal(input []byte, dest interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JsonUnmarshal", input, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JsonUnmarshal indicates an expected call of JsonUnmarshal.
+func (mr *MockinfraProviderMockRecorder) JsonUnmarshal(input, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JsonUnmarshal", reflect.TypeOf((*MockinfraProvider)(nil).JsonUnmarshal), input, dest)
+}
+
+// ReadAll mocks base method.
+func (m *MockinfraProvider) ReadAll(input io.Reader) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockinfraProviderMockRecorder) ReadAll(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockinfraProvider)(nil).ReadAll), input)
+}
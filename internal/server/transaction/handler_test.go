@@ -0,0 +1,26 @@
+package transaction
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockInfra := NewMockinfraProvider(ctrl)
+	mockTransactionUC := NewMocktransactionUCManager(ctrl)
+
+	want := &Handler{
+		infra:       mockInfra,
+		transaction: mockTransactionUC,
+	}
+
+	assert.Equal(t, want, NewHandler(TransactionHandlerParam{
+		Infra:       mockInfra,
+		Transaction: mockTransactionUC,
+	}))
+}
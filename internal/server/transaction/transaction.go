@@ -0,0 +1,353 @@
+package transaction
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+)
+
+const (
+	limitKey         = "limit"
+	offsetKey        = "offset"
+	transactionIDKey = "transaction_id"
+	walletIDKey      = "wallet_id"
+)
+
+var (
+	errLimitInvalid         = errors.New("limit not valid")
+	errOffsetInvalid        = errors.New("offset not valid")
+	errTransactionIDInvalid = errors.New("transaction_id not valid")
+	errUnauthorized         = errors.New("unauthorized!")
+	errWalletIDInvalid      = errors.New("wallet_id not valid")
+)
+
+// HandleCreateTransaction will record a new transaction for user
+// and adjust the balance of its wallet.
+func (h *Handler) HandleCreateTransaction(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response transactionResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request createTransactionParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	created, err := h.transaction.CreateTransaction(r.Context(), transaction.CreateTransactionParam{
+		Amount:       request.Amount,
+		CategoryID:   request.CategoryID,
+		Note:         request.Note,
+		Payee:        request.Payee,
+		Tags:         request.Tags,
+		TransactedAt: request.TransactedAt,
+		Type:         request.Type,
+		WalletID:     request.WalletID,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+
+		var validationErr *transaction.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	response.Code = http.StatusCreated
+	response.Transaction = &created
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleDeleteTransaction will delete a transaction of user
+// and take it out of the balance of its wallet.
+func (h *Handler) HandleDeleteTransaction(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	transactionID, err := strconv.ParseInt(mux.Vars(r)[transactionIDKey], 10, 64)
+	if err != nil || transactionID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTransactionIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.transaction.DeleteTransaction(r.Context(), transactionID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetTransaction will fetch a transaction of user.
+func (h *Handler) HandleGetTransaction(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response transactionResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	transactionID, err := strconv.ParseInt(mux.Vars(r)[transactionIDKey], 10, 64)
+	if err != nil || transactionID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTransactionIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.transaction.GetTransaction(r.Context(), transactionID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Transaction = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetTransactions will list transactions of user, ordered from the most recent transaction.
+// The result is paginated using limit and offset, and can be narrowed to a wallet using wallet_id.
+func (h *Handler) HandleGetTransactions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response transactionsResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	query := r.URL.Query()
+	limit, err := parseOptionalInt(query.Get(limitKey))
+	if err != nil || limit < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errLimitInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	offset, err := parseOptionalInt(query.Get(offsetKey))
+	if err != nil || offset < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errOffsetInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var walletID int64
+	if value := query.Get(walletIDKey); value != "" {
+		walletID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || walletID <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			response.Code = http.StatusBadRequest
+			response.Error = errWalletIDInvalid.Error()
+
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+	}
+
+	transactions, err := h.transaction.ListTransactions(r.Context(), transaction.ListTransactionsParam{
+		Limit:    limit,
+		Offset:   offset,
+		WalletID: walletID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Transactions = transactions
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleUpdateTransaction will update a transaction of user.
+// Fields left out of the request keep their current value,
+// and the balance of the affected wallets follows the change.
+func (h *Handler) HandleUpdateTransaction(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response transactionResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	transactionID, err := strconv.ParseInt(mux.Vars(r)[transactionIDKey], 10, 64)
+	if err != nil || transactionID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errTransactionIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request updateTransactionParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	updated, err := h.transaction.UpdateTransaction(r.Context(), transactionID, transaction.UpdateTransactionParam{
+		Amount:       request.Amount,
+		CategoryID:   request.CategoryID,
+		Note:         request.Note,
+		Payee:        request.Payee,
+		Tags:         request.Tags,
+		TransactedAt: request.TransactedAt,
+		Type:         request.Type,
+		WalletID:     request.WalletID,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		var validationErr *transaction.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Transaction = &updated
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseOptionalInt will parse an optional integer query parameter.
+// An empty value is parsed as zero.
+func parseOptionalInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,547 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+)
+
+func TestHandler_HandleCreateTransaction(t *testing.T) {
+	type mockFields struct {
+		infra         *MockinfraProvider
+		transactionUC *MocktransactionUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockRequest := createTransactionParam{
+		Amount:       25000,
+		Payee:        "Warung",
+		Tags:         []string{"food"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		WalletID:     1,
+	}
+	mockParam := transaction.CreateTransactionParam{
+		Amount:       25000,
+		Payee:        "Warung",
+		Tags:         []string{"food"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		WalletID:     1,
+	}
+	mockUnmarshal := func(request createTransactionParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*createTransactionParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_request_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().CreateTransaction(ctx, mockParam).Return(transaction.Transaction{}, &transaction.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_CreateTransaction_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().CreateTransaction(ctx, mockParam).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_created",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().CreateTransaction(ctx, mockParam).Return(transaction.Transaction{ID: 1}, nil)
+			},
+			wantCode: http.StatusCreated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra:         NewMockinfraProvider(ctrl),
+				transactionUC: NewMocktransactionUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				infra:       mockFields.infra,
+				transaction: mockFields.transactionUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transactions", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleCreateTransaction(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleDeleteTransaction(t *testing.T) {
+	type mockFields struct {
+		transactionUC *MocktransactionUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		transactionID string
+		mockFields    func(mockFields)
+		wantCode      int
+	}{
+		{
+			name:          "when_principal_not_exist_then_return_unauthorized",
+			ctx:           context.Background(),
+			transactionID: "1",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_transaction_id_invalid_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "abc",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusBadRequest,
+		},
+		{
+			name:          "when_transaction_not_exist_then_return_not_found",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().DeleteTransaction(gomock.Any(), int64(1)).Return(transaction.ErrTransactionNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "when_DeleteTransaction_error_then_return_internal_server_error",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().DeleteTransaction(gomock.Any(), int64(1)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "when_no_error_occured_then_return_ok",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().DeleteTransaction(gomock.Any(), int64(1)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionUC: NewMocktransactionUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				transaction: mockFields.transactionUC,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/transactions/"+test.transactionID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				transactionIDKey: test.transactionID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleDeleteTransaction(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetTransaction(t *testing.T) {
+	type mockFields struct {
+		transactionUC *MocktransactionUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		transactionID string
+		mockFields    func(mockFields)
+		wantCode      int
+	}{
+		{
+			name:          "when_principal_not_exist_then_return_unauthorized",
+			ctx:           context.Background(),
+			transactionID: "1",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_transaction_id_invalid_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "0",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusBadRequest,
+		},
+		{
+			name:          "when_transaction_not_exist_then_return_not_found",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().GetTransaction(gomock.Any(), int64(1)).Return(transaction.Transaction{}, transaction.ErrTransactionNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "when_GetTransaction_error_then_return_internal_server_error",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().GetTransaction(gomock.Any(), int64(1)).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "when_no_error_occured_then_return_ok",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().GetTransaction(gomock.Any(), int64(1)).Return(transaction.Transaction{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionUC: NewMocktransactionUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				transaction: mockFields.transactionUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/transactions/"+test.transactionID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				transactionIDKey: test.transactionID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleGetTransaction(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetTransactions(t *testing.T) {
+	type mockFields struct {
+		transactionUC *MocktransactionUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/transactions",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_limit_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/transactions?limit=-1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_offset_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/transactions?offset=abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "when_wallet_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/transactions?wallet_id=0",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_ListTransactions_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/transactions",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().ListTransactions(ctx, transaction.ListTransactionsParam{}).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/transactions?limit=10&offset=20&wallet_id=1",
+			mockFields: func(mf mockFields) {
+				mf.transactionUC.EXPECT().ListTransactions(ctx, transaction.ListTransactionsParam{
+					Limit:    10,
+					Offset:   20,
+					WalletID: 1,
+				}).Return([]transaction.Transaction{{ID: 1}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionUC: NewMocktransactionUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				transaction: mockFields.transactionUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetTransactions(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleUpdateTransaction(t *testing.T) {
+	type mockFields struct {
+		infra         *MockinfraProvider
+		transactionUC *MocktransactionUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	amount := int64(30000)
+	walletID := int64(2)
+	mockRequest := updateTransactionParam{
+		Amount:   &amount,
+		WalletID: &walletID,
+	}
+	mockParam := transaction.UpdateTransactionParam{
+		Amount:   &amount,
+		WalletID: &walletID,
+	}
+	mockUnmarshal := func(request updateTransactionParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*updateTransactionParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		transactionID string
+		mockFields    func(mockFields)
+		wantCode      int
+	}{
+		{
+			name:          "when_principal_not_exist_then_return_unauthorized",
+			ctx:           context.Background(),
+			transactionID: "1",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "when_transaction_id_invalid_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "-1",
+			mockFields:    func(mf mockFields) {},
+			wantCode:      http.StatusBadRequest,
+		},
+		{
+			name:          "when_ReadAll_error_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:          "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:          "when_request_invalid_then_return_bad_request",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().UpdateTransaction(gomock.Any(), int64(1), mockParam).Return(transaction.Transaction{}, &transaction.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:          "when_transaction_not_exist_then_return_not_found",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().UpdateTransaction(gomock.Any(), int64(1), mockParam).Return(transaction.Transaction{}, transaction.ErrTransactionNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "when_UpdateTransaction_error_then_return_internal_server_error",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().UpdateTransaction(gomock.Any(), int64(1), mockParam).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "when_no_error_occured_then_return_ok",
+			ctx:           ctx,
+			transactionID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateTransactionParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.transactionUC.EXPECT().UpdateTransaction(gomock.Any(), int64(1), mockParam).Return(transaction.Transaction{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra:         NewMockinfraProvider(ctrl),
+				transactionUC: NewMocktransactionUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				infra:       mockFields.infra,
+				transaction: mockFields.transactionUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/transactions/"+test.transactionID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				transactionIDKey: test.transactionID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleUpdateTransaction(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package transaction
+
+import (
+	// golang package
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/transaction"
+)
+
+// -------------------------
+// | structs for parameter |
+// -------------------------
+
+// createTransactionParam represents parameters needed to record a transaction.
+// Amount is in minor unit of the wallet's currency. CategoryID can be left out for an uncategorized transaction.
+type createTransactionParam struct {
+	Amount       int64     `json:"amount"`
+	CategoryID   int64     `json:"category_id"`
+	Note         string    `json:"note"`
+	Payee        string    `json:"payee"`
+	Tags         []string  `json:"tags"`
+	TransactedAt time.Time `json:"transacted_at"`
+	Type         string    `json:"type"`
+	WalletID     int64     `json:"wallet_id"`
+}
+
+// updateTransactionParam represents parameters needed to update a transaction.
+// A field that is left out of the request keeps its current value.
+type updateTransactionParam struct {
+	Amount       *int64     `json:"amount"`
+	CategoryID   *int64     `json:"category_id"`
+	Note         *string    `json:"note"`
+	Payee        *string    `json:"payee"`
+	Tags         *[]string  `json:"tags"`
+	TransactedAt *time.Time `json:"transacted_at"`
+	Type         *string    `json:"type"`
+	WalletID     *int64     `json:"wallet_id"`
+}
+
+// ------------------------
+// | structs for response |
+// ------------------------
+
+// defaultResponse represents default response of an API call
+type defaultResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// transactionResponse represents response that will be given by endpoint POST /transactions,
+// GET /transactions/{transaction_id} and PATCH /transactions/{transaction_id}.
+// Fields is only filled when the request isn't valid.
+type transactionResponse struct {
+	defaultResponse
+	Fields      []transaction.FieldError `json:"fields,omitempty"`
+	Transaction *transaction.Transaction `json:"transaction,omitempty"`
+}
+
+// transactionsResponse represents response that will be given by endpoint GET /transactions
+type transactionsResponse struct {
+	defaultResponse
+	Transactions []transaction.Transaction `json:"transactions"`
+}
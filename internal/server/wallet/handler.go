@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"io"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
+)
+
+//go:generate mockgen -source=handler.go -destination=handler_mock.go -package=wallet
+
+// walletUCManager holds all methods served by usecase wallet that will be needed by wallet handler.
+type walletUCManager interface {
+	// CreateWallet will create a new wallet for the user acting on ctx.
+	// The new wallet is placed after every other wallet of the user.
+	CreateWallet(ctx context.Context, param wallet.CreateWalletParam) (wallet.Wallet, error)
+
+	// DeleteWallet will delete a wallet of the user acting on ctx.
+	// A user can only delete their own wallet, and only while no transaction is booked to it.
+	DeleteWallet(ctx context.Context, walletID int64) error
+
+	// GetWallet will fetch a wallet of the user acting on ctx.
+	GetWallet(ctx context.Context, walletID int64) (wallet.Wallet, error)
+
+	// ListWallets will fetch wallets of the user acting on ctx, ordered by their display order.
+	// Archived wallets are left out unless param.IncludeArchived is true.
+	ListWallets(ctx context.Context, param wallet.ListWalletsParam) ([]wallet.Wallet, error)
+
+	// UpdateWallet will update a wallet of the user acting on ctx and return the updated wallet.
+	// Only fields given in param are changed.
+	UpdateWallet(ctx context.Context, walletID int64, param wallet.UpdateWalletParam) (wallet.Wallet, error)
+}
+
+// infraProvider holds all methods served by infra that will be needed by wallet handler.
+type infraProvider interface {
+	// JsonUnmarshal parses the JSON-encoded data and stores the result in the value pointed to by dest.
+	JsonUnmarshal(input []byte, dest interface{}) error
+
+	// ReadAll reads from r until an error or EOF and returns the data it read.
+	// A successful call returns err == nil, not err == EOF. Because ReadAll is
+	// defined to read from src until EOF, it does not treat an EOF from Read
+	// as an error to be reported.
+	ReadAll(input io.Reader) ([]byte, error)
+}
+
+// WalletHandlerParam holds all parameters needed to instantiate a new wallet Handler.
+type WalletHandlerParam struct {
+	Infra  infraProvider
+	Wallet walletUCManager
+}
+
+type Handler struct {
+	infra  infraProvider
+	wallet walletUCManager
+}
+
+// NewHandler instantiate a new instance of Handler.
+func NewHandler(param WalletHandlerParam) *Handler {
+	return &Handler{
+		infra:  param.Infra,
+		wallet: param.Wallet,
+	}
+}
@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package wallet is a generated GoMock package.
+package wallet
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	wallet "github.com/arifinhermawan/bubi/internal/usecase/wallet"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockwalletUCManager is a mock of walletUCManager interface.
+type MockwalletUCManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockwalletUCManagerMockRecorder
+}
+
+// MockwalletUCManagerMockRecorder is the mock recorder for MockwalletUCManager.
+type MockwalletUCManagerMockRecorder struct {
+	mock *MockwalletUCManager
+}
+
+// NewMockwalletUCManager creates a new mock instance.
+func NewMockwalletUCManager(ctrl *gomock.Controller) *MockwalletUCManager {
+	mock := &MockwalletUCManager{ctrl: ctrl}
+	mock.recorder = &MockwalletUCManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwalletUCManager) EXPECT() *MockwalletUCManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockwalletUCManager) CreateWallet(ctx context.Context, param wallet.CreateWalletParam) (wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, param)
+	ret0, _ := ret[0].(wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockwalletUCManagerMockRecorder) CreateWallet(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockwalletUCManager)(nil).CreateWallet), ctx, param)
+}
+
+// DeleteWallet mocks base method.
+func (m *MockwalletUCManager) DeleteWallet(ctx context.Context, walletID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWallet", ctx, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWallet indicates an expected call of DeleteWallet.
+func (mr *MockwalletUCManagerMockRecorder) DeleteWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWallet", reflect.TypeOf((*MockwalletUCManager)(nil).DeleteWallet), ctx, walletID)
+}
+
+// GetWallet mocks base method.
+func (m *MockwalletUCManager) GetWallet(ctx context.Context, walletID int64) (wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, walletID)
+	ret0, _ := ret[0].(wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockwalletUCManagerMockRecorder) GetWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockwalletUCManager)(nil).GetWallet), ctx, walletID)
+}
+
+// ListWallets mocks base method.
+func (m *MockwalletUCManager) ListWallets(ctx context.Context, param wallet.ListWalletsParam) ([]wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWallets", ctx, param)
+	ret0, _ := ret[0].([]wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWallets indicates an expected call of ListWallets.
+func (mr *MockwalletUCManagerMockRecorder) ListWallets(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWallets", reflect.TypeOf((*MockwalletUCManager)(nil).ListWallets), ctx, param)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockwalletUCManager) UpdateWallet(ctx context.Context, walletID int64, param wallet.UpdateWalletParam) (wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, walletID, param)
+	ret0, _ := ret[0].(wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockwalletUCManagerMockRecorder) UpdateWallet(ctx, walletID, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockwalletUCManager)(nil).UpdateWallet), ctx, walletID, param)
+}
+
+// MockinfraProvider is a mock of infraProvider interface.
+type MockinfraProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfraProviderMockRecorder
+}
+
+// MockinfraProviderMockRecorder is the mock recorder for MockinfraProvider.
+type MockinfraProviderMockRecorder struct {
+	mock *MockinfraProvider
+}
+
+// NewMockinfraProvider creates a new mock instance.
+func NewMockinfraProvider(ctrl *gomock.Controller) *MockinfraProvider {
+	mock := &MockinfraProvider{ctrl: ctrl}
+	mock.recorder = &MockinfraProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
+	return m.recorder
+}
+
+// JsonUnmarshal mocks base method.
+func (m *MockinfraProvider) JsonUnmarshal(input []byte, dest interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JsonUnmarshal", input, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JsonUnmarshal indicates an expected call of JsonUnmarshal.
+func (mr *MockinfraProviderMockRecorder) JsonUnmarshal(input, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JsonUnmarshal", reflect.TypeOf((*MockinfraProvider)(nil).JsonUnmarshal), input, dest)
+}
+
+// ReadAll mocks base method.
+func (m *MockinfraProvider) ReadAll(input io.Reader) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockinfraProviderMockRecorder) ReadAll(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockinfraProvider)(nil).ReadAll), input)
+}
@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockInfra := NewMockinfraProvider(ctrl)
+	mockWalletUC := NewMockwalletUCManager(ctrl)
+
+	want := &Handler{
+		infra:  mockInfra,
+		wallet: mockWalletUC,
+	}
+
+	assert.Equal(t, want, NewHandler(WalletHandlerParam{
+		Infra:  mockInfra,
+		Wallet: mockWalletUC,
+	}))
+}
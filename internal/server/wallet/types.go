@@ -0,0 +1,55 @@
+package wallet
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
+)
+
+// -------------------------
+// | structs for parameter |
+// -------------------------
+
+// createWalletParam represents parameters needed to create a wallet.
+// OpeningBalance is in minor unit of the currency.
+type createWalletParam struct {
+	Currency       string `json:"currency"`
+	Name           string `json:"name"`
+	OpeningBalance int64  `json:"opening_balance"`
+	Type           string `json:"type"`
+}
+
+// updateWalletParam represents parameters needed to update a wallet.
+// A field that is left out of the request keeps its current value.
+type updateWalletParam struct {
+	Archived       *bool   `json:"archived"`
+	Currency       *string `json:"currency"`
+	DisplayOrder   *int    `json:"display_order"`
+	Name           *string `json:"name"`
+	OpeningBalance *int64  `json:"opening_balance"`
+	Type           *string `json:"type"`
+}
+
+// ------------------------
+// | structs for response |
+// ------------------------
+
+// defaultResponse represents default response of an API call
+type defaultResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// walletResponse represents response that will be given by endpoint POST /wallets,
+// GET /wallets/{wallet_id} and PATCH /wallets/{wallet_id}.
+// Fields is only filled when the request isn't valid.
+type walletResponse struct {
+	defaultResponse
+	Fields []wallet.FieldError `json:"fields,omitempty"`
+	Wallet *wallet.Wallet      `json:"wallet,omitempty"`
+}
+
+// walletsResponse represents response that will be given by endpoint GET /wallets
+type walletsResponse struct {
+	defaultResponse
+	Wallets []wallet.Wallet `json:"wallets"`
+}
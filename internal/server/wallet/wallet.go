@@ -0,0 +1,315 @@
+package wallet
+
+import (
+	// golang package
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	// external package
+	"github.com/gorilla/mux"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
+)
+
+const (
+	includeArchivedKey = "include_archived"
+	walletIDKey        = "wallet_id"
+)
+
+var (
+	errIncludeArchivedInvalid = errors.New("include_archived not valid")
+	errUnauthorized           = errors.New("unauthorized!")
+	errWalletIDInvalid        = errors.New("wallet_id not valid")
+)
+
+// HandleCreateWallet will create a new wallet for user.
+// The new wallet is placed after every other wallet of user.
+func (h *Handler) HandleCreateWallet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response walletResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request createWalletParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	created, err := h.wallet.CreateWallet(r.Context(), wallet.CreateWalletParam{
+		Currency:       request.Currency,
+		Name:           request.Name,
+		OpeningBalance: request.OpeningBalance,
+		Type:           request.Type,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+
+		var validationErr *wallet.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	response.Code = http.StatusCreated
+	response.Wallet = &created
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleDeleteWallet will delete a wallet of user.
+// To keep a wallet out of sight without losing it, archive it instead.
+func (h *Handler) HandleDeleteWallet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response defaultResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	walletID, err := strconv.ParseInt(mux.Vars(r)[walletIDKey], 10, 64)
+	if err != nil || walletID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errWalletIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.wallet.DeleteWallet(r.Context(), walletID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		switch {
+		case errors.Is(err, wallet.ErrWalletNotFound):
+			response.Code = http.StatusNotFound
+		case errors.Is(err, wallet.ErrWalletHasTransactions):
+			response.Code = http.StatusConflict
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetWallet will fetch a wallet of user.
+func (h *Handler) HandleGetWallet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response walletResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	walletID, err := strconv.ParseInt(mux.Vars(r)[walletIDKey], 10, 64)
+	if err != nil || walletID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errWalletIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	result, err := h.wallet.GetWallet(r.Context(), walletID)
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, wallet.ErrWalletNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Wallet = &result
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleGetWallets will list wallets of user, ordered by their display order.
+// Archived wallets are only listed when include_archived is true.
+func (h *Handler) HandleGetWallets(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response walletsResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var includeArchived bool
+	if value := r.URL.Query().Get(includeArchivedKey); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			response.Code = http.StatusBadRequest
+			response.Error = errIncludeArchivedInvalid.Error()
+
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		includeArchived = parsed
+	}
+
+	wallets, err := h.wallet.ListWallets(r.Context(), wallet.ListWalletsParam{
+		IncludeArchived: includeArchived,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		response.Code = http.StatusInternalServerError
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Wallets = wallets
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleUpdateWallet will update a wallet of user.
+// Fields left out of the request keep their current value,
+// setting archived hides the wallet from the wallet list.
+func (h *Handler) HandleUpdateWallet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var response walletResponse
+	_, ok := entity.GetPrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		response.Code = http.StatusUnauthorized
+		response.Error = errUnauthorized.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	walletID, err := strconv.ParseInt(mux.Vars(r)[walletIDKey], 10, 64)
+	if err != nil || walletID <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = errWalletIDInvalid.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	bytes, err := h.infra.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	var request updateWalletParam
+	err = h.infra.JsonUnmarshal(bytes, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		response.Code = http.StatusBadRequest
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	updated, err := h.wallet.UpdateWallet(r.Context(), walletID, wallet.UpdateWalletParam{
+		Archived:       request.Archived,
+		Currency:       request.Currency,
+		DisplayOrder:   request.DisplayOrder,
+		Name:           request.Name,
+		OpeningBalance: request.OpeningBalance,
+		Type:           request.Type,
+	})
+	if err != nil {
+		response.Code = http.StatusInternalServerError
+		if errors.Is(err, wallet.ErrWalletNotFound) {
+			response.Code = http.StatusNotFound
+		}
+
+		var validationErr *wallet.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Code = http.StatusBadRequest
+			response.Fields = validationErr.Fields
+		}
+
+		w.WriteHeader(response.Code)
+		response.Error = err.Error()
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	response.Code = http.StatusOK
+	response.Wallet = &updated
+	json.NewEncoder(w).Encode(response)
+}
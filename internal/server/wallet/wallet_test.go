@@ -0,0 +1,534 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/usecase/wallet"
+)
+
+func TestHandler_HandleCreateWallet(t *testing.T) {
+	type mockFields struct {
+		infra    *MockinfraProvider
+		walletUC *MockwalletUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockRequest := createWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+	}
+	mockParam := wallet.CreateWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+	}
+	mockUnmarshal := func(request createWalletParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*createWalletParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "when_ReadAll_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_request_invalid_then_return_bad_request",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().CreateWallet(ctx, mockParam).Return(wallet.Wallet{}, &wallet.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "when_CreateWallet_error_then_return_internal_server_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().CreateWallet(ctx, mockParam).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "when_no_error_occured_then_return_created",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest createWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().CreateWallet(ctx, mockParam).Return(wallet.Wallet{ID: 1}, nil)
+			},
+			wantCode: http.StatusCreated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra:    NewMockinfraProvider(ctrl),
+				walletUC: NewMockwalletUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				infra:  mockFields.infra,
+				wallet: mockFields.walletUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets", nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleCreateWallet(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleDeleteWallet(t *testing.T) {
+	type mockFields struct {
+		walletUC *MockwalletUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		walletID   string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			walletID:   "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_wallet_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			walletID:   "abc",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:     "when_wallet_not_exist_then_return_not_found",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().DeleteWallet(gomock.Any(), int64(1)).Return(wallet.ErrWalletNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "when_wallet_has_transactions_then_return_conflict",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().DeleteWallet(gomock.Any(), int64(1)).Return(wallet.ErrWalletHasTransactions)
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:     "when_DeleteWallet_error_then_return_internal_server_error",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().DeleteWallet(gomock.Any(), int64(1)).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "when_no_error_occured_then_return_ok",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().DeleteWallet(gomock.Any(), int64(1)).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletUC: NewMockwalletUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				wallet: mockFields.walletUC,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/wallets/"+test.walletID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				walletIDKey: test.walletID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleDeleteWallet(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetWallet(t *testing.T) {
+	type mockFields struct {
+		walletUC *MockwalletUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		walletID   string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			walletID:   "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_wallet_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			walletID:   "0",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:     "when_wallet_not_exist_then_return_not_found",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().GetWallet(gomock.Any(), int64(1)).Return(wallet.Wallet{}, wallet.ErrWalletNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "when_GetWallet_error_then_return_internal_server_error",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().GetWallet(gomock.Any(), int64(1)).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "when_no_error_occured_then_return_ok",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().GetWallet(gomock.Any(), int64(1)).Return(wallet.Wallet{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletUC: NewMockwalletUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				wallet: mockFields.walletUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/"+test.walletID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				walletIDKey: test.walletID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleGetWallet(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleGetWallets(t *testing.T) {
+	type mockFields struct {
+		walletUC *MockwalletUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		target     string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			target:     "/wallets",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_include_archived_invalid_then_return_bad_request",
+			ctx:        ctx,
+			target:     "/wallets?include_archived=maybe",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:   "when_ListWallets_error_then_return_internal_server_error",
+			ctx:    ctx,
+			target: "/wallets",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().ListWallets(ctx, wallet.ListWalletsParam{}).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "when_no_error_occured_then_return_ok",
+			ctx:    ctx,
+			target: "/wallets?include_archived=true",
+			mockFields: func(mf mockFields) {
+				mf.walletUC.EXPECT().ListWallets(ctx, wallet.ListWalletsParam{
+					IncludeArchived: true,
+				}).Return([]wallet.Wallet{{ID: 1}}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletUC: NewMockwalletUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				wallet: mockFields.walletUC,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, test.target, nil).WithContext(test.ctx)
+			w := httptest.NewRecorder()
+
+			h.HandleGetWallets(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_HandleUpdateWallet(t *testing.T) {
+	type mockFields struct {
+		infra    *MockinfraProvider
+		walletUC *MockwalletUCManager
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	archived := true
+	name := "BCA Tahapan"
+	mockRequest := updateWalletParam{
+		Archived: &archived,
+		Name:     &name,
+	}
+	mockParam := wallet.UpdateWalletParam{
+		Archived: &archived,
+		Name:     &name,
+	}
+	mockUnmarshal := func(request updateWalletParam) func(input []byte, dest interface{}) error {
+		return func(input []byte, dest interface{}) error {
+			*dest.(*updateWalletParam) = request
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		walletID   string
+		mockFields func(mockFields)
+		wantCode   int
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_unauthorized",
+			ctx:        context.Background(),
+			walletID:   "1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "when_wallet_id_invalid_then_return_bad_request",
+			ctx:        ctx,
+			walletID:   "-1",
+			mockFields: func(mf mockFields) {},
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:     "when_ReadAll_error_then_return_bad_request",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when_JsonUnmarshal_error_then_return_bad_request",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).Return(assert.AnError)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when_request_invalid_then_return_bad_request",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().UpdateWallet(gomock.Any(), int64(1), mockParam).Return(wallet.Wallet{}, &wallet.ValidationError{})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when_wallet_not_exist_then_return_not_found",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().UpdateWallet(gomock.Any(), int64(1), mockParam).Return(wallet.Wallet{}, wallet.ErrWalletNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "when_UpdateWallet_error_then_return_internal_server_error",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().UpdateWallet(gomock.Any(), int64(1), mockParam).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "when_no_error_occured_then_return_ok",
+			ctx:      ctx,
+			walletID: "1",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().ReadAll(gomock.Any()).Return(nil, nil)
+
+				var dest updateWalletParam
+				mf.infra.EXPECT().JsonUnmarshal(nil, &dest).DoAndReturn(mockUnmarshal(mockRequest))
+				mf.walletUC.EXPECT().UpdateWallet(gomock.Any(), int64(1), mockParam).Return(wallet.Wallet{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra:    NewMockinfraProvider(ctrl),
+				walletUC: NewMockwalletUCManager(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			h := &Handler{
+				infra:  mockFields.infra,
+				wallet: mockFields.walletUC,
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/wallets/"+test.walletID, nil).WithContext(test.ctx)
+			req = mux.SetURLVars(req, map[string]string{
+				walletIDKey: test.walletID,
+			})
+			w := httptest.NewRecorder()
+
+			h.HandleUpdateWallet(w, req)
+			assert.Equal(t, test.wantCode, w.Code)
+		})
+	}
+}
@@ -21,20 +21,90 @@ type dbRepoProvider interface {
 	// Commit will commit the transaction.
 	Commit(tx *sql.Tx) error
 
+	// DeletePersonalAccessToken will delete a personal access token of a user.
+	// It returns false if the user doesn't have the token.
+	DeletePersonalAccessToken(ctx context.Context, tx *sql.Tx, userID, tokenID int64) (bool, error)
+
+	// DeleteUserAccountsPendingDeletion will permanently delete every account whose deletion
+	// was requested at or before requestedBefore, alongside every row owned by those accounts.
+	// It returns id of the deleted accounts.
+	DeleteUserAccountsPendingDeletion(ctx context.Context, tx *sql.Tx, requestedBefore time.Time) ([]int64, error)
+
+	// DeleteUserTOTPRecoveryCode will remove a recovery code from recovery codes of a user whose TOTP is enabled.
+	// It returns false if the user doesn't have the code, so a recovery code can only be used once.
+	DeleteUserTOTPRecoveryCode(ctx context.Context, tx *sql.Tx, userID int64, codeHash string) (bool, error)
+
+	// GetAccountAuditEventsByUserID will fetch security events of a user,
+	// ordered from the most recent event.
+	GetAccountAuditEventsByUserID(ctx context.Context, param pgsql.GetAccountAuditEventsParam) ([]pgsql.AccountAuditEvent, error)
+
+	// GetPersonalAccessTokenByHash will fetch a personal access token alongside email of its owner
+	// based of hash of the token.
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (pgsql.PersonalAccessToken, error)
+
+	// GetPersonalAccessTokensByUserID will fetch every personal access token of a user,
+	// ordered from the most recently created token.
+	GetPersonalAccessTokensByUserID(ctx context.Context, userID int64) ([]pgsql.PersonalAccessToken, error)
+
 	// GetUserAccountByEmail will fetch user's information based of account's email.
 	GetUserAccountByEmail(ctx context.Context, email string) (pgsql.Account, error)
 
+	// GetUserAccountByID will fetch user's information based of account's id.
+	GetUserAccountByID(ctx context.Context, userID int64) (pgsql.Account, error)
+
+	// InsertAccountAuditEvent will create a new entry in table account_audit_event in database.
+	InsertAccountAuditEvent(ctx context.Context, tx *sql.Tx, param pgsql.InsertAccountAuditEventParam) error
+
+	// InsertAdminAuditLog will create a new entry in table admin_audit_log in database.
+	InsertAdminAuditLog(ctx context.Context, tx *sql.Tx, param pgsql.InsertAdminAuditLogParam) error
+
+	// InsertPersonalAccessToken will create a new entry in table personal_access_token in database.
+	// It returns id of the new entry.
+	InsertPersonalAccessToken(ctx context.Context, tx *sql.Tx, param pgsql.InsertPersonalAccessTokenParam) (int64, error)
+
 	// InsertUserAccount will create a new entry in table user_account in database.
 	InsertUserAccount(ctx context.Context, tx *sql.Tx, email, password string) error
 
 	// Rollback will aborts the transaction.
 	Rollback(tx *sql.Tx) error
 
+	// SearchUserAccounts will fetch accounts whose email, first name or last name contains param.Query,
+	// ordered by their id.
+	SearchUserAccounts(ctx context.Context, param pgsql.SearchUserAccountsParam) ([]pgsql.Account, error)
+
 	// UpdateUserAccount will update user's account information.
 	UpdateUserAccount(ctx context.Context, tx *sql.Tx, param pgsql.UpdateUserAccountParam) error
 
+	// UpdateUserDeletionRequested will set the time user requested to delete their account.
+	// An invalid requestedAt cancels a pending deletion.
+	UpdateUserDeletionRequested(ctx context.Context, tx *sql.Tx, userID int64, requestedAt sql.NullTime) error
+
+	// UpdateUserDisabled will set the time user's account was disabled.
+	// An invalid disabledAt enables the account again.
+	UpdateUserDisabled(ctx context.Context, tx *sql.Tx, userID int64, disabledAt sql.NullTime) error
+
+	// UpdateUserEmail will replace user's email with a new one.
+	// The new email is marked as verified, since its ownership had been confirmed before the update.
+	UpdateUserEmail(ctx context.Context, tx *sql.Tx, userID int64, email string) error
+
+	// UpdateUserEmailVerified will mark user's email as verified.
+	// An email that had been verified before will keep its verification time.
+	UpdateUserEmailVerified(ctx context.Context, tx *sql.Tx, userID int64) error
+
 	// UpdateUserPassword will update user's password.
 	UpdateUserPassword(ctx context.Context, tx *sql.Tx, userID int64, password string) error
+
+	// UpdateUserPasswordHash will replace the hash of user's password with password,
+	// only if the hash is still oldPassword. It returns false if the hash had been changed.
+	UpdateUserPasswordHash(ctx context.Context, tx *sql.Tx, userID int64, oldPassword, password string) (bool, error)
+
+	// UpdateUserTOTP will update user's TOTP secret, recovery codes, enablement time and last used time step.
+	UpdateUserTOTP(ctx context.Context, tx *sql.Tx, param pgsql.UpdateUserTOTPParam) error
+
+	// UpdateUserTOTPLastUsedStep will record the TOTP time step of a code user has just used.
+	// It returns false if TOTP of the user isn't enabled, or a code of the same or a later step
+	// had been used before, so a TOTP code can't be replayed.
+	UpdateUserTOTPLastUsedStep(ctx context.Context, tx *sql.Tx, userID, step int64) (bool, error)
 }
 
 // infraRepoProvider holds all methods from infra that will be needed in resource.
@@ -48,17 +118,55 @@ type infraRepoProvider interface {
 
 // redisRepoProvider holds all methods from redis repo that wil be used in account's resource.
 type redisRepoProvider interface {
+	// CompareAndSwap will replace the value of a redis key with value atomically,
+	// only if the key still holds old. The key keeps its time to live.
+	// It returns true if the value is replaced, so a key that doesn't exist is never created.
+	CompareAndSwap(ctx context.Context, key string, old, value interface{}) (bool, error)
+
 	// Del will delete a key in redis.
 	Del(ctx context.Context, key string) error
 
+	// Expire will set a timeout on a redis key.
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+
 	// Get will get the value of a redis key.
 	// First it will check whether the key exist or not.
 	// If it exists, then it will return the value.
 	// Otherwise, it returns empty string.
 	Get(ctx context.Context, key string) (string, error)
 
+	// GetDel will get the value of a redis key and delete the key atomically.
+	// If the key doesn't exist, it returns empty string.
+	GetDel(ctx context.Context, key string) (string, error)
+
+	// GetSet will save the value of a key to redis and return its previous value atomically.
+	// If the key doesn't exist before, it returns empty string.
+	GetSet(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error)
+
+	// Incr will increment the integer value of a redis key by one and return the new value.
+	// If the key doesn't exist, it is set to 0 before the increment.
+	Incr(ctx context.Context, key string) (int64, error)
+
+	// SAdd will add a member to a redis set.
+	SAdd(ctx context.Context, key, member string) error
+
+	// SMembers will get all members of a redis set.
+	// If the key doesn't exist, it returns empty slice.
+	SMembers(ctx context.Context, key string) ([]string, error)
+
+	// SRem will remove a member from a redis set.
+	SRem(ctx context.Context, key, member string) error
+
 	// Set will save the value of a key to redis.
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+
+	// SetNX will save the value of a key to redis only if the key doesn't exist.
+	// It returns true if the value is saved.
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+
+	// TTL will get the remaining time to live of a redis key.
+	// If the key doesn't exist or has no timeout, it returns 0.
+	TTL(ctx context.Context, key string) (time.Duration, error)
 }
 
 // AccountResourceParam holds all parameters needed to instantiate
@@ -9,77 +9,828 @@ import (
 )
 
 const (
-	redisKeyJWT = "account:jwt:"
+	redisKeyEmailChange             = "account:email_change:"
+	redisKeyEmailVerification       = "account:email_verification:"
+	redisKeyEmailVerificationResend = "account:email_verification_resend:"
+	redisKeyLoginBlock              = "account:login_block:"
+	redisKeyLoginFailure            = "account:login_failure:"
+	redisKeyMagicLink               = "account:magic_link:"
+	redisKeyMagicLinkCooldown       = "account:magic_link_cooldown:"
+	redisKeyMagicLinkIndex          = "account:magic_links:"
+	redisKeyMFAChallenge            = "account:mfa_challenge:"
+	redisKeyPasswordReset           = "account:password_reset:"
+	redisKeyPasswordResetCooldown   = "account:password_reset_cooldown:"
+	redisKeyPasswordResetIndex      = "account:password_resets:"
+	redisKeyRefreshToken            = "account:refresh:"
+	redisKeyRefreshTokenFamily      = "account:refresh_family:"
+	redisKeySession                 = "account:session:"
+	redisKeySessionIndex            = "account:sessions:"
 )
 
-// DeleteJWTInCache will delete JWT of a user.
+// DeleteJWTInCache will delete every session of a user,
+// so none of JWT and refresh token of the user can be used anymore.
 func (rsc *Resource) DeleteJWTInCache(ctx context.Context, userID int64) error {
-	key := buildJWTKey(userID)
+	key := buildSessionIndexKey(userID)
 
 	meta := map[string]interface{}{
 		"key": key,
 	}
 
+	sessionIDs, err := rsc.cache.SMembers(ctx, key)
+	if err != nil {
+		log.Printf("[DeleteJWTInCache] rsc.cache.SMembers() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		err = rsc.DeleteSessionInCache(ctx, userID, sessionID)
+		if err != nil {
+			log.Printf("[DeleteJWTInCache] rsc.DeleteSessionInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	err = rsc.cache.Del(ctx, key)
+	if err != nil {
+		log.Printf("[DeleteJWTInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMagicLinksInCache will delete every magic link login token of a user,
+// so none of the magic links sent to the user can be used anymore.
+func (rsc *Resource) DeleteMagicLinksInCache(ctx context.Context, userID int64) error {
+	key := buildMagicLinkIndexKey(userID)
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	tokenHashes, err := rsc.cache.SMembers(ctx, key)
+	if err != nil {
+		log.Printf("[DeleteMagicLinksInCache] rsc.cache.SMembers() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	for _, tokenHash := range tokenHashes {
+		err = rsc.cache.Del(ctx, redisKeyMagicLink+tokenHash)
+		if err != nil {
+			log.Printf("[DeleteMagicLinksInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	err = rsc.cache.Del(ctx, key)
+	if err != nil {
+		log.Printf("[DeleteMagicLinksInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// DeletePasswordResetTokensInCache will delete every password reset token of a user,
+// so none of the password reset links sent to the user can be used anymore.
+func (rsc *Resource) DeletePasswordResetTokensInCache(ctx context.Context, userID int64) error {
+	key := buildPasswordResetIndexKey(userID)
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	tokenHashes, err := rsc.cache.SMembers(ctx, key)
+	if err != nil {
+		log.Printf("[DeletePasswordResetTokensInCache] rsc.cache.SMembers() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	for _, tokenHash := range tokenHashes {
+		err = rsc.cache.Del(ctx, redisKeyPasswordReset+tokenHash)
+		if err != nil {
+			log.Printf("[DeletePasswordResetTokensInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	err = rsc.cache.Del(ctx, key)
+	if err != nil {
+		log.Printf("[DeletePasswordResetTokensInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLoginFailureInCache will reset the failed log in counter of a subject.
+func (rsc *Resource) DeleteLoginFailureInCache(ctx context.Context, subject string) error {
+	key := redisKeyLoginFailure + subject
+
 	err := rsc.cache.Del(ctx, key)
 	if err != nil {
-		log.Printf("[GetJWTFromCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[DeleteLoginFailureInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
 	return nil
 }
 
-// GetJWTFromCache will fetch JWT of a user from cache.
-// If the ke doesn't exist, it will return empty string
-func (rsc *Resource) GetJWTFromCache(ctx context.Context, userID int64) (string, error) {
-	key := buildJWTKey(userID)
+// DeleteSessionInCache will delete a session of a user alongside its refresh token family.
+// Once deleted, JWT and refresh token of that session can no longer be used.
+func (rsc *Resource) DeleteSessionInCache(ctx context.Context, userID int64, sessionID string) error {
+	meta := map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	err := rsc.cache.Del(ctx, buildSessionKey(userID, sessionID))
+	if err != nil {
+		log.Printf("[DeleteSessionInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.cache.Del(ctx, buildRefreshTokenFamilyKey(userID, sessionID))
+	if err != nil {
+		log.Printf("[DeleteSessionInCache] rsc.cache.Del() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.cache.SRem(ctx, buildSessionIndexKey(userID), sessionID)
+	if err != nil {
+		log.Printf("[DeleteSessionInCache] rsc.cache.SRem() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// GetLoginBlockFromCache will fetch the active log in block of a subject from cache.
+// If the subject isn't blocked, it will return empty LoginBlock.
+func (rsc *Resource) GetLoginBlockFromCache(ctx context.Context, subject string) (LoginBlock, error) {
+	key := redisKeyLoginBlock + subject
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisReason, err := rsc.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[GetLoginBlockFromCache] rsc.cache.Get() got an error: %+v\nMeta:%+v\n", err, meta)
+		return LoginBlock{}, err
+	}
+
+	if redisReason == "" {
+		return LoginBlock{}, nil
+	}
+
+	var reason string
+	err = rsc.infra.JsonUnmarshal([]byte(redisReason), &reason)
+	if err != nil {
+		log.Printf("[GetLoginBlockFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return LoginBlock{}, err
+	}
+
+	retryAfter, err := rsc.cache.TTL(ctx, key)
+	if err != nil {
+		log.Printf("[GetLoginBlockFromCache] rsc.cache.TTL() got an error: %+v\nMeta:%+v\n", err, meta)
+		return LoginBlock{}, err
+	}
+
+	return LoginBlock{
+		Reason:     reason,
+		RetryAfter: retryAfter,
+	}, nil
+}
+
+// GetRefreshTokenFromCache will fetch the owner of a refresh token from cache.
+// If the key doesn't exist, it will return empty RefreshToken.
+func (rsc *Resource) GetRefreshTokenFromCache(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	key := redisKeyRefreshToken + tokenHash
 
 	meta := map[string]interface{}{
 		"key": key,
 	}
 
-	redisJWT, err := rsc.cache.Get(ctx, key)
+	redisToken, err := rsc.cache.Get(ctx, key)
 	if err != nil {
-		log.Printf("[GetJWTFromCache] rsc.cache.Get() got an error: %+v\nMeta:%+v\n", err, meta)
-		return "", err
+		log.Printf("[GetRefreshTokenFromCache] rsc.cache.Get() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, err
 	}
 
-	if redisJWT == "" {
-		return "", nil
+	if redisToken == "" {
+		return RefreshToken{}, nil
 	}
 
-	var jwt string
-	err = rsc.infra.JsonUnmarshal([]byte(redisJWT), &jwt)
+	var token RefreshToken
+	err = rsc.infra.JsonUnmarshal([]byte(redisToken), &token)
 	if err != nil {
-		log.Printf("[GetJWTFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
-		return "", err
+		log.Printf("[GetRefreshTokenFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, err
 	}
 
-	return jwt, nil
+	return token, nil
 }
 
-// SetJWTToCache will save jwt of a user in cache.
-func (rsc *Resource) SetJWTToCache(ctx context.Context, userID int64, jwt string) error {
-	key := buildJWTKey(userID)
-	ttl := rsc.infra.GetConfig().JWT.TTL
+// GetRefreshTokenFamilyFromCache will fetch refresh token family of a session from cache.
+// If the key doesn't exist, it will return empty RefreshTokenFamily.
+func (rsc *Resource) GetRefreshTokenFamilyFromCache(ctx context.Context, userID int64, sessionID string) (RefreshTokenFamily, error) {
+	key := buildRefreshTokenFamilyKey(userID, sessionID)
 
 	meta := map[string]interface{}{
 		"key": key,
-		"ttl": ttl,
+	}
+
+	redisFamily, err := rsc.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[GetRefreshTokenFamilyFromCache] rsc.cache.Get() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshTokenFamily{}, err
+	}
+
+	if redisFamily == "" {
+		return RefreshTokenFamily{}, nil
+	}
+
+	var family RefreshTokenFamily
+	err = rsc.infra.JsonUnmarshal([]byte(redisFamily), &family)
+	if err != nil {
+		log.Printf("[GetRefreshTokenFamilyFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshTokenFamily{}, err
+	}
+
+	return family, nil
+}
+
+// GetSessionFromCache will fetch a session of a user from cache.
+// If the key doesn't exist, it will return empty Session.
+func (rsc *Resource) GetSessionFromCache(ctx context.Context, userID int64, sessionID string) (Session, error) {
+	key := buildSessionKey(userID, sessionID)
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisSession, err := rsc.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[GetSessionFromCache] rsc.cache.Get() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Session{}, err
+	}
+
+	if redisSession == "" {
+		return Session{}, nil
+	}
+
+	var session Session
+	err = rsc.infra.JsonUnmarshal([]byte(redisSession), &session)
+	if err != nil {
+		log.Printf("[GetSessionFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Session{}, err
+	}
+
+	return session, nil
+}
+
+// GetSessionIDsFromCache will fetch id of every session of a user from cache.
+// The ids might include sessions that had expired.
+func (rsc *Resource) GetSessionIDsFromCache(ctx context.Context, userID int64) ([]string, error) {
+	key := buildSessionIndexKey(userID)
+
+	sessionIDs, err := rsc.cache.SMembers(ctx, key)
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[GetSessionIDsFromCache] rsc.cache.SMembers() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return sessionIDs, nil
+}
+
+// IncrLoginFailureInCache will increment the failed log in counter of a subject and return the new count.
+// The counter expires once no failure happened for the configured window.
+func (rsc *Resource) IncrLoginFailureInCache(ctx context.Context, subject string) (int64, error) {
+	key := redisKeyLoginFailure + subject
+	window := rsc.infra.GetConfig().Account.Security.FailedAttemptWindow
+
+	meta := map[string]interface{}{
+		"key":    key,
+		"window": window,
+	}
+
+	count, err := rsc.cache.Incr(ctx, key)
+	if err != nil {
+		log.Printf("[IncrLoginFailureInCache] rsc.cache.Incr() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	err = rsc.cache.Expire(ctx, key, time.Second*time.Duration(window))
+	if err != nil {
+		log.Printf("[IncrLoginFailureInCache] rsc.cache.Expire() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// PopEmailChangeFromCache will fetch the owner of an email change confirmation token
+// and delete the token from cache atomically, so the token can only be used once.
+// If the key doesn't exist, it will return empty EmailChange.
+func (rsc *Resource) PopEmailChangeFromCache(ctx context.Context, tokenHash string) (EmailChange, error) {
+	key := redisKeyEmailChange + tokenHash
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisChange, err := rsc.cache.GetDel(ctx, key)
+	if err != nil {
+		log.Printf("[PopEmailChangeFromCache] rsc.cache.GetDel() got an error: %+v\nMeta:%+v\n", err, meta)
+		return EmailChange{}, err
+	}
+
+	if redisChange == "" {
+		return EmailChange{}, nil
+	}
+
+	var change EmailChange
+	err = rsc.infra.JsonUnmarshal([]byte(redisChange), &change)
+	if err != nil {
+		log.Printf("[PopEmailChangeFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return EmailChange{}, err
+	}
+
+	return change, nil
+}
+
+// PopEmailVerificationTokenFromCache will fetch id of the owner of an email verification token
+// and delete the token from cache atomically, so the token can only be used once.
+// If the key doesn't exist, it will return 0.
+func (rsc *Resource) PopEmailVerificationTokenFromCache(ctx context.Context, tokenHash string) (int64, error) {
+	key := redisKeyEmailVerification + tokenHash
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisUserID, err := rsc.cache.GetDel(ctx, key)
+	if err != nil {
+		log.Printf("[PopEmailVerificationTokenFromCache] rsc.cache.GetDel() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	if redisUserID == "" {
+		return 0, nil
+	}
+
+	var userID int64
+	err = rsc.infra.JsonUnmarshal([]byte(redisUserID), &userID)
+	if err != nil {
+		log.Printf("[PopEmailVerificationTokenFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// PopMagicLinkFromCache will fetch the owner of a magic link login token
+// and delete the token from cache atomically, so the token can only be used once.
+// If the key doesn't exist, it will return empty MagicLink.
+func (rsc *Resource) PopMagicLinkFromCache(ctx context.Context, tokenHash string) (MagicLink, error) {
+	key := redisKeyMagicLink + tokenHash
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisLink, err := rsc.cache.GetDel(ctx, key)
+	if err != nil {
+		log.Printf("[PopMagicLinkFromCache] rsc.cache.GetDel() got an error: %+v\nMeta:%+v\n", err, meta)
+		return MagicLink{}, err
+	}
+
+	if redisLink == "" {
+		return MagicLink{}, nil
+	}
+
+	var link MagicLink
+	err = rsc.infra.JsonUnmarshal([]byte(redisLink), &link)
+	if err != nil {
+		log.Printf("[PopMagicLinkFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return MagicLink{}, err
+	}
+
+	return link, nil
+}
+
+// PopMFAChallengeFromCache will fetch the owner of an MFA challenge token
+// and delete the token from cache atomically, so the token can only be used once.
+// If the key doesn't exist, it will return empty MFAChallenge.
+func (rsc *Resource) PopMFAChallengeFromCache(ctx context.Context, tokenHash string) (MFAChallenge, error) {
+	key := redisKeyMFAChallenge + tokenHash
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisChallenge, err := rsc.cache.GetDel(ctx, key)
+	if err != nil {
+		log.Printf("[PopMFAChallengeFromCache] rsc.cache.GetDel() got an error: %+v\nMeta:%+v\n", err, meta)
+		return MFAChallenge{}, err
+	}
+
+	if redisChallenge == "" {
+		return MFAChallenge{}, nil
+	}
+
+	var challenge MFAChallenge
+	err = rsc.infra.JsonUnmarshal([]byte(redisChallenge), &challenge)
+	if err != nil {
+		log.Printf("[PopMFAChallengeFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return MFAChallenge{}, err
+	}
+
+	return challenge, nil
+}
+
+// PopPasswordResetTokenFromCache will fetch id of the owner of a password reset token
+// and delete the token from cache atomically, so the token can only be used once.
+// If the key doesn't exist, it will return 0.
+func (rsc *Resource) PopPasswordResetTokenFromCache(ctx context.Context, tokenHash string) (int64, error) {
+	key := redisKeyPasswordReset + tokenHash
+
+	meta := map[string]interface{}{
+		"key": key,
+	}
+
+	redisUserID, err := rsc.cache.GetDel(ctx, key)
+	if err != nil {
+		log.Printf("[PopPasswordResetTokenFromCache] rsc.cache.GetDel() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	if redisUserID == "" {
+		return 0, nil
+	}
+
+	var userID int64
+	err = rsc.infra.JsonUnmarshal([]byte(redisUserID), &userID)
+	if err != nil {
+		log.Printf("[PopPasswordResetTokenFromCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// SetEmailChangeToCache will save the owner of an email change confirmation token in cache.
+func (rsc *Resource) SetEmailChangeToCache(ctx context.Context, tokenHash string, change EmailChange) error {
+	key := redisKeyEmailChange + tokenHash
+	ttl := rsc.infra.GetConfig().Account.EmailChangeTTL
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": change.UserID,
+	}
+
+	ttlDuration := time.Minute * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, change, ttlDuration)
+	if err != nil {
+		log.Printf("[SetEmailChangeToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetEmailVerificationCooldownToCache will start the resend cooldown of user's verification email.
+// It returns false if the previous cooldown is still running.
+func (rsc *Resource) SetEmailVerificationCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	key := redisKeyEmailVerificationResend + strconv.FormatInt(userID, 10)
+	cooldown := rsc.infra.GetConfig().Account.EmailVerificationResendCooldown
+
+	meta := map[string]interface{}{
+		"key":      key,
+		"cooldown": cooldown,
+	}
+
+	cooldownDuration := time.Second * time.Duration(cooldown)
+	ok, err := rsc.cache.SetNX(ctx, key, userID, cooldownDuration)
+	if err != nil {
+		log.Printf("[SetEmailVerificationCooldownToCache] rsc.cache.SetNX() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// SetEmailVerificationTokenToCache will save id of the owner of an email verification token in cache.
+func (rsc *Resource) SetEmailVerificationTokenToCache(ctx context.Context, tokenHash string, userID int64) error {
+	key := redisKeyEmailVerification + tokenHash
+	ttl := rsc.infra.GetConfig().Account.EmailVerificationTTL
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": userID,
+	}
+
+	ttlDuration := time.Minute * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, userID, ttlDuration)
+	if err != nil {
+		log.Printf("[SetEmailVerificationTokenToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetLoginBlockToCache will refuse log in attempts of a subject until the block expires.
+func (rsc *Resource) SetLoginBlockToCache(ctx context.Context, subject string, block LoginBlock) error {
+	key := redisKeyLoginBlock + subject
+
+	err := rsc.cache.Set(ctx, key, block.Reason, block.RetryAfter)
+	if err != nil {
+		meta := map[string]interface{}{
+			"key":         key,
+			"reason":      block.Reason,
+			"retry_after": block.RetryAfter,
+		}
+
+		log.Printf("[SetLoginBlockToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetMagicLinkCooldownToCache will start the cooldown of user's magic link email.
+// It returns false if the previous cooldown is still running.
+func (rsc *Resource) SetMagicLinkCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	key := redisKeyMagicLinkCooldown + strconv.FormatInt(userID, 10)
+	cooldown := rsc.infra.GetConfig().Account.MagicLinkCooldown
+
+	meta := map[string]interface{}{
+		"key":      key,
+		"cooldown": cooldown,
+	}
+
+	cooldownDuration := time.Second * time.Duration(cooldown)
+	ok, err := rsc.cache.SetNX(ctx, key, userID, cooldownDuration)
+	if err != nil {
+		log.Printf("[SetMagicLinkCooldownToCache] rsc.cache.SetNX() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// SetMagicLinkToCache will save the owner of a magic link login token in cache.
+func (rsc *Resource) SetMagicLinkToCache(ctx context.Context, tokenHash string, link MagicLink) error {
+	key := redisKeyMagicLink + tokenHash
+	ttl := rsc.infra.GetConfig().Account.MagicLinkTTL
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": link.UserID,
+	}
+
+	ttlDuration := time.Minute * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, link, ttlDuration)
+	if err != nil {
+		log.Printf("[SetMagicLinkToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	// the list lives as long as the newest token, so it outlives every token in it.
+	indexKey := buildMagicLinkIndexKey(link.UserID)
+	err = rsc.cache.SAdd(ctx, indexKey, tokenHash)
+	if err != nil {
+		log.Printf("[SetMagicLinkToCache] rsc.cache.SAdd() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.cache.Expire(ctx, indexKey, ttlDuration)
+	if err != nil {
+		log.Printf("[SetMagicLinkToCache] rsc.cache.Expire() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetMFAChallengeToCache will save the owner of an MFA challenge token in cache.
+func (rsc *Resource) SetMFAChallengeToCache(ctx context.Context, tokenHash string, challenge MFAChallenge) error {
+	key := redisKeyMFAChallenge + tokenHash
+	ttl := rsc.infra.GetConfig().Account.TOTP.MFAChallengeTTL
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": challenge.UserID,
 	}
 
 	ttlDuration := time.Second * time.Duration(ttl)
-	err := rsc.cache.Set(ctx, key, jwt, ttlDuration)
+	err := rsc.cache.Set(ctx, key, challenge, ttlDuration)
 	if err != nil {
-		log.Printf("[SetJWTToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[SetMFAChallengeToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
 	return nil
 }
 
-// buildJWTKey will build a redis key for jwt related case
-func buildJWTKey(userID int64) string {
+// SetPasswordResetCooldownToCache will start the cooldown of user's password reset email.
+// It returns false if the previous cooldown is still running.
+func (rsc *Resource) SetPasswordResetCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	key := redisKeyPasswordResetCooldown + strconv.FormatInt(userID, 10)
+	cooldown := rsc.infra.GetConfig().Account.PasswordResetCooldown
+
+	meta := map[string]interface{}{
+		"key":      key,
+		"cooldown": cooldown,
+	}
+
+	cooldownDuration := time.Second * time.Duration(cooldown)
+	ok, err := rsc.cache.SetNX(ctx, key, userID, cooldownDuration)
+	if err != nil {
+		log.Printf("[SetPasswordResetCooldownToCache] rsc.cache.SetNX() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// SetPasswordResetTokenToCache will save id of the owner of a password reset token in cache.
+func (rsc *Resource) SetPasswordResetTokenToCache(ctx context.Context, tokenHash string, userID int64) error {
+	key := redisKeyPasswordReset + tokenHash
+	ttl := rsc.infra.GetConfig().Account.PasswordResetTTL
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": userID,
+	}
+
+	ttlDuration := time.Minute * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, userID, ttlDuration)
+	if err != nil {
+		log.Printf("[SetPasswordResetTokenToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	// the list lives as long as the newest token, so it outlives every token in it.
+	indexKey := buildPasswordResetIndexKey(userID)
+	err = rsc.cache.SAdd(ctx, indexKey, tokenHash)
+	if err != nil {
+		log.Printf("[SetPasswordResetTokenToCache] rsc.cache.SAdd() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.cache.Expire(ctx, indexKey, ttlDuration)
+	if err != nil {
+		log.Printf("[SetPasswordResetTokenToCache] rsc.cache.Expire() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetRefreshTokenToCache will save the owner of a refresh token in cache.
+func (rsc *Resource) SetRefreshTokenToCache(ctx context.Context, tokenHash string, token RefreshToken) error {
+	key := redisKeyRefreshToken + tokenHash
+	ttl := rsc.infra.GetConfig().Account.ExpiredTimeInHour
+
+	meta := map[string]interface{}{
+		"key":     key,
+		"ttl":     ttl,
+		"user_id": token.UserID,
+	}
+
+	ttlDuration := time.Hour * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, token, ttlDuration)
+	if err != nil {
+		log.Printf("[SetRefreshTokenToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SetSessionToCache will save a session of a user in cache
+// and register it to the list of user's sessions.
+func (rsc *Resource) SetSessionToCache(ctx context.Context, session Session) error {
+	key := buildSessionKey(session.UserID, session.SessionID)
+	ttl := rsc.infra.GetConfig().Account.ExpiredTimeInHour
+
+	meta := map[string]interface{}{
+		"key": key,
+		"ttl": ttl,
+	}
+
+	ttlDuration := time.Hour * time.Duration(ttl)
+	err := rsc.cache.Set(ctx, key, session, ttlDuration)
+	if err != nil {
+		log.Printf("[SetSessionToCache] rsc.cache.Set() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.cache.SAdd(ctx, buildSessionIndexKey(session.UserID), session.SessionID)
+	if err != nil {
+		log.Printf("[SetSessionToCache] rsc.cache.SAdd() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SwapRefreshTokenFamilyInCache will save refresh token family of a session in cache
+// and return the family that was saved before atomically.
+// If there is no family saved before, it will return empty RefreshTokenFamily.
+func (rsc *Resource) SwapRefreshTokenFamilyInCache(ctx context.Context, userID int64, sessionID string, family RefreshTokenFamily) (RefreshTokenFamily, error) {
+	key := buildRefreshTokenFamilyKey(userID, sessionID)
+	ttl := rsc.infra.GetConfig().Account.ExpiredTimeInHour
+
+	meta := map[string]interface{}{
+		"key": key,
+		"ttl": ttl,
+	}
+
+	ttlDuration := time.Hour * time.Duration(ttl)
+	redisFamily, err := rsc.cache.GetSet(ctx, key, family, ttlDuration)
+	if err != nil {
+		log.Printf("[SwapRefreshTokenFamilyInCache] rsc.cache.GetSet() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshTokenFamily{}, err
+	}
+
+	if redisFamily == "" {
+		return RefreshTokenFamily{}, nil
+	}
+
+	var previous RefreshTokenFamily
+	err = rsc.infra.JsonUnmarshal([]byte(redisFamily), &previous)
+	if err != nil {
+		log.Printf("[SwapRefreshTokenFamilyInCache] rsc.infra.JsonUnmarshal() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshTokenFamily{}, err
+	}
+
+	return previous, nil
+}
+
+// buildMagicLinkIndexKey will build a redis key for the list of user's magic link login tokens
+func buildMagicLinkIndexKey(userID int64) string {
+	userIDStr := strconv.FormatInt(userID, 10)
+	return redisKeyMagicLinkIndex + userIDStr
+}
+
+// buildPasswordResetIndexKey will build a redis key for the list of user's password reset tokens
+func buildPasswordResetIndexKey(userID int64) string {
+	userIDStr := strconv.FormatInt(userID, 10)
+	return redisKeyPasswordResetIndex + userIDStr
+}
+
+// buildRefreshTokenFamilyKey will build a redis key for refresh token family related case
+func buildRefreshTokenFamilyKey(userID int64, sessionID string) string {
+	userIDStr := strconv.FormatInt(userID, 10)
+	return redisKeyRefreshTokenFamily + userIDStr + ":" + sessionID
+}
+
+// buildSessionKey will build a redis key for session related case
+func buildSessionKey(userID int64, sessionID string) string {
 	userIDStr := strconv.FormatInt(userID, 10)
-	return redisKeyJWT + userIDStr
+	return redisKeySession + userIDStr + ":" + sessionID
+}
+
+// buildSessionIndexKey will build a redis key for the list of user's sessions
+func buildSessionIndexKey(userID int64) string {
+	userIDStr := strconv.FormatInt(userID, 10)
+	return redisKeySessionIndex + userIDStr
+}
+
+// UpdateSessionLastSeenInCache will save lastSeenAt as last seen time of a session in cache,
+// only if the session saved in cache is still the same as session.
+// A session that is revoked or changed since it was read is left as it is, and it keeps its expiry.
+func (rsc *Resource) UpdateSessionLastSeenInCache(ctx context.Context, session Session, lastSeenAt time.Time) error {
+	key := buildSessionKey(session.UserID, session.SessionID)
+
+	seen := session
+	seen.LastSeenAt = lastSeenAt
+	_, err := rsc.cache.CompareAndSwap(ctx, key, session, seen)
+	if err != nil {
+		meta := map[string]interface{}{
+			"key": key,
+		}
+
+		log.Printf("[UpdateSessionLastSeenInCache] rsc.cache.CompareAndSwap() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
 }
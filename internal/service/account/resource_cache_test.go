@@ -15,7 +15,7 @@ import (
 )
 
 func TestResource_DeleteJWTInCache(t *testing.T) {
-	mockKey := "account:jwt:3"
+	mockKey := "account:sessions:3"
 	type mockFields struct {
 		cache *MockredisRepoProvider
 	}
@@ -29,10 +29,28 @@ func TestResource_DeleteJWTInCache(t *testing.T) {
 		mockFields func(mockFields)
 		wantErr    error
 	}{
+		{
+			name: "when_SMembers_error_then_return_error",
+			args: args{userID: 3},
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteSessionInCache_error_then_return_error",
+			args: args{userID: 3},
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"session"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:session:3:session").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
 		{
 			name: "name_when_Del_error_then_return_error",
 			args: args{userID: 3},
 			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, nil)
 				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
@@ -41,6 +59,10 @@ func TestResource_DeleteJWTInCache(t *testing.T) {
 			name: "when_no_error_occured_then_return_nil",
 			args: args{userID: 3},
 			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"session"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:session:3:session").Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:refresh_family:3:session").Return(nil)
+				mf.cache.EXPECT().SRem(context.Background(), mockKey, "session").Return(nil)
 				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(nil)
 			},
 		},
@@ -63,62 +85,61 @@ func TestResource_DeleteJWTInCache(t *testing.T) {
 	}
 }
 
-func TestResource_GetJWTFromCache(t *testing.T) {
-	mockKey := "account:jwt:3"
+func TestResource_GetSessionFromCache(t *testing.T) {
+	mockKey := "account:session:3:session"
+	mockSession := Session{
+		SessionID: "session",
+		TokenID:   "jti",
+		UserID:    3,
+	}
+
 	type mockFields struct {
 		cache *MockredisRepoProvider
 		infra *MockinfraRepoProvider
 	}
 
-	type args struct {
-		userID int64
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
-		want       string
+		want       Session
 		wantErr    error
 	}{
 		{
 			name: "when_Get_error_then_return_error",
-			args: args{userID: 3},
 			mockFields: func(mf mockFields) {
 				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_key_not_exist_then_return_empty_string",
-			args: args{userID: 3},
+			name: "when_key_not_exist_then_return_empty_session",
 			mockFields: func(mf mockFields) {
 				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", nil)
 			},
 		},
 		{
 			name: "when_failed_to_unmarshal_then_return_error",
-			args: args{userID: 3},
 			mockFields: func(mf mockFields) {
 				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
 
-				var dest string
+				var dest Session
 				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_no_error_occured_then_return_string",
-			args: args{userID: 3},
+			name: "when_no_error_occured_then_return_session",
 			mockFields: func(mf mockFields) {
-				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("token", nil)
-				var dest string
-				mf.infra.EXPECT().JsonUnmarshal([]byte("token"), &dest).DoAndReturn(
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest Session
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).DoAndReturn(
 					func(input []byte, dest interface{}) error {
-						*dest.(*string) = "token"
+						*dest.(*Session) = mockSession
 						return nil
 					})
 			},
-			want: "token",
+			want: mockSession,
 		},
 	}
 	for _, test := range tests {
@@ -135,57 +156,326 @@ func TestResource_GetJWTFromCache(t *testing.T) {
 				infra: mockFields.infra,
 			}
 
-			got, err := r.GetJWTFromCache(context.Background(), test.args.userID)
+			got, err := r.GetSessionFromCache(context.Background(), 3, "session")
 			assert.Equal(t, test.want, got)
 			assert.Equal(t, test.wantErr, err)
 		})
 	}
 }
 
-func TestResource_SetJWTToCache(t *testing.T) {
-	mockKey := "account:jwt:3"
+func TestResource_SetSessionToCache(t *testing.T) {
+	mockKey := "account:session:3:session"
 	mockConfig := &configuration.AppConfig{
-		JWT: configuration.JWTConfig{
-			TTL: 1,
+		Account: configuration.AccountConfig{
+			ExpiredTimeInHour: 2,
 		},
 	}
+	mockSession := Session{
+		SessionID: "session",
+		TokenID:   "jti",
+		UserID:    3,
+	}
 
 	type mockFields struct {
 		cache *MockredisRepoProvider
 		infra *MockinfraRepoProvider
 	}
 
-	type args struct {
-		userID int64
-		jwt    string
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
 		wantErr    error
 	}{
 		{
 			name: "when_Set_error_then_return_error",
-			args: args{
-				userID: 3,
-				jwt:    "token",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockSession, 2*time.Hour).Return(assert.AnError)
 			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SAdd_error_then_return_error",
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.cache.EXPECT().Set(context.Background(), mockKey, "token", time.Duration(1)*time.Second).Return(assert.AnError)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockSession, 2*time.Hour).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:sessions:3", "session").Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
 			name: "when_no_error_occured_then_return_nil",
-			args: args{
-				userID: 3,
-				jwt:    "token",
-			},
 			mockFields: func(mf mockFields) {
 				mf.infra.EXPECT().GetConfig().Return(mockConfig)
-				mf.cache.EXPECT().Set(context.Background(), mockKey, "token", time.Duration(1)*time.Second).Return(nil)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockSession, 2*time.Hour).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:sessions:3", "session").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetSessionToCache(context.Background(), mockSession)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeleteSessionInCache(t *testing.T) {
+	mockSessionKey := "account:session:3:session"
+	mockFamilyKey := "account:refresh_family:3:session"
+	mockIndexKey := "account:sessions:3"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Del_session_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockSessionKey).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Del_refresh_token_family_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockSessionKey).Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), mockFamilyKey).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SRem_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockSessionKey).Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), mockFamilyKey).Return(nil)
+				mf.cache.EXPECT().SRem(context.Background(), mockIndexKey, "session").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockSessionKey).Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), mockFamilyKey).Return(nil)
+				mf.cache.EXPECT().SRem(context.Background(), mockIndexKey, "session").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.DeleteSessionInCache(context.Background(), 3, "session")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetSessionIDsFromCache(t *testing.T) {
+	mockKey := "account:sessions:3"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []string
+		wantErr    error
+	}{
+		{
+			name: "when_SMembers_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_session_ids",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"a", "b"}, nil)
+			},
+			want: []string{"a", "b"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			got, err := r.GetSessionIDsFromCache(context.Background(), 3)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetRefreshTokenFromCache(t *testing.T) {
+	mockKey := "account:refresh:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       RefreshToken
+		wantErr    error
+	}{
+		{
+			name: "when_Get_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_refresh_token",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest RefreshToken
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_refresh_token",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest RefreshToken
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*RefreshToken) = RefreshToken{
+							Email:    "email",
+							FamilyID: "family",
+							UserID:   3,
+						}
+						return nil
+					})
+			},
+			want: RefreshToken{
+				Email:    "email",
+				FamilyID: "family",
+				UserID:   3,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.GetRefreshTokenFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetRefreshTokenFamilyFromCache(t *testing.T) {
+	mockKey := "account:refresh_family:3:session"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       RefreshTokenFamily
+		wantErr    error
+	}{
+		{
+			name: "when_Get_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_family",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest RefreshTokenFamily
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_family",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest RefreshTokenFamily
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*RefreshTokenFamily) = RefreshTokenFamily{
+							FamilyID:  "family",
+							TokenHash: "hash",
+						}
+						return nil
+					})
+			},
+			want: RefreshTokenFamily{
+				FamilyID:  "family",
+				TokenHash: "hash",
 			},
 		},
 	}
@@ -203,7 +493,1461 @@ func TestResource_SetJWTToCache(t *testing.T) {
 				infra: mockFields.infra,
 			}
 
-			err := r.SetJWTToCache(context.Background(), test.args.userID, test.args.jwt)
+			got, err := r.GetRefreshTokenFamilyFromCache(context.Background(), 3, "session")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetRefreshTokenToCache(t *testing.T) {
+	mockKey := "account:refresh:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			ExpiredTimeInHour: 2,
+		},
+	}
+	mockToken := RefreshToken{
+		Email:     "email",
+		FamilyID:  "family",
+		SessionID: "session",
+		UserID:    3,
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockToken, 2*time.Hour).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockToken, 2*time.Hour).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetRefreshTokenToCache(context.Background(), "hash", mockToken)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SwapRefreshTokenFamilyInCache(t *testing.T) {
+	mockKey := "account:refresh_family:3:session"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			ExpiredTimeInHour: 2,
+		},
+	}
+	mockFamily := RefreshTokenFamily{
+		FamilyID:  "family",
+		TokenHash: "new_hash",
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       RefreshTokenFamily
+		wantErr    error
+	}{
+		{
+			name: "when_GetSet_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().GetSet(context.Background(), mockKey, mockFamily, 2*time.Hour).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_previous_family_then_return_empty_family",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().GetSet(context.Background(), mockKey, mockFamily, 2*time.Hour).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().GetSet(context.Background(), mockKey, mockFamily, 2*time.Hour).Return("abcd", nil)
+
+				var dest RefreshTokenFamily
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_previous_family",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().GetSet(context.Background(), mockKey, mockFamily, 2*time.Hour).Return("abcd", nil)
+
+				var dest RefreshTokenFamily
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*RefreshTokenFamily) = RefreshTokenFamily{
+							FamilyID:  "family",
+							TokenHash: "old_hash",
+						}
+						return nil
+					})
+			},
+			want: RefreshTokenFamily{
+				FamilyID:  "family",
+				TokenHash: "old_hash",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.SwapRefreshTokenFamilyInCache(context.Background(), 3, "session", mockFamily)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_PopPasswordResetTokenFromCache(t *testing.T) {
+	mockKey := "account:password_reset:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest int64
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_user_id",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("3", nil)
+
+				var dest int64
+				mf.infra.EXPECT().JsonUnmarshal([]byte("3"), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*int64) = 3
+						return nil
+					})
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.PopPasswordResetTokenFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetPasswordResetCooldownToCache(t *testing.T) {
+	mockKey := "account:password_reset_cooldown:3"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			PasswordResetCooldown: 60,
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_SetNX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, nil)
+			},
+			want: false,
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(true, nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.SetPasswordResetCooldownToCache(context.Background(), 3)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetPasswordResetTokenToCache(t *testing.T) {
+	mockKey := "account:password_reset:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			PasswordResetTTL: 30,
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 30*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SAdd_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 30*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:password_resets:3", "hash").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Expire_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 30*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:password_resets:3", "hash").Return(nil)
+				mf.cache.EXPECT().Expire(context.Background(), "account:password_resets:3", 30*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 30*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:password_resets:3", "hash").Return(nil)
+				mf.cache.EXPECT().Expire(context.Background(), "account:password_resets:3", 30*time.Minute).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetPasswordResetTokenToCache(context.Background(), "hash", 3)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_PopEmailChangeFromCache(t *testing.T) {
+	mockKey := "account:email_change:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       EmailChange
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_struct",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest EmailChange
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_email_change",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return(`{"new_email":"new@mail.com","user_id":3}`, nil)
+
+				var dest EmailChange
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`{"new_email":"new@mail.com","user_id":3}`), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*EmailChange) = EmailChange{NewEmail: "new@mail.com", UserID: 3}
+						return nil
+					})
+			},
+			want: EmailChange{NewEmail: "new@mail.com", UserID: 3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.PopEmailChangeFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_PopEmailVerificationTokenFromCache(t *testing.T) {
+	mockKey := "account:email_verification:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest int64
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_user_id",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("3", nil)
+
+				var dest int64
+				mf.infra.EXPECT().JsonUnmarshal([]byte("3"), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*int64) = 3
+						return nil
+					})
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.PopEmailVerificationTokenFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetEmailChangeToCache(t *testing.T) {
+	mockKey := "account:email_change:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			EmailChangeTTL: 15,
+		},
+	}
+	mockChange := EmailChange{NewEmail: "new@mail.com", UserID: 3}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockChange, 15*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockChange, 15*time.Minute).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetEmailChangeToCache(context.Background(), "hash", mockChange)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetEmailVerificationCooldownToCache(t *testing.T) {
+	mockKey := "account:email_verification_resend:3"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			EmailVerificationResendCooldown: 60,
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_SetNX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, nil)
+			},
+			want: false,
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(true, nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.SetEmailVerificationCooldownToCache(context.Background(), 3)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetEmailVerificationTokenToCache(t *testing.T) {
+	mockKey := "account:email_verification:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			EmailVerificationTTL: 1440,
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 24*time.Hour).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, int64(3), 24*time.Hour).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetEmailVerificationTokenToCache(context.Background(), "hash", 3)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeleteLoginFailureInCache(t *testing.T) {
+	mockKey := "account:login_failure:email:email"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Del_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.DeleteLoginFailureInCache(context.Background(), "email:email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetLoginBlockFromCache(t *testing.T) {
+	mockKey := "account:login_block:email:email"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	mockUnmarshal := func(input []byte, dest interface{}) error {
+		*dest.(*string) = "locked"
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       LoginBlock
+		wantErr    error
+	}{
+		{
+			name: "when_Get_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_block",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return(`"locked"`, nil)
+
+				var dest string
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`"locked"`), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_TTL_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return(`"locked"`, nil)
+
+				var dest string
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`"locked"`), &dest).DoAndReturn(mockUnmarshal)
+				mf.cache.EXPECT().TTL(context.Background(), mockKey).Return(time.Duration(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_block",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Get(context.Background(), mockKey).Return(`"locked"`, nil)
+
+				var dest string
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`"locked"`), &dest).DoAndReturn(mockUnmarshal)
+				mf.cache.EXPECT().TTL(context.Background(), mockKey).Return(time.Minute, nil)
+			},
+			want: LoginBlock{
+				Reason:     "locked",
+				RetryAfter: time.Minute,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.GetLoginBlockFromCache(context.Background(), "email:email")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_IncrLoginFailureInCache(t *testing.T) {
+	mockKey := "account:login_failure:email:email"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			Security: configuration.SecurityConfig{
+				FailedAttemptWindow: 900,
+			},
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_Incr_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Incr(context.Background(), mockKey).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Expire_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Incr(context.Background(), mockKey).Return(int64(3), nil)
+				mf.cache.EXPECT().Expire(context.Background(), mockKey, 15*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_count",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Incr(context.Background(), mockKey).Return(int64(3), nil)
+				mf.cache.EXPECT().Expire(context.Background(), mockKey, 15*time.Minute).Return(nil)
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.IncrLoginFailureInCache(context.Background(), "email:email")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetLoginBlockToCache(t *testing.T) {
+	mockKey := "account:login_block:ip:127.0.0.1"
+	mockBlock := LoginBlock{
+		Reason:     "throttled",
+		RetryAfter: 4 * time.Second,
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Set(context.Background(), mockKey, "throttled", 4*time.Second).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().Set(context.Background(), mockKey, "throttled", 4*time.Second).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.SetLoginBlockToCache(context.Background(), "ip:127.0.0.1", mockBlock)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_PopMFAChallengeFromCache(t *testing.T) {
+	mockKey := "account:mfa_challenge:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       MFAChallenge
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_struct",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest MFAChallenge
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_challenge",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return(`{"email":"email","user_id":3}`, nil)
+
+				var dest MFAChallenge
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`{"email":"email","user_id":3}`), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*MFAChallenge) = MFAChallenge{Email: "email", UserID: 3}
+						return nil
+					})
+			},
+			want: MFAChallenge{Email: "email", UserID: 3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.PopMFAChallengeFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetMFAChallengeToCache(t *testing.T) {
+	mockKey := "account:mfa_challenge:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			TOTP: configuration.TOTPConfig{
+				MFAChallengeTTL: 300,
+			},
+		},
+	}
+	mockChallenge := MFAChallenge{Email: "email", UserID: 3}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockChallenge, 5*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockChallenge, 5*time.Minute).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetMFAChallengeToCache(context.Background(), "hash", mockChallenge)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_PopMagicLinkFromCache(t *testing.T) {
+	mockKey := "account:magic_link:hash"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       MagicLink
+		wantErr    error
+	}{
+		{
+			name: "when_GetDel_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_key_not_exist_then_return_empty_struct",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("", nil)
+			},
+		},
+		{
+			name: "when_failed_to_unmarshal_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return("abcd", nil)
+
+				var dest MagicLink
+				mf.infra.EXPECT().JsonUnmarshal([]byte("abcd"), &dest).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_magic_link",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().GetDel(context.Background(), mockKey).Return(`{"email":"email","user_id":3}`, nil)
+
+				var dest MagicLink
+				mf.infra.EXPECT().JsonUnmarshal([]byte(`{"email":"email","user_id":3}`), &dest).DoAndReturn(
+					func(input []byte, dest interface{}) error {
+						*dest.(*MagicLink) = MagicLink{Email: "email", UserID: 3}
+						return nil
+					})
+			},
+			want: MagicLink{Email: "email", UserID: 3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.PopMagicLinkFromCache(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetMagicLinkCooldownToCache(t *testing.T) {
+	mockKey := "account:magic_link_cooldown:3"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			MagicLinkCooldown: 60,
+		},
+	}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_SetNX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(false, nil)
+			},
+			want: false,
+		},
+		{
+			name: "when_no_error_occured_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().SetNX(context.Background(), mockKey, int64(3), time.Minute).Return(true, nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			got, err := r.SetMagicLinkCooldownToCache(context.Background(), 3)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SetMagicLinkToCache(t *testing.T) {
+	mockKey := "account:magic_link:hash"
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			MagicLinkTTL: 15,
+		},
+	}
+	mockLink := MagicLink{Email: "email", UserID: 3}
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+		infra *MockinfraRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_Set_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockLink, 15*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SAdd_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockLink, 15*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:magic_links:3", "hash").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Expire_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockLink, 15*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:magic_links:3", "hash").Return(nil)
+				mf.cache.EXPECT().Expire(context.Background(), "account:magic_links:3", 15*time.Minute).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.cache.EXPECT().Set(context.Background(), mockKey, mockLink, 15*time.Minute).Return(nil)
+				mf.cache.EXPECT().SAdd(context.Background(), "account:magic_links:3", "hash").Return(nil)
+				mf.cache.EXPECT().Expire(context.Background(), "account:magic_links:3", 15*time.Minute).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+				infra: NewMockinfraRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+				infra: mockFields.infra,
+			}
+
+			err := r.SetMagicLinkToCache(context.Background(), "hash", mockLink)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateSessionLastSeenInCache(t *testing.T) {
+	mockKey := "account:session:3:session"
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockSession := Session{
+		LastSeenAt: mockTime.Add(-time.Hour),
+		SessionID:  "session",
+		TokenID:    "jti",
+		UserID:     3,
+	}
+	mockSeenSession := mockSession
+	mockSeenSession.LastSeenAt = mockTime
+
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_CompareAndSwap_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().CompareAndSwap(context.Background(), mockKey, mockSession, mockSeenSession).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_session_changed_since_read_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().CompareAndSwap(context.Background(), mockKey, mockSession, mockSeenSession).Return(false, nil)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().CompareAndSwap(context.Background(), mockKey, mockSession, mockSeenSession).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.UpdateSessionLastSeenInCache(context.Background(), mockSession, mockTime)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeletePasswordResetTokensInCache(t *testing.T) {
+	mockKey := "account:password_resets:3"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SMembers_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Del_token_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"hash"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:password_reset:hash").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Del_list_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, nil)
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"hash"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:password_reset:hash").Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.DeletePasswordResetTokensInCache(context.Background(), 3)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeleteMagicLinksInCache(t *testing.T) {
+	mockKey := "account:magic_links:3"
+	type mockFields struct {
+		cache *MockredisRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SMembers_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Del_token_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"hash"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:magic_link:hash").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Del_list_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return(nil, nil)
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.cache.EXPECT().SMembers(context.Background(), mockKey).Return([]string{"hash"}, nil)
+				mf.cache.EXPECT().Del(context.Background(), "account:magic_link:hash").Return(nil)
+				mf.cache.EXPECT().Del(context.Background(), mockKey).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				cache: NewMockredisRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			r := &Resource{
+				cache: mockFields.cache,
+			}
+
+			err := r.DeleteMagicLinksInCache(context.Background(), 3)
 			assert.Equal(t, test.wantErr, err)
 		})
 	}
@@ -5,12 +5,192 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"strings"
+	"time"
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
 )
 
+// DeletePersonalAccessTokenInDB will delete a personal access token of a user.
+// It returns false if the user doesn't have the token.
+func (rsc *Resource) DeletePersonalAccessTokenInDB(ctx context.Context, userID, tokenID int64) (bool, error) {
+	meta := map[string]interface{}{
+		"token_id": tokenID,
+		"user_id":  userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeletePersonalAccessTokenInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeletePersonalAccessTokenInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	deleted, err := rsc.db.DeletePersonalAccessToken(ctx, tx, userID, tokenID)
+	if err != nil {
+		log.Printf("[DeletePersonalAccessTokenInDB] rsc.db.DeletePersonalAccessToken() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[DeletePersonalAccessTokenInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return deleted, nil
+}
+
+// DeleteUserAccountsPendingDeletionInDB will permanently delete every account whose deletion
+// was requested at or before requestedBefore, alongside every row owned by those accounts.
+// It returns id of the deleted accounts.
+func (rsc *Resource) DeleteUserAccountsPendingDeletionInDB(ctx context.Context, requestedBefore time.Time) ([]int64, error) {
+	meta := map[string]interface{}{
+		"requested_before": requestedBefore,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletionInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeleteUserAccountsPendingDeletionInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	userIDs, err := rsc.db.DeleteUserAccountsPendingDeletion(ctx, tx, requestedBefore)
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletionInDB] rsc.db.DeleteUserAccountsPendingDeletion() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	// unlike other writes, a failed commit is returned,
+	// since the accounts are reported as deleted only once the purge is committed.
+	err = rsc.db.Commit(tx)
+	if err != nil {
+		log.Printf("[DeleteUserAccountsPendingDeletionInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// DeleteUserTOTPRecoveryCodeInDB will remove a recovery code from recovery codes of a user whose TOTP is enabled.
+// It returns false if the user doesn't have the code, so a recovery code can only be used once.
+func (rsc *Resource) DeleteUserTOTPRecoveryCodeInDB(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCodeInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeleteUserTOTPRecoveryCodeInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	updated, err := rsc.db.DeleteUserTOTPRecoveryCode(ctx, tx, userID, codeHash)
+	if err != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCodeInDB] rsc.db.DeleteUserTOTPRecoveryCode() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	// an unsaved change would let the code be used again, so failing to commit fails the verification.
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[DeleteUserTOTPRecoveryCodeInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return updated, nil
+}
+
+// GetAccountAuditEventsFromDB will fetch security events of a user,
+// ordered from the most recent event.
+func (rsc *Resource) GetAccountAuditEventsFromDB(ctx context.Context, param GetAccountAuditEventsParam) ([]AccountAuditEvent, error) {
+	events, err := rsc.db.GetAccountAuditEventsByUserID(ctx, pgsql.GetAccountAuditEventsParam{
+		Limit:  param.Limit,
+		Offset: param.Offset,
+		UserID: param.UserID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"param": param,
+		}
+
+		log.Printf("[GetAccountAuditEventsFromDB] rsc.db.GetAccountAuditEventsByUserID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]AccountAuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, AccountAuditEvent{
+			CreatedAt: event.CreatedAt,
+			Event:     event.Event,
+			IPAddress: event.IPAddress,
+			RequestID: event.RequestID,
+			UserAgent: event.UserAgent,
+			UserID:    event.UserID,
+		})
+	}
+
+	return result, nil
+}
+
+// GetPersonalAccessTokenByHashFromDB will fetch a personal access token alongside email of its owner
+// based of hash of the token.
+// If the token doesn't exist, it returns an empty PersonalAccessToken.
+func (rsc *Resource) GetPersonalAccessTokenByHashFromDB(ctx context.Context, tokenHash string) (PersonalAccessToken, error) {
+	token, err := rsc.db.GetPersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		log.Printf("[GetPersonalAccessTokenByHashFromDB] rsc.db.GetPersonalAccessTokenByHash() got an error: %+v\n", err)
+		return PersonalAccessToken{}, err
+	}
+
+	return convertPersonalAccessToken(token), nil
+}
+
+// GetPersonalAccessTokensByUserIDFromDB will fetch every personal access token of a user,
+// ordered from the most recently created token.
+func (rsc *Resource) GetPersonalAccessTokensByUserIDFromDB(ctx context.Context, userID int64) ([]PersonalAccessToken, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	tokens, err := rsc.db.GetPersonalAccessTokensByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("[GetPersonalAccessTokensByUserIDFromDB] rsc.db.GetPersonalAccessTokensByUserID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, convertPersonalAccessToken(token))
+	}
+
+	return result, nil
+}
+
 // GetUserAccountByEmailFromDB will fetch user's information based of account's email.
 func (rsc *Resource) GetUserAccountByEmailFromDB(ctx context.Context, email string) (entity.Account, error) {
 	meta := map[string]interface{}{
@@ -23,16 +203,22 @@ func (rsc *Resource) GetUserAccountByEmailFromDB(ctx context.Context, email stri
 		return entity.Account{}, err
 	}
 
-	entity := entity.Account{
-		ID:                account.ID,
-		FirstName:         account.FirstName.String,
-		LastName:          account.LastName.String,
-		Password:          account.Password,
-		Email:             account.Email,
-		RecordPeriodStart: account.RecordPeriodStart,
+	return convertAccount(account), nil
+}
+
+// GetUserAccountByIDFromDB will fetch user's information based of account's id.
+func (rsc *Resource) GetUserAccountByIDFromDB(ctx context.Context, userID int64) (entity.Account, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	account, err := rsc.db.GetUserAccountByID(ctx, userID)
+	if err != nil {
+		log.Printf("[GetUserAccountByIDFromDB] rsc.db.GetUserAccountByID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return entity.Account{}, err
 	}
 
-	return entity, nil
+	return convertAccount(account), nil
 }
 
 // InsertUserAccountToDB will create a new entry of user account in database.
@@ -69,13 +255,163 @@ func (rsc *Resource) InsertUserAccountToDB(ctx context.Context, email, password
 	return nil
 }
 
+// InsertAccountAuditEventToDB will record a security event of a user.
+func (rsc *Resource) InsertAccountAuditEventToDB(ctx context.Context, event AccountAuditEvent) error {
+	meta := map[string]interface{}{
+		"event":   event.Event,
+		"user_id": event.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertAccountAuditEventToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertAccountAuditEventToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.InsertAccountAuditEvent(ctx, tx, pgsql.InsertAccountAuditEventParam{
+		Event:     event.Event,
+		IPAddress: event.IPAddress,
+		RequestID: event.RequestID,
+		UserAgent: event.UserAgent,
+		UserID:    event.UserID,
+	})
+	if err != nil {
+		log.Printf("[InsertAccountAuditEventToDB] rsc.db.InsertAccountAuditEvent() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertAccountAuditEventToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
+// InsertAdminAuditLogToDB will record an action an admin took on an account.
+func (rsc *Resource) InsertAdminAuditLogToDB(ctx context.Context, param AdminAuditLog) error {
+	meta := map[string]interface{}{
+		"param": param,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertAdminAuditLogToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertAdminAuditLogToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.InsertAdminAuditLog(ctx, tx, pgsql.InsertAdminAuditLogParam{
+		Action:       param.Action,
+		ActorID:      param.ActorID,
+		Refused:      param.Refused,
+		TargetUserID: param.TargetUserID,
+	})
+	if err != nil {
+		log.Printf("[InsertAdminAuditLogToDB] rsc.db.InsertAdminAuditLog() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertAdminAuditLogToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// InsertPersonalAccessTokenToDB will create a new personal access token in database.
+// It returns id of the new token.
+func (rsc *Resource) InsertPersonalAccessTokenToDB(ctx context.Context, param InsertPersonalAccessTokenParam) (int64, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertPersonalAccessTokenToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertPersonalAccessTokenToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	id, err := rsc.db.InsertPersonalAccessToken(ctx, tx, pgsql.InsertPersonalAccessTokenParam{
+		ExpiresAt: sql.NullTime{Time: param.ExpiresAt, Valid: !param.ExpiresAt.IsZero()},
+		Name:      param.Name,
+		Scopes:    strings.Join(param.Scopes, ","),
+		TokenHash: param.TokenHash,
+		UserID:    param.UserID,
+	})
+	if err != nil {
+		log.Printf("[InsertPersonalAccessTokenToDB] rsc.db.InsertPersonalAccessToken() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertPersonalAccessTokenToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return id, nil
+}
+
+// SearchUserAccountsFromDB will fetch accounts whose email, first name or last name contains param.Query,
+// ordered by their id.
+func (rsc *Resource) SearchUserAccountsFromDB(ctx context.Context, param SearchUserAccountsParam) ([]entity.Account, error) {
+	accounts, err := rsc.db.SearchUserAccounts(ctx, pgsql.SearchUserAccountsParam{
+		Limit:  param.Limit,
+		Offset: param.Offset,
+		Query:  param.Query,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"param": param,
+		}
+
+		log.Printf("[SearchUserAccountsFromDB] rsc.db.SearchUserAccounts() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]entity.Account, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, convertAccount(account))
+	}
+
+	return result, nil
+}
+
 // UpdateUserAccountInDB will update user's account based on the given parameter.
 func (rsc *Resource) UpdateUserAccountInDB(ctx context.Context, param UpdateUserAccountParam) error {
 	meta := map[string]interface{}{
-		"first_name":    param.FirstName,
-		"last_name":     param.LastName,
-		"record_period": param.RecordPeriod,
-		"user_id":       param.UserID,
+		"budgeting_mode": param.BudgetingMode,
+		"first_name":     param.FirstName,
+		"last_name":      param.LastName,
+		"record_period":  param.RecordPeriod,
+		"timezone":       param.Timezone,
+		"user_id":        param.UserID,
 	}
 
 	var err error
@@ -106,6 +442,152 @@ func (rsc *Resource) UpdateUserAccountInDB(ctx context.Context, param UpdateUser
 	return nil
 }
 
+// UpdateUserDeletionRequestedInDB will set the time user requested to delete their account.
+// A zero requestedAt cancels a pending deletion.
+func (rsc *Resource) UpdateUserDeletionRequestedInDB(ctx context.Context, userID int64, requestedAt time.Time) error {
+	meta := map[string]interface{}{
+		"requested_at": requestedAt,
+		"user_id":      userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserDeletionRequestedInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserDeletionRequestedInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.UpdateUserDeletionRequested(ctx, tx, userID, sql.NullTime{
+		Time:  requestedAt,
+		Valid: !requestedAt.IsZero(),
+	})
+	if err != nil {
+		log.Printf("[UpdateUserDeletionRequestedInDB] rsc.db.UpdateUserDeletionRequested() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserDeletionRequestedInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
+// UpdateUserDisabledInDB will set the time user's account was disabled.
+// A zero disabledAt enables the account again.
+func (rsc *Resource) UpdateUserDisabledInDB(ctx context.Context, userID int64, disabledAt time.Time) error {
+	meta := map[string]interface{}{
+		"disabled_at": disabledAt,
+		"user_id":     userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserDisabledInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserDisabledInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.UpdateUserDisabled(ctx, tx, userID, sql.NullTime{
+		Time:  disabledAt,
+		Valid: !disabledAt.IsZero(),
+	})
+	if err != nil {
+		log.Printf("[UpdateUserDisabledInDB] rsc.db.UpdateUserDisabled() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserDisabledInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
+// UpdateUserEmailInDB will replace user's email with a new one that had been confirmed.
+func (rsc *Resource) UpdateUserEmailInDB(ctx context.Context, userID int64, email string) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserEmailInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserEmailInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.UpdateUserEmail(ctx, tx, userID, email)
+	if err != nil {
+		log.Printf("[UpdateUserEmailInDB] rsc.db.UpdateUserEmail() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserEmailInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
+// UpdateUserEmailVerifiedInDB will mark user's email as verified.
+func (rsc *Resource) UpdateUserEmailVerifiedInDB(ctx context.Context, userID int64) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserEmailVerifiedInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserEmailVerifiedInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.UpdateUserEmailVerified(ctx, tx, userID)
+	if err != nil {
+		log.Printf("[UpdateUserEmailVerifiedInDB] rsc.db.UpdateUserEmailVerified() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserEmailVerifiedInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
 // UpdateUserPasswordInDB will update user's password based on the given parameter.
 func (rsc *Resource) UpdateUserPasswordInDB(ctx context.Context, userID int64, password string) error {
 	meta := map[string]interface{}{
@@ -140,6 +622,121 @@ func (rsc *Resource) UpdateUserPasswordInDB(ctx context.Context, userID int64, p
 	return nil
 }
 
+// UpdateUserPasswordHashInDB will replace the hash of user's password with password,
+// only if the hash is still oldPassword. It returns false if the hash had been changed.
+func (rsc *Resource) UpdateUserPasswordHashInDB(ctx context.Context, userID int64, oldPassword, password string) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserPasswordHashInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserPasswordHashInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	updated, err := rsc.db.UpdateUserPasswordHash(ctx, tx, userID, oldPassword, password)
+	if err != nil {
+		log.Printf("[UpdateUserPasswordHashInDB] rsc.db.UpdateUserPasswordHash() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserPasswordHashInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return updated, nil
+}
+
+// UpdateUserTOTPInDB will update user's TOTP two-factor authentication based on the given parameter.
+func (rsc *Resource) UpdateUserTOTPInDB(ctx context.Context, param UpdateUserTOTPParam) error {
+	meta := map[string]interface{}{
+		"user_id": param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserTOTPInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserTOTPInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	recoveryCodes := strings.Join(param.RecoveryCodes, ",")
+	err = rsc.db.UpdateUserTOTP(ctx, tx, pgsql.UpdateUserTOTPParam{
+		EnabledAt:     sql.NullTime{Time: param.EnabledAt, Valid: !param.EnabledAt.IsZero()},
+		LastUsedStep:  sql.NullInt64{Int64: param.LastUsedStep, Valid: param.LastUsedStep != 0},
+		RecoveryCodes: sql.NullString{String: recoveryCodes, Valid: recoveryCodes != ""},
+		Secret:        sql.NullString{String: param.Secret, Valid: param.Secret != ""},
+		UserID:        param.UserID,
+	})
+	if err != nil {
+		log.Printf("[UpdateUserTOTPInDB] rsc.db.UpdateUserTOTP() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserTOTPInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+	}
+
+	return nil
+}
+
+// UpdateUserTOTPLastUsedStepInDB will record the TOTP time step of a code user has just used.
+// It returns false if TOTP of the user isn't enabled, or a code of the same or a later step
+// had been used before, so a TOTP code can't be replayed.
+func (rsc *Resource) UpdateUserTOTPLastUsedStepInDB(ctx context.Context, userID, step int64) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStepInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateUserTOTPLastUsedStepInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	updated, err := rsc.db.UpdateUserTOTPLastUsedStep(ctx, tx, userID, step)
+	if err != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStepInDB] rsc.db.UpdateUserTOTPLastUsedStep() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	// an unsaved change would let the code be used again, so failing to commit fails the verification.
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateUserTOTPLastUsedStepInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return updated, nil
+}
+
 // rollbackTX will rollback a transaction if any error occured.
 func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) error {
 	if err == nil {
@@ -154,3 +751,49 @@ func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) erro
 
 	return nil
 }
+
+// convertAccount will convert user's account saved in database.
+func convertAccount(account pgsql.Account) entity.Account {
+	return entity.Account{
+		BudgetingMode:       account.BudgetingMode,
+		CreatedAt:           account.CreatedAt.Time,
+		DeletionRequestedAt: account.DeletionRequestedAt.Time,
+		DisabledAt:          account.DisabledAt.Time,
+		Email:               account.Email,
+		EmailVerifiedAt:     account.EmailVerifiedAt.Time,
+		FirstName:           account.FirstName.String,
+		ID:                  account.ID,
+		LastName:            account.LastName.String,
+		Password:            account.Password,
+		RecordPeriodStart:   account.RecordPeriodStart,
+		Role:                account.Role,
+		TOTPEnabledAt:       account.TOTPEnabledAt.Time,
+		TOTPRecoveryCodes:   splitCommaSeparated(account.TOTPRecoveryCodes.String),
+		TOTPSecret:          account.TOTPSecret.String,
+		Timezone:            account.Timezone,
+		UpdatedAt:           account.UpdatedAt.Time,
+	}
+}
+
+// convertPersonalAccessToken will convert a personal access token saved in database.
+func convertPersonalAccessToken(token pgsql.PersonalAccessToken) PersonalAccessToken {
+	return PersonalAccessToken{
+		CreatedAt: token.CreatedAt,
+		Email:     token.Email,
+		ExpiresAt: token.ExpiresAt.Time,
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    splitCommaSeparated(token.Scopes),
+		UserID:    token.UserID,
+	}
+}
+
+// splitCommaSeparated will split values that are saved as a comma separated list,
+// such as recovery codes and scopes.
+func splitCommaSeparated(values string) []string {
+	if values == "" {
+		return nil
+	}
+
+	return strings.Split(values, ",")
+}
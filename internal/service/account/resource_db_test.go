@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	// external package
 	"github.com/golang/mock/gomock"
@@ -17,6 +18,7 @@ import (
 
 func TestResource_GetUserAccountByEmailFromDB(t *testing.T) {
 	email := "lee.jieun@iu.com"
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	type mockFields struct {
 		db *MockdbRepoProvider
@@ -48,21 +50,39 @@ func TestResource_GetUserAccountByEmailFromDB(t *testing.T) {
 			args: email,
 			mockFields: func(mf mockFields) {
 				mf.db.EXPECT().GetUserAccountByEmail(context.Background(), email).Return(pgsql.Account{
-					Email:             "lee.jieun@iu.com",
-					FirstName:         sql.NullString{Valid: true, String: "Ji Eun"},
-					ID:                1,
-					LastName:          sql.NullString{Valid: true, String: "Lee"},
-					Password:          "password 123",
-					RecordPeriodStart: 1,
+					BudgetingMode:       "envelope",
+					CreatedAt:           sql.NullTime{Valid: true, Time: mockTime},
+					Email:               "lee.jieun@iu.com",
+					DeletionRequestedAt: sql.NullTime{Valid: true, Time: mockTime},
+					EmailVerifiedAt:     sql.NullTime{Valid: true, Time: mockTime},
+					FirstName:           sql.NullString{Valid: true, String: "Ji Eun"},
+					ID:                  1,
+					LastName:            sql.NullString{Valid: true, String: "Lee"},
+					Password:            "password 123",
+					RecordPeriodStart:   1,
+					TOTPEnabledAt:       sql.NullTime{Valid: true, Time: mockTime},
+					TOTPRecoveryCodes:   sql.NullString{Valid: true, String: "hash1,hash2"},
+					TOTPSecret:          sql.NullString{Valid: true, String: "secret"},
+					Timezone:            "Asia/Jakarta",
+					UpdatedAt:           sql.NullTime{Valid: true, Time: mockTime},
 				}, nil)
 			},
 			want: entity.Account{
-				Email:             "lee.jieun@iu.com",
-				FirstName:         "Ji Eun",
-				ID:                1,
-				LastName:          "Lee",
-				Password:          "password 123",
-				RecordPeriodStart: 1,
+				BudgetingMode:       "envelope",
+				CreatedAt:           mockTime,
+				Email:               "lee.jieun@iu.com",
+				DeletionRequestedAt: mockTime,
+				EmailVerifiedAt:     mockTime,
+				FirstName:           "Ji Eun",
+				ID:                  1,
+				LastName:            "Lee",
+				Password:            "password 123",
+				RecordPeriodStart:   1,
+				TOTPEnabledAt:       mockTime,
+				TOTPRecoveryCodes:   []string{"hash1", "hash2"},
+				TOTPSecret:          "secret",
+				Timezone:            "Asia/Jakarta",
+				UpdatedAt:           mockTime,
 			},
 		},
 	}
@@ -85,6 +105,95 @@ func TestResource_GetUserAccountByEmailFromDB(t *testing.T) {
 	}
 }
 
+func TestResource_GetUserAccountByIDFromDB(t *testing.T) {
+	userID := int64(1)
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+	tests := []struct {
+		name       string
+		args       int64
+		mockFields func(mockFields)
+		want       entity.Account
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			args: userID,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetUserAccountByID(context.Background(), userID).Return(pgsql.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetUserAccountByID_return_empty_account_then_return_empty_struct",
+			args: userID,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetUserAccountByID(context.Background(), userID).Return(pgsql.Account{}, nil)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_populated_account_struct",
+			args: userID,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetUserAccountByID(context.Background(), userID).Return(pgsql.Account{
+					BudgetingMode:       "envelope",
+					CreatedAt:           sql.NullTime{Valid: true, Time: mockTime},
+					Email:               "lee.jieun@iu.com",
+					DeletionRequestedAt: sql.NullTime{Valid: true, Time: mockTime},
+					EmailVerifiedAt:     sql.NullTime{Valid: true, Time: mockTime},
+					FirstName:           sql.NullString{Valid: true, String: "Ji Eun"},
+					ID:                  1,
+					LastName:            sql.NullString{Valid: true, String: "Lee"},
+					Password:            "password 123",
+					RecordPeriodStart:   1,
+					TOTPEnabledAt:       sql.NullTime{Valid: true, Time: mockTime},
+					TOTPRecoveryCodes:   sql.NullString{Valid: true, String: "hash1,hash2"},
+					TOTPSecret:          sql.NullString{Valid: true, String: "secret"},
+					Timezone:            "Asia/Jakarta",
+					UpdatedAt:           sql.NullTime{Valid: true, Time: mockTime},
+				}, nil)
+			},
+			want: entity.Account{
+				BudgetingMode:       "envelope",
+				CreatedAt:           mockTime,
+				Email:               "lee.jieun@iu.com",
+				DeletionRequestedAt: mockTime,
+				EmailVerifiedAt:     mockTime,
+				FirstName:           "Ji Eun",
+				ID:                  1,
+				LastName:            "Lee",
+				Password:            "password 123",
+				RecordPeriodStart:   1,
+				TOTPEnabledAt:       mockTime,
+				TOTPRecoveryCodes:   []string{"hash1", "hash2"},
+				TOTPSecret:          "secret",
+				Timezone:            "Asia/Jakarta",
+				UpdatedAt:           mockTime,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetUserAccountByIDFromDB(context.Background(), test.args)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
 func TestResource_InsertUserAccountToDB(t *testing.T) {
 	type mockFields struct {
 		db *MockdbRepoProvider
@@ -279,6 +388,146 @@ func TestResource_UpdateUserAccountInDB(t *testing.T) {
 	}
 }
 
+func TestResource_UpdateUserEmailInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserEmail_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmail(context.Background(), &sql.Tx{}, int64(123), "new@mail.com").Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserEmail_error_and_failed_to_rollback_transaction_then_log_the_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmail(context.Background(), &sql.Tx{}, int64(123), "new@mail.com").Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_log_the_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmail(context.Background(), &sql.Tx{}, int64(123), "new@mail.com").Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmail(context.Background(), &sql.Tx{}, int64(123), "new@mail.com").Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateUserEmailInDB(context.Background(), 123, "new@mail.com")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateUserEmailVerifiedInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserEmailVerified_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmailVerified(context.Background(), &sql.Tx{}, int64(123)).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserEmailVerified_error_and_failed_to_rollback_transaction_then_log_the_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmailVerified(context.Background(), &sql.Tx{}, int64(123)).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_log_the_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmailVerified(context.Background(), &sql.Tx{}, int64(123)).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserEmailVerified(context.Background(), &sql.Tx{}, int64(123)).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateUserEmailVerifiedInDB(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
 func TestResource_UpdateUserPasswordInDB(t *testing.T) {
 	type mockFields struct {
 		db *MockdbRepoProvider
@@ -371,3 +620,1077 @@ func TestResource_UpdateUserPasswordInDB(t *testing.T) {
 		})
 	}
 }
+
+func TestResource_UpdateUserPasswordHashInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserPasswordHash_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserPasswordHash(context.Background(), &sql.Tx{}, int64(123), "old_hash", "new_hash").Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserPasswordHash(context.Background(), &sql.Tx{}, int64(123), "old_hash", "new_hash").Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_whether_hash_replaced",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserPasswordHash(context.Background(), &sql.Tx{}, int64(123), "old_hash", "new_hash").Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.UpdateUserPasswordHashInDB(context.Background(), 123, "old_hash", "new_hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateUserTOTPInDB(t *testing.T) {
+	mockTime := time.Date(1993, 05, 16, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		param      UpdateUserTOTPParam
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:  "when_BeginTX_error_then_return_error",
+			param: UpdateUserTOTPParam{UserID: 123},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_UpdateUserTOTP_error_then_rollback_transaction_then_return_error",
+			param: UpdateUserTOTPParam{UserID: 123},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTP(context.Background(), &sql.Tx{}, pgsql.UpdateUserTOTPParam{UserID: 123}).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_failed_to_commit_then_log_the_error",
+			param: UpdateUserTOTPParam{UserID: 123},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTP(context.Background(), &sql.Tx{}, pgsql.UpdateUserTOTPParam{UserID: 123}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			param: UpdateUserTOTPParam{
+				EnabledAt:     mockTime,
+				RecoveryCodes: []string{"hash1", "hash2"},
+				Secret:        "secret",
+				UserID:        123,
+			},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTP(context.Background(), &sql.Tx{}, pgsql.UpdateUserTOTPParam{
+					EnabledAt:     sql.NullTime{Time: mockTime, Valid: true},
+					RecoveryCodes: sql.NullString{String: "hash1,hash2", Valid: true},
+					Secret:        sql.NullString{String: "secret", Valid: true},
+					UserID:        123,
+				}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateUserTOTPInDB(context.Background(), test.param)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeletePersonalAccessTokenInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeletePersonalAccessToken_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeletePersonalAccessToken(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_log_the_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeletePersonalAccessToken(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			want: true,
+		},
+		{
+			name: "when_no_error_occured_then_return_whether_token_deleted",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeletePersonalAccessToken(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.DeletePersonalAccessTokenInDB(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetPersonalAccessTokenByHashFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       PersonalAccessToken
+		wantErr    error
+	}{
+		{
+			name: "when_GetPersonalAccessTokenByHash_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetPersonalAccessTokenByHash(context.Background(), "hash").Return(pgsql.PersonalAccessToken{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_exist_then_return_empty_token",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetPersonalAccessTokenByHash(context.Background(), "hash").Return(pgsql.PersonalAccessToken{}, nil)
+			},
+		},
+		{
+			name: "when_token_exist_then_return_the_token",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetPersonalAccessTokenByHash(context.Background(), "hash").Return(pgsql.PersonalAccessToken{
+					CreatedAt: mockTime,
+					Email:     "email",
+					ExpiresAt: sql.NullTime{Time: mockTime.Add(time.Hour), Valid: true},
+					ID:        1,
+					Name:      "script",
+					Scopes:    "read,write",
+					UserID:    123,
+				}, nil)
+			},
+			want: PersonalAccessToken{
+				CreatedAt: mockTime,
+				Email:     "email",
+				ExpiresAt: mockTime.Add(time.Hour),
+				ID:        1,
+				Name:      "script",
+				Scopes:    []string{"read", "write"},
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetPersonalAccessTokenByHashFromDB(context.Background(), "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetPersonalAccessTokensByUserIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []PersonalAccessToken
+		wantErr    error
+	}{
+		{
+			name: "when_GetPersonalAccessTokensByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetPersonalAccessTokensByUserID(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_tokens",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetPersonalAccessTokensByUserID(context.Background(), int64(123)).Return([]pgsql.PersonalAccessToken{
+					{
+						CreatedAt: mockTime,
+						ID:        1,
+						Name:      "script",
+						Scopes:    "read",
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []PersonalAccessToken{
+				{
+					CreatedAt: mockTime,
+					ID:        1,
+					Name:      "script",
+					Scopes:    []string{"read"},
+					UserID:    123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetPersonalAccessTokensByUserIDFromDB(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertPersonalAccessTokenToDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockParam := InsertPersonalAccessTokenParam{
+		ExpiresAt: mockTime,
+		Name:      "script",
+		Scopes:    []string{"read", "write"},
+		TokenHash: "hash",
+		UserID:    123,
+	}
+	mockDBParam := pgsql.InsertPersonalAccessTokenParam{
+		ExpiresAt: sql.NullTime{Time: mockTime, Valid: true},
+		Name:      "script",
+		Scopes:    "read,write",
+		TokenHash: "hash",
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertPersonalAccessToken_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertPersonalAccessToken(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_log_the_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertPersonalAccessToken(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			want: 1,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertPersonalAccessToken(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.InsertPersonalAccessTokenToDB(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeleteUserAccountsPendingDeletionInDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteUserAccountsPendingDeletion_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserAccountsPendingDeletion(context.Background(), &sql.Tx{}, mockTime).Return(nil, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserAccountsPendingDeletion(context.Background(), &sql.Tx{}, mockTime).Return([]int64{1}, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_deleted_ids",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserAccountsPendingDeletion(context.Background(), &sql.Tx{}, mockTime).Return([]int64{1, 2}, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: []int64{1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.DeleteUserAccountsPendingDeletionInDB(context.Background(), mockTime)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateUserDeletionRequestedInDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name        string
+		requestedAt time.Time
+		mockFields  func(mockFields)
+		wantErr     error
+	}{
+		{
+			name:        "when_BeginTX_error_then_return_error",
+			requestedAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:        "when_UpdateUserDeletionRequested_error_then_rollback_transaction_then_return_error",
+			requestedAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDeletionRequested(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:        "when_failed_to_commit_then_log_the_error",
+			requestedAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDeletionRequested(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name:        "when_deletion_requested_then_return_nil_error",
+			requestedAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDeletionRequested(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_deletion_cancelled_then_save_null",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDeletionRequested(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateUserDeletionRequestedInDB(context.Background(), 123, test.requestedAt)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertAdminAuditLogToDB(t *testing.T) {
+	mockParam := AdminAuditLog{
+		Action:       "disable_account",
+		ActorID:      1,
+		Refused:      true,
+		TargetUserID: 123,
+	}
+
+	mockDBParam := pgsql.InsertAdminAuditLogParam{
+		Action:       "disable_account",
+		ActorID:      1,
+		Refused:      true,
+		TargetUserID: 123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertAdminAuditLog_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAdminAuditLog(context.Background(), &sql.Tx{}, mockDBParam).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAdminAuditLog(context.Background(), &sql.Tx{}, mockDBParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAdminAuditLog(context.Background(), &sql.Tx{}, mockDBParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.InsertAdminAuditLogToDB(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SearchUserAccountsFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockDBParam := pgsql.SearchUserAccountsParam{
+		Limit:  20,
+		Offset: 40,
+		Query:  "lee",
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []entity.Account
+		wantErr    error
+	}{
+		{
+			name: "when_SearchUserAccounts_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().SearchUserAccounts(context.Background(), mockDBParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_accounts",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().SearchUserAccounts(context.Background(), mockDBParam).Return([]pgsql.Account{
+					{
+						CreatedAt:  sql.NullTime{Time: mockTime, Valid: true},
+						DisabledAt: sql.NullTime{Time: mockTime, Valid: true},
+						Email:      "lee.jieun@iu.com",
+						FirstName:  sql.NullString{String: "Ji Eun", Valid: true},
+						ID:         1,
+						Role:       "user",
+					},
+				}, nil)
+			},
+			want: []entity.Account{
+				{
+					CreatedAt:  mockTime,
+					DisabledAt: mockTime,
+					Email:      "lee.jieun@iu.com",
+					FirstName:  "Ji Eun",
+					ID:         1,
+					Role:       "user",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.SearchUserAccountsFromDB(context.Background(), SearchUserAccountsParam{
+				Limit:  20,
+				Offset: 40,
+				Query:  "lee",
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateUserDisabledInDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		disabledAt time.Time
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_BeginTX_error_then_return_error",
+			disabledAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_UpdateUserDisabled_error_then_rollback_transaction_then_return_error",
+			disabledAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDisabled(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_failed_to_commit_then_log_the_error",
+			disabledAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDisabled(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name:       "when_account_disabled_then_return_nil_error",
+			disabledAt: mockTime,
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDisabled(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{Time: mockTime, Valid: true}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_account_enabled_then_save_null",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserDisabled(context.Background(), &sql.Tx{}, int64(123), sql.NullTime{}).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateUserDisabledInDB(context.Background(), 123, test.disabledAt)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetAccountAuditEventsFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockDBParam := pgsql.GetAccountAuditEventsParam{
+		Limit:  20,
+		Offset: 40,
+		UserID: 123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AccountAuditEvent
+		wantErr    error
+	}{
+		{
+			name: "when_GetAccountAuditEventsByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetAccountAuditEventsByUserID(context.Background(), mockDBParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_events",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetAccountAuditEventsByUserID(context.Background(), mockDBParam).Return([]pgsql.AccountAuditEvent{
+					{
+						CreatedAt: mockTime,
+						Event:     "logout",
+						ID:        1,
+						IPAddress: "127.0.0.1",
+						RequestID: "req-1",
+						UserAgent: "Mozilla/5.0",
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []AccountAuditEvent{
+				{
+					CreatedAt: mockTime,
+					Event:     "logout",
+					IPAddress: "127.0.0.1",
+					RequestID: "req-1",
+					UserAgent: "Mozilla/5.0",
+					UserID:    123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetAccountAuditEventsFromDB(context.Background(), GetAccountAuditEventsParam{
+				Limit:  20,
+				Offset: 40,
+				UserID: 123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertAccountAuditEventToDB(t *testing.T) {
+	mockParam := AccountAuditEvent{
+		Event:     "logout",
+		IPAddress: "127.0.0.1",
+		RequestID: "req-1",
+		UserAgent: "Mozilla/5.0",
+		UserID:    123,
+	}
+
+	mockDBParam := pgsql.InsertAccountAuditEventParam{
+		Event:     "logout",
+		IPAddress: "127.0.0.1",
+		RequestID: "req-1",
+		UserAgent: "Mozilla/5.0",
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertAccountAuditEvent_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAccountAuditEvent(context.Background(), &sql.Tx{}, mockDBParam).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_log_the_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAccountAuditEvent(context.Background(), &sql.Tx{}, mockDBParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertAccountAuditEvent(context.Background(), &sql.Tx{}, mockDBParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.InsertAccountAuditEventToDB(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_DeleteUserTOTPRecoveryCodeInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteUserTOTPRecoveryCode_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserTOTPRecoveryCode(context.Background(), &sql.Tx{}, int64(123), "hash").Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserTOTPRecoveryCode(context.Background(), &sql.Tx{}, int64(123), "hash").Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_whether_code_deleted",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteUserTOTPRecoveryCode(context.Background(), &sql.Tx{}, int64(123), "hash").Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.DeleteUserTOTPRecoveryCodeInDB(context.Background(), 123, "hash")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateUserTOTPLastUsedStepInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateUserTOTPLastUsedStep_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTPLastUsedStep(context.Background(), &sql.Tx{}, int64(123), int64(56000000)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTPLastUsedStep(context.Background(), &sql.Tx{}, int64(123), int64(56000000)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_whether_step_recorded",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateUserTOTPLastUsedStep(context.Background(), &sql.Tx{}, int64(123), int64(56000000)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.UpdateUserTOTPLastUsedStepInDB(context.Background(), 123, 56000000)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
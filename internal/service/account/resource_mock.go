@@ -67,6 +67,96 @@ func (mr *MockdbRepoProviderMockRecorder) Commit(tx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockdbRepoProvider)(nil).Commit), tx)
 }
 
+// DeletePersonalAccessToken mocks base method.
+func (m *MockdbRepoProvider) DeletePersonalAccessToken(ctx context.Context, tx *sql.Tx, userID, tokenID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonalAccessToken", ctx, tx, userID, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePersonalAccessToken indicates an expected call of DeletePersonalAccessToken.
+func (mr *MockdbRepoProviderMockRecorder) DeletePersonalAccessToken(ctx, tx, userID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonalAccessToken", reflect.TypeOf((*MockdbRepoProvider)(nil).DeletePersonalAccessToken), ctx, tx, userID, tokenID)
+}
+
+// DeleteUserAccountsPendingDeletion mocks base method.
+func (m *MockdbRepoProvider) DeleteUserAccountsPendingDeletion(ctx context.Context, tx *sql.Tx, requestedBefore time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserAccountsPendingDeletion", ctx, tx, requestedBefore)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserAccountsPendingDeletion indicates an expected call of DeleteUserAccountsPendingDeletion.
+func (mr *MockdbRepoProviderMockRecorder) DeleteUserAccountsPendingDeletion(ctx, tx, requestedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAccountsPendingDeletion", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteUserAccountsPendingDeletion), ctx, tx, requestedBefore)
+}
+
+// DeleteUserTOTPRecoveryCode mocks base method.
+func (m *MockdbRepoProvider) DeleteUserTOTPRecoveryCode(ctx context.Context, tx *sql.Tx, userID int64, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTOTPRecoveryCode", ctx, tx, userID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserTOTPRecoveryCode indicates an expected call of DeleteUserTOTPRecoveryCode.
+func (mr *MockdbRepoProviderMockRecorder) DeleteUserTOTPRecoveryCode(ctx, tx, userID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTOTPRecoveryCode", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteUserTOTPRecoveryCode), ctx, tx, userID, codeHash)
+}
+
+// GetAccountAuditEventsByUserID mocks base method.
+func (m *MockdbRepoProvider) GetAccountAuditEventsByUserID(ctx context.Context, param pgsql.GetAccountAuditEventsParam) ([]pgsql.AccountAuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAuditEventsByUserID", ctx, param)
+	ret0, _ := ret[0].([]pgsql.AccountAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAuditEventsByUserID indicates an expected call of GetAccountAuditEventsByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetAccountAuditEventsByUserID(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAuditEventsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAccountAuditEventsByUserID), ctx, param)
+}
+
+// GetPersonalAccessTokenByHash mocks base method.
+func (m *MockdbRepoProvider) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (pgsql.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(pgsql.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessTokenByHash indicates an expected call of GetPersonalAccessTokenByHash.
+func (mr *MockdbRepoProviderMockRecorder) GetPersonalAccessTokenByHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessTokenByHash", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPersonalAccessTokenByHash), ctx, tokenHash)
+}
+
+// GetPersonalAccessTokensByUserID mocks base method.
+func (m *MockdbRepoProvider) GetPersonalAccessTokensByUserID(ctx context.Context, userID int64) ([]pgsql.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessTokensByUserID", ctx, userID)
+	ret0, _ := ret[0].([]pgsql.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessTokensByUserID indicates an expected call of GetPersonalAccessTokensByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetPersonalAccessTokensByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessTokensByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPersonalAccessTokensByUserID), ctx, userID)
+}
+
 // GetUserAccountByEmail mocks base method.
 func (m *MockdbRepoProvider) GetUserAccountByEmail(ctx context.Context, email string) (pgsql.Account, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +172,64 @@ func (mr *MockdbRepoProviderMockRecorder) GetUserAccountByEmail(ctx, email inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByEmail", reflect.TypeOf((*MockdbRepoProvider)(nil).GetUserAccountByEmail), ctx, email)
 }
 
+// GetUserAccountByID mocks base method.
+func (m *MockdbRepoProvider) GetUserAccountByID(ctx context.Context, userID int64) (pgsql.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccountByID", ctx, userID)
+	ret0, _ := ret[0].(pgsql.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccountByID indicates an expected call of GetUserAccountByID.
+func (mr *MockdbRepoProviderMockRecorder) GetUserAccountByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetUserAccountByID), ctx, userID)
+}
+
+// InsertAccountAuditEvent mocks base method.
+func (m *MockdbRepoProvider) InsertAccountAuditEvent(ctx context.Context, tx *sql.Tx, param pgsql.InsertAccountAuditEventParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAccountAuditEvent", ctx, tx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAccountAuditEvent indicates an expected call of InsertAccountAuditEvent.
+func (mr *MockdbRepoProviderMockRecorder) InsertAccountAuditEvent(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAccountAuditEvent", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertAccountAuditEvent), ctx, tx, param)
+}
+
+// InsertAdminAuditLog mocks base method.
+func (m *MockdbRepoProvider) InsertAdminAuditLog(ctx context.Context, tx *sql.Tx, param pgsql.InsertAdminAuditLogParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAdminAuditLog", ctx, tx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAdminAuditLog indicates an expected call of InsertAdminAuditLog.
+func (mr *MockdbRepoProviderMockRecorder) InsertAdminAuditLog(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAdminAuditLog", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertAdminAuditLog), ctx, tx, param)
+}
+
+// InsertPersonalAccessToken mocks base method.
+func (m *MockdbRepoProvider) InsertPersonalAccessToken(ctx context.Context, tx *sql.Tx, param pgsql.InsertPersonalAccessTokenParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPersonalAccessToken", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPersonalAccessToken indicates an expected call of InsertPersonalAccessToken.
+func (mr *MockdbRepoProviderMockRecorder) InsertPersonalAccessToken(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPersonalAccessToken", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertPersonalAccessToken), ctx, tx, param)
+}
+
 // InsertUserAccount mocks base method.
 func (m *MockdbRepoProvider) InsertUserAccount(ctx context.Context, tx *sql.Tx, email, password string) error {
 	m.ctrl.T.Helper()
@@ -110,6 +258,21 @@ func (mr *MockdbRepoProviderMockRecorder) Rollback(tx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockdbRepoProvider)(nil).Rollback), tx)
 }
 
+// SearchUserAccounts mocks base method.
+func (m *MockdbRepoProvider) SearchUserAccounts(ctx context.Context, param pgsql.SearchUserAccountsParam) ([]pgsql.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserAccounts", ctx, param)
+	ret0, _ := ret[0].([]pgsql.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserAccounts indicates an expected call of SearchUserAccounts.
+func (mr *MockdbRepoProviderMockRecorder) SearchUserAccounts(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserAccounts", reflect.TypeOf((*MockdbRepoProvider)(nil).SearchUserAccounts), ctx, param)
+}
+
 // UpdateUserAccount mocks base method.
 func (m *MockdbRepoProvider) UpdateUserAccount(ctx context.Context, tx *sql.Tx, param pgsql.UpdateUserAccountParam) error {
 	m.ctrl.T.Helper()
@@ -124,6 +287,62 @@ func (mr *MockdbRepoProviderMockRecorder) UpdateUserAccount(ctx, tx, param inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAccount", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserAccount), ctx, tx, param)
 }
 
+// UpdateUserDeletionRequested mocks base method.
+func (m *MockdbRepoProvider) UpdateUserDeletionRequested(ctx context.Context, tx *sql.Tx, userID int64, requestedAt sql.NullTime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDeletionRequested", ctx, tx, userID, requestedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDeletionRequested indicates an expected call of UpdateUserDeletionRequested.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserDeletionRequested(ctx, tx, userID, requestedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeletionRequested", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserDeletionRequested), ctx, tx, userID, requestedAt)
+}
+
+// UpdateUserDisabled mocks base method.
+func (m *MockdbRepoProvider) UpdateUserDisabled(ctx context.Context, tx *sql.Tx, userID int64, disabledAt sql.NullTime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDisabled", ctx, tx, userID, disabledAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDisabled indicates an expected call of UpdateUserDisabled.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserDisabled(ctx, tx, userID, disabledAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDisabled", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserDisabled), ctx, tx, userID, disabledAt)
+}
+
+// UpdateUserEmail mocks base method.
+func (m *MockdbRepoProvider) UpdateUserEmail(ctx context.Context, tx *sql.Tx, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", ctx, tx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserEmail(ctx, tx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserEmail), ctx, tx, userID, email)
+}
+
+// UpdateUserEmailVerified mocks base method.
+func (m *MockdbRepoProvider) UpdateUserEmailVerified(ctx context.Context, tx *sql.Tx, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmailVerified", ctx, tx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmailVerified indicates an expected call of UpdateUserEmailVerified.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserEmailVerified(ctx, tx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmailVerified", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserEmailVerified), ctx, tx, userID)
+}
+
 // UpdateUserPassword mocks base method.
 func (m *MockdbRepoProvider) UpdateUserPassword(ctx context.Context, tx *sql.Tx, userID int64, password string) error {
 	m.ctrl.T.Helper()
@@ -138,6 +357,50 @@ func (mr *MockdbRepoProviderMockRecorder) UpdateUserPassword(ctx, tx, userID, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserPassword), ctx, tx, userID, password)
 }
 
+// UpdateUserPasswordHash mocks base method.
+func (m *MockdbRepoProvider) UpdateUserPasswordHash(ctx context.Context, tx *sql.Tx, userID int64, oldPassword, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPasswordHash", ctx, tx, userID, oldPassword, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPasswordHash indicates an expected call of UpdateUserPasswordHash.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserPasswordHash(ctx, tx, userID, oldPassword, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordHash", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserPasswordHash), ctx, tx, userID, oldPassword, password)
+}
+
+// UpdateUserTOTP mocks base method.
+func (m *MockdbRepoProvider) UpdateUserTOTP(ctx context.Context, tx *sql.Tx, param pgsql.UpdateUserTOTPParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTOTP", ctx, tx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTOTP indicates an expected call of UpdateUserTOTP.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserTOTP(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTOTP", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserTOTP), ctx, tx, param)
+}
+
+// UpdateUserTOTPLastUsedStep mocks base method.
+func (m *MockdbRepoProvider) UpdateUserTOTPLastUsedStep(ctx context.Context, tx *sql.Tx, userID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTOTPLastUsedStep", ctx, tx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTOTPLastUsedStep indicates an expected call of UpdateUserTOTPLastUsedStep.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserTOTPLastUsedStep(ctx, tx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTOTPLastUsedStep", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserTOTPLastUsedStep), ctx, tx, userID, step)
+}
+
 // MockinfraRepoProvider is a mock of infraRepoProvider interface.
 type MockinfraRepoProvider struct {
 	ctrl     *gomock.Controller
@@ -212,6 +475,21 @@ func (m *MockredisRepoProvider) EXPECT() *MockredisRepoProviderMockRecorder {
 	return m.recorder
 }
 
+// CompareAndSwap mocks base method.
+func (m *MockredisRepoProvider) CompareAndSwap(ctx context.Context, key string, old, value interface{}) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSwap", ctx, key, old, value)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSwap indicates an expected call of CompareAndSwap.
+func (mr *MockredisRepoProviderMockRecorder) CompareAndSwap(ctx, key, old, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwap", reflect.TypeOf((*MockredisRepoProvider)(nil).CompareAndSwap), ctx, key, old, value)
+}
+
 // Del mocks base method.
 func (m *MockredisRepoProvider) Del(ctx context.Context, key string) error {
 	m.ctrl.T.Helper()
@@ -226,6 +504,20 @@ func (mr *MockredisRepoProviderMockRecorder) Del(ctx, key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockredisRepoProvider)(nil).Del), ctx, key)
 }
 
+// Expire mocks base method.
+func (m *MockredisRepoProvider) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, key, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockredisRepoProviderMockRecorder) Expire(ctx, key, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockredisRepoProvider)(nil).Expire), ctx, key, expiration)
+}
+
 // Get mocks base method.
 func (m *MockredisRepoProvider) Get(ctx context.Context, key string) (string, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +533,94 @@ func (mr *MockredisRepoProviderMockRecorder) Get(ctx, key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockredisRepoProvider)(nil).Get), ctx, key)
 }
 
+// GetDel mocks base method.
+func (m *MockredisRepoProvider) GetDel(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDel", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDel indicates an expected call of GetDel.
+func (mr *MockredisRepoProviderMockRecorder) GetDel(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDel", reflect.TypeOf((*MockredisRepoProvider)(nil).GetDel), ctx, key)
+}
+
+// GetSet mocks base method.
+func (m *MockredisRepoProvider) GetSet(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSet", ctx, key, value, expiration)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSet indicates an expected call of GetSet.
+func (mr *MockredisRepoProviderMockRecorder) GetSet(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSet", reflect.TypeOf((*MockredisRepoProvider)(nil).GetSet), ctx, key, value, expiration)
+}
+
+// Incr mocks base method.
+func (m *MockredisRepoProvider) Incr(ctx context.Context, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockredisRepoProviderMockRecorder) Incr(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockredisRepoProvider)(nil).Incr), ctx, key)
+}
+
+// SAdd mocks base method.
+func (m *MockredisRepoProvider) SAdd(ctx context.Context, key, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SAdd", ctx, key, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SAdd indicates an expected call of SAdd.
+func (mr *MockredisRepoProviderMockRecorder) SAdd(ctx, key, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SAdd", reflect.TypeOf((*MockredisRepoProvider)(nil).SAdd), ctx, key, member)
+}
+
+// SMembers mocks base method.
+func (m *MockredisRepoProvider) SMembers(ctx context.Context, key string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SMembers", ctx, key)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SMembers indicates an expected call of SMembers.
+func (mr *MockredisRepoProviderMockRecorder) SMembers(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMembers", reflect.TypeOf((*MockredisRepoProvider)(nil).SMembers), ctx, key)
+}
+
+// SRem mocks base method.
+func (m *MockredisRepoProvider) SRem(ctx context.Context, key, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SRem", ctx, key, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SRem indicates an expected call of SRem.
+func (mr *MockredisRepoProviderMockRecorder) SRem(ctx, key, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockredisRepoProvider)(nil).SRem), ctx, key, member)
+}
+
 // Set mocks base method.
 func (m *MockredisRepoProvider) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
 	m.ctrl.T.Helper()
@@ -254,3 +634,33 @@ func (mr *MockredisRepoProviderMockRecorder) Set(ctx, key, value, expiration int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockredisRepoProvider)(nil).Set), ctx, key, value, expiration)
 }
+
+// SetNX mocks base method.
+func (m *MockredisRepoProvider) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, key, value, expiration)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockredisRepoProviderMockRecorder) SetNX(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockredisRepoProvider)(nil).SetNX), ctx, key, value, expiration)
+}
+
+// TTL mocks base method.
+func (m *MockredisRepoProvider) TTL(ctx context.Context, key string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL", ctx, key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TTL indicates an expected call of TTL.
+func (mr *MockredisRepoProviderMockRecorder) TTL(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockredisRepoProvider)(nil).TTL), ctx, key)
+}
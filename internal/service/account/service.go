@@ -5,44 +5,241 @@ import (
 	"context"
 	"time"
 
+	// external package
+	"github.com/golang-jwt/jwt"
+
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
 )
 
 //go:generate mockgen -source=./service.go -destination=./service_mock.go -package=account
 
 // resourceProvider holds all methods from resource that wil be used in account's service.
 type resourceProvider interface {
-	// DeleteJWTInCache will delete JWT of a user.
+	// DeleteJWTInCache will delete every session of a user,
+	// so none of JWT and refresh token of the user can be used anymore.
 	DeleteJWTInCache(ctx context.Context, userID int64) error
 
-	// GetJWTFromCache will fetch JWT of a user from cache.
-	GetJWTFromCache(ctx context.Context, userID int64) (string, error)
+	// DeleteLoginFailureInCache will reset the failed log in counter of a subject.
+	DeleteLoginFailureInCache(ctx context.Context, subject string) error
+
+	// DeleteMagicLinksInCache will delete every magic link login token of a user,
+	// so none of the magic links sent to the user can be used anymore.
+	DeleteMagicLinksInCache(ctx context.Context, userID int64) error
+
+	// DeletePasswordResetTokensInCache will delete every password reset token of a user,
+	// so none of the password reset links sent to the user can be used anymore.
+	DeletePasswordResetTokensInCache(ctx context.Context, userID int64) error
+
+	// DeletePersonalAccessTokenInDB will delete a personal access token of a user.
+	// It returns false if the user doesn't have the token.
+	DeletePersonalAccessTokenInDB(ctx context.Context, userID, tokenID int64) (bool, error)
+
+	// DeleteSessionInCache will delete a session of a user alongside its refresh token family.
+	// Once deleted, JWT and refresh token of that session can no longer be used.
+	DeleteSessionInCache(ctx context.Context, userID int64, sessionID string) error
+
+	// DeleteUserAccountsPendingDeletionInDB will permanently delete every account whose deletion
+	// was requested at or before requestedBefore, alongside every row owned by those accounts.
+	// It returns id of the deleted accounts.
+	DeleteUserAccountsPendingDeletionInDB(ctx context.Context, requestedBefore time.Time) ([]int64, error)
+
+	// DeleteUserTOTPRecoveryCodeInDB will remove a recovery code from recovery codes of a user whose TOTP is enabled.
+	// It returns false if the user doesn't have the code, so a recovery code can only be used once.
+	DeleteUserTOTPRecoveryCodeInDB(ctx context.Context, userID int64, codeHash string) (bool, error)
+
+	// GetAccountAuditEventsFromDB will fetch security events of a user,
+	// ordered from the most recent event.
+	GetAccountAuditEventsFromDB(ctx context.Context, param GetAccountAuditEventsParam) ([]AccountAuditEvent, error)
+
+	// GetLoginBlockFromCache will fetch the active log in block of a subject from cache.
+	// If the subject isn't blocked, it will return empty LoginBlock.
+	GetLoginBlockFromCache(ctx context.Context, subject string) (LoginBlock, error)
+
+	// GetRefreshTokenFromCache will fetch the owner of a refresh token from cache.
+	// If the key doesn't exist, it will return empty RefreshToken.
+	GetRefreshTokenFromCache(ctx context.Context, tokenHash string) (RefreshToken, error)
+
+	// GetRefreshTokenFamilyFromCache will fetch refresh token family of a session from cache.
+	// If the key doesn't exist, it will return empty RefreshTokenFamily.
+	GetRefreshTokenFamilyFromCache(ctx context.Context, userID int64, sessionID string) (RefreshTokenFamily, error)
+
+	// GetSessionFromCache will fetch a session of a user from cache.
+	// If the key doesn't exist, it will return empty Session.
+	GetSessionFromCache(ctx context.Context, userID int64, sessionID string) (Session, error)
+
+	// GetSessionIDsFromCache will fetch id of every session of a user from cache.
+	// The ids might include sessions that had expired.
+	GetSessionIDsFromCache(ctx context.Context, userID int64) ([]string, error)
+
+	// GetPersonalAccessTokenByHashFromDB will fetch a personal access token alongside email of its owner
+	// based of hash of the token.
+	// If the token doesn't exist, it returns an empty PersonalAccessToken.
+	GetPersonalAccessTokenByHashFromDB(ctx context.Context, tokenHash string) (PersonalAccessToken, error)
+
+	// GetPersonalAccessTokensByUserIDFromDB will fetch every personal access token of a user,
+	// ordered from the most recently created token.
+	GetPersonalAccessTokensByUserIDFromDB(ctx context.Context, userID int64) ([]PersonalAccessToken, error)
 
 	// GetUserAccountByEmailFromDB will fetch user's information based of account's email.
 	GetUserAccountByEmailFromDB(ctx context.Context, email string) (entity.Account, error)
 
+	// GetUserAccountByIDFromDB will fetch user's information based of account's id.
+	GetUserAccountByIDFromDB(ctx context.Context, userID int64) (entity.Account, error)
+
+	// IncrLoginFailureInCache will increment the failed log in counter of a subject and return the new count.
+	// The counter expires once no failure happened for the configured window.
+	IncrLoginFailureInCache(ctx context.Context, subject string) (int64, error)
+
+	// InsertAccountAuditEventToDB will record a security event of a user.
+	InsertAccountAuditEventToDB(ctx context.Context, event AccountAuditEvent) error
+
+	// InsertAdminAuditLogToDB will record an action an admin took on an account.
+	InsertAdminAuditLogToDB(ctx context.Context, param AdminAuditLog) error
+
+	// InsertPersonalAccessTokenToDB will create a new personal access token in database.
+	// It returns id of the new token.
+	InsertPersonalAccessTokenToDB(ctx context.Context, param InsertPersonalAccessTokenParam) (int64, error)
+
 	// InsertUserAccountToDB will create a new entry of user account in database.
 	InsertUserAccountToDB(ctx context.Context, email, password string) error
 
-	// SetJWTToCache will save jwt of a user in cache
-	SetJWTToCache(ctx context.Context, userID int64, jwt string) error
+	// PopEmailChangeFromCache will fetch the owner of an email change confirmation token
+	// and delete the token from cache atomically, so the token can only be used once.
+	// If the key doesn't exist, it will return empty EmailChange.
+	PopEmailChangeFromCache(ctx context.Context, tokenHash string) (EmailChange, error)
+
+	// PopEmailVerificationTokenFromCache will fetch id of the owner of an email verification token
+	// and delete the token from cache atomically, so the token can only be used once.
+	// If the key doesn't exist, it will return 0.
+	PopEmailVerificationTokenFromCache(ctx context.Context, tokenHash string) (int64, error)
+
+	// PopMagicLinkFromCache will fetch the owner of a magic link login token
+	// and delete the token from cache atomically, so the token can only be used once.
+	// If the key doesn't exist, it will return empty MagicLink.
+	PopMagicLinkFromCache(ctx context.Context, tokenHash string) (MagicLink, error)
+
+	// PopMFAChallengeFromCache will fetch the owner of an MFA challenge token
+	// and delete the token from cache atomically, so the token can only be used once.
+	// If the key doesn't exist, it will return empty MFAChallenge.
+	PopMFAChallengeFromCache(ctx context.Context, tokenHash string) (MFAChallenge, error)
+
+	// PopPasswordResetTokenFromCache will fetch id of the owner of a password reset token
+	// and delete the token from cache atomically, so the token can only be used once.
+	// If the key doesn't exist, it will return 0.
+	PopPasswordResetTokenFromCache(ctx context.Context, tokenHash string) (int64, error)
+
+	// SearchUserAccountsFromDB will fetch accounts whose email, first name or last name contains param.Query,
+	// ordered by their id.
+	SearchUserAccountsFromDB(ctx context.Context, param SearchUserAccountsParam) ([]entity.Account, error)
+
+	// SetEmailChangeToCache will save the owner of an email change confirmation token in cache.
+	SetEmailChangeToCache(ctx context.Context, tokenHash string, change EmailChange) error
+
+	// SetEmailVerificationCooldownToCache will start the resend cooldown of user's verification email.
+	// It returns false if the previous cooldown is still running.
+	SetEmailVerificationCooldownToCache(ctx context.Context, userID int64) (bool, error)
+
+	// SetEmailVerificationTokenToCache will save id of the owner of an email verification token in cache.
+	SetEmailVerificationTokenToCache(ctx context.Context, tokenHash string, userID int64) error
+
+	// SetLoginBlockToCache will refuse log in attempts of a subject until the block expires.
+	SetLoginBlockToCache(ctx context.Context, subject string, block LoginBlock) error
+
+	// SetMagicLinkCooldownToCache will start the cooldown of user's magic link email.
+	// It returns false if the previous cooldown is still running.
+	SetMagicLinkCooldownToCache(ctx context.Context, userID int64) (bool, error)
+
+	// SetMagicLinkToCache will save the owner of a magic link login token in cache.
+	SetMagicLinkToCache(ctx context.Context, tokenHash string, link MagicLink) error
+
+	// SetMFAChallengeToCache will save the owner of an MFA challenge token in cache.
+	SetMFAChallengeToCache(ctx context.Context, tokenHash string, challenge MFAChallenge) error
+
+	// SetPasswordResetCooldownToCache will start the cooldown of user's password reset email.
+	// It returns false if the previous cooldown is still running.
+	SetPasswordResetCooldownToCache(ctx context.Context, userID int64) (bool, error)
+
+	// SetPasswordResetTokenToCache will save id of the owner of a password reset token in cache.
+	SetPasswordResetTokenToCache(ctx context.Context, tokenHash string, userID int64) error
+
+	// SetRefreshTokenToCache will save the owner of a refresh token in cache.
+	SetRefreshTokenToCache(ctx context.Context, tokenHash string, token RefreshToken) error
+
+	// SetSessionToCache will save a session of a user in cache
+	// and register it to the list of user's sessions.
+	SetSessionToCache(ctx context.Context, session Session) error
+
+	// SwapRefreshTokenFamilyInCache will save refresh token family of a session in cache
+	// and return the family that was saved before atomically.
+	// If there is no family saved before, it will return empty RefreshTokenFamily.
+	SwapRefreshTokenFamilyInCache(ctx context.Context, userID int64, sessionID string, family RefreshTokenFamily) (RefreshTokenFamily, error)
+
+	// UpdateSessionLastSeenInCache will save lastSeenAt as last seen time of a session in cache,
+	// only if the session saved in cache is still the same as session.
+	// A session that is revoked or changed since it was read is left as it is, and it keeps its expiry.
+	UpdateSessionLastSeenInCache(ctx context.Context, session Session, lastSeenAt time.Time) error
 
 	// UpdateUserAccountInDB will update user's account based on the given parameter.
 	UpdateUserAccountInDB(ctx context.Context, param UpdateUserAccountParam) error
 
+	// UpdateUserDeletionRequestedInDB will set the time user requested to delete their account.
+	// A zero requestedAt cancels a pending deletion.
+	UpdateUserDeletionRequestedInDB(ctx context.Context, userID int64, requestedAt time.Time) error
+
+	// UpdateUserDisabledInDB will set the time user's account was disabled.
+	// A zero disabledAt enables the account again.
+	UpdateUserDisabledInDB(ctx context.Context, userID int64, disabledAt time.Time) error
+
+	// UpdateUserEmailInDB will replace user's email with a new one that had been confirmed.
+	UpdateUserEmailInDB(ctx context.Context, userID int64, email string) error
+
+	// UpdateUserEmailVerifiedInDB will mark user's email as verified.
+	UpdateUserEmailVerifiedInDB(ctx context.Context, userID int64) error
+
 	// UpdateUserPasswordInDB will update user's password based on the given parameter.
 	UpdateUserPasswordInDB(ctx context.Context, userID int64, password string) error
+
+	// UpdateUserPasswordHashInDB will replace the hash of user's password with password,
+	// only if the hash is still oldPassword. It returns false if the hash had been changed.
+	UpdateUserPasswordHashInDB(ctx context.Context, userID int64, oldPassword, password string) (bool, error)
+
+	// UpdateUserTOTPInDB will update user's TOTP two-factor authentication based on the given parameter.
+	UpdateUserTOTPInDB(ctx context.Context, param UpdateUserTOTPParam) error
+
+	// UpdateUserTOTPLastUsedStepInDB will record the TOTP time step of a code user has just used.
+	// It returns false if TOTP of the user isn't enabled, or a code of the same or a later step
+	// had been used before, so a TOTP code can't be replayed.
+	UpdateUserTOTPLastUsedStepInDB(ctx context.Context, userID, step int64) (bool, error)
 }
 
 // infraProvider holds all methods from infra that will be needed in resource.
 type infraProvider interface {
+	// ComparePassword will check whether password matches hash.
+	ComparePassword(hash, password string) error
+
 	// GetConfig will get configuration that had been saved to memory.
 	GetConfig() *configuration.AppConfig
 
 	// GetTimeGMT7 will get current time in GMT+7
 	GetTimeGMT7() time.Time
+
+	// HashPassword will hash password with the configured algorithm and parameters.
+	HashPassword(password string) (string, error)
+
+	// IsPasswordBreached will check whether password was exposed in a known breach.
+	IsPasswordBreached(password string) bool
+
+	// NeedsRehash will check whether hash was made with an outdated algorithm or parameters.
+	NeedsRehash(hash string) bool
+
+	// SendMail will send an email using the configured driver.
+	SendMail(ctx context.Context, msg mailer.Message) error
+
+	// SignJWT will sign claims with the active key of the keyring.
+	SignJWT(claims jwt.Claims) (string, error)
 }
 
 // AccountServiceParam holds all parameters needed to instantiate
@@ -5,17 +5,14 @@ import (
 	"context"
 	"errors"
 	"log"
-
-	// external package
-	"golang.org/x/crypto/bcrypt"
 )
 
 var (
-	errIncorrectPassword = errors.New("incorrect password!")
+	// ErrAccountNotFound is returned when the requested account doesn't exist.
+	ErrAccountNotFound = errors.New("account not found")
 
-	// for mocking purpose
-	compareHashPassword  = bcrypt.CompareHashAndPassword
-	generateFromPassword = bcrypt.GenerateFromPassword
+	// ErrIncorrectPassword is returned when the given password doesn't match user's password.
+	ErrIncorrectPassword = errors.New("incorrect password!")
 )
 
 // CheckPasswordCorrect will check whether user's password match with current password or not.
@@ -30,10 +27,14 @@ func (svc *Service) CheckPasswordCorrect(ctx context.Context, email, password st
 		return err
 	}
 
-	err = compareHashPassword([]byte(account.Password), []byte(password))
+	err = svc.infra.ComparePassword(account.Password, password)
 	if err != nil {
-		log.Printf("[CheckPasswordCorrect] compareHashPassword() got an error: %+v\nMeta:%+v\n", errIncorrectPassword, meta)
-		return errIncorrectPassword
+		log.Printf("[CheckPasswordCorrect] svc.infra.ComparePassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		return ErrIncorrectPassword
+	}
+
+	if svc.infra.NeedsRehash(account.Password) {
+		svc.rehashPassword(ctx, account.ID, account.Password, password)
 	}
 
 	return nil
@@ -54,19 +55,39 @@ func (svc *Service) GetUserAccountByEmail(ctx context.Context, email string) (Ac
 	return Account(account), nil
 }
 
+// GetUserAccountByID will fetch user's account based on its id.
+// If the account doesn't exist, it will return ErrAccountNotFound.
+func (svc *Service) GetUserAccountByID(ctx context.Context, userID int64) (Account, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	account, err := svc.rsc.GetUserAccountByIDFromDB(ctx, userID)
+	if err != nil {
+		log.Printf("[GetUserAccountByID] svc.rsc.GetUserAccountByIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Account{}, err
+	}
+
+	if account.ID == 0 {
+		return Account{}, ErrAccountNotFound
+	}
+
+	return Account(account), nil
+}
+
 // InsertUserAccount will create a new user account.
 func (svc *Service) InsertUserAccount(ctx context.Context, email, password string) (err error) {
 	meta := map[string]interface{}{
 		"email": email,
 	}
 
-	hashed, err := generateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashed, err := svc.infra.HashPassword(password)
 	if err != nil {
-		log.Printf("[InsertUserAccount] generateFromPassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[InsertUserAccount] svc.infra.HashPassword() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
-	err = svc.rsc.InsertUserAccountToDB(ctx, email, string(hashed))
+	err = svc.rsc.InsertUserAccountToDB(ctx, email, hashed)
 	if err != nil {
 		log.Printf("[InsertUserAccount] svc.rsc.InsertUserAccountToDB() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
@@ -100,13 +121,13 @@ func (svc *Service) UpdateUserPassword(ctx context.Context, userID int64, passwo
 		"user_id": userID,
 	}
 
-	hashed, err := generateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashed, err := svc.infra.HashPassword(password)
 	if err != nil {
-		log.Printf("[UpdateUserPassword] generateFromPassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[UpdateUserPassword] svc.infra.HashPassword() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
-	err = svc.rsc.UpdateUserPasswordInDB(ctx, userID, string(hashed))
+	err = svc.rsc.UpdateUserPasswordInDB(ctx, userID, hashed)
 	if err != nil {
 		log.Printf("[UpdateUserPassword] svc.rsc.UpdateUserPasswordInDB() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
@@ -114,3 +135,25 @@ func (svc *Service) UpdateUserPassword(ctx context.Context, userID int64, passwo
 
 	return nil
 }
+
+// rehashPassword will replace oldHash, the stored hash of user's password, with a hash made
+// with the current algorithm and parameters.
+// Nothing is replaced when the password had been changed since oldHash was read,
+// so a password reset or change is never overwritten by the password that was just verified.
+// It only logs errors, since the password had been verified and the old hash still works.
+func (svc *Service) rehashPassword(ctx context.Context, userID int64, oldHash, password string) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	hashed, err := svc.infra.HashPassword(password)
+	if err != nil {
+		log.Printf("[rehashPassword] svc.infra.HashPassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		return
+	}
+
+	_, err = svc.rsc.UpdateUserPasswordHashInDB(ctx, userID, oldHash, hashed)
+	if err != nil {
+		log.Printf("[rehashPassword] svc.rsc.UpdateUserPasswordHashInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+	}
+}
@@ -0,0 +1,68 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"log"
+	"time"
+)
+
+// CancelAccountDeletion will cancel a pending deletion of a user's account.
+func (svc *Service) CancelAccountDeletion(ctx context.Context, userID int64) error {
+	err := svc.rsc.UpdateUserDeletionRequestedInDB(ctx, userID, time.Time{})
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[CancelAccountDeletion] svc.rsc.UpdateUserDeletionRequestedInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// PurgeAccountsPendingDeletion will permanently delete every account whose grace period
+// has passed, alongside every row owned by those accounts.
+// It returns id of the deleted accounts.
+func (svc *Service) PurgeAccountsPendingDeletion(ctx context.Context) ([]int64, error) {
+	gracePeriod := time.Duration(svc.infra.GetConfig().Account.DeletionGracePeriod) * time.Hour
+	requestedBefore := svc.infra.GetTimeGMT7().Add(-gracePeriod)
+
+	userIDs, err := svc.rsc.DeleteUserAccountsPendingDeletionInDB(ctx, requestedBefore)
+	if err != nil {
+		meta := map[string]interface{}{
+			"requested_before": requestedBefore,
+		}
+
+		log.Printf("[PurgeAccountsPendingDeletion] svc.rsc.DeleteUserAccountsPendingDeletionInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// RequestAccountDeletion will mark a user's account as pending deletion
+// and revoke every session of the user.
+// It returns the time the account will be purged at.
+func (svc *Service) RequestAccountDeletion(ctx context.Context, userID int64) (time.Time, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	now := svc.infra.GetTimeGMT7()
+	err := svc.rsc.UpdateUserDeletionRequestedInDB(ctx, userID, now)
+	if err != nil {
+		log.Printf("[RequestAccountDeletion] svc.rsc.UpdateUserDeletionRequestedInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return time.Time{}, err
+	}
+
+	err = svc.rsc.DeleteJWTInCache(ctx, userID)
+	if err != nil {
+		log.Printf("[RequestAccountDeletion] svc.rsc.DeleteJWTInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return time.Time{}, err
+	}
+
+	gracePeriod := time.Duration(svc.infra.GetConfig().Account.DeletionGracePeriod) * time.Hour
+	return now.Add(gracePeriod), nil
+}
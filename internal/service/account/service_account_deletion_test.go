@@ -0,0 +1,182 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+func TestService_CancelAccountDeletion(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserDeletionRequestedInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserDeletionRequestedInDB(context.Background(), int64(123), time.Time{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserDeletionRequestedInDB(context.Background(), int64(123), time.Time{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.CancelAccountDeletion(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_PurgeAccountsPendingDeletion(t *testing.T) {
+	mockTime := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			DeletionGracePeriod: 720,
+		},
+	}
+	requestedBefore := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []int64
+		wantErr    error
+	}{
+		{
+			name: "when_DeleteUserAccountsPendingDeletionInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().DeleteUserAccountsPendingDeletionInDB(context.Background(), requestedBefore).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_deleted_ids",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().DeleteUserAccountsPendingDeletionInDB(context.Background(), requestedBefore).Return([]int64{1, 2}, nil)
+			},
+			want: []int64{1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.PurgeAccountsPendingDeletion(context.Background())
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RequestAccountDeletion(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			DeletionGracePeriod: 720,
+		},
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       time.Time
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserDeletionRequestedInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDeletionRequestedInDB(context.Background(), int64(123), mockTime).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteJWTInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDeletionRequestedInDB(context.Background(), int64(123), mockTime).Return(nil)
+				mf.rsc.EXPECT().DeleteJWTInCache(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_purge_time",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDeletionRequestedInDB(context.Background(), int64(123), mockTime).Return(nil)
+				mf.rsc.EXPECT().DeleteJWTInCache(context.Background(), int64(123)).Return(nil)
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+			},
+			want: time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.RequestAccountDeletion(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
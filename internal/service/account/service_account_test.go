@@ -2,19 +2,176 @@ package account
 
 import (
 	"context"
-	"encoding/json"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
-	"golang.org/x/crypto/bcrypt"
+
+	"github.com/arifinhermawan/bubi/internal/entity"
 )
 
-func TestService_InsertUserAccount(t *testing.T) {
-	generateFromPasswordOri := bcrypt.GenerateFromPassword
+func TestService_CheckPasswordCorrect(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockAccount := entity.Account{
+		Email:    "email",
+		ID:       123,
+		Password: "old_hash",
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByEmailFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(entity.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_password_not_match_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(assert.AnError)
+			},
+			wantErr: ErrIncorrectPassword,
+		},
+		{
+			name: "when_hash_up_to_date_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(nil)
+				mf.infra.EXPECT().NeedsRehash("old_hash").Return(false)
+			},
+		},
+		{
+			name: "when_rehash_HashPassword_error_then_still_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(nil)
+				mf.infra.EXPECT().NeedsRehash("old_hash").Return(true)
+				mf.infra.EXPECT().HashPassword("password").Return("", assert.AnError)
+			},
+		},
+		{
+			name: "when_rehash_UpdateUserPasswordHashInDB_error_then_still_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(nil)
+				mf.infra.EXPECT().NeedsRehash("old_hash").Return(true)
+				mf.infra.EXPECT().HashPassword("password").Return("new_hash", nil)
+				mf.rsc.EXPECT().UpdateUserPasswordHashInDB(context.Background(), int64(123), "old_hash", "new_hash").Return(false, assert.AnError)
+			},
+		},
+		{
+			name: "when_hash_outdated_then_rehash_password_and_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(nil)
+				mf.infra.EXPECT().NeedsRehash("old_hash").Return(true)
+				mf.infra.EXPECT().HashPassword("password").Return("new_hash", nil)
+				mf.rsc.EXPECT().UpdateUserPasswordHashInDB(context.Background(), int64(123), "old_hash", "new_hash").Return(true, nil)
+			},
+		},
+		{
+			name: "when_password_changed_before_rehash_then_keep_changed_password_and_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByEmailFromDB(context.Background(), "email").Return(mockAccount, nil)
+				mf.infra.EXPECT().ComparePassword("old_hash", "password").Return(nil)
+				mf.infra.EXPECT().NeedsRehash("old_hash").Return(true)
+				mf.infra.EXPECT().HashPassword("password").Return("new_hash", nil)
+				mf.rsc.EXPECT().UpdateUserPasswordHashInDB(context.Background(), int64(123), "old_hash", "new_hash").Return(false, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.CheckPasswordCorrect(context.Background(), "email", "password")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetUserAccountByID(t *testing.T) {
 	type mockFields struct {
 		rsc *MockresourceProvider
 	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Account
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{}, nil)
+			},
+			wantErr: ErrAccountNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_account",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+			},
+			want: Account{
+				Email: "email",
+				ID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetUserAccountByID(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_InsertUserAccount(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
 
 	type args struct {
 		email    string
@@ -27,12 +184,10 @@ func TestService_InsertUserAccount(t *testing.T) {
 		wantErr    error
 	}{
 		{
-			name: "when_generateFromPassword_error_then_return_error",
+			name: "when_HashPassword_error_then_return_error",
 			args: args{password: "1234"},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					return nil, assert.AnError
-				}
+				mf.infra.EXPECT().HashPassword("1234").Return("", assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
@@ -43,13 +198,8 @@ func TestService_InsertUserAccount(t *testing.T) {
 				email:    "email",
 			},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					hashed, _ := json.Marshal("1234")
-					return hashed, nil
-				}
-
-				hashed, _ := json.Marshal("1234")
-				mf.rsc.EXPECT().InsertUserAccountToDB(context.Background(), "email", string(hashed)).Return(assert.AnError)
+				mf.infra.EXPECT().HashPassword("1234").Return("hashed", nil)
+				mf.rsc.EXPECT().InsertUserAccountToDB(context.Background(), "email", "hashed").Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
@@ -60,13 +210,8 @@ func TestService_InsertUserAccount(t *testing.T) {
 				email:    "email",
 			},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					hashed, _ := json.Marshal("1234")
-					return hashed, nil
-				}
-
-				hashed, _ := json.Marshal("1234")
-				mf.rsc.EXPECT().InsertUserAccountToDB(context.Background(), "email", string(hashed)).Return(nil)
+				mf.infra.EXPECT().HashPassword("1234").Return("hashed", nil)
+				mf.rsc.EXPECT().InsertUserAccountToDB(context.Background(), "email", "hashed").Return(nil)
 			},
 		},
 	}
@@ -74,18 +219,16 @@ func TestService_InsertUserAccount(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
-				rsc: NewMockresourceProvider(ctrl),
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
 			}
 			test.mockFields(mockFields)
 
 			svc := &Service{
-				rsc: mockFields.rsc,
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
 			}
 
-			defer func() {
-				generateFromPassword = generateFromPasswordOri
-			}()
-
 			err := svc.InsertUserAccount(context.Background(), test.args.email, test.args.password)
 			assert.Equal(t, test.wantErr, err)
 		})
@@ -145,9 +288,9 @@ func TestService_UpdateUserAccount(t *testing.T) {
 }
 
 func TestService_UpdateUserPassword(t *testing.T) {
-	generateFromPasswordOri := bcrypt.GenerateFromPassword
 	type mockFields struct {
-		rsc *MockresourceProvider
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
 	}
 
 	type args struct {
@@ -161,12 +304,10 @@ func TestService_UpdateUserPassword(t *testing.T) {
 		wantErr    error
 	}{
 		{
-			name: "when_generateFromPassword_error_then_return_error",
+			name: "when_HashPassword_error_then_return_error",
 			args: args{password: "1234"},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					return nil, assert.AnError
-				}
+				mf.infra.EXPECT().HashPassword("1234").Return("", assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
@@ -177,13 +318,8 @@ func TestService_UpdateUserPassword(t *testing.T) {
 				userID:   123,
 			},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					hashed, _ := json.Marshal("1234")
-					return hashed, nil
-				}
-
-				hashed, _ := json.Marshal("1234")
-				mf.rsc.EXPECT().UpdateUserPasswordInDB(context.Background(), int64(123), string(hashed)).Return(assert.AnError)
+				mf.infra.EXPECT().HashPassword("1234").Return("hashed", nil)
+				mf.rsc.EXPECT().UpdateUserPasswordInDB(context.Background(), int64(123), "hashed").Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
@@ -194,30 +330,23 @@ func TestService_UpdateUserPassword(t *testing.T) {
 				userID:   123,
 			},
 			mockFields: func(mf mockFields) {
-				generateFromPassword = func(password []byte, cost int) ([]byte, error) {
-					hashed, _ := json.Marshal("1234")
-					return hashed, nil
-				}
-
-				hashed, _ := json.Marshal("1234")
-				mf.rsc.EXPECT().UpdateUserPasswordInDB(context.Background(), int64(123), string(hashed)).Return(nil)
+				mf.infra.EXPECT().HashPassword("1234").Return("hashed", nil)
+				mf.rsc.EXPECT().UpdateUserPasswordInDB(context.Background(), int64(123), "hashed").Return(nil)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			defer func() {
-				generateFromPassword = generateFromPasswordOri
-			}()
-
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
-				rsc: NewMockresourceProvider(ctrl),
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
 			}
 			test.mockFields(mockFields)
 
 			svc := &Service{
-				rsc: mockFields.rsc,
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
 			}
 
 			err := svc.UpdateUserPassword(context.Background(), test.args.userID, test.args.password)
@@ -0,0 +1,80 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"log"
+	"time"
+)
+
+// DisableAccount will disable a user's account and revoke every session of the user.
+// A disabled account can't log in until it's enabled again.
+func (svc *Service) DisableAccount(ctx context.Context, userID int64) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	err := svc.rsc.UpdateUserDisabledInDB(ctx, userID, svc.infra.GetTimeGMT7())
+	if err != nil {
+		log.Printf("[DisableAccount] svc.rsc.UpdateUserDisabledInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.DeleteJWTInCache(ctx, userID)
+	if err != nil {
+		log.Printf("[DisableAccount] svc.rsc.DeleteJWTInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// EnableAccount will enable a user's account that had been disabled.
+func (svc *Service) EnableAccount(ctx context.Context, userID int64) error {
+	err := svc.rsc.UpdateUserDisabledInDB(ctx, userID, time.Time{})
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[EnableAccount] svc.rsc.UpdateUserDisabledInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// RecordAdminAction will write an action an admin took on an account to the audit trail.
+func (svc *Service) RecordAdminAction(ctx context.Context, action AdminAuditLog) error {
+	err := svc.rsc.InsertAdminAuditLogToDB(ctx, action)
+	if err != nil {
+		meta := map[string]interface{}{
+			"action": action,
+		}
+
+		log.Printf("[RecordAdminAction] svc.rsc.InsertAdminAuditLogToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SearchUserAccounts will fetch accounts whose email, first name or last name contains param.Query.
+func (svc *Service) SearchUserAccounts(ctx context.Context, param SearchUserAccountsParam) ([]Account, error) {
+	accounts, err := svc.rsc.SearchUserAccountsFromDB(ctx, param)
+	if err != nil {
+		meta := map[string]interface{}{
+			"param": param,
+		}
+
+		log.Printf("[SearchUserAccounts] svc.rsc.SearchUserAccountsFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Account, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, Account(account))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,211 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_DisableAccount(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserDisabledInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDisabledInDB(context.Background(), int64(123), mockTime).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteJWTInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDisabledInDB(context.Background(), int64(123), mockTime).Return(nil)
+				mf.rsc.EXPECT().DeleteJWTInCache(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateUserDisabledInDB(context.Background(), int64(123), mockTime).Return(nil)
+				mf.rsc.EXPECT().DeleteJWTInCache(context.Background(), int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.DisableAccount(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_EnableAccount(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserDisabledInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserDisabledInDB(context.Background(), int64(123), time.Time{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserDisabledInDB(context.Background(), int64(123), time.Time{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.EnableAccount(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RecordAdminAction(t *testing.T) {
+	mockAction := AdminAuditLog{
+		Action:       "disable_account",
+		ActorID:      1,
+		TargetUserID: 123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_InsertAdminAuditLogToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertAdminAuditLogToDB(context.Background(), mockAction).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertAdminAuditLogToDB(context.Background(), mockAction).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.RecordAdminAction(context.Background(), mockAction)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SearchUserAccounts(t *testing.T) {
+	mockParam := SearchUserAccountsParam{
+		Limit:  20,
+		Offset: 40,
+		Query:  "lee",
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Account
+		wantErr    error
+	}{
+		{
+			name: "when_SearchUserAccountsFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SearchUserAccountsFromDB(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_accounts",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SearchUserAccountsFromDB(context.Background(), mockParam).Return([]entity.Account{{ID: 1}}, nil)
+			},
+			want: []Account{{ID: 1}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.SearchUserAccounts(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// Security events recorded to the account audit trail.
+const (
+	AuditEventEmailChanged    = "email_changed"
+	AuditEventLoginFailed     = "login_failed"
+	AuditEventLoginSucceeded  = "login_succeeded"
+	AuditEventLogout          = "logout"
+	AuditEventPasswordChanged = "password_changed"
+	AuditEventProfileUpdated  = "profile_updated"
+	AuditEventSignUp          = "signup"
+	AuditEventTokenRevoked    = "token_revoked"
+)
+
+// ListAuditEvents will fetch security events of a user, ordered from the most recent event.
+func (svc *Service) ListAuditEvents(ctx context.Context, param GetAccountAuditEventsParam) ([]AccountAuditEvent, error) {
+	events, err := svc.rsc.GetAccountAuditEventsFromDB(ctx, param)
+	if err != nil {
+		meta := map[string]interface{}{
+			"param": param,
+		}
+
+		log.Printf("[ListAuditEvents] svc.rsc.GetAccountAuditEventsFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// RecordAuditEvent will write a security event of a user to the account audit trail.
+// IP address, user agent and request id are taken from the request metadata on ctx.
+func (svc *Service) RecordAuditEvent(ctx context.Context, userID int64, event string) error {
+	reqMeta := entity.GetRequestMetadataFromContext(ctx)
+	err := svc.rsc.InsertAccountAuditEventToDB(ctx, AccountAuditEvent{
+		Event:     event,
+		IPAddress: reqMeta.IPAddress,
+		RequestID: reqMeta.RequestID,
+		UserAgent: reqMeta.UserAgent,
+		UserID:    userID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"event":   event,
+			"user_id": userID,
+		}
+
+		log.Printf("[RecordAuditEvent] svc.rsc.InsertAccountAuditEventToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_ListAuditEvents(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockParam := GetAccountAuditEventsParam{
+		Limit:  20,
+		Offset: 0,
+		UserID: 123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AccountAuditEvent
+		wantErr    error
+	}{
+		{
+			name: "when_GetAccountAuditEventsFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetAccountAuditEventsFromDB(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_events",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetAccountAuditEventsFromDB(context.Background(), mockParam).Return([]AccountAuditEvent{
+					{
+						CreatedAt: mockTime,
+						Event:     AuditEventLoginSucceeded,
+						IPAddress: "127.0.0.1",
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []AccountAuditEvent{
+				{
+					CreatedAt: mockTime,
+					Event:     AuditEventLoginSucceeded,
+					IPAddress: "127.0.0.1",
+					UserID:    123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListAuditEvents(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RecordAuditEvent(t *testing.T) {
+	ctx := entity.NewContextWithRequestMetadata(context.Background(), entity.RequestMetadata{
+		IPAddress: "127.0.0.1",
+		RequestID: "req-1",
+		UserAgent: "Mozilla/5.0",
+	})
+	mockEvent := AccountAuditEvent{
+		Event:     AuditEventLogout,
+		IPAddress: "127.0.0.1",
+		RequestID: "req-1",
+		UserAgent: "Mozilla/5.0",
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_InsertAccountAuditEventToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertAccountAuditEventToDB(ctx, mockEvent).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertAccountAuditEventToDB(ctx, mockEvent).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.RecordAuditEvent(ctx, 123, AuditEventLogout)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -1,59 +1,74 @@
 package account
 
 import (
-	// external package
+	// golang package
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"log"
+	"strconv"
 	"time"
 
 	// external package
 	"github.com/golang-jwt/jwt"
 )
 
+const (
+	opaqueTokenLength       = 32
+	sessionLastSeenInterval = time.Minute
+	tokenIDLength           = 16
+)
+
 var (
-	jwtNewWithClaims = jwt.NewWithClaims
+	randRead = rand.Read
 )
 
-// GenerateJWT will generate a new JWT for user if not exist.
-// If it already exist, it will get the value from cache.
-// If it's not exist, it will generate new token and save it to cache.
-func (svc *Service) GenerateJWT(ctx context.Context, userID int64, email string) (string, error) {
+// GenerateJWT will generate a new short-lived JWT for a session of user
+// and save it to cache as the active JWT of the session.
+// The new JWT replaces the previous JWT of the session.
+func (svc *Service) GenerateJWT(ctx context.Context, session Session, email string) (string, error) {
 	meta := map[string]interface{}{
-		"user_id": userID,
-		"email":   email,
+		"user_id":    session.UserID,
+		"session_id": session.SessionID,
+		"email":      email,
 	}
 
-	existingJWT, err := svc.rsc.GetJWTFromCache(ctx, userID)
+	tokenID, err := generateTokenID()
 	if err != nil {
-		log.Printf("[GenerateJWT] svc.rsc.GetJWTFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[GenerateJWT] generateTokenID() got an error: %+v\nMeta:%+v\n", err, meta)
 		return "", err
 	}
 
-	if existingJWT != "" {
-		return existingJWT, nil
-	}
-
-	expired := svc.infra.GetConfig().Account.ExpiredTimeInHour
-	token := jwtNewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"exp": svc.infra.GetTimeGMT7().Add(time.Hour * time.Duration(expired)).Unix(),
+	now := svc.infra.GetTimeGMT7()
+	ttl := svc.infra.GetConfig().JWT.TTL
+	tokenString, err := svc.infra.SignJWT(jwt.MapClaims{
+		"email": email,
+		"exp":   now.Add(time.Second * time.Duration(ttl)).Unix(),
+		"iat":   now.Unix(),
+		"jti":   tokenID,
+		"role":  session.Role,
+		"sid":   session.SessionID,
+		"sub":   strconv.FormatInt(session.UserID, 10),
 	})
-
-	sec := []byte(svc.infra.GetConfig().JWT.Secret)
-	tokenString, err := token.SignedString(sec)
 	if err != nil {
-		log.Printf("[GenerateJWT] token.SignedString() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[GenerateJWT] svc.infra.SignJWT() got an error: %+v\nMeta:%+v\n", err, meta)
 		return "", err
 	}
 
-	err = svc.rsc.SetJWTToCache(ctx, userID, tokenString)
+	session.LastSeenAt = now
+	session.TokenID = tokenID
+	err = svc.rsc.SetSessionToCache(ctx, session)
 	if err != nil {
-		log.Printf("[GenerateJWT] svc.rsc.SetJWTToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[GenerateJWT] svc.rsc.SetSessionToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
 	}
 
 	return tokenString, nil
 }
 
-// InvalidateJWT will delete user's cached JWT.
+// InvalidateJWT will revoke every session of a user.
 func (svc *Service) InvalidateJWT(ctx context.Context, userID int64) error {
 	err := svc.rsc.DeleteJWTInCache(ctx, userID)
 	if err != nil {
@@ -67,3 +82,66 @@ func (svc *Service) InvalidateJWT(ctx context.Context, userID int64) error {
 
 	return nil
 }
+
+// IsJWTActive will check whether the given JWT is still the active JWT of a session.
+// A JWT is no longer active once its session is revoked or it's replaced by another JWT.
+// When the JWT is active, last seen time of the session will be updated.
+func (svc *Service) IsJWTActive(ctx context.Context, userID int64, sessionID, tokenID string) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	session, err := svc.rsc.GetSessionFromCache(ctx, userID, sessionID)
+	if err != nil {
+		log.Printf("[IsJWTActive] svc.rsc.GetSessionFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return false, err
+	}
+
+	if session.TokenID == "" || session.TokenID != tokenID {
+		return false, nil
+	}
+
+	// last seen time is only a hint for user, so it's updated at most
+	// once per interval and failing to update it doesn't reject the JWT.
+	// Only last seen time is written, so a session revoked in the meantime isn't brought back.
+	now := svc.infra.GetTimeGMT7()
+	if now.Sub(session.LastSeenAt) >= sessionLastSeenInterval {
+		err = svc.rsc.UpdateSessionLastSeenInCache(ctx, session, now)
+		if err != nil {
+			log.Printf("[IsJWTActive] svc.rsc.UpdateSessionLastSeenInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		}
+	}
+
+	return true, nil
+}
+
+// generateTokenID will generate a random identifier for a token.
+func generateTokenID() (string, error) {
+	bytes := make([]byte, tokenIDLength)
+	_, err := randRead(bytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+// generateOpaqueToken will generate a random opaque token alongside its hash.
+// Only the hash is saved, so a leaked cache can't be used to redeem the token.
+func generateOpaqueToken() (string, string, error) {
+	bytes := make([]byte, opaqueTokenLength)
+	_, err := randRead(bytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(bytes)
+	return token, hashToken(token), nil
+}
+
+// hashToken will hash an opaque token using SHA-256.
+func hashToken(token string) string {
+	hashed := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hashed[:])
+}
@@ -4,47 +4,112 @@ import (
 	// golang package
 	"context"
 	"testing"
+	"time"
 
 	// external package
+	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
 )
 
 func TestService_GenerateJWT(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockConfig := &configuration.AppConfig{
+		JWT: configuration.JWTConfig{
+			TTL: 900,
+		},
+	}
+
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockClaims := jwt.MapClaims{
+		"email": "email",
+		"exp":   mockTime.Add(900 * time.Second).Unix(),
+		"iat":   mockTime.Unix(),
+		"jti":   "abababababababababababababababab",
+		"role":  "admin",
+		"sid":   "session",
+		"sub":   "123",
+	}
+
+	mockSession := Session{
+		CreatedAt:  mockTime.Add(-time.Hour),
+		DeviceName: "device",
+		Role:       "admin",
+		SessionID:  "session",
+		UserID:     123,
+	}
+	mockSavedSession := mockSession
+	mockSavedSession.LastSeenAt = mockTime
+	mockSavedSession.TokenID = "abababababababababababababababab"
+
 	type mockFields struct {
 		infra *MockinfraProvider
 		rsc   *MockresourceProvider
 	}
-	type args struct {
-		userID int64
-		email  string
-	}
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
 		want       string
 		wantErr    error
 	}{
 		{
-			name: "when_GetJWTFromCache_error_then_return_error",
-			args: args{userID: 123},
+			name: "when_generateTokenID_error_then_return_error",
 			mockFields: func(mf mockFields) {
-				mf.rsc.EXPECT().GetJWTFromCache(context.Background(), int64(123)).Return("", assert.AnError)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_token_exist_then_return_existing_token",
-			args: args{userID: 123},
+			name: "when_SignJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().SignJWT(mockClaims).Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetSessionToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().SignJWT(mockClaims).Return("token", nil)
+				mf.rsc.EXPECT().SetSessionToCache(context.Background(), mockSavedSession).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_new_token",
 			mockFields: func(mf mockFields) {
-				mf.rsc.EXPECT().GetJWTFromCache(context.Background(), int64(123)).Return("token", nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.infra.EXPECT().SignJWT(mockClaims).Return("token", nil)
+				mf.rsc.EXPECT().SetSessionToCache(context.Background(), mockSavedSession).Return(nil)
 			},
 			want: "token",
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
 				rsc:   NewMockresourceProvider(ctrl),
@@ -57,7 +122,7 @@ func TestService_GenerateJWT(t *testing.T) {
 				rsc:   mockFields.rsc,
 			}
 
-			got, err := svc.GenerateJWT(context.Background(), test.args.userID, test.args.email)
+			got, err := svc.GenerateJWT(context.Background(), mockSession, "email")
 			assert.Equal(t, test.want, got)
 			assert.Equal(t, test.wantErr, err)
 		})
@@ -110,3 +175,136 @@ func TestService_InvalidateJWT(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTokenID(t *testing.T) {
+	randReadOri := randRead
+	defer func() {
+		randRead = randReadOri
+	}()
+
+	tests := []struct {
+		name     string
+		mockRead func(b []byte) (n int, err error)
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "when_randRead_error_then_return_error",
+			mockRead: func(b []byte) (n int, err error) {
+				return 0, assert.AnError
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_hex_encoded_id",
+			mockRead: func(b []byte) (n int, err error) {
+				for i := range b {
+					b[i] = 0xab
+				}
+				return len(b), nil
+			},
+			want: "abababababababababababababababab",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randRead = test.mockRead
+
+			got, err := generateTokenID()
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_IsJWTActive(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockSession := Session{
+		LastSeenAt: mockTime,
+		SessionID:  "session",
+		TokenID:    "jti",
+		UserID:     123,
+	}
+	mockStaleSession := mockSession
+	mockStaleSession.LastSeenAt = mockTime.Add(-time.Hour)
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_GetSessionFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_session_revoked_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{}, nil)
+			},
+			want: false,
+		},
+		{
+			name: "when_jwt_replaced_then_return_false",
+			mockFields: func(mf mockFields) {
+				session := mockSession
+				session.TokenID = "another_jti"
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(session, nil)
+			},
+			want: false,
+		},
+		{
+			name: "when_jwt_active_and_recently_seen_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime.Add(time.Second))
+			},
+			want: true,
+		},
+		{
+			name: "when_UpdateSessionLastSeenInCache_error_then_still_return_true",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(mockStaleSession, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateSessionLastSeenInCache(context.Background(), mockStaleSession, mockTime).Return(assert.AnError)
+			},
+			want: true,
+		},
+		{
+			name: "when_jwt_active_then_update_last_seen_and_return_true",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(mockStaleSession, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().UpdateSessionLastSeenInCache(context.Background(), mockStaleSession, mockTime).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.IsJWTActive(context.Background(), 123, "session", "jti")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
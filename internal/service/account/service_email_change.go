@@ -0,0 +1,132 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+const (
+	emailChangeSubject = "Confirm your new bubi email"
+	emailChangeBody    = "Hi,\n\n" +
+		"We received a request to change the email of your bubi account to this address.\n" +
+		"Open the link below to confirm it. The link can only be used once and expires in %d minutes.\n\n" +
+		"%s\n\n" +
+		"If you didn't request this change, you can safely ignore this email."
+
+	emailChangeNoticeSubject = "Your bubi email is being changed"
+	emailChangeNoticeBody    = "Hi,\n\n" +
+		"We received a request to change the email of your bubi account to %s.\n" +
+		"The change takes effect once it's confirmed from the new address.\n\n" +
+		"If you didn't request this change, change your password right away."
+)
+
+var (
+	// ErrEmailChangeTokenInvalid is returned when an email change confirmation token is unknown, expired or already used.
+	ErrEmailChangeTokenInvalid = errors.New("email change token not valid")
+)
+
+// ConsumeEmailChangeToken will redeem an email change confirmation token
+// and return its owner alongside the email it will be changed to.
+// An email change confirmation token can only be redeemed once.
+func (svc *Service) ConsumeEmailChangeToken(ctx context.Context, token string) (EmailChange, error) {
+	change, err := svc.rsc.PopEmailChangeFromCache(ctx, hashToken(token))
+	if err != nil {
+		log.Printf("[ConsumeEmailChangeToken] svc.rsc.PopEmailChangeFromCache() got an error: %+v\n", err)
+		return EmailChange{}, err
+	}
+
+	if change.UserID <= 0 {
+		log.Printf("[ConsumeEmailChangeToken] email change token not found\n")
+		return EmailChange{}, ErrEmailChangeTokenInvalid
+	}
+
+	return change, nil
+}
+
+// SendEmailChangeToken will generate a one-time email change confirmation token for user
+// and send it to the new email as a link. A notice is sent to the current email as well,
+// so the owner knows about the change even if it wasn't requested by them.
+func (svc *Service) SendEmailChangeToken(ctx context.Context, param SendEmailChangeParam) error {
+	meta := map[string]interface{}{
+		"user_id": param.UserID,
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[SendEmailChangeToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	cfg := svc.infra.GetConfig().Account
+	link, err := buildTokenURL(cfg.EmailChangeURL, token)
+	if err != nil {
+		log.Printf("[SendEmailChangeToken] buildTokenURL() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.SetEmailChangeToCache(ctx, tokenHash, EmailChange{
+		NewEmail: param.NewEmail,
+		UserID:   param.UserID,
+	})
+	if err != nil {
+		log.Printf("[SendEmailChangeToken] svc.rsc.SetEmailChangeToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.infra.SendMail(ctx, mailer.Message{
+		Body:    fmt.Sprintf(emailChangeNoticeBody, param.NewEmail),
+		Subject: emailChangeNoticeSubject,
+		To:      param.Email,
+	})
+	if err != nil {
+		log.Printf("[SendEmailChangeToken] svc.infra.SendMail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.infra.SendMail(ctx, mailer.Message{
+		Body:    fmt.Sprintf(emailChangeBody, cfg.EmailChangeTTL, link),
+		Subject: emailChangeSubject,
+		To:      param.NewEmail,
+	})
+	if err != nil {
+		log.Printf("[SendEmailChangeToken] svc.infra.SendMail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUserEmail will replace email of an existing account with a confirmed new email,
+// and mark the new email as verified.
+// Every password reset and magic link sent before is invalidated, since they were sent to the previous email.
+func (svc *Service) UpdateUserEmail(ctx context.Context, userID int64, email string) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	err := svc.rsc.UpdateUserEmailInDB(ctx, userID, email)
+	if err != nil {
+		log.Printf("[UpdateUserEmail] svc.rsc.UpdateUserEmailInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.DeletePasswordResetTokensInCache(ctx, userID)
+	if err != nil {
+		log.Printf("[UpdateUserEmail] svc.rsc.DeletePasswordResetTokensInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.DeleteMagicLinksInCache(ctx, userID)
+	if err != nil {
+		log.Printf("[UpdateUserEmail] svc.rsc.DeleteMagicLinksInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
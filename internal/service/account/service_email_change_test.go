@@ -0,0 +1,246 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"fmt"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+func TestService_ConsumeEmailChangeToken(t *testing.T) {
+	mockTokenHash := hashToken("token")
+	mockChange := EmailChange{NewEmail: "new@mail.com", UserID: 123}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       EmailChange
+		wantErr    error
+	}{
+		{
+			name: "when_PopEmailChangeFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailChangeFromCache(context.Background(), mockTokenHash).Return(EmailChange{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailChangeFromCache(context.Background(), mockTokenHash).Return(EmailChange{}, nil)
+			},
+			wantErr: ErrEmailChangeTokenInvalid,
+		},
+		{
+			name: "when_no_error_occured_then_return_email_change",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailChangeFromCache(context.Background(), mockTokenHash).Return(mockChange, nil)
+			},
+			want: mockChange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ConsumeEmailChangeToken(context.Background(), "token")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SendEmailChangeToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockTokenHash := hashToken(mockToken)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			EmailChangeTTL: 30,
+			EmailChangeURL: "https://bubi.app/email/confirm",
+		},
+	}
+	mockChange := EmailChange{NewEmail: "new@mail.com", UserID: 123}
+	mockNotice := mailer.Message{
+		Body:    fmt.Sprintf(emailChangeNoticeBody, "new@mail.com"),
+		Subject: emailChangeNoticeSubject,
+		To:      "old@mail.com",
+	}
+	mockConfirmation := mailer.Message{
+		Body:    fmt.Sprintf(emailChangeBody, 30, "https://bubi.app/email/confirm?token="+mockToken),
+		Subject: emailChangeSubject,
+		To:      "new@mail.com",
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetEmailChangeToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailChangeToCache(context.Background(), mockTokenHash, mockChange).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_send_notice_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailChangeToCache(context.Background(), mockTokenHash, mockChange).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockNotice).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_send_confirmation_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailChangeToCache(context.Background(), mockTokenHash, mockChange).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockNotice).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockConfirmation).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailChangeToCache(context.Background(), mockTokenHash, mockChange).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockNotice).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockConfirmation).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.SendEmailChangeToken(context.Background(), SendEmailChangeParam{
+				Email:    "old@mail.com",
+				NewEmail: "new@mail.com",
+				UserID:   123,
+			})
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_UpdateUserEmail(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserEmailInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserEmailInDB(context.Background(), int64(123), "new@mail.com").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeletePasswordResetTokensInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserEmailInDB(context.Background(), int64(123), "new@mail.com").Return(nil)
+				mf.rsc.EXPECT().DeletePasswordResetTokensInCache(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteMagicLinksInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserEmailInDB(context.Background(), int64(123), "new@mail.com").Return(nil)
+				mf.rsc.EXPECT().DeletePasswordResetTokensInCache(context.Background(), int64(123)).Return(nil)
+				mf.rsc.EXPECT().DeleteMagicLinksInCache(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserEmailInDB(context.Background(), int64(123), "new@mail.com").Return(nil)
+				mf.rsc.EXPECT().DeletePasswordResetTokensInCache(context.Background(), int64(123)).Return(nil)
+				mf.rsc.EXPECT().DeleteMagicLinksInCache(context.Background(), int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.UpdateUserEmail(context.Background(), 123, "new@mail.com")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
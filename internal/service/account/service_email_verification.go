@@ -0,0 +1,126 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+const (
+	emailVerificationSubject = "Verify your bubi email"
+	emailVerificationBody    = "Hi,\n\n" +
+		"Thanks for signing up to bubi. Open the link below to verify your email.\n" +
+		"The link can only be used once and expires in %d minutes.\n\n" +
+		"%s\n\n" +
+		"If you didn't sign up to bubi, you can safely ignore this email."
+)
+
+var (
+	// ErrEmailNotVerified is returned when an account whose email is not verified
+	// is used while verified email is required.
+	ErrEmailNotVerified = errors.New("email not verified")
+
+	// ErrEmailVerificationThrottled is returned when a verification email is requested
+	// before the cooldown of the previous one ends.
+	ErrEmailVerificationThrottled = errors.New("email verification requested too often")
+
+	// ErrEmailVerificationTokenInvalid is returned when an email verification token is unknown, expired or already used.
+	ErrEmailVerificationTokenInvalid = errors.New("email verification token not valid")
+)
+
+// CheckEmailVerified will check whether an account may be used with its current verification state.
+// It returns ErrEmailNotVerified only when verified email is required by config.
+func (svc *Service) CheckEmailVerified(account Account) error {
+	if !svc.infra.GetConfig().Account.RequireVerifiedEmail {
+		return nil
+	}
+
+	if account.EmailVerifiedAt.IsZero() {
+		return ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+// SendEmailVerificationToken will generate a one-time email verification token for user
+// and send it to user's email as a link.
+// It returns ErrEmailVerificationThrottled if the previous email was sent too recently.
+func (svc *Service) SendEmailVerificationToken(ctx context.Context, userID int64, email string) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	ok, err := svc.rsc.SetEmailVerificationCooldownToCache(ctx, userID)
+	if err != nil {
+		log.Printf("[SendEmailVerificationToken] svc.rsc.SetEmailVerificationCooldownToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !ok {
+		log.Printf("[SendEmailVerificationToken] email verification throttled\nMeta:%+v\n", meta)
+		return ErrEmailVerificationThrottled
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[SendEmailVerificationToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	cfg := svc.infra.GetConfig().Account
+	link, err := buildTokenURL(cfg.EmailVerificationURL, token)
+	if err != nil {
+		log.Printf("[SendEmailVerificationToken] buildTokenURL() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.SetEmailVerificationTokenToCache(ctx, tokenHash, userID)
+	if err != nil {
+		log.Printf("[SendEmailVerificationToken] svc.rsc.SetEmailVerificationTokenToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.infra.SendMail(ctx, mailer.Message{
+		Body:    fmt.Sprintf(emailVerificationBody, cfg.EmailVerificationTTL, link),
+		Subject: emailVerificationSubject,
+		To:      email,
+	})
+	if err != nil {
+		log.Printf("[SendEmailVerificationToken] svc.infra.SendMail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// VerifyEmail will redeem an email verification token and mark email of its owner as verified.
+// An email verification token can only be redeemed once.
+func (svc *Service) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := svc.rsc.PopEmailVerificationTokenFromCache(ctx, hashToken(token))
+	if err != nil {
+		log.Printf("[VerifyEmail] svc.rsc.PopEmailVerificationTokenFromCache() got an error: %+v\n", err)
+		return err
+	}
+
+	if userID <= 0 {
+		log.Printf("[VerifyEmail] email verification token not found\n")
+		return ErrEmailVerificationTokenInvalid
+	}
+
+	err = svc.rsc.UpdateUserEmailVerifiedInDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[VerifyEmail] svc.rsc.UpdateUserEmailVerifiedInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+func TestService_CheckEmailVerified(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		infra *MockinfraProvider
+	}
+	tests := []struct {
+		name       string
+		account    Account
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:    "when_verified_email_not_required_then_return_nil",
+			account: Account{ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(&configuration.AppConfig{})
+			},
+		},
+		{
+			name:    "when_email_not_verified_then_return_error",
+			account: Account{ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(&configuration.AppConfig{
+					Account: configuration.AccountConfig{RequireVerifiedEmail: true},
+				})
+			},
+			wantErr: ErrEmailNotVerified,
+		},
+		{
+			name:    "when_email_verified_then_return_nil",
+			account: Account{EmailVerifiedAt: mockTime, ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(&configuration.AppConfig{
+					Account: configuration.AccountConfig{RequireVerifiedEmail: true},
+				})
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+			}
+
+			err := svc.CheckEmailVerified(test.account)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SendEmailVerificationToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockTokenHash := hashToken(mockToken)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			EmailVerificationTTL: 1440,
+			EmailVerificationURL: "https://api.bubi.app/account/verify",
+		},
+	}
+	mockMessage := mailer.Message{
+		Body:    fmt.Sprintf(emailVerificationBody, 1440, "https://api.bubi.app/account/verify?token="+mockToken),
+		Subject: emailVerificationSubject,
+		To:      "email",
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SetEmailVerificationCooldownToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(false, nil)
+			},
+			wantErr: ErrEmailVerificationThrottled,
+		},
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetEmailVerificationTokenToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailVerificationTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendMail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailVerificationTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetEmailVerificationCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetEmailVerificationTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.SendEmailVerificationToken(context.Background(), 123, "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_VerifyEmail(t *testing.T) {
+	mockTokenHash := hashToken("token")
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_PopEmailVerificationTokenFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailVerificationTokenFromCache(context.Background(), mockTokenHash).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailVerificationTokenFromCache(context.Background(), mockTokenHash).Return(int64(0), nil)
+			},
+			wantErr: ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "when_UpdateUserEmailVerifiedInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailVerificationTokenFromCache(context.Background(), mockTokenHash).Return(int64(123), nil)
+				mf.rsc.EXPECT().UpdateUserEmailVerifiedInDB(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopEmailVerificationTokenFromCache(context.Background(), mockTokenHash).Return(int64(123), nil)
+				mf.rsc.EXPECT().UpdateUserEmailVerifiedInDB(context.Background(), int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.VerifyEmail(context.Background(), "token")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+const (
+	loginBlockReasonLocked    = "locked"
+	loginBlockReasonThrottled = "throttled"
+
+	loginSubjectEmail = "email:"
+	loginSubjectIP    = "ip:"
+)
+
+var (
+	// ErrAccountLocked is returned when log in is refused because of too many failed attempts.
+	ErrAccountLocked = errors.New("too many failed log in attempts, account is temporarily locked")
+
+	// ErrLoginThrottled is returned when log in is refused because the previous attempt failed too recently.
+	ErrLoginThrottled = errors.New("too many failed log in attempts, try again later")
+)
+
+// CheckLoginAttempt will check whether a log in attempt to email from ipAddress may proceed.
+// If it may not, it returns how long until the next attempt is allowed
+// alongside ErrAccountLocked or ErrLoginThrottled.
+func (svc *Service) CheckLoginAttempt(ctx context.Context, email, ipAddress string) (time.Duration, error) {
+	for _, subject := range buildLoginSubjects(email, ipAddress) {
+		block, err := svc.rsc.GetLoginBlockFromCache(ctx, subject)
+		if err != nil {
+			meta := map[string]interface{}{
+				"subject": subject,
+			}
+
+			log.Printf("[CheckLoginAttempt] svc.rsc.GetLoginBlockFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return 0, err
+		}
+
+		switch block.Reason {
+		case loginBlockReasonLocked:
+			return block.RetryAfter, ErrAccountLocked
+		case loginBlockReasonThrottled:
+			return block.RetryAfter, ErrLoginThrottled
+		}
+	}
+
+	return 0, nil
+}
+
+// RecordLoginFailure will count a failed log in attempt to email from ipAddress.
+// Once the count passes the configured thresholds, further attempts
+// are refused for a backoff or a lockout.
+func (svc *Service) RecordLoginFailure(ctx context.Context, email, ipAddress string) error {
+	cfg := svc.infra.GetConfig().Account.Security
+
+	for _, subject := range buildLoginSubjects(email, ipAddress) {
+		meta := map[string]interface{}{
+			"subject": subject,
+		}
+
+		count, err := svc.rsc.IncrLoginFailureInCache(ctx, subject)
+		if err != nil {
+			log.Printf("[RecordLoginFailure] svc.rsc.IncrLoginFailureInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+
+		block := computeLoginBlock(cfg, count)
+		if block.Reason == "" {
+			continue
+		}
+
+		if block.Reason == loginBlockReasonLocked {
+			err = svc.rsc.DeleteLoginFailureInCache(ctx, subject)
+			if err != nil {
+				log.Printf("[RecordLoginFailure] svc.rsc.DeleteLoginFailureInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+				return err
+			}
+		}
+
+		err = svc.rsc.SetLoginBlockToCache(ctx, subject, block)
+		if err != nil {
+			log.Printf("[RecordLoginFailure] svc.rsc.SetLoginBlockToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResetLoginFailures will reset the failed log in counter of email after a successful log in.
+// Counter of the client IP is kept, so logging in to another account
+// can't be used to keep guessing passwords from the same IP.
+func (svc *Service) ResetLoginFailures(ctx context.Context, email string) error {
+	err := svc.rsc.DeleteLoginFailureInCache(ctx, loginSubjectEmail+email)
+	if err != nil {
+		meta := map[string]interface{}{
+			"email": email,
+		}
+
+		log.Printf("[ResetLoginFailures] svc.rsc.DeleteLoginFailureInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// buildLoginSubjects will build the subjects whose failed log in attempts are counted.
+func buildLoginSubjects(email, ipAddress string) []string {
+	subjects := []string{loginSubjectEmail + email}
+	if ipAddress != "" {
+		subjects = append(subjects, loginSubjectIP+ipAddress)
+	}
+
+	return subjects
+}
+
+// computeLoginBlock will decide how long a subject is blocked after count failed log in attempts.
+// It returns empty LoginBlock if the subject shouldn't be blocked yet.
+func computeLoginBlock(cfg configuration.SecurityConfig, count int64) LoginBlock {
+	if cfg.MaxFailedAttempts > 0 && count >= int64(cfg.MaxFailedAttempts) {
+		return LoginBlock{
+			Reason:     loginBlockReasonLocked,
+			RetryAfter: time.Duration(cfg.LockoutDuration) * time.Second,
+		}
+	}
+
+	if cfg.BackoffBase <= 0 || count <= int64(cfg.BackoffAfterAttempts) {
+		return LoginBlock{}
+	}
+
+	backoff := time.Duration(cfg.BackoffBase) * time.Second
+	maxBackoff := time.Duration(cfg.BackoffMax) * time.Second
+	for i := int64(cfg.BackoffAfterAttempts) + 1; i < count; i++ {
+		backoff *= 2
+		if maxBackoff > 0 && backoff >= maxBackoff {
+			break
+		}
+	}
+
+	if maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return LoginBlock{
+		Reason:     loginBlockReasonThrottled,
+		RetryAfter: backoff,
+	}
+}
@@ -0,0 +1,271 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+func TestService_CheckLoginAttempt(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       time.Duration
+		wantErr    error
+	}{
+		{
+			name: "when_GetLoginBlockFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "email:email").Return(LoginBlock{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_email_locked_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "email:email").Return(LoginBlock{
+					Reason:     loginBlockReasonLocked,
+					RetryAfter: time.Minute,
+				}, nil)
+			},
+			want:    time.Minute,
+			wantErr: ErrAccountLocked,
+		},
+		{
+			name: "when_ip_throttled_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "email:email").Return(LoginBlock{}, nil)
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "ip:127.0.0.1").Return(LoginBlock{
+					Reason:     loginBlockReasonThrottled,
+					RetryAfter: time.Second,
+				}, nil)
+			},
+			want:    time.Second,
+			wantErr: ErrLoginThrottled,
+		},
+		{
+			name: "when_not_blocked_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "email:email").Return(LoginBlock{}, nil)
+				mf.rsc.EXPECT().GetLoginBlockFromCache(context.Background(), "ip:127.0.0.1").Return(LoginBlock{}, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.CheckLoginAttempt(context.Background(), "email", "127.0.0.1")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RecordLoginFailure(t *testing.T) {
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			Security: configuration.SecurityConfig{
+				BackoffAfterAttempts: 3,
+				BackoffBase:          1,
+				BackoffMax:           60,
+				LockoutDuration:      900,
+				MaxFailedAttempts:    10,
+			},
+		},
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_IncrLoginFailureInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().IncrLoginFailureInCache(context.Background(), "email:email").Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteLoginFailureInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().IncrLoginFailureInCache(context.Background(), "email:email").Return(int64(10), nil)
+				mf.rsc.EXPECT().DeleteLoginFailureInCache(context.Background(), "email:email").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetLoginBlockToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().IncrLoginFailureInCache(context.Background(), "email:email").Return(int64(4), nil)
+				mf.rsc.EXPECT().SetLoginBlockToCache(context.Background(), "email:email", LoginBlock{
+					Reason:     loginBlockReasonThrottled,
+					RetryAfter: time.Second,
+				}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_block_each_subject_by_its_count",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().IncrLoginFailureInCache(context.Background(), "email:email").Return(int64(10), nil)
+				mf.rsc.EXPECT().DeleteLoginFailureInCache(context.Background(), "email:email").Return(nil)
+				mf.rsc.EXPECT().SetLoginBlockToCache(context.Background(), "email:email", LoginBlock{
+					Reason:     loginBlockReasonLocked,
+					RetryAfter: 15 * time.Minute,
+				}).Return(nil)
+				mf.rsc.EXPECT().IncrLoginFailureInCache(context.Background(), "ip:127.0.0.1").Return(int64(2), nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.RecordLoginFailure(context.Background(), "email", "127.0.0.1")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ResetLoginFailures(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_DeleteLoginFailureInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteLoginFailureInCache(context.Background(), "email:email").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteLoginFailureInCache(context.Background(), "email:email").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.ResetLoginFailures(context.Background(), "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestBuildLoginSubjects(t *testing.T) {
+	assert.Equal(t, []string{"email:email"}, buildLoginSubjects("email", ""))
+	assert.Equal(t, []string{"email:email", "ip:127.0.0.1"}, buildLoginSubjects("email", "127.0.0.1"))
+}
+
+func TestComputeLoginBlock(t *testing.T) {
+	mockConfig := configuration.SecurityConfig{
+		BackoffAfterAttempts: 3,
+		BackoffBase:          1,
+		BackoffMax:           10,
+		LockoutDuration:      900,
+		MaxFailedAttempts:    10,
+	}
+
+	tests := []struct {
+		name  string
+		cfg   configuration.SecurityConfig
+		count int64
+		want  LoginBlock
+	}{
+		{
+			name:  "when_count_below_backoff_threshold_then_return_empty_block",
+			cfg:   mockConfig,
+			count: 3,
+		},
+		{
+			name:  "when_count_passes_backoff_threshold_then_return_base_backoff",
+			cfg:   mockConfig,
+			count: 4,
+			want:  LoginBlock{Reason: loginBlockReasonThrottled, RetryAfter: time.Second},
+		},
+		{
+			name:  "when_count_keeps_failing_then_double_backoff",
+			cfg:   mockConfig,
+			count: 6,
+			want:  LoginBlock{Reason: loginBlockReasonThrottled, RetryAfter: 4 * time.Second},
+		},
+		{
+			name:  "when_backoff_passes_max_then_return_max_backoff",
+			cfg:   mockConfig,
+			count: 9,
+			want:  LoginBlock{Reason: loginBlockReasonThrottled, RetryAfter: 10 * time.Second},
+		},
+		{
+			name:  "when_count_reaches_max_failed_attempts_then_return_lockout",
+			cfg:   mockConfig,
+			count: 10,
+			want:  LoginBlock{Reason: loginBlockReasonLocked, RetryAfter: 15 * time.Minute},
+		},
+		{
+			name:  "when_protection_disabled_then_return_empty_block",
+			cfg:   configuration.SecurityConfig{},
+			count: 100,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := computeLoginBlock(test.cfg, test.count)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
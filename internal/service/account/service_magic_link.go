@@ -0,0 +1,101 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+const (
+	magicLinkSubject = "Log in to bubi"
+	magicLinkBody    = "Hi,\n\n" +
+		"We received a request to log in to your bubi account.\n" +
+		"Open the link below to log in. The link can only be used once and expires in %d minutes.\n\n" +
+		"%s\n\n" +
+		"If you didn't request to log in, you can safely ignore this email."
+)
+
+var (
+	// ErrMagicLinkTokenInvalid is returned when a magic link login token is unknown, expired or already used.
+	ErrMagicLinkTokenInvalid = errors.New("magic link token not valid")
+
+	// ErrMagicLinkThrottled is returned when a magic link is requested
+	// before the cooldown of the previous one ends.
+	ErrMagicLinkThrottled = errors.New("magic link requested too often")
+)
+
+// ConsumeMagicLink will redeem a magic link login token and return its owner.
+// A magic link login token can only be redeemed once.
+func (svc *Service) ConsumeMagicLink(ctx context.Context, token string) (MagicLink, error) {
+	link, err := svc.rsc.PopMagicLinkFromCache(ctx, hashToken(token))
+	if err != nil {
+		log.Printf("[ConsumeMagicLink] svc.rsc.PopMagicLinkFromCache() got an error: %+v\n", err)
+		return MagicLink{}, err
+	}
+
+	if link.UserID <= 0 {
+		log.Printf("[ConsumeMagicLink] magic link token not found\n")
+		return MagicLink{}, ErrMagicLinkTokenInvalid
+	}
+
+	return link, nil
+}
+
+// SendMagicLink will generate a one-time magic link login token for user
+// and send it to user's email as a link.
+// It returns ErrMagicLinkThrottled if the previous email was sent too recently.
+func (svc *Service) SendMagicLink(ctx context.Context, userID int64, email string) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	ok, err := svc.rsc.SetMagicLinkCooldownToCache(ctx, userID)
+	if err != nil {
+		log.Printf("[SendMagicLink] svc.rsc.SetMagicLinkCooldownToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !ok {
+		log.Printf("[SendMagicLink] magic link throttled\nMeta:%+v\n", meta)
+		return ErrMagicLinkThrottled
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[SendMagicLink] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	cfg := svc.infra.GetConfig().Account
+	link, err := buildTokenURL(cfg.MagicLinkURL, token)
+	if err != nil {
+		log.Printf("[SendMagicLink] buildTokenURL() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.SetMagicLinkToCache(ctx, tokenHash, MagicLink{
+		Email:  email,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("[SendMagicLink] svc.rsc.SetMagicLinkToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.infra.SendMail(ctx, mailer.Message{
+		Body:    fmt.Sprintf(magicLinkBody, cfg.MagicLinkTTL, link),
+		Subject: magicLinkSubject,
+		To:      email,
+	})
+	if err != nil {
+		log.Printf("[SendMagicLink] svc.infra.SendMail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
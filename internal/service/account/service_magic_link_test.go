@@ -0,0 +1,191 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"fmt"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+func TestService_ConsumeMagicLink(t *testing.T) {
+	mockTokenHash := hashToken("token")
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       MagicLink
+		wantErr    error
+	}{
+		{
+			name: "when_PopMagicLinkFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMagicLinkFromCache(context.Background(), mockTokenHash).Return(MagicLink{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMagicLinkFromCache(context.Background(), mockTokenHash).Return(MagicLink{}, nil)
+			},
+			wantErr: ErrMagicLinkTokenInvalid,
+		},
+		{
+			name: "when_no_error_occured_then_return_magic_link",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMagicLinkFromCache(context.Background(), mockTokenHash).Return(MagicLink{
+					Email:  "email",
+					UserID: 123,
+				}, nil)
+			},
+			want: MagicLink{
+				Email:  "email",
+				UserID: 123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ConsumeMagicLink(context.Background(), "token")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SendMagicLink(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockTokenHash := hashToken(mockToken)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			MagicLinkTTL: 15,
+			MagicLinkURL: "https://bubi.app/login/magic",
+		},
+	}
+	mockLink := MagicLink{
+		Email:  "email",
+		UserID: 123,
+	}
+	mockMessage := mailer.Message{
+		Body:    fmt.Sprintf(magicLinkBody, 15, "https://bubi.app/login/magic?token="+mockToken),
+		Subject: magicLinkSubject,
+		To:      "email",
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SetMagicLinkCooldownToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(false, nil)
+			},
+			wantErr: ErrMagicLinkThrottled,
+		},
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetMagicLinkToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetMagicLinkToCache(context.Background(), mockTokenHash, mockLink).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendMail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetMagicLinkToCache(context.Background(), mockTokenHash, mockLink).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetMagicLinkCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetMagicLinkToCache(context.Background(), mockTokenHash, mockLink).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.SendMagicLink(context.Background(), 123, "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
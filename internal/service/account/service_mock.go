@@ -11,6 +11,8 @@ import (
 
 	entity "github.com/arifinhermawan/bubi/internal/entity"
 	configuration "github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	mailer "github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+	jwt "github.com/golang-jwt/jwt"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -51,19 +53,225 @@ func (mr *MockresourceProviderMockRecorder) DeleteJWTInCache(ctx, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJWTInCache", reflect.TypeOf((*MockresourceProvider)(nil).DeleteJWTInCache), ctx, userID)
 }
 
-// GetJWTFromCache mocks base method.
-func (m *MockresourceProvider) GetJWTFromCache(ctx context.Context, userID int64) (string, error) {
+// DeleteLoginFailureInCache mocks base method.
+func (m *MockresourceProvider) DeleteLoginFailureInCache(ctx context.Context, subject string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJWTFromCache", ctx, userID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DeleteLoginFailureInCache", ctx, subject)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginFailureInCache indicates an expected call of DeleteLoginFailureInCache.
+func (mr *MockresourceProviderMockRecorder) DeleteLoginFailureInCache(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginFailureInCache", reflect.TypeOf((*MockresourceProvider)(nil).DeleteLoginFailureInCache), ctx, subject)
+}
+
+// DeleteMagicLinksInCache mocks base method.
+func (m *MockresourceProvider) DeleteMagicLinksInCache(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMagicLinksInCache", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMagicLinksInCache indicates an expected call of DeleteMagicLinksInCache.
+func (mr *MockresourceProviderMockRecorder) DeleteMagicLinksInCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMagicLinksInCache", reflect.TypeOf((*MockresourceProvider)(nil).DeleteMagicLinksInCache), ctx, userID)
+}
+
+// DeletePasswordResetTokensInCache mocks base method.
+func (m *MockresourceProvider) DeletePasswordResetTokensInCache(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasswordResetTokensInCache", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePasswordResetTokensInCache indicates an expected call of DeletePasswordResetTokensInCache.
+func (mr *MockresourceProviderMockRecorder) DeletePasswordResetTokensInCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasswordResetTokensInCache", reflect.TypeOf((*MockresourceProvider)(nil).DeletePasswordResetTokensInCache), ctx, userID)
+}
+
+// DeletePersonalAccessTokenInDB mocks base method.
+func (m *MockresourceProvider) DeletePersonalAccessTokenInDB(ctx context.Context, userID, tokenID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonalAccessTokenInDB", ctx, userID, tokenID)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJWTFromCache indicates an expected call of GetJWTFromCache.
-func (mr *MockresourceProviderMockRecorder) GetJWTFromCache(ctx, userID interface{}) *gomock.Call {
+// DeletePersonalAccessTokenInDB indicates an expected call of DeletePersonalAccessTokenInDB.
+func (mr *MockresourceProviderMockRecorder) DeletePersonalAccessTokenInDB(ctx, userID, tokenID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWTFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetJWTFromCache), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonalAccessTokenInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeletePersonalAccessTokenInDB), ctx, userID, tokenID)
+}
+
+// DeleteSessionInCache mocks base method.
+func (m *MockresourceProvider) DeleteSessionInCache(ctx context.Context, userID int64, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionInCache", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionInCache indicates an expected call of DeleteSessionInCache.
+func (mr *MockresourceProviderMockRecorder) DeleteSessionInCache(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionInCache", reflect.TypeOf((*MockresourceProvider)(nil).DeleteSessionInCache), ctx, userID, sessionID)
+}
+
+// DeleteUserAccountsPendingDeletionInDB mocks base method.
+func (m *MockresourceProvider) DeleteUserAccountsPendingDeletionInDB(ctx context.Context, requestedBefore time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserAccountsPendingDeletionInDB", ctx, requestedBefore)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserAccountsPendingDeletionInDB indicates an expected call of DeleteUserAccountsPendingDeletionInDB.
+func (mr *MockresourceProviderMockRecorder) DeleteUserAccountsPendingDeletionInDB(ctx, requestedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAccountsPendingDeletionInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeleteUserAccountsPendingDeletionInDB), ctx, requestedBefore)
+}
+
+// DeleteUserTOTPRecoveryCodeInDB mocks base method.
+func (m *MockresourceProvider) DeleteUserTOTPRecoveryCodeInDB(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTOTPRecoveryCodeInDB", ctx, userID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserTOTPRecoveryCodeInDB indicates an expected call of DeleteUserTOTPRecoveryCodeInDB.
+func (mr *MockresourceProviderMockRecorder) DeleteUserTOTPRecoveryCodeInDB(ctx, userID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTOTPRecoveryCodeInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeleteUserTOTPRecoveryCodeInDB), ctx, userID, codeHash)
+}
+
+// GetAccountAuditEventsFromDB mocks base method.
+func (m *MockresourceProvider) GetAccountAuditEventsFromDB(ctx context.Context, param GetAccountAuditEventsParam) ([]AccountAuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAuditEventsFromDB", ctx, param)
+	ret0, _ := ret[0].([]AccountAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAuditEventsFromDB indicates an expected call of GetAccountAuditEventsFromDB.
+func (mr *MockresourceProviderMockRecorder) GetAccountAuditEventsFromDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAuditEventsFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetAccountAuditEventsFromDB), ctx, param)
+}
+
+// GetLoginBlockFromCache mocks base method.
+func (m *MockresourceProvider) GetLoginBlockFromCache(ctx context.Context, subject string) (LoginBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginBlockFromCache", ctx, subject)
+	ret0, _ := ret[0].(LoginBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginBlockFromCache indicates an expected call of GetLoginBlockFromCache.
+func (mr *MockresourceProviderMockRecorder) GetLoginBlockFromCache(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBlockFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetLoginBlockFromCache), ctx, subject)
+}
+
+// GetPersonalAccessTokenByHashFromDB mocks base method.
+func (m *MockresourceProvider) GetPersonalAccessTokenByHashFromDB(ctx context.Context, tokenHash string) (PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessTokenByHashFromDB", ctx, tokenHash)
+	ret0, _ := ret[0].(PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessTokenByHashFromDB indicates an expected call of GetPersonalAccessTokenByHashFromDB.
+func (mr *MockresourceProviderMockRecorder) GetPersonalAccessTokenByHashFromDB(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessTokenByHashFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetPersonalAccessTokenByHashFromDB), ctx, tokenHash)
+}
+
+// GetPersonalAccessTokensByUserIDFromDB mocks base method.
+func (m *MockresourceProvider) GetPersonalAccessTokensByUserIDFromDB(ctx context.Context, userID int64) ([]PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessTokensByUserIDFromDB", ctx, userID)
+	ret0, _ := ret[0].([]PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessTokensByUserIDFromDB indicates an expected call of GetPersonalAccessTokensByUserIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetPersonalAccessTokensByUserIDFromDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessTokensByUserIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetPersonalAccessTokensByUserIDFromDB), ctx, userID)
+}
+
+// GetRefreshTokenFamilyFromCache mocks base method.
+func (m *MockresourceProvider) GetRefreshTokenFamilyFromCache(ctx context.Context, userID int64, sessionID string) (RefreshTokenFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenFamilyFromCache", ctx, userID, sessionID)
+	ret0, _ := ret[0].(RefreshTokenFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenFamilyFromCache indicates an expected call of GetRefreshTokenFamilyFromCache.
+func (mr *MockresourceProviderMockRecorder) GetRefreshTokenFamilyFromCache(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenFamilyFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetRefreshTokenFamilyFromCache), ctx, userID, sessionID)
+}
+
+// GetRefreshTokenFromCache mocks base method.
+func (m *MockresourceProvider) GetRefreshTokenFromCache(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenFromCache indicates an expected call of GetRefreshTokenFromCache.
+func (mr *MockresourceProviderMockRecorder) GetRefreshTokenFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetRefreshTokenFromCache), ctx, tokenHash)
+}
+
+// GetSessionFromCache mocks base method.
+func (m *MockresourceProvider) GetSessionFromCache(ctx context.Context, userID int64, sessionID string) (Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionFromCache", ctx, userID, sessionID)
+	ret0, _ := ret[0].(Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionFromCache indicates an expected call of GetSessionFromCache.
+func (mr *MockresourceProviderMockRecorder) GetSessionFromCache(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetSessionFromCache), ctx, userID, sessionID)
+}
+
+// GetSessionIDsFromCache mocks base method.
+func (m *MockresourceProvider) GetSessionIDsFromCache(ctx context.Context, userID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionIDsFromCache", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionIDsFromCache indicates an expected call of GetSessionIDsFromCache.
+func (mr *MockresourceProviderMockRecorder) GetSessionIDsFromCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionIDsFromCache", reflect.TypeOf((*MockresourceProvider)(nil).GetSessionIDsFromCache), ctx, userID)
 }
 
 // GetUserAccountByEmailFromDB mocks base method.
@@ -81,6 +289,79 @@ func (mr *MockresourceProviderMockRecorder) GetUserAccountByEmailFromDB(ctx, ema
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByEmailFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetUserAccountByEmailFromDB), ctx, email)
 }
 
+// GetUserAccountByIDFromDB mocks base method.
+func (m *MockresourceProvider) GetUserAccountByIDFromDB(ctx context.Context, userID int64) (entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccountByIDFromDB", ctx, userID)
+	ret0, _ := ret[0].(entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccountByIDFromDB indicates an expected call of GetUserAccountByIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetUserAccountByIDFromDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetUserAccountByIDFromDB), ctx, userID)
+}
+
+// IncrLoginFailureInCache mocks base method.
+func (m *MockresourceProvider) IncrLoginFailureInCache(ctx context.Context, subject string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrLoginFailureInCache", ctx, subject)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrLoginFailureInCache indicates an expected call of IncrLoginFailureInCache.
+func (mr *MockresourceProviderMockRecorder) IncrLoginFailureInCache(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrLoginFailureInCache", reflect.TypeOf((*MockresourceProvider)(nil).IncrLoginFailureInCache), ctx, subject)
+}
+
+// InsertAccountAuditEventToDB mocks base method.
+func (m *MockresourceProvider) InsertAccountAuditEventToDB(ctx context.Context, event AccountAuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAccountAuditEventToDB", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAccountAuditEventToDB indicates an expected call of InsertAccountAuditEventToDB.
+func (mr *MockresourceProviderMockRecorder) InsertAccountAuditEventToDB(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAccountAuditEventToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertAccountAuditEventToDB), ctx, event)
+}
+
+// InsertAdminAuditLogToDB mocks base method.
+func (m *MockresourceProvider) InsertAdminAuditLogToDB(ctx context.Context, param AdminAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAdminAuditLogToDB", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAdminAuditLogToDB indicates an expected call of InsertAdminAuditLogToDB.
+func (mr *MockresourceProviderMockRecorder) InsertAdminAuditLogToDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAdminAuditLogToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertAdminAuditLogToDB), ctx, param)
+}
+
+// InsertPersonalAccessTokenToDB mocks base method.
+func (m *MockresourceProvider) InsertPersonalAccessTokenToDB(ctx context.Context, param InsertPersonalAccessTokenParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPersonalAccessTokenToDB", ctx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPersonalAccessTokenToDB indicates an expected call of InsertPersonalAccessTokenToDB.
+func (mr *MockresourceProviderMockRecorder) InsertPersonalAccessTokenToDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPersonalAccessTokenToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertPersonalAccessTokenToDB), ctx, param)
+}
+
 // InsertUserAccountToDB mocks base method.
 func (m *MockresourceProvider) InsertUserAccountToDB(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
@@ -95,18 +376,280 @@ func (mr *MockresourceProviderMockRecorder) InsertUserAccountToDB(ctx, email, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserAccountToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertUserAccountToDB), ctx, email, password)
 }
 
-// SetJWTToCache mocks base method.
-func (m *MockresourceProvider) SetJWTToCache(ctx context.Context, userID int64, jwt string) error {
+// PopEmailChangeFromCache mocks base method.
+func (m *MockresourceProvider) PopEmailChangeFromCache(ctx context.Context, tokenHash string) (EmailChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopEmailChangeFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(EmailChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopEmailChangeFromCache indicates an expected call of PopEmailChangeFromCache.
+func (mr *MockresourceProviderMockRecorder) PopEmailChangeFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopEmailChangeFromCache", reflect.TypeOf((*MockresourceProvider)(nil).PopEmailChangeFromCache), ctx, tokenHash)
+}
+
+// PopEmailVerificationTokenFromCache mocks base method.
+func (m *MockresourceProvider) PopEmailVerificationTokenFromCache(ctx context.Context, tokenHash string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopEmailVerificationTokenFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopEmailVerificationTokenFromCache indicates an expected call of PopEmailVerificationTokenFromCache.
+func (mr *MockresourceProviderMockRecorder) PopEmailVerificationTokenFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopEmailVerificationTokenFromCache", reflect.TypeOf((*MockresourceProvider)(nil).PopEmailVerificationTokenFromCache), ctx, tokenHash)
+}
+
+// PopMFAChallengeFromCache mocks base method.
+func (m *MockresourceProvider) PopMFAChallengeFromCache(ctx context.Context, tokenHash string) (MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopMFAChallengeFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopMFAChallengeFromCache indicates an expected call of PopMFAChallengeFromCache.
+func (mr *MockresourceProviderMockRecorder) PopMFAChallengeFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopMFAChallengeFromCache", reflect.TypeOf((*MockresourceProvider)(nil).PopMFAChallengeFromCache), ctx, tokenHash)
+}
+
+// PopMagicLinkFromCache mocks base method.
+func (m *MockresourceProvider) PopMagicLinkFromCache(ctx context.Context, tokenHash string) (MagicLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopMagicLinkFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(MagicLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopMagicLinkFromCache indicates an expected call of PopMagicLinkFromCache.
+func (mr *MockresourceProviderMockRecorder) PopMagicLinkFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopMagicLinkFromCache", reflect.TypeOf((*MockresourceProvider)(nil).PopMagicLinkFromCache), ctx, tokenHash)
+}
+
+// PopPasswordResetTokenFromCache mocks base method.
+func (m *MockresourceProvider) PopPasswordResetTokenFromCache(ctx context.Context, tokenHash string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopPasswordResetTokenFromCache", ctx, tokenHash)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopPasswordResetTokenFromCache indicates an expected call of PopPasswordResetTokenFromCache.
+func (mr *MockresourceProviderMockRecorder) PopPasswordResetTokenFromCache(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopPasswordResetTokenFromCache", reflect.TypeOf((*MockresourceProvider)(nil).PopPasswordResetTokenFromCache), ctx, tokenHash)
+}
+
+// SearchUserAccountsFromDB mocks base method.
+func (m *MockresourceProvider) SearchUserAccountsFromDB(ctx context.Context, param SearchUserAccountsParam) ([]entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserAccountsFromDB", ctx, param)
+	ret0, _ := ret[0].([]entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserAccountsFromDB indicates an expected call of SearchUserAccountsFromDB.
+func (mr *MockresourceProviderMockRecorder) SearchUserAccountsFromDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserAccountsFromDB", reflect.TypeOf((*MockresourceProvider)(nil).SearchUserAccountsFromDB), ctx, param)
+}
+
+// SetEmailChangeToCache mocks base method.
+func (m *MockresourceProvider) SetEmailChangeToCache(ctx context.Context, tokenHash string, change EmailChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailChangeToCache", ctx, tokenHash, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailChangeToCache indicates an expected call of SetEmailChangeToCache.
+func (mr *MockresourceProviderMockRecorder) SetEmailChangeToCache(ctx, tokenHash, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailChangeToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetEmailChangeToCache), ctx, tokenHash, change)
+}
+
+// SetEmailVerificationCooldownToCache mocks base method.
+func (m *MockresourceProvider) SetEmailVerificationCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailVerificationCooldownToCache", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEmailVerificationCooldownToCache indicates an expected call of SetEmailVerificationCooldownToCache.
+func (mr *MockresourceProviderMockRecorder) SetEmailVerificationCooldownToCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerificationCooldownToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetEmailVerificationCooldownToCache), ctx, userID)
+}
+
+// SetEmailVerificationTokenToCache mocks base method.
+func (m *MockresourceProvider) SetEmailVerificationTokenToCache(ctx context.Context, tokenHash string, userID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetJWTToCache", ctx, userID, jwt)
+	ret := m.ctrl.Call(m, "SetEmailVerificationTokenToCache", ctx, tokenHash, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetJWTToCache indicates an expected call of SetJWTToCache.
-func (mr *MockresourceProviderMockRecorder) SetJWTToCache(ctx, userID, jwt interface{}) *gomock.Call {
+// SetEmailVerificationTokenToCache indicates an expected call of SetEmailVerificationTokenToCache.
+func (mr *MockresourceProviderMockRecorder) SetEmailVerificationTokenToCache(ctx, tokenHash, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJWTToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetJWTToCache), ctx, userID, jwt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerificationTokenToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetEmailVerificationTokenToCache), ctx, tokenHash, userID)
+}
+
+// SetLoginBlockToCache mocks base method.
+func (m *MockresourceProvider) SetLoginBlockToCache(ctx context.Context, subject string, block LoginBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLoginBlockToCache", ctx, subject, block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLoginBlockToCache indicates an expected call of SetLoginBlockToCache.
+func (mr *MockresourceProviderMockRecorder) SetLoginBlockToCache(ctx, subject, block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoginBlockToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetLoginBlockToCache), ctx, subject, block)
+}
+
+// SetMFAChallengeToCache mocks base method.
+func (m *MockresourceProvider) SetMFAChallengeToCache(ctx context.Context, tokenHash string, challenge MFAChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMFAChallengeToCache", ctx, tokenHash, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMFAChallengeToCache indicates an expected call of SetMFAChallengeToCache.
+func (mr *MockresourceProviderMockRecorder) SetMFAChallengeToCache(ctx, tokenHash, challenge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMFAChallengeToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetMFAChallengeToCache), ctx, tokenHash, challenge)
+}
+
+// SetMagicLinkCooldownToCache mocks base method.
+func (m *MockresourceProvider) SetMagicLinkCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMagicLinkCooldownToCache", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMagicLinkCooldownToCache indicates an expected call of SetMagicLinkCooldownToCache.
+func (mr *MockresourceProviderMockRecorder) SetMagicLinkCooldownToCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMagicLinkCooldownToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetMagicLinkCooldownToCache), ctx, userID)
+}
+
+// SetMagicLinkToCache mocks base method.
+func (m *MockresourceProvider) SetMagicLinkToCache(ctx context.Context, tokenHash string, link MagicLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMagicLinkToCache", ctx, tokenHash, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMagicLinkToCache indicates an expected call of SetMagicLinkToCache.
+func (mr *MockresourceProviderMockRecorder) SetMagicLinkToCache(ctx, tokenHash, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMagicLinkToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetMagicLinkToCache), ctx, tokenHash, link)
+}
+
+// SetPasswordResetCooldownToCache mocks base method.
+func (m *MockresourceProvider) SetPasswordResetCooldownToCache(ctx context.Context, userID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPasswordResetCooldownToCache", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPasswordResetCooldownToCache indicates an expected call of SetPasswordResetCooldownToCache.
+func (mr *MockresourceProviderMockRecorder) SetPasswordResetCooldownToCache(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPasswordResetCooldownToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetPasswordResetCooldownToCache), ctx, userID)
+}
+
+// SetPasswordResetTokenToCache mocks base method.
+func (m *MockresourceProvider) SetPasswordResetTokenToCache(ctx context.Context, tokenHash string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPasswordResetTokenToCache", ctx, tokenHash, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPasswordResetTokenToCache indicates an expected call of SetPasswordResetTokenToCache.
+func (mr *MockresourceProviderMockRecorder) SetPasswordResetTokenToCache(ctx, tokenHash, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPasswordResetTokenToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetPasswordResetTokenToCache), ctx, tokenHash, userID)
+}
+
+// SetRefreshTokenToCache mocks base method.
+func (m *MockresourceProvider) SetRefreshTokenToCache(ctx context.Context, tokenHash string, token RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRefreshTokenToCache", ctx, tokenHash, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRefreshTokenToCache indicates an expected call of SetRefreshTokenToCache.
+func (mr *MockresourceProviderMockRecorder) SetRefreshTokenToCache(ctx, tokenHash, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRefreshTokenToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetRefreshTokenToCache), ctx, tokenHash, token)
+}
+
+// SetSessionToCache mocks base method.
+func (m *MockresourceProvider) SetSessionToCache(ctx context.Context, session Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSessionToCache", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSessionToCache indicates an expected call of SetSessionToCache.
+func (mr *MockresourceProviderMockRecorder) SetSessionToCache(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionToCache", reflect.TypeOf((*MockresourceProvider)(nil).SetSessionToCache), ctx, session)
+}
+
+// SwapRefreshTokenFamilyInCache mocks base method.
+func (m *MockresourceProvider) SwapRefreshTokenFamilyInCache(ctx context.Context, userID int64, sessionID string, family RefreshTokenFamily) (RefreshTokenFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapRefreshTokenFamilyInCache", ctx, userID, sessionID, family)
+	ret0, _ := ret[0].(RefreshTokenFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapRefreshTokenFamilyInCache indicates an expected call of SwapRefreshTokenFamilyInCache.
+func (mr *MockresourceProviderMockRecorder) SwapRefreshTokenFamilyInCache(ctx, userID, sessionID, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapRefreshTokenFamilyInCache", reflect.TypeOf((*MockresourceProvider)(nil).SwapRefreshTokenFamilyInCache), ctx, userID, sessionID, family)
+}
+
+// UpdateSessionLastSeenInCache mocks base method.
+func (m *MockresourceProvider) UpdateSessionLastSeenInCache(ctx context.Context, session Session, lastSeenAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionLastSeenInCache", ctx, session, lastSeenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionLastSeenInCache indicates an expected call of UpdateSessionLastSeenInCache.
+func (mr *MockresourceProviderMockRecorder) UpdateSessionLastSeenInCache(ctx, session, lastSeenAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionLastSeenInCache", reflect.TypeOf((*MockresourceProvider)(nil).UpdateSessionLastSeenInCache), ctx, session, lastSeenAt)
 }
 
 // UpdateUserAccountInDB mocks base method.
@@ -123,6 +666,77 @@ func (mr *MockresourceProviderMockRecorder) UpdateUserAccountInDB(ctx, param int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAccountInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserAccountInDB), ctx, param)
 }
 
+// UpdateUserDeletionRequestedInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserDeletionRequestedInDB(ctx context.Context, userID int64, requestedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDeletionRequestedInDB", ctx, userID, requestedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDeletionRequestedInDB indicates an expected call of UpdateUserDeletionRequestedInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserDeletionRequestedInDB(ctx, userID, requestedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeletionRequestedInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserDeletionRequestedInDB), ctx, userID, requestedAt)
+}
+
+// UpdateUserDisabledInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserDisabledInDB(ctx context.Context, userID int64, disabledAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDisabledInDB", ctx, userID, disabledAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDisabledInDB indicates an expected call of UpdateUserDisabledInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserDisabledInDB(ctx, userID, disabledAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDisabledInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserDisabledInDB), ctx, userID, disabledAt)
+}
+
+// UpdateUserEmailInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserEmailInDB(ctx context.Context, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmailInDB", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmailInDB indicates an expected call of UpdateUserEmailInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserEmailInDB(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmailInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserEmailInDB), ctx, userID, email)
+}
+
+// UpdateUserEmailVerifiedInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserEmailVerifiedInDB(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmailVerifiedInDB", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmailVerifiedInDB indicates an expected call of UpdateUserEmailVerifiedInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserEmailVerifiedInDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmailVerifiedInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserEmailVerifiedInDB), ctx, userID)
+}
+
+// UpdateUserPasswordHashInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserPasswordHashInDB(ctx context.Context, userID int64, oldPassword, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPasswordHashInDB", ctx, userID, oldPassword, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPasswordHashInDB indicates an expected call of UpdateUserPasswordHashInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserPasswordHashInDB(ctx, userID, oldPassword, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordHashInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserPasswordHashInDB), ctx, userID, oldPassword, password)
+}
+
 // UpdateUserPasswordInDB mocks base method.
 func (m *MockresourceProvider) UpdateUserPasswordInDB(ctx context.Context, userID int64, password string) error {
 	m.ctrl.T.Helper()
@@ -137,6 +751,35 @@ func (mr *MockresourceProviderMockRecorder) UpdateUserPasswordInDB(ctx, userID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserPasswordInDB), ctx, userID, password)
 }
 
+// UpdateUserTOTPInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserTOTPInDB(ctx context.Context, param UpdateUserTOTPParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTOTPInDB", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTOTPInDB indicates an expected call of UpdateUserTOTPInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserTOTPInDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTOTPInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserTOTPInDB), ctx, param)
+}
+
+// UpdateUserTOTPLastUsedStepInDB mocks base method.
+func (m *MockresourceProvider) UpdateUserTOTPLastUsedStepInDB(ctx context.Context, userID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTOTPLastUsedStepInDB", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTOTPLastUsedStepInDB indicates an expected call of UpdateUserTOTPLastUsedStepInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateUserTOTPLastUsedStepInDB(ctx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTOTPLastUsedStepInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateUserTOTPLastUsedStepInDB), ctx, userID, step)
+}
+
 // MockinfraProvider is a mock of infraProvider interface.
 type MockinfraProvider struct {
 	ctrl     *gomock.Controller
@@ -160,6 +803,20 @@ func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
 	return m.recorder
 }
 
+// ComparePassword mocks base method.
+func (m *MockinfraProvider) ComparePassword(hash, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComparePassword", hash, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComparePassword indicates an expected call of ComparePassword.
+func (mr *MockinfraProviderMockRecorder) ComparePassword(hash, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePassword", reflect.TypeOf((*MockinfraProvider)(nil).ComparePassword), hash, password)
+}
+
 // GetConfig mocks base method.
 func (m *MockinfraProvider) GetConfig() *configuration.AppConfig {
 	m.ctrl.T.Helper()
@@ -187,3 +844,75 @@ func (mr *MockinfraProviderMockRecorder) GetTimeGMT7() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeGMT7", reflect.TypeOf((*MockinfraProvider)(nil).GetTimeGMT7))
 }
+
+// HashPassword mocks base method.
+func (m *MockinfraProvider) HashPassword(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashPassword", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashPassword indicates an expected call of HashPassword.
+func (mr *MockinfraProviderMockRecorder) HashPassword(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockinfraProvider)(nil).HashPassword), password)
+}
+
+// IsPasswordBreached mocks base method.
+func (m *MockinfraProvider) IsPasswordBreached(password string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPasswordBreached", password)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPasswordBreached indicates an expected call of IsPasswordBreached.
+func (mr *MockinfraProviderMockRecorder) IsPasswordBreached(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPasswordBreached", reflect.TypeOf((*MockinfraProvider)(nil).IsPasswordBreached), password)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockinfraProvider) NeedsRehash(hash string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", hash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockinfraProviderMockRecorder) NeedsRehash(hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockinfraProvider)(nil).NeedsRehash), hash)
+}
+
+// SendMail mocks base method.
+func (m *MockinfraProvider) SendMail(ctx context.Context, msg mailer.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMail", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMail indicates an expected call of SendMail.
+func (mr *MockinfraProviderMockRecorder) SendMail(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMail", reflect.TypeOf((*MockinfraProvider)(nil).SendMail), ctx, msg)
+}
+
+// SignJWT mocks base method.
+func (m *MockinfraProvider) SignJWT(claims jwt.Claims) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignJWT", claims)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignJWT indicates an expected call of SignJWT.
+func (mr *MockinfraProviderMockRecorder) SignJWT(claims interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignJWT", reflect.TypeOf((*MockinfraProvider)(nil).SignJWT), claims)
+}
@@ -0,0 +1,151 @@
+package account
+
+import (
+	// golang package
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// PasswordViolationBreached means the password was exposed in a known breach.
+	PasswordViolationBreached = "breached"
+
+	// PasswordViolationContainsEmail means the password contains user's email.
+	PasswordViolationContainsEmail = "contains_email"
+
+	// PasswordViolationContainsName means the password contains user's name.
+	PasswordViolationContainsName = "contains_name"
+
+	// PasswordViolationTooFewCharacterClasses means the password mixes too few kinds of characters.
+	PasswordViolationTooFewCharacterClasses = "too_few_character_classes"
+
+	// PasswordViolationTooPredictable means the estimated entropy of the password is too low.
+	PasswordViolationTooPredictable = "too_predictable"
+
+	// PasswordViolationTooShort means the password is shorter than the minimum length.
+	PasswordViolationTooShort = "too_short"
+
+	// personal information shorter than this is too common to be refused inside a password.
+	minPersonalInfoLength = 3
+
+	poolDigit  = 10
+	poolLower  = 26
+	poolSymbol = 33
+	poolUpper  = 26
+)
+
+// ValidatePassword will check a new password against the configured password policy.
+// If the password violates any rule, it returns a PasswordPolicyError holding every violation.
+func (svc *Service) ValidatePassword(param ValidatePasswordParam) error {
+	policy := svc.infra.GetConfig().Account.Password.Policy
+	password := param.Password
+
+	var violations []PasswordViolation
+	if policy.MinLength > 0 && utf8.RuneCountInString(password) < policy.MinLength {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationTooShort,
+			Message: fmt.Sprintf("password must be at least %d characters", policy.MinLength),
+		})
+	}
+
+	classes, pool := characterClasses(password)
+	if policy.MinCharacterClasses > 0 && classes < policy.MinCharacterClasses {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationTooFewCharacterClasses,
+			Message: fmt.Sprintf("password must use at least %d of lowercase, uppercase, digit and symbol characters", policy.MinCharacterClasses),
+		})
+	}
+
+	var entropy float64
+	if pool > 0 {
+		entropy = float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+	}
+
+	if policy.MinEntropy > 0 && entropy < float64(policy.MinEntropy) {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationTooPredictable,
+			Message: "password is too easy to guess, make it longer or mix more kinds of characters",
+		})
+	}
+
+	localPart, _, _ := strings.Cut(param.Email, "@")
+	if containsFold(password, localPart) {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationContainsEmail,
+			Message: "password must not contain your email",
+		})
+	}
+
+	if containsFold(password, param.FirstName) || containsFold(password, param.LastName) {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationContainsName,
+			Message: "password must not contain your name",
+		})
+	}
+
+	if svc.infra.IsPasswordBreached(password) {
+		violations = append(violations, PasswordViolation{
+			Code:    PasswordViolationBreached,
+			Message: "password has appeared in a data breach, choose a different one",
+		})
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+// characterClasses will count character classes used by password
+// alongside the size of the character pool those classes make up.
+// Any character that isn't a letter or digit is counted as a symbol.
+func characterClasses(password string) (int, int) {
+	var hasDigit, hasLower, hasSymbol, hasUpper bool
+	for _, r := range password {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	var classes, pool int
+	for _, class := range []struct {
+		used bool
+		size int
+	}{
+		{hasDigit, poolDigit},
+		{hasLower, poolLower},
+		{hasSymbol, poolSymbol},
+		{hasUpper, poolUpper},
+	} {
+		if class.used {
+			classes++
+			pool += class.size
+		}
+	}
+
+	return classes, pool
+}
+
+// containsFold will check whether password contains info, ignoring case.
+// Info that is too short is ignored.
+func containsFold(password, info string) bool {
+	info = strings.TrimSpace(info)
+	if utf8.RuneCountInString(info) < minPersonalInfoLength {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(password), strings.ToLower(info))
+}
@@ -0,0 +1,192 @@
+package account
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+func TestService_ValidatePassword(t *testing.T) {
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			Password: configuration.PasswordConfig{
+				Policy: configuration.PasswordPolicyConfig{
+					MinCharacterClasses: 3,
+					MinEntropy:          50,
+					MinLength:           10,
+				},
+			},
+		},
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+	}
+	tests := []struct {
+		name       string
+		param      ValidatePasswordParam
+		config     *configuration.AppConfig
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_policy_disabled_then_return_nil",
+			param: ValidatePasswordParam{
+				Password: "a",
+			},
+			config: &configuration.AppConfig{},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("a").Return(false)
+			},
+		},
+		{
+			name: "when_password_weak_then_return_every_violation",
+			param: ValidatePasswordParam{
+				Password: "abc",
+			},
+			config: mockConfig,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("abc").Return(false)
+			},
+			wantErr: &PasswordPolicyError{
+				Violations: []PasswordViolation{
+					{
+						Code:    PasswordViolationTooShort,
+						Message: "password must be at least 10 characters",
+					},
+					{
+						Code:    PasswordViolationTooFewCharacterClasses,
+						Message: "password must use at least 3 of lowercase, uppercase, digit and symbol characters",
+					},
+					{
+						Code:    PasswordViolationTooPredictable,
+						Message: "password is too easy to guess, make it longer or mix more kinds of characters",
+					},
+				},
+			},
+		},
+		{
+			name: "when_password_contains_personal_info_then_return_error",
+			param: ValidatePasswordParam{
+				Email:     "lee.jieun@iu.com",
+				FirstName: "Ji Eun",
+				LastName:  "Lee",
+				Password:  "LEE.JIEUN-ji eun-2023",
+			},
+			config: mockConfig,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("LEE.JIEUN-ji eun-2023").Return(false)
+			},
+			wantErr: &PasswordPolicyError{
+				Violations: []PasswordViolation{
+					{
+						Code:    PasswordViolationContainsEmail,
+						Message: "password must not contain your email",
+					},
+					{
+						Code:    PasswordViolationContainsName,
+						Message: "password must not contain your name",
+					},
+				},
+			},
+		},
+		{
+			name: "when_password_breached_then_return_error",
+			param: ValidatePasswordParam{
+				Password: "P@ssw0rd1234",
+			},
+			config: mockConfig,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("P@ssw0rd1234").Return(true)
+			},
+			wantErr: &PasswordPolicyError{
+				Violations: []PasswordViolation{
+					{
+						Code:    PasswordViolationBreached,
+						Message: "password has appeared in a data breach, choose a different one",
+					},
+				},
+			},
+		},
+		{
+			name: "when_personal_info_too_short_then_ignore_it",
+			param: ValidatePasswordParam{
+				Email:     "iu@iu.com",
+				FirstName: "IU",
+				Password:  "iu-Likes-Blue-Skies-9",
+			},
+			config: mockConfig,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("iu-Likes-Blue-Skies-9").Return(false)
+			},
+		},
+		{
+			name: "when_password_strong_then_return_nil",
+			param: ValidatePasswordParam{
+				Email:     "lee.jieun@iu.com",
+				FirstName: "Ji Eun",
+				LastName:  "Lee",
+				Password:  "Correct-Horse-Battery-9",
+			},
+			config: mockConfig,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().IsPasswordBreached("Correct-Horse-Battery-9").Return(false)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+			}
+			mockFields.infra.EXPECT().GetConfig().Return(test.config)
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+			}
+
+			err := svc.ValidatePassword(test.param)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestCharacterClasses(t *testing.T) {
+	tests := []struct {
+		name        string
+		password    string
+		wantClasses int
+		wantPool    int
+	}{
+		{
+			name: "when_password_empty_then_return_zero",
+		},
+		{
+			name:        "when_password_only_lowercase_then_return_one_class",
+			password:    "abc",
+			wantClasses: 1,
+			wantPool:    26,
+		},
+		{
+			name:        "when_password_use_every_class_then_return_four_classes",
+			password:    "aB3!",
+			wantClasses: 4,
+			wantPool:    95,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotClasses, gotPool := characterClasses(test.password)
+			assert.Equal(t, test.wantClasses, gotClasses)
+			assert.Equal(t, test.wantPool, gotPool)
+		})
+	}
+}
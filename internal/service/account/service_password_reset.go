@@ -0,0 +1,113 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+const (
+	passwordResetSubject = "Reset your bubi password"
+	passwordResetBody    = "Hi,\n\n" +
+		"We received a request to reset the password of your bubi account.\n" +
+		"Open the link below to set a new password. The link can only be used once and expires in %d minutes.\n\n" +
+		"%s\n\n" +
+		"If you didn't request a password reset, you can safely ignore this email."
+)
+
+var (
+	// ErrPasswordResetTokenInvalid is returned when a password reset token is unknown, expired or already used.
+	ErrPasswordResetTokenInvalid = errors.New("password reset token not valid")
+
+	// ErrPasswordResetThrottled is returned when a password reset email is requested
+	// before the cooldown of the previous one ends.
+	ErrPasswordResetThrottled = errors.New("password reset requested too often")
+)
+
+// ConsumePasswordResetToken will redeem a password reset token and return id of its owner.
+// A password reset token can only be redeemed once.
+func (svc *Service) ConsumePasswordResetToken(ctx context.Context, token string) (int64, error) {
+	userID, err := svc.rsc.PopPasswordResetTokenFromCache(ctx, hashToken(token))
+	if err != nil {
+		log.Printf("[ConsumePasswordResetToken] svc.rsc.PopPasswordResetTokenFromCache() got an error: %+v\n", err)
+		return 0, err
+	}
+
+	if userID <= 0 {
+		log.Printf("[ConsumePasswordResetToken] password reset token not found\n")
+		return 0, ErrPasswordResetTokenInvalid
+	}
+
+	return userID, nil
+}
+
+// SendPasswordResetToken will generate a one-time password reset token for user
+// and send it to user's email as a link.
+// It returns ErrPasswordResetThrottled if the previous email was sent too recently.
+func (svc *Service) SendPasswordResetToken(ctx context.Context, userID int64, email string) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	ok, err := svc.rsc.SetPasswordResetCooldownToCache(ctx, userID)
+	if err != nil {
+		log.Printf("[SendPasswordResetToken] svc.rsc.SetPasswordResetCooldownToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !ok {
+		log.Printf("[SendPasswordResetToken] password reset throttled\nMeta:%+v\n", meta)
+		return ErrPasswordResetThrottled
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[SendPasswordResetToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	cfg := svc.infra.GetConfig().Account
+	link, err := buildTokenURL(cfg.PasswordResetURL, token)
+	if err != nil {
+		log.Printf("[SendPasswordResetToken] buildTokenURL() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.SetPasswordResetTokenToCache(ctx, tokenHash, userID)
+	if err != nil {
+		log.Printf("[SendPasswordResetToken] svc.rsc.SetPasswordResetTokenToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.infra.SendMail(ctx, mailer.Message{
+		Body:    fmt.Sprintf(passwordResetBody, cfg.PasswordResetTTL, link),
+		Subject: passwordResetSubject,
+		To:      email,
+	})
+	if err != nil {
+		log.Printf("[SendPasswordResetToken] svc.infra.SendMail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// buildTokenURL will add token as "token" query parameter of baseURL.
+func buildTokenURL(baseURL, token string) (string, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsed.Query()
+	query.Set("token", token)
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
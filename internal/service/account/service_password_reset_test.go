@@ -0,0 +1,213 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"fmt"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+	"github.com/arifinhermawan/bubi/internal/infrastructure/mailer"
+)
+
+func TestService_ConsumePasswordResetToken(t *testing.T) {
+	mockTokenHash := hashToken("token")
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_PopPasswordResetTokenFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopPasswordResetTokenFromCache(context.Background(), mockTokenHash).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopPasswordResetTokenFromCache(context.Background(), mockTokenHash).Return(int64(0), nil)
+			},
+			wantErr: ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "when_no_error_occured_then_return_user_id",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopPasswordResetTokenFromCache(context.Background(), mockTokenHash).Return(int64(123), nil)
+			},
+			want: 123,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ConsumePasswordResetToken(context.Background(), "token")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SendPasswordResetToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockTokenHash := hashToken(mockToken)
+	mockConfig := &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			PasswordResetTTL: 30,
+			PasswordResetURL: "https://bubi.app/reset",
+		},
+	}
+	mockMessage := mailer.Message{
+		Body:    fmt.Sprintf(passwordResetBody, 30, "https://bubi.app/reset?token="+mockToken),
+		Subject: passwordResetSubject,
+		To:      "email",
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_SetPasswordResetCooldownToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_cooldown_running_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(false, nil)
+			},
+			wantErr: ErrPasswordResetThrottled,
+		},
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetPasswordResetTokenToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetPasswordResetTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendMail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetPasswordResetTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().SetPasswordResetCooldownToCache(context.Background(), int64(123)).Return(true, nil)
+				randRead = mockRead
+				mf.infra.EXPECT().GetConfig().Return(mockConfig)
+				mf.rsc.EXPECT().SetPasswordResetTokenToCache(context.Background(), mockTokenHash, int64(123)).Return(nil)
+				mf.infra.EXPECT().SendMail(context.Background(), mockMessage).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.SendPasswordResetToken(context.Background(), 123, "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestBuildTokenURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "when_base_url_invalid_then_return_error",
+			baseURL: "://invalid",
+			wantErr: true,
+		},
+		{
+			name:    "when_base_url_has_query_then_keep_it",
+			baseURL: "https://bubi.app/reset?lang=id",
+			want:    "https://bubi.app/reset?lang=id&token=token",
+		},
+		{
+			name:    "when_no_error_occured_then_return_url",
+			baseURL: "https://bubi.app/reset",
+			want:    "https://bubi.app/reset?token=token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := buildTokenURL(test.baseURL, "token")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
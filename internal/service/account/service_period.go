@@ -0,0 +1,29 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// GetPeriod will return the period of a user that is offset periods away from the current one.
+// The period starts on user's record period start day and is counted in user's timezone.
+func (svc *Service) GetPeriod(ctx context.Context, userID int64, offset int) (entity.Period, error) {
+	meta := map[string]interface{}{
+		"offset":  offset,
+		"user_id": userID,
+	}
+
+	account, err := svc.GetUserAccountByID(ctx, userID)
+	if err != nil {
+		log.Printf("[GetPeriod] svc.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		return entity.Period{}, err
+	}
+
+	now := svc.infra.GetTimeGMT7().In(entity.LoadTimezone(account.Timezone))
+
+	return entity.GetPeriod(account.RecordPeriodStart, now, offset), nil
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_GetPeriod(t *testing.T) {
+	makassar, _ := time.LoadLocation("Asia/Makassar")
+	mockTime := time.Date(2024, 3, 30, 17, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	type args struct {
+		offset int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		want       entity.Period
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{}, nil)
+			},
+			wantErr: ErrAccountNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_period_in_user_timezone",
+			args: args{offset: -1},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetUserAccountByIDFromDB(context.Background(), int64(123)).Return(entity.Account{
+					ID:                123,
+					RecordPeriodStart: 31,
+					Timezone:          "Asia/Makassar",
+				}, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+			},
+			want: entity.Period{
+				End:   time.Date(2024, 3, 31, 0, 0, 0, 0, makassar),
+				Start: time.Date(2024, 2, 29, 0, 0, 0, 0, makassar),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.GetPeriod(context.Background(), 123, test.args.offset)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
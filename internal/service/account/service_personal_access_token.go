@@ -0,0 +1,165 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+var (
+	// ErrPersonalAccessTokenExpiryInvalid is returned when a personal access token is created with an expiry in the past.
+	ErrPersonalAccessTokenExpiryInvalid = errors.New("personal access token expiry must be in the future")
+
+	// ErrPersonalAccessTokenNotFound is returned when a personal access token doesn't exist or belongs to another user.
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+
+	// ErrPersonalAccessTokenScopeInvalid is returned when a personal access token is created without scope or with an unknown scope.
+	ErrPersonalAccessTokenScopeInvalid = errors.New("personal access token scope not valid")
+)
+
+// AuthenticatePersonalAccessToken will find the user that owns a personal access token.
+// It returns false when the token is unknown or already expired.
+func (svc *Service) AuthenticatePersonalAccessToken(ctx context.Context, token string) (entity.Principal, bool, error) {
+	if !strings.HasPrefix(token, entity.PersonalAccessTokenPrefix) {
+		return entity.Principal{}, false, nil
+	}
+
+	pat, err := svc.rsc.GetPersonalAccessTokenByHashFromDB(ctx, hashToken(strings.TrimPrefix(token, entity.PersonalAccessTokenPrefix)))
+	if err != nil {
+		log.Printf("[AuthenticatePersonalAccessToken] svc.rsc.GetPersonalAccessTokenByHashFromDB() got an error: %+v\n", err)
+		return entity.Principal{}, false, err
+	}
+
+	if pat.ID == 0 {
+		return entity.Principal{}, false, nil
+	}
+
+	if !pat.ExpiresAt.IsZero() && !svc.infra.GetTimeGMT7().Before(pat.ExpiresAt) {
+		return entity.Principal{}, false, nil
+	}
+
+	return entity.Principal{
+		Email:    pat.Email,
+		IssuedAt: pat.CreatedAt,
+		Scopes:   pat.Scopes,
+		UserID:   pat.UserID,
+	}, true, nil
+}
+
+// CreatePersonalAccessToken will create a new named personal access token for a user.
+// It returns the token alongside its information. The token is only returned this once,
+// since only its hash is saved.
+func (svc *Service) CreatePersonalAccessToken(ctx context.Context, param CreatePersonalAccessTokenParam) (string, PersonalAccessToken, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	scopes, err := normalizeScopes(param.Scopes)
+	if err != nil {
+		log.Printf("[CreatePersonalAccessToken] normalizeScopes() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", PersonalAccessToken{}, err
+	}
+
+	now := svc.infra.GetTimeGMT7()
+	if !param.ExpiresAt.IsZero() && !now.Before(param.ExpiresAt) {
+		log.Printf("[CreatePersonalAccessToken] expiry is not in the future\nMeta:%+v\n", meta)
+		return "", PersonalAccessToken{}, ErrPersonalAccessTokenExpiryInvalid
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[CreatePersonalAccessToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", PersonalAccessToken{}, err
+	}
+
+	id, err := svc.rsc.InsertPersonalAccessTokenToDB(ctx, InsertPersonalAccessTokenParam{
+		ExpiresAt: param.ExpiresAt,
+		Name:      param.Name,
+		Scopes:    scopes,
+		TokenHash: tokenHash,
+		UserID:    param.UserID,
+	})
+	if err != nil {
+		log.Printf("[CreatePersonalAccessToken] svc.rsc.InsertPersonalAccessTokenToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", PersonalAccessToken{}, err
+	}
+
+	return entity.PersonalAccessTokenPrefix + token, PersonalAccessToken{
+		CreatedAt: now,
+		ExpiresAt: param.ExpiresAt,
+		ID:        id,
+		Name:      param.Name,
+		Scopes:    scopes,
+		UserID:    param.UserID,
+	}, nil
+}
+
+// ListPersonalAccessTokens will fetch every personal access token of a user,
+// ordered from the most recently created token.
+func (svc *Service) ListPersonalAccessTokens(ctx context.Context, userID int64) ([]PersonalAccessToken, error) {
+	tokens, err := svc.rsc.GetPersonalAccessTokensByUserIDFromDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[ListPersonalAccessTokens] svc.rsc.GetPersonalAccessTokensByUserIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// RevokePersonalAccessToken will delete a personal access token of a user.
+// If the user doesn't have the token, it will return ErrPersonalAccessTokenNotFound.
+func (svc *Service) RevokePersonalAccessToken(ctx context.Context, userID, tokenID int64) error {
+	meta := map[string]interface{}{
+		"token_id": tokenID,
+		"user_id":  userID,
+	}
+
+	deleted, err := svc.rsc.DeletePersonalAccessTokenInDB(ctx, userID, tokenID)
+	if err != nil {
+		log.Printf("[RevokePersonalAccessToken] svc.rsc.DeletePersonalAccessTokenInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !deleted {
+		return ErrPersonalAccessTokenNotFound
+	}
+
+	return nil
+}
+
+// normalizeScopes will check that every scope is known,
+// then remove duplicated scopes and sort them.
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, ErrPersonalAccessTokenScopeInvalid
+	}
+
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope != entity.ScopeRead && scope != entity.ScopeWrite {
+			return nil, ErrPersonalAccessTokenScopeInvalid
+		}
+
+		if seen[scope] {
+			continue
+		}
+
+		seen[scope] = true
+		result = append(result, scope)
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,367 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_AuthenticatePersonalAccessToken(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockTokenHash := hashToken("token")
+	mockPAT := PersonalAccessToken{
+		CreatedAt: mockTime.Add(-time.Hour),
+		Email:     "email",
+		ID:        1,
+		Name:      "ci",
+		Scopes:    []string{entity.ScopeRead},
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		token      string
+		mockFields func(mockFields)
+		want       entity.Principal
+		wantOK     bool
+		wantErr    error
+	}{
+		{
+			name:       "when_token_has_no_prefix_then_return_false",
+			token:      "token",
+			mockFields: func(mf mockFields) {},
+		},
+		{
+			name:  "when_GetPersonalAccessTokenByHashFromDB_error_then_return_error",
+			token: entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetPersonalAccessTokenByHashFromDB(context.Background(), mockTokenHash).Return(PersonalAccessToken{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_token_not_found_then_return_false",
+			token: entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetPersonalAccessTokenByHashFromDB(context.Background(), mockTokenHash).Return(PersonalAccessToken{}, nil)
+			},
+		},
+		{
+			name:  "when_token_expired_then_return_false",
+			token: entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				expired := mockPAT
+				expired.ExpiresAt = mockTime
+				mf.rsc.EXPECT().GetPersonalAccessTokenByHashFromDB(context.Background(), mockTokenHash).Return(expired, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+			},
+		},
+		{
+			name:  "when_token_not_expired_then_return_principal",
+			token: entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				active := mockPAT
+				active.ExpiresAt = mockTime.Add(time.Second)
+				mf.rsc.EXPECT().GetPersonalAccessTokenByHashFromDB(context.Background(), mockTokenHash).Return(active, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+			},
+			want: entity.Principal{
+				Email:    "email",
+				IssuedAt: mockTime.Add(-time.Hour),
+				Scopes:   []string{entity.ScopeRead},
+				UserID:   123,
+			},
+			wantOK: true,
+		},
+		{
+			name:  "when_token_never_expires_then_return_principal",
+			token: entity.PersonalAccessTokenPrefix + "token",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetPersonalAccessTokenByHashFromDB(context.Background(), mockTokenHash).Return(mockPAT, nil)
+			},
+			want: entity.Principal{
+				Email:    "email",
+				IssuedAt: mockTime.Add(-time.Hour),
+				Scopes:   []string{entity.ScopeRead},
+				UserID:   123,
+			},
+			wantOK: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, ok, err := svc.AuthenticatePersonalAccessToken(context.Background(), test.token)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantOK, ok)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_CreatePersonalAccessToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockInsertParam := InsertPersonalAccessTokenParam{
+		ExpiresAt: mockTime.Add(time.Hour),
+		Name:      "ci",
+		Scopes:    []string{entity.ScopeRead, entity.ScopeWrite},
+		TokenHash: hashToken(mockToken),
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		param      CreatePersonalAccessTokenParam
+		mockFields func(mockFields)
+		wantToken  string
+		want       PersonalAccessToken
+		wantErr    error
+	}{
+		{
+			name: "when_scopes_empty_then_return_error",
+			param: CreatePersonalAccessTokenParam{
+				Name:   "ci",
+				UserID: 123,
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrPersonalAccessTokenScopeInvalid,
+		},
+		{
+			name: "when_scope_unknown_then_return_error",
+			param: CreatePersonalAccessTokenParam{
+				Name:   "ci",
+				Scopes: []string{"admin"},
+				UserID: 123,
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrPersonalAccessTokenScopeInvalid,
+		},
+		{
+			name: "when_expiry_not_in_the_future_then_return_error",
+			param: CreatePersonalAccessTokenParam{
+				ExpiresAt: mockTime,
+				Name:      "ci",
+				Scopes:    []string{entity.ScopeRead},
+				UserID:    123,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+			},
+			wantErr: ErrPersonalAccessTokenExpiryInvalid,
+		},
+		{
+			name: "when_generate_random_error_then_return_error",
+			param: CreatePersonalAccessTokenParam{
+				Name:   "ci",
+				Scopes: []string{entity.ScopeRead},
+				UserID: 123,
+			},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertPersonalAccessTokenToDB_error_then_return_error",
+			param: CreatePersonalAccessTokenParam{
+				ExpiresAt: mockTime.Add(time.Hour),
+				Name:      "ci",
+				Scopes:    []string{entity.ScopeWrite, entity.ScopeRead, entity.ScopeWrite},
+				UserID:    123,
+			},
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().InsertPersonalAccessTokenToDB(context.Background(), mockInsertParam).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_token",
+			param: CreatePersonalAccessTokenParam{
+				ExpiresAt: mockTime.Add(time.Hour),
+				Name:      "ci",
+				Scopes:    []string{entity.ScopeWrite, entity.ScopeRead, entity.ScopeWrite},
+				UserID:    123,
+			},
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+				mf.rsc.EXPECT().InsertPersonalAccessTokenToDB(context.Background(), mockInsertParam).Return(int64(1), nil)
+			},
+			wantToken: entity.PersonalAccessTokenPrefix + mockToken,
+			want: PersonalAccessToken{
+				CreatedAt: mockTime,
+				ExpiresAt: mockTime.Add(time.Hour),
+				ID:        1,
+				Name:      "ci",
+				Scopes:    []string{entity.ScopeRead, entity.ScopeWrite},
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			token, got, err := svc.CreatePersonalAccessToken(context.Background(), test.param)
+			assert.Equal(t, test.wantToken, token)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListPersonalAccessTokens(t *testing.T) {
+	mockTokens := []PersonalAccessToken{
+		{
+			ID:     1,
+			Name:   "ci",
+			Scopes: []string{entity.ScopeRead},
+			UserID: 123,
+		},
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []PersonalAccessToken
+		wantErr    error
+	}{
+		{
+			name: "when_GetPersonalAccessTokensByUserIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetPersonalAccessTokensByUserIDFromDB(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_tokens",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetPersonalAccessTokensByUserIDFromDB(context.Background(), int64(123)).Return(mockTokens, nil)
+			},
+			want: mockTokens,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListPersonalAccessTokens(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RevokePersonalAccessToken(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_DeletePersonalAccessTokenInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeletePersonalAccessTokenInDB(context.Background(), int64(123), int64(1)).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeletePersonalAccessTokenInDB(context.Background(), int64(123), int64(1)).Return(false, nil)
+			},
+			wantErr: ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeletePersonalAccessTokenInDB(context.Background(), int64(123), int64(1)).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.RevokePersonalAccessToken(context.Background(), 123, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
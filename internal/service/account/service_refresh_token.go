@@ -0,0 +1,149 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+)
+
+var (
+	// ErrRefreshTokenInvalid is returned when a refresh token is unknown, expired or revoked.
+	ErrRefreshTokenInvalid = errors.New("refresh token not valid")
+
+	// ErrRefreshTokenReused is returned when a refresh token that had been rotated is used again.
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// GenerateRefreshToken will generate a new refresh token for a session of user
+// and start a new token family for that session.
+// The new family replaces the previous family of the session.
+func (svc *Service) GenerateRefreshToken(ctx context.Context, session Session, email string) (string, error) {
+	meta := map[string]interface{}{
+		"user_id":    session.UserID,
+		"session_id": session.SessionID,
+		"email":      email,
+	}
+
+	familyID, err := generateTokenID()
+	if err != nil {
+		log.Printf("[GenerateRefreshToken] generateTokenID() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[GenerateRefreshToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	err = svc.rsc.SetRefreshTokenToCache(ctx, tokenHash, RefreshToken{
+		Email:     email,
+		FamilyID:  familyID,
+		SessionID: session.SessionID,
+		UserID:    session.UserID,
+	})
+	if err != nil {
+		log.Printf("[GenerateRefreshToken] svc.rsc.SetRefreshTokenToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	_, err = svc.rsc.SwapRefreshTokenFamilyInCache(ctx, session.UserID, session.SessionID, RefreshTokenFamily{
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+	})
+	if err != nil {
+		log.Printf("[GenerateRefreshToken] svc.rsc.SwapRefreshTokenFamilyInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	return token, nil
+}
+
+// RotateRefreshToken will exchange a refresh token with a new one from the same family.
+// A refresh token can only be used once. If a refresh token that had been
+// rotated is used again, the whole family and its session will be revoked.
+// It returns the owner of the refresh token alongside the new refresh token.
+func (svc *Service) RotateRefreshToken(ctx context.Context, refreshToken string) (RefreshToken, string, error) {
+	tokenHash := hashToken(refreshToken)
+
+	owner, err := svc.rsc.GetRefreshTokenFromCache(ctx, tokenHash)
+	if err != nil {
+		log.Printf("[RotateRefreshToken] svc.rsc.GetRefreshTokenFromCache() got an error: %+v\n", err)
+		return RefreshToken{}, "", err
+	}
+
+	if owner.UserID <= 0 {
+		log.Printf("[RotateRefreshToken] refresh token not found\n")
+		return RefreshToken{}, "", ErrRefreshTokenInvalid
+	}
+
+	meta := map[string]interface{}{
+		"user_id":    owner.UserID,
+		"session_id": owner.SessionID,
+		"family_id":  owner.FamilyID,
+	}
+
+	family, err := svc.rsc.GetRefreshTokenFamilyFromCache(ctx, owner.UserID, owner.SessionID)
+	if err != nil {
+		log.Printf("[RotateRefreshToken] svc.rsc.GetRefreshTokenFamilyFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, "", err
+	}
+
+	// session of the family is revoked or expired.
+	if family.FamilyID != owner.FamilyID {
+		log.Printf("[RotateRefreshToken] refresh token family no longer active\nMeta:%+v\n", meta)
+		return RefreshToken{}, "", ErrRefreshTokenInvalid
+	}
+
+	if family.TokenHash != tokenHash {
+		log.Printf("[RotateRefreshToken] refresh token reused\nMeta:%+v\n", meta)
+		return RefreshToken{}, "", svc.revokeRefreshTokenFamily(ctx, owner.UserID, owner.SessionID)
+	}
+
+	newToken, newTokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[RotateRefreshToken] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, "", err
+	}
+
+	err = svc.rsc.SetRefreshTokenToCache(ctx, newTokenHash, owner)
+	if err != nil {
+		log.Printf("[RotateRefreshToken] svc.rsc.SetRefreshTokenToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, "", err
+	}
+
+	previous, err := svc.rsc.SwapRefreshTokenFamilyInCache(ctx, owner.UserID, owner.SessionID, RefreshTokenFamily{
+		FamilyID:  owner.FamilyID,
+		TokenHash: newTokenHash,
+	})
+	if err != nil {
+		log.Printf("[RotateRefreshToken] svc.rsc.SwapRefreshTokenFamilyInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return RefreshToken{}, "", err
+	}
+
+	// another request rotated the same refresh token first.
+	if previous != family {
+		log.Printf("[RotateRefreshToken] refresh token reused concurrently\nMeta:%+v\n", meta)
+		return RefreshToken{}, "", svc.revokeRefreshTokenFamily(ctx, owner.UserID, owner.SessionID)
+	}
+
+	return owner, newToken, nil
+}
+
+// revokeRefreshTokenFamily will revoke refresh token family alongside its session
+// after a refresh token reuse is detected.
+func (svc *Service) revokeRefreshTokenFamily(ctx context.Context, userID int64, sessionID string) error {
+	err := svc.rsc.DeleteSessionInCache(ctx, userID, sessionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":    userID,
+			"session_id": sessionID,
+		}
+
+		log.Printf("[revokeRefreshTokenFamily] svc.rsc.DeleteSessionInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return ErrRefreshTokenReused
+}
@@ -0,0 +1,292 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GenerateRefreshToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockTokenHash := hashToken(mockToken)
+	mockFamilyID := "abababababababababababababababab"
+
+	mockSession := Session{
+		SessionID: "session",
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetRefreshTokenToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockTokenHash, RefreshToken{
+					Email:     "email",
+					FamilyID:  mockFamilyID,
+					SessionID: "session",
+					UserID:    123,
+				}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SwapRefreshTokenFamilyInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockTokenHash, RefreshToken{
+					Email:     "email",
+					FamilyID:  mockFamilyID,
+					SessionID: "session",
+					UserID:    123,
+				}).Return(nil)
+				mf.rsc.EXPECT().SwapRefreshTokenFamilyInCache(context.Background(), int64(123), "session", RefreshTokenFamily{
+					FamilyID:  mockFamilyID,
+					TokenHash: mockTokenHash,
+				}).Return(RefreshTokenFamily{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_refresh_token",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockTokenHash, RefreshToken{
+					Email:     "email",
+					FamilyID:  mockFamilyID,
+					SessionID: "session",
+					UserID:    123,
+				}).Return(nil)
+				mf.rsc.EXPECT().SwapRefreshTokenFamilyInCache(context.Background(), int64(123), "session", RefreshTokenFamily{
+					FamilyID:  mockFamilyID,
+					TokenHash: mockTokenHash,
+				}).Return(RefreshTokenFamily{FamilyID: "old"}, nil)
+			},
+			want: mockToken,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GenerateRefreshToken(context.Background(), mockSession, "email")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RotateRefreshToken(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockOldToken := "old_token"
+	mockOldTokenHash := hashToken(mockOldToken)
+	mockNewToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockNewTokenHash := hashToken(mockNewToken)
+
+	mockOwner := RefreshToken{
+		Email:     "email",
+		FamilyID:  "family",
+		SessionID: "session",
+		UserID:    123,
+	}
+	mockFamily := RefreshTokenFamily{
+		FamilyID:  "family",
+		TokenHash: mockOldTokenHash,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name         string
+		mockFields   func(mockFields)
+		wantOwner    RefreshToken
+		wantNewToken string
+		wantErr      error
+	}{
+		{
+			name: "when_GetRefreshTokenFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(RefreshToken{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_refresh_token_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(RefreshToken{}, nil)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_GetRefreshTokenFamilyFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(RefreshTokenFamily{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_family_no_longer_active_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(RefreshTokenFamily{
+					FamilyID:  "another_family",
+					TokenHash: "another_hash",
+				}, nil)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_refresh_token_reused_then_revoke_family",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: "newer_hash",
+				}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "session").Return(nil)
+			},
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "when_revoke_family_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: "newer_hash",
+				}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "session").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetRefreshTokenToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(mockFamily, nil)
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockNewTokenHash, mockOwner).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SwapRefreshTokenFamilyInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(mockFamily, nil)
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockNewTokenHash, mockOwner).Return(nil)
+				mf.rsc.EXPECT().SwapRefreshTokenFamilyInCache(context.Background(), int64(123), "session", RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: mockNewTokenHash,
+				}).Return(RefreshTokenFamily{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_refresh_token_rotated_concurrently_then_revoke_family",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(mockFamily, nil)
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockNewTokenHash, mockOwner).Return(nil)
+				mf.rsc.EXPECT().SwapRefreshTokenFamilyInCache(context.Background(), int64(123), "session", RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: mockNewTokenHash,
+				}).Return(RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: "concurrent_hash",
+				}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "session").Return(nil)
+			},
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "when_no_error_occured_then_return_owner_and_new_token",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().GetRefreshTokenFromCache(context.Background(), mockOldTokenHash).Return(mockOwner, nil)
+				mf.rsc.EXPECT().GetRefreshTokenFamilyFromCache(context.Background(), int64(123), "session").Return(mockFamily, nil)
+				mf.rsc.EXPECT().SetRefreshTokenToCache(context.Background(), mockNewTokenHash, mockOwner).Return(nil)
+				mf.rsc.EXPECT().SwapRefreshTokenFamilyInCache(context.Background(), int64(123), "session", RefreshTokenFamily{
+					FamilyID:  "family",
+					TokenHash: mockNewTokenHash,
+				}).Return(mockFamily, nil)
+			},
+			wantOwner:    mockOwner,
+			wantNewToken: mockNewToken,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			gotOwner, gotNewToken, err := svc.RotateRefreshToken(context.Background(), mockOldToken)
+			assert.Equal(t, test.wantOwner, gotOwner)
+			assert.Equal(t, test.wantNewToken, gotNewToken)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
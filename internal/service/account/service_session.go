@@ -0,0 +1,155 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"sort"
+)
+
+var (
+	// ErrSessionNotFound is returned when a session doesn't exist or had expired.
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// NewSession will build a new session for a device that logs in to user's account.
+// The session will be saved once a JWT is generated for it.
+func (svc *Service) NewSession(param CreateSessionParam) (Session, error) {
+	sessionID, err := generateTokenID()
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": param.UserID,
+		}
+
+		log.Printf("[NewSession] generateTokenID() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Session{}, err
+	}
+
+	now := svc.infra.GetTimeGMT7()
+	return Session{
+		CreatedAt:  now,
+		DeviceName: param.DeviceName,
+		IPAddress:  param.IPAddress,
+		LastSeenAt: now,
+		Role:       param.Role,
+		SessionID:  sessionID,
+		UserAgent:  param.UserAgent,
+		UserID:     param.UserID,
+	}, nil
+}
+
+// GetSession will fetch an active session of a user.
+// If the session doesn't exist, it will return ErrSessionNotFound.
+func (svc *Service) GetSession(ctx context.Context, userID int64, sessionID string) (Session, error) {
+	meta := map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	session, err := svc.rsc.GetSessionFromCache(ctx, userID, sessionID)
+	if err != nil {
+		log.Printf("[GetSession] svc.rsc.GetSessionFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Session{}, err
+	}
+
+	if session.SessionID == "" {
+		log.Printf("[GetSession] session not found\nMeta:%+v\n", meta)
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+// ListSessions will fetch every active session of a user,
+// ordered from the most recently seen session.
+// Expired sessions found along the way will be removed.
+func (svc *Service) ListSessions(ctx context.Context, userID int64) ([]Session, error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	sessionIDs, err := svc.rsc.GetSessionIDsFromCache(ctx, userID)
+	if err != nil {
+		log.Printf("[ListSessions] svc.rsc.GetSessionIDsFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	sessions := make([]Session, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := svc.rsc.GetSessionFromCache(ctx, userID, sessionID)
+		if err != nil {
+			log.Printf("[ListSessions] svc.rsc.GetSessionFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return nil, err
+		}
+
+		if session.SessionID == "" {
+			err = svc.rsc.DeleteSessionInCache(ctx, userID, sessionID)
+			if err != nil {
+				log.Printf("[ListSessions] svc.rsc.DeleteSessionInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+				return nil, err
+			}
+
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// RevokeOtherSessions will revoke every session of a user except the given session.
+func (svc *Service) RevokeOtherSessions(ctx context.Context, userID int64, sessionID string) error {
+	meta := map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	sessionIDs, err := svc.rsc.GetSessionIDsFromCache(ctx, userID)
+	if err != nil {
+		log.Printf("[RevokeOtherSessions] svc.rsc.GetSessionIDsFromCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	for _, otherSessionID := range sessionIDs {
+		if otherSessionID == sessionID {
+			continue
+		}
+
+		err = svc.rsc.DeleteSessionInCache(ctx, userID, otherSessionID)
+		if err != nil {
+			log.Printf("[RevokeOtherSessions] svc.rsc.DeleteSessionInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevokeSession will revoke a session of a user.
+// If the session doesn't exist, it will return ErrSessionNotFound.
+func (svc *Service) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	meta := map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	_, err := svc.GetSession(ctx, userID, sessionID)
+	if err != nil {
+		log.Printf("[RevokeSession] svc.GetSession() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = svc.rsc.DeleteSessionInCache(ctx, userID, sessionID)
+	if err != nil {
+		log.Printf("[RevokeSession] svc.rsc.DeleteSessionInCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
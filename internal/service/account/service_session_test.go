@@ -0,0 +1,321 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_NewSession(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockParam := CreateSessionParam{
+		DeviceName: "device",
+		IPAddress:  "127.0.0.1",
+		Role:       "user",
+		UserAgent:  "agent",
+		UserID:     123,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Session
+		wantErr    error
+	}{
+		{
+			name: "when_generateTokenID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_session",
+			mockFields: func(mf mockFields) {
+				randRead = func(b []byte) (n int, err error) {
+					for i := range b {
+						b[i] = 0xab
+					}
+					return len(b), nil
+				}
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTime)
+			},
+			want: Session{
+				CreatedAt:  mockTime,
+				DeviceName: "device",
+				IPAddress:  "127.0.0.1",
+				LastSeenAt: mockTime,
+				Role:       "user",
+				SessionID:  "abababababababababababababababab",
+				UserAgent:  "agent",
+				UserID:     123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+			}
+
+			got, err := svc.NewSession(mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetSession(t *testing.T) {
+	mockSession := Session{
+		SessionID: "session",
+		UserID:    123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Session
+		wantErr    error
+	}{
+		{
+			name: "when_GetSessionFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_session_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{}, nil)
+			},
+			wantErr: ErrSessionNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_session",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(mockSession, nil)
+			},
+			want: mockSession,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetSession(context.Background(), 123, "session")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListSessions(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockOldSession := Session{
+		LastSeenAt: mockTime.Add(-time.Hour),
+		SessionID:  "old",
+		UserID:     123,
+	}
+	mockNewSession := Session{
+		LastSeenAt: mockTime,
+		SessionID:  "new",
+		UserID:     123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Session
+		wantErr    error
+	}{
+		{
+			name: "when_GetSessionIDsFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetSessionFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return([]string{"old"}, nil)
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "old").Return(Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteSessionInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return([]string{"expired"}, nil)
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "expired").Return(Session{}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "expired").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_sessions_ordered_by_last_seen",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return([]string{"old", "expired", "new"}, nil)
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "old").Return(mockOldSession, nil)
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "expired").Return(Session{}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "expired").Return(nil)
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "new").Return(mockNewSession, nil)
+			},
+			want: []Session{mockNewSession, mockOldSession},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListSessions(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RevokeOtherSessions(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetSessionIDsFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteSessionInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return([]string{"current", "other"}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "other").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_keep_current_session",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionIDsFromCache(context.Background(), int64(123)).Return([]string{"current", "other", "another"}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "other").Return(nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "another").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.RevokeOtherSessions(context.Background(), 123, "current")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RevokeSession(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetSession_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{}, nil)
+			},
+			wantErr: ErrSessionNotFound,
+		},
+		{
+			name: "when_DeleteSessionInCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{SessionID: "session"}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "session").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetSessionFromCache(context.Background(), int64(123), "session").Return(Session{SessionID: "session"}, nil)
+				mf.rsc.EXPECT().DeleteSessionInCache(context.Background(), int64(123), "session").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.RevokeSession(context.Background(), 123, "session")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
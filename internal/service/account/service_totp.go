@@ -0,0 +1,396 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	recoveryCodeLength = 5
+	totpDigits         = 6
+	totpPeriod         = 30
+	totpSecretLength   = 20
+	totpSkew           = 1
+)
+
+var (
+	// ErrMFAChallengeInvalid is returned when an MFA challenge token is unknown, expired or already used.
+	ErrMFAChallengeInvalid = errors.New("mfa challenge not valid")
+
+	// ErrTOTPAlreadyEnabled is returned when enrolling TOTP for an account that already has it enabled.
+	ErrTOTPAlreadyEnabled = errors.New("two-factor authentication already enabled")
+
+	// ErrTOTPCodeInvalid is returned when neither a TOTP code nor a recovery code matches.
+	ErrTOTPCodeInvalid = errors.New("two-factor authentication code not valid")
+
+	// ErrTOTPNotEnabled is returned when verifying TOTP of an account that doesn't have it enabled.
+	ErrTOTPNotEnabled = errors.New("two-factor authentication not enabled")
+
+	// ErrTOTPNotEnrolled is returned when confirming TOTP of an account that hasn't enrolled yet.
+	ErrTOTPNotEnrolled = errors.New("two-factor authentication not enrolled")
+
+	errTOTPEncryptionKeyInvalid = errors.New("totp encryption key must be 32 bytes")
+	errTOTPSecretMalformed      = errors.New("totp secret malformed")
+)
+
+// ConfirmTOTP will enable TOTP of an account once the first code
+// generated by user's authenticator is verified.
+func (svc *Service) ConfirmTOTP(ctx context.Context, account Account, code string) error {
+	meta := map[string]interface{}{
+		"user_id": account.ID,
+	}
+
+	if !account.TOTPEnabledAt.IsZero() {
+		return ErrTOTPAlreadyEnabled
+	}
+
+	if account.TOTPSecret == "" {
+		return ErrTOTPNotEnrolled
+	}
+
+	now := svc.infra.GetTimeGMT7()
+	step, matched, err := svc.checkTOTPCode(account.TOTPSecret, code, now)
+	if err != nil {
+		log.Printf("[ConfirmTOTP] svc.checkTOTPCode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !matched {
+		return ErrTOTPCodeInvalid
+	}
+
+	// the code confirming TOTP is recorded as used, so it can't be replayed to log in.
+	err = svc.rsc.UpdateUserTOTPInDB(ctx, UpdateUserTOTPParam{
+		EnabledAt:     now,
+		LastUsedStep:  step,
+		RecoveryCodes: account.TOTPRecoveryCodes,
+		Secret:        account.TOTPSecret,
+		UserID:        account.ID,
+	})
+	if err != nil {
+		log.Printf("[ConfirmTOTP] svc.rsc.UpdateUserTOTPInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeMFAChallenge will redeem an MFA challenge token and return its owner.
+// An MFA challenge token can only be redeemed once.
+func (svc *Service) ConsumeMFAChallenge(ctx context.Context, token string) (MFAChallenge, error) {
+	challenge, err := svc.rsc.PopMFAChallengeFromCache(ctx, hashToken(token))
+	if err != nil {
+		log.Printf("[ConsumeMFAChallenge] svc.rsc.PopMFAChallengeFromCache() got an error: %+v\n", err)
+		return MFAChallenge{}, err
+	}
+
+	if challenge.UserID <= 0 {
+		log.Printf("[ConsumeMFAChallenge] mfa challenge not found\n")
+		return MFAChallenge{}, ErrMFAChallengeInvalid
+	}
+
+	return challenge, nil
+}
+
+// CreateMFAChallenge will generate a short-lived challenge token for an account
+// whose password had been verified but still needs a TOTP code to log in.
+func (svc *Service) CreateMFAChallenge(ctx context.Context, account Account) (string, error) {
+	meta := map[string]interface{}{
+		"user_id": account.ID,
+	}
+
+	token, tokenHash, err := generateOpaqueToken()
+	if err != nil {
+		log.Printf("[CreateMFAChallenge] generateOpaqueToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	err = svc.rsc.SetMFAChallengeToCache(ctx, tokenHash, MFAChallenge{
+		Email:  account.Email,
+		UserID: account.ID,
+	})
+	if err != nil {
+		log.Printf("[CreateMFAChallenge] svc.rsc.SetMFAChallengeToCache() got an error: %+v\nMeta:%+v\n", err, meta)
+		return "", err
+	}
+
+	return token, nil
+}
+
+// DisableTOTP will turn off TOTP of a user and remove its secret and recovery codes.
+func (svc *Service) DisableTOTP(ctx context.Context, userID int64) error {
+	err := svc.rsc.UpdateUserTOTPInDB(ctx, UpdateUserTOTPParam{
+		UserID: userID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[DisableTOTP] svc.rsc.UpdateUserTOTPInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// EnrollTOTP will generate a new TOTP secret and recovery codes for an account.
+// The secret is saved encrypted and stays inactive until it's confirmed by ConfirmTOTP.
+// Enrolling again before confirming replaces the previous secret.
+func (svc *Service) EnrollTOTP(ctx context.Context, account Account) (TOTPEnrollment, error) {
+	meta := map[string]interface{}{
+		"user_id": account.ID,
+	}
+
+	if !account.TOTPEnabledAt.IsZero() {
+		return TOTPEnrollment{}, ErrTOTPAlreadyEnabled
+	}
+
+	cfg := svc.infra.GetConfig().Account.TOTP
+
+	secret := make([]byte, totpSecretLength)
+	_, err := randRead(secret)
+	if err != nil {
+		log.Printf("[EnrollTOTP] randRead() got an error: %+v\nMeta:%+v\n", err, meta)
+		return TOTPEnrollment{}, err
+	}
+
+	encryptedSecret, err := encryptTOTPSecret(cfg.EncryptionKey, secret)
+	if err != nil {
+		log.Printf("[EnrollTOTP] encryptTOTPSecret() got an error: %+v\nMeta:%+v\n", err, meta)
+		return TOTPEnrollment{}, err
+	}
+
+	recoveryCodes, err := generateRecoveryCodes(cfg.RecoveryCodeCount)
+	if err != nil {
+		log.Printf("[EnrollTOTP] generateRecoveryCodes() got an error: %+v\nMeta:%+v\n", err, meta)
+		return TOTPEnrollment{}, err
+	}
+
+	recoveryCodeHashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		recoveryCodeHashes = append(recoveryCodeHashes, hashToken(code))
+	}
+
+	err = svc.rsc.UpdateUserTOTPInDB(ctx, UpdateUserTOTPParam{
+		RecoveryCodes: recoveryCodeHashes,
+		Secret:        encryptedSecret,
+		UserID:        account.ID,
+	})
+	if err != nil {
+		log.Printf("[EnrollTOTP] svc.rsc.UpdateUserTOTPInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return TOTPEnrollment{}, err
+	}
+
+	encodedSecret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret)
+	return TOTPEnrollment{
+		ProvisioningURI: buildTOTPProvisioningURI(cfg.Issuer, account.Email, encodedSecret),
+		RecoveryCodes:   recoveryCodes,
+		Secret:          encodedSecret,
+	}, nil
+}
+
+// VerifyTOTP will check a code given by user whose TOTP is enabled.
+// The code is either generated by user's authenticator or one of user's recovery codes.
+// A TOTP code is refused unless its time step is later than the last one accepted,
+// and a recovery code can only be used once.
+func (svc *Service) VerifyTOTP(ctx context.Context, account Account, code string) error {
+	meta := map[string]interface{}{
+		"user_id": account.ID,
+	}
+
+	if account.TOTPEnabledAt.IsZero() {
+		return ErrTOTPNotEnabled
+	}
+
+	step, matched, err := svc.checkTOTPCode(account.TOTPSecret, code, svc.infra.GetTimeGMT7())
+	if err != nil {
+		log.Printf("[VerifyTOTP] svc.checkTOTPCode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if matched {
+		used, err := svc.rsc.UpdateUserTOTPLastUsedStepInDB(ctx, account.ID, step)
+		if err != nil {
+			log.Printf("[VerifyTOTP] svc.rsc.UpdateUserTOTPLastUsedStepInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+
+		if !used {
+			log.Printf("[VerifyTOTP] totp code replayed\nMeta:%+v\n", meta)
+			return ErrTOTPCodeInvalid
+		}
+
+		return nil
+	}
+
+	consumed, err := svc.rsc.DeleteUserTOTPRecoveryCodeInDB(ctx, account.ID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		log.Printf("[VerifyTOTP] svc.rsc.DeleteUserTOTPRecoveryCodeInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !consumed {
+		return ErrTOTPCodeInvalid
+	}
+
+	return nil
+}
+
+// checkTOTPCode will decrypt a TOTP secret and check whether code
+// matches the code of the current time step or its adjacent steps.
+// It returns the time step code matches.
+func (svc *Service) checkTOTPCode(encryptedSecret, code string, now time.Time) (int64, bool, error) {
+	secret, err := decryptTOTPSecret(svc.infra.GetConfig().Account.TOTP.EncryptionKey, encryptedSecret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(code) != totpDigits {
+		return 0, false, nil
+	}
+
+	counter := now.Unix() / totpPeriod
+	for skew := -totpSkew; skew <= totpSkew; skew++ {
+		step := counter + int64(skew)
+		expected := generateTOTPCode(secret, uint64(step))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// buildTOTPProvisioningURI will build an otpauth URI that can be scanned by authenticator apps.
+func buildTOTPProvisioningURI(issuer, email, secret string) string {
+	query := url.Values{}
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("issuer", issuer)
+	query.Set("period", fmt.Sprint(totpPeriod))
+	query.Set("secret", secret)
+
+	label := url.PathEscape(issuer + ":" + email)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// decryptTOTPSecret will decrypt a TOTP secret encrypted by encryptTOTPSecret.
+func decryptTOTPSecret(key, encryptedSecret string) ([]byte, error) {
+	gcm, err := newTOTPCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errTOTPSecretMalformed
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// encryptTOTPSecret will encrypt a TOTP secret using AES-GCM,
+// so a leaked database can't be used to generate user's codes.
+func encryptTOTPSecret(key string, secret []byte) (string, error) {
+	gcm, err := newTOTPCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = randRead(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, secret, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// generateRecoveryCodes will generate count random recovery codes formatted as xxxxx-xxxxx.
+func generateRecoveryCodes(count int) ([]string, error) {
+	bytes := make([]byte, count*recoveryCodeLength)
+	_, err := randRead(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		code := hex.EncodeToString(bytes[i*recoveryCodeLength : (i+1)*recoveryCodeLength])
+		codes = append(codes, code[:recoveryCodeLength]+"-"+code[recoveryCodeLength:])
+	}
+
+	return codes, nil
+}
+
+// generateTOTPCode will generate the RFC 6238 code of secret for a time step counter.
+func generateTOTPCode(secret []byte, counter uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, counter)
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+// newTOTPCipher will build the AES-GCM cipher used to encrypt TOTP secrets.
+func newTOTPCipher(key string) (cipher.AEAD, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawKey) != 32 {
+		return nil, errTOTPEncryptionKeyInvalid
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// normalizeRecoveryCode will format a recovery code typed by user the way it was issued.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	if len(code) != recoveryCodeLength*2 {
+		return code
+	}
+
+	return code[:recoveryCodeLength] + "-" + code[recoveryCodeLength:]
+}
@@ -0,0 +1,571 @@
+package account
+
+import (
+	// golang package
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/infrastructure/configuration"
+)
+
+var (
+	mockTOTPKey    = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	mockTOTPConfig = &configuration.AppConfig{
+		Account: configuration.AccountConfig{
+			TOTP: configuration.TOTPConfig{
+				EncryptionKey:     mockTOTPKey,
+				Issuer:            "bubi",
+				MFAChallengeTTL:   300,
+				RecoveryCodeCount: 2,
+			},
+		},
+	}
+	mockTOTPSecret = bytes.Repeat([]byte{0xab}, totpSecretLength)
+	mockTOTPTime   = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestService_ConfirmTOTP(t *testing.T) {
+	encryptedSecret, _ := encryptTOTPSecret(mockTOTPKey, mockTOTPSecret)
+	mockStep := mockTOTPTime.Unix() / totpPeriod
+	mockCode := generateTOTPCode(mockTOTPSecret, uint64(mockStep))
+	mockAccount := Account{
+		ID:                123,
+		TOTPRecoveryCodes: []string{"hash"},
+		TOTPSecret:        encryptedSecret,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		account    Account
+		code       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_totp_already_enabled_then_return_error",
+			account:    Account{ID: 123, TOTPEnabledAt: mockTOTPTime},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrTOTPAlreadyEnabled,
+		},
+		{
+			name:       "when_totp_not_enrolled_then_return_error",
+			account:    Account{ID: 123},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrTOTPNotEnrolled,
+		},
+		{
+			name:    "when_code_not_match_then_return_error",
+			account: mockAccount,
+			code:    "000000",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+			},
+			wantErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name:    "when_UpdateUserTOTPInDB_error_then_return_error",
+			account: mockAccount,
+			code:    mockCode,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), UpdateUserTOTPParam{
+					EnabledAt:     mockTOTPTime,
+					LastUsedStep:  mockStep,
+					RecoveryCodes: []string{"hash"},
+					Secret:        encryptedSecret,
+					UserID:        123,
+				}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "when_no_error_occured_then_return_nil",
+			account: mockAccount,
+			code:    mockCode,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), UpdateUserTOTPParam{
+					EnabledAt:     mockTOTPTime,
+					LastUsedStep:  mockStep,
+					RecoveryCodes: []string{"hash"},
+					Secret:        encryptedSecret,
+					UserID:        123,
+				}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.ConfirmTOTP(context.Background(), test.account, test.code)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ConsumeMFAChallenge(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       MFAChallenge
+		wantErr    error
+	}{
+		{
+			name: "when_PopMFAChallengeFromCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMFAChallengeFromCache(context.Background(), hashToken("token")).Return(MFAChallenge{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_challenge_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMFAChallengeFromCache(context.Background(), hashToken("token")).Return(MFAChallenge{}, nil)
+			},
+			wantErr: ErrMFAChallengeInvalid,
+		},
+		{
+			name: "when_no_error_occured_then_return_challenge",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().PopMFAChallengeFromCache(context.Background(), hashToken("token")).Return(MFAChallenge{
+					Email:  "email",
+					UserID: 123,
+				}, nil)
+			},
+			want: MFAChallenge{
+				Email:  "email",
+				UserID: 123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ConsumeMFAChallenge(context.Background(), "token")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_CreateMFAChallenge(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	mockToken := "q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s"
+	mockChallenge := MFAChallenge{
+		Email:  "email",
+		UserID: 123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "when_generate_random_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SetMFAChallengeToCache_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().SetMFAChallengeToCache(context.Background(), hashToken(mockToken), mockChallenge).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_token",
+			mockFields: func(mf mockFields) {
+				randRead = mockRead
+				mf.rsc.EXPECT().SetMFAChallengeToCache(context.Background(), hashToken(mockToken), mockChallenge).Return(nil)
+			},
+			want: mockToken,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.CreateMFAChallenge(context.Background(), Account{Email: "email", ID: 123})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_DisableTOTP(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateUserTOTPInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), UpdateUserTOTPParam{UserID: 123}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), UpdateUserTOTPParam{UserID: 123}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.DisableTOTP(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_EnrollTOTP(t *testing.T) {
+	mockRead := func(b []byte) (n int, err error) {
+		for i := range b {
+			b[i] = 0xab
+		}
+		return len(b), nil
+	}
+
+	randReadOri := randRead
+	randRead = mockRead
+	encryptedSecret, _ := encryptTOTPSecret(mockTOTPKey, mockTOTPSecret)
+	randRead = randReadOri
+
+	mockParam := UpdateUserTOTPParam{
+		RecoveryCodes: []string{hashToken("ababa-babab"), hashToken("ababa-babab")},
+		Secret:        encryptedSecret,
+		UserID:        123,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		account    Account
+		mockFields func(mockFields)
+		want       TOTPEnrollment
+		wantErr    error
+	}{
+		{
+			name:       "when_totp_already_enabled_then_return_error",
+			account:    Account{ID: 123, TOTPEnabledAt: mockTOTPTime},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrTOTPAlreadyEnabled,
+		},
+		{
+			name:    "when_generate_random_error_then_return_error",
+			account: Account{Email: "email", ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				randRead = func(b []byte) (n int, err error) {
+					return 0, assert.AnError
+				}
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "when_UpdateUserTOTPInDB_error_then_return_error",
+			account: Account{Email: "email", ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				randRead = mockRead
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), mockParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "when_no_error_occured_then_return_enrollment",
+			account: Account{Email: "email", ID: 123},
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				randRead = mockRead
+				mf.rsc.EXPECT().UpdateUserTOTPInDB(context.Background(), mockParam).Return(nil)
+			},
+			want: TOTPEnrollment{
+				ProvisioningURI: "otpauth://totp/bubi:email?algorithm=SHA1&digits=6&issuer=bubi&period=30&secret=VOV2XK5LVOV2XK5LVOV2XK5LVOV2XK5L",
+				RecoveryCodes:   []string{"ababa-babab", "ababa-babab"},
+				Secret:          "VOV2XK5LVOV2XK5LVOV2XK5LVOV2XK5L",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randReadOri := randRead
+			defer func() {
+				randRead = randReadOri
+			}()
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.EnrollTOTP(context.Background(), test.account)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_VerifyTOTP(t *testing.T) {
+	encryptedSecret, _ := encryptTOTPSecret(mockTOTPKey, mockTOTPSecret)
+	mockStep := mockTOTPTime.Unix() / totpPeriod
+	mockCode := generateTOTPCode(mockTOTPSecret, uint64(mockStep))
+	mockAccount := Account{
+		ID:                123,
+		TOTPEnabledAt:     mockTOTPTime,
+		TOTPRecoveryCodes: []string{hashToken("ababa-babab"), hashToken("cdcdc-dcdcd")},
+		TOTPSecret:        encryptedSecret,
+	}
+
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		account    Account
+		code       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_totp_not_enabled_then_return_error",
+			account:    Account{ID: 123},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrTOTPNotEnabled,
+		},
+		{
+			name:    "when_UpdateUserTOTPLastUsedStepInDB_error_then_return_error",
+			account: mockAccount,
+			code:    mockCode,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().UpdateUserTOTPLastUsedStepInDB(context.Background(), int64(123), mockStep).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "when_totp_code_replayed_then_return_error",
+			account: mockAccount,
+			code:    mockCode,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().UpdateUserTOTPLastUsedStepInDB(context.Background(), int64(123), mockStep).Return(false, nil)
+			},
+			wantErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name:    "when_totp_code_match_then_return_nil",
+			account: mockAccount,
+			code:    mockCode,
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime.Add(totpPeriod * time.Second))
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().UpdateUserTOTPLastUsedStepInDB(context.Background(), int64(123), mockStep).Return(true, nil)
+			},
+		},
+		{
+			name:    "when_DeleteUserTOTPRecoveryCodeInDB_error_then_return_error",
+			account: mockAccount,
+			code:    "ABABABABAB",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().DeleteUserTOTPRecoveryCodeInDB(context.Background(), int64(123), hashToken("ababa-babab")).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "when_neither_totp_nor_recovery_code_match_then_return_error",
+			account: mockAccount,
+			code:    "000000",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().DeleteUserTOTPRecoveryCodeInDB(context.Background(), int64(123), hashToken("000000")).Return(false, nil)
+			},
+			wantErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name:    "when_recovery_code_match_then_remove_it_and_return_nil",
+			account: mockAccount,
+			code:    "ababa-babab",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockTOTPTime)
+				mf.infra.EXPECT().GetConfig().Return(mockTOTPConfig)
+				mf.rsc.EXPECT().DeleteUserTOTPRecoveryCodeInDB(context.Background(), int64(123), hashToken("ababa-babab")).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.VerifyTOTP(context.Background(), test.account, test.code)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestGenerateTOTPCode(t *testing.T) {
+	// test vectors from RFC 6238 appendix B, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		name     string
+		unixTime int64
+		want     string
+	}{
+		{
+			name:     "when_time_is_59_then_return_287082",
+			unixTime: 59,
+			want:     "287082",
+		},
+		{
+			name:     "when_time_is_1111111109_then_return_081804",
+			unixTime: 1111111109,
+			want:     "081804",
+		},
+		{
+			name:     "when_time_is_2000000000_then_return_279037",
+			unixTime: 2000000000,
+			want:     "279037",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := generateTOTPCode(secret, uint64(test.unixTime/totpPeriod))
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestEncryptTOTPSecret(t *testing.T) {
+	t.Run("when_key_invalid_then_return_error", func(t *testing.T) {
+		_, err := encryptTOTPSecret("c2hvcnQ=", mockTOTPSecret)
+		assert.Equal(t, errTOTPEncryptionKeyInvalid, err)
+	})
+
+	t.Run("when_secret_encrypted_then_it_can_be_decrypted", func(t *testing.T) {
+		encrypted, err := encryptTOTPSecret(mockTOTPKey, mockTOTPSecret)
+		assert.Nil(t, err)
+		assert.NotContains(t, encrypted, string(mockTOTPSecret))
+
+		decrypted, err := decryptTOTPSecret(mockTOTPKey, encrypted)
+		assert.Nil(t, err)
+		assert.Equal(t, mockTOTPSecret, decrypted)
+	})
+
+	t.Run("when_encrypted_secret_malformed_then_return_error", func(t *testing.T) {
+		_, err := decryptTOTPSecret(mockTOTPKey, "YWI=")
+		assert.Equal(t, errTOTPSecretMalformed, err)
+	})
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	assert.Equal(t, "ababa-babab", normalizeRecoveryCode(" ABABA-BABAB "))
+	assert.Equal(t, "ababa-babab", normalizeRecoveryCode("ababababab"))
+	assert.Equal(t, "123456", normalizeRecoveryCode("123456"))
+}
@@ -1,6 +1,9 @@
 package account
 
 import (
+	// golang package
+	"time"
+
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/entity"
 )
@@ -8,10 +11,201 @@ import (
 // Account is an entity representational of Account.
 type Account entity.Account
 
-// UpdateUserAccountParam represents parameters needed to update user's account
+// AccountAuditEvent holds information about a security event that happened on a user's account.
+// IPAddress, RequestID and UserAgent describe the request that caused the event.
+type AccountAuditEvent struct {
+	CreatedAt time.Time
+	Event     string
+	IPAddress string
+	RequestID string
+	UserAgent string
+	UserID    int64
+}
+
+// AdminAuditLog holds information about an action an admin took on an account.
+// ActorID is the id of the admin. Refused is true if the action was refused instead of taken.
+type AdminAuditLog struct {
+	Action       string
+	ActorID      int64
+	Refused      bool
+	TargetUserID int64
+}
+
+// CreatePersonalAccessTokenParam represents parameters needed to create a personal access token.
+// A zero ExpiresAt means the token never expires.
+type CreatePersonalAccessTokenParam struct {
+	ExpiresAt time.Time
+	Name      string
+	Scopes    []string
+	UserID    int64
+}
+
+// CreateSessionParam represents parameters needed to create a new session.
+// Role is the role of the user when the session is created, it's carried in every JWT of the session.
+type CreateSessionParam struct {
+	DeviceName string
+	IPAddress  string
+	Role       string
+	UserAgent  string
+	UserID     int64
+}
+
+// SearchUserAccountsParam represents parameters needed to search user accounts.
+// Query is matched against email, first name and last name.
+type SearchUserAccountsParam struct {
+	Limit  int
+	Offset int
+	Query  string
+}
+
+// SendEmailChangeParam represents parameters needed to send an email change confirmation.
+// Email is the current email of the user, which only receives a notice.
+type SendEmailChangeParam struct {
+	Email    string
+	NewEmail string
+	UserID   int64
+}
+
+// UpdateUserAccountParam represents parameters needed to update user's account.
+// An empty BudgetingMode or Timezone keeps the current one.
 type UpdateUserAccountParam struct {
-	FirstName    string
-	LastName     string
-	RecordPeriod int
-	UserID       int64
+	BudgetingMode string
+	FirstName     string
+	LastName      string
+	RecordPeriod  int
+	Timezone      string
+	UserID        int64
+}
+
+// PasswordPolicyError is returned when a password violates the password policy.
+// It holds every rule the password violates.
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+// Error returns a summary of the violations.
+func (e *PasswordPolicyError) Error() string {
+	return "password doesn't meet the password policy"
+}
+
+// PasswordViolation describes a password policy rule that a password violates.
+type PasswordViolation struct {
+	Code    string
+	Message string
+}
+
+// ValidatePasswordParam represents parameters needed to validate a password against the password policy.
+// Email and names are used to refuse passwords that contain them.
+type ValidatePasswordParam struct {
+	Email     string
+	FirstName string
+	LastName  string
+	Password  string
+}
+
+// GetAccountAuditEventsParam represents parameters needed to fetch security events of a user.
+type GetAccountAuditEventsParam struct {
+	Limit  int
+	Offset int
+	UserID int64
+}
+
+// InsertPersonalAccessTokenParam represents parameters needed to create a personal access token.
+// A zero ExpiresAt means the token never expires.
+type InsertPersonalAccessTokenParam struct {
+	ExpiresAt time.Time
+	Name      string
+	Scopes    []string
+	TokenHash string
+	UserID    int64
+}
+
+// PersonalAccessToken holds information about a personal access token of a user.
+// A zero ExpiresAt means the token never expires.
+// Email of the owner is only filled when the token is fetched by its hash.
+type PersonalAccessToken struct {
+	CreatedAt time.Time
+	Email     string
+	ExpiresAt time.Time
+	ID        int64
+	Name      string
+	Scopes    []string
+	UserID    int64
+}
+
+// TOTPEnrollment holds what user needs to set up an authenticator app.
+// Secret is the base32 encoded secret for apps that can't scan ProvisioningURI.
+// RecoveryCodes are only shown once, since only their hash is saved.
+type TOTPEnrollment struct {
+	ProvisioningURI string
+	RecoveryCodes   []string
+	Secret          string
+}
+
+// UpdateUserTOTPParam represents parameters needed to update user's TOTP two-factor authentication.
+// Zero value of a field will be saved as empty.
+type UpdateUserTOTPParam struct {
+	EnabledAt     time.Time
+	LastUsedStep  int64
+	RecoveryCodes []string
+	Secret        string
+	UserID        int64
+}
+
+// LoginBlock holds information about why and how long log in attempts of a subject are refused.
+// Subject is either an email or a client IP.
+type LoginBlock struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+// EmailChange holds information about the owner of an email change confirmation token
+// and the email it will be changed to.
+type EmailChange struct {
+	NewEmail string `json:"new_email"`
+	UserID   int64  `json:"user_id"`
+}
+
+// MagicLink holds information about the owner of a magic link login token.
+type MagicLink struct {
+	Email  string `json:"email"`
+	UserID int64  `json:"user_id"`
+}
+
+// MFAChallenge holds information about the owner of a challenge token
+// that is waiting for a TOTP code to finish log in.
+type MFAChallenge struct {
+	Email  string `json:"email"`
+	UserID int64  `json:"user_id"`
+}
+
+// RefreshToken holds information about the owner of a refresh token.
+type RefreshToken struct {
+	Email     string `json:"email"`
+	FamilyID  string `json:"family_id"`
+	SessionID string `json:"session_id"`
+	UserID    int64  `json:"user_id"`
+}
+
+// RefreshTokenFamily holds the latest refresh token of a family.
+// A family is started on every log in, belongs to the session
+// created by that log in, and its refresh token is rotated on every refresh.
+type RefreshTokenFamily struct {
+	FamilyID  string `json:"family_id"`
+	TokenHash string `json:"token_hash"`
+}
+
+// Session holds information about a device that is logged in to a user's account.
+// TokenID is the id of the latest JWT issued for the session, older JWT of
+// the session are no longer active. Role is carried in every JWT of the session.
+type Session struct {
+	CreatedAt  time.Time `json:"created_at"`
+	DeviceName string    `json:"device_name"`
+	IPAddress  string    `json:"ip_address"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Role       string    `json:"role"`
+	SessionID  string    `json:"session_id"`
+	TokenID    string    `json:"token_id"`
+	UserAgent  string    `json:"user_agent"`
+	UserID     int64     `json:"user_id"`
 }
@@ -0,0 +1,92 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+//go:generate mockgen -source=./resource.go -destination=./resource_mock.go -package=budget
+
+// dbRepoProvider holds all methods from db repo that wil be used in budget's resource.
+type dbRepoProvider interface {
+	// BeginTX will start a new transaction.
+	BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error)
+
+	// Commit will commit the transaction.
+	Commit(tx *sql.Tx) error
+
+	// DeleteBudget will delete the budget of a category of a user in a period.
+	// It returns false if the category isn't budgeted in the period.
+	DeleteBudget(ctx context.Context, tx *sql.Tx, param pgsql.DeleteBudgetParam) (bool, error)
+
+	// GetAvailableToAssign will count, per currency, the income of a user that isn't assigned to any envelope budget
+	// up to a period, adding back what envelope budgets of the periods before have released, ordered by the currency.
+	// The amount is negative when more is assigned than earned.
+	GetAvailableToAssign(ctx context.Context, param pgsql.GetAvailableToAssignParam) ([]pgsql.AvailableToAssign, error)
+
+	// GetBudgetsByPeriod will fetch every budget of a user in a period alongside how much is spent on them,
+	// ordered by their category.
+	GetBudgetsByPeriod(ctx context.Context, param pgsql.GetBudgetsParam) ([]pgsql.Budget, error)
+
+	// GetBudgetsForUpdate will fetch the budgets of two categories of a user in a period alongside
+	// how much is spent on them, ordered by their category, and lock them until tx is over
+	// so money moved between them can't be overwritten by another move.
+	GetBudgetsForUpdate(ctx context.Context, tx *sql.Tx, param pgsql.GetBudgetsForUpdateParam) ([]pgsql.Budget, error)
+
+	// GetBudgetRolloverByUserID will fetch the latest period budgets of a user are rolled over into.
+	// If they have never been rolled over, it will return empty BudgetRollover.
+	GetBudgetRolloverByUserID(ctx context.Context, userID int64) (pgsql.BudgetRollover, error)
+
+	// InsertBudget will create the budget of a category of a user in a period.
+	// It returns false if the category is archived, doesn't belong to the user or is already budgeted in the period.
+	InsertBudget(ctx context.Context, tx *sql.Tx, param pgsql.InsertBudgetParam) (bool, error)
+
+	// LockBudgetRollover will take the lock of rolling over budgets of a user, waiting while another transaction holds it.
+	// The lock is held until tx is committed or rolled back.
+	LockBudgetRollover(ctx context.Context, tx *sql.Tx, userID int64) error
+
+	// ReleaseBudget will save what the budget of a category of a user in a period gives back to what is left
+	// to assign once the period has ended. It returns false if the budget doesn't exist or is already released.
+	ReleaseBudget(ctx context.Context, tx *sql.Tx, param pgsql.ReleaseBudgetParam) (bool, error)
+
+	// Rollback will aborts the transaction.
+	Rollback(tx *sql.Tx) error
+
+	// SettleBudgetCarry will fix what the budget of a category of a user in a period carries over
+	// from the previous period. It returns false if the budget doesn't exist or its carry is already fixed.
+	SettleBudgetCarry(ctx context.Context, tx *sql.Tx, param pgsql.SettleBudgetCarryParam) (bool, error)
+
+	// UpdateBudgetAmount will change the amount and the carried over amount of the budget of a category
+	// of a user in a period. It returns false if the category isn't budgeted in the period.
+	UpdateBudgetAmount(ctx context.Context, tx *sql.Tx, param pgsql.UpdateBudgetAmountParam) (bool, error)
+
+	// UpsertBudget will set the budget of a category of a user in a period,
+	// replacing the budget that is already set for the category in that period.
+	// The carried over amount of a budget that is already set is kept. It returns id of the budget.
+	UpsertBudget(ctx context.Context, tx *sql.Tx, param pgsql.UpsertBudgetParam) (int64, error)
+
+	// UpsertBudgetRollover will save the latest period budgets of a user are rolled over into.
+	// A period before the one that is already saved is ignored.
+	UpsertBudgetRollover(ctx context.Context, tx *sql.Tx, param pgsql.UpsertBudgetRolloverParam) error
+}
+
+// BudgetResourceParam holds all parameters needed to instantiate
+// a new instance of Resource.
+type BudgetResourceParam struct {
+	DB dbRepoProvider
+}
+
+type Resource struct {
+	db dbRepoProvider
+}
+
+// NewResource will instantiate a new instance of Resource.
+func NewResource(param BudgetResourceParam) *Resource {
+	return &Resource{
+		db: param.DB,
+	}
+}
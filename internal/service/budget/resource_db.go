@@ -0,0 +1,572 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+// DeleteBudgetInDB will delete the budget of a category of a user in a period.
+// It returns false if the category isn't budgeted in the period.
+func (rsc *Resource) DeleteBudgetInDB(ctx context.Context, userID, categoryID int64, period entity.Period) (bool, error) {
+	meta := map[string]interface{}{
+		"category_id":  categoryID,
+		"period_start": period.Start,
+		"user_id":      userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeleteBudgetInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeleteBudgetInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	deleted, err := rsc.db.DeleteBudget(ctx, tx, pgsql.DeleteBudgetParam{
+		CategoryID:  categoryID,
+		PeriodStart: period.Start,
+		UserID:      userID,
+	})
+	if err != nil {
+		log.Printf("[DeleteBudgetInDB] rsc.db.DeleteBudget() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[DeleteBudgetInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return deleted, nil
+}
+
+// GetAvailableToAssignFromDB will count, per currency, the income of a user until the end of a period
+// that isn't assigned to any envelope budget up to that period, ordered by the currency.
+// What envelope budgets of the periods before don't carry over is given back to it.
+func (rsc *Resource) GetAvailableToAssignFromDB(ctx context.Context, userID int64, period entity.Period) ([]AvailableToAssign, error) {
+	available, err := rsc.db.GetAvailableToAssign(ctx, pgsql.GetAvailableToAssignParam{
+		End:         period.End,
+		PeriodStart: period.Start,
+		UserID:      userID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"period_start": period.Start,
+			"user_id":      userID,
+		}
+
+		log.Printf("[GetAvailableToAssignFromDB] rsc.db.GetAvailableToAssign() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]AvailableToAssign, 0, len(available))
+	for _, item := range available {
+		result = append(result, AvailableToAssign{
+			Amount:   item.Amount,
+			Currency: item.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+// GetBudgetsByPeriodFromDB will fetch every budget of a user in a period alongside how much is spent on them,
+// ordered by their category.
+func (rsc *Resource) GetBudgetsByPeriodFromDB(ctx context.Context, userID int64, period entity.Period) ([]entity.Budget, error) {
+	budgets, err := rsc.db.GetBudgetsByPeriod(ctx, pgsql.GetBudgetsParam{
+		End:    period.End,
+		Start:  period.Start,
+		UserID: userID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"period_start": period.Start,
+			"user_id":      userID,
+		}
+
+		log.Printf("[GetBudgetsByPeriodFromDB] rsc.db.GetBudgetsByPeriod() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]entity.Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		result = append(result, convertBudget(budget))
+	}
+
+	return result, nil
+}
+
+// GetRolledOverPeriodStartFromDB will fetch start of the latest period budgets of a user are rolled over into.
+// It returns zero time if they have never been rolled over.
+func (rsc *Resource) GetRolledOverPeriodStartFromDB(ctx context.Context, userID int64) (time.Time, error) {
+	rollover, err := rsc.db.GetBudgetRolloverByUserID(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[GetRolledOverPeriodStartFromDB] rsc.db.GetBudgetRolloverByUserID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return time.Time{}, err
+	}
+
+	return rollover.PeriodStart, nil
+}
+
+// InsertBudgetsToDB will create budgets in a single transaction.
+// Budgets of archived categories and of categories that are already budgeted in their period are skipped.
+// It returns how many budgets are created.
+func (rsc *Resource) InsertBudgetsToDB(ctx context.Context, budgets []entity.Budget) (int64, error) {
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertBudgetsToDB] rsc.db.BeginTX() got an error: %+v\n", err)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertBudgetsToDB] rsc.rollbackTX() got an error: %+v\n", err)
+		}
+	}()
+
+	var inserted int64
+	for _, budget := range budgets {
+		var ok bool
+		ok, err = rsc.db.InsertBudget(ctx, tx, pgsql.InsertBudgetParam{
+			Amount:       budget.Amount,
+			CarriedOver:  budget.CarriedOver,
+			CarryPending: budget.CarryPending,
+			CategoryID:   budget.CategoryID,
+			Currency:     budget.Currency,
+			Envelope:     budget.Envelope,
+			PeriodStart:  budget.PeriodStart,
+			RolloverMode: budget.RolloverMode,
+			UserID:       budget.UserID,
+		})
+		if err != nil {
+			meta := map[string]interface{}{
+				"category_id":  budget.CategoryID,
+				"period_start": budget.PeriodStart,
+				"user_id":      budget.UserID,
+			}
+
+			log.Printf("[InsertBudgetsToDB] rsc.db.InsertBudget() got an error: %+v\nMeta: %+v\n", err, meta)
+			return 0, err
+		}
+
+		if ok {
+			inserted++
+		}
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertBudgetsToDB] rsc.db.Commit() got an error: %+v\n", errCommit)
+		return 0, errCommit
+	}
+
+	return inserted, nil
+}
+
+// LockRolledOverPeriodInDB will take the lock of rolling over budgets of a user,
+// waiting while another request holds it. The lock is held until the returned function is called.
+func (rsc *Resource) LockRolledOverPeriodInDB(ctx context.Context, userID int64) (func(), error) {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[LockRolledOverPeriodInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	err = rsc.db.LockBudgetRollover(ctx, tx, userID)
+	if err != nil {
+		log.Printf("[LockRolledOverPeriodInDB] rsc.db.LockBudgetRollover() got an error: %+v\nMeta: %+v\n", err, meta)
+
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[LockRolledOverPeriodInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", errRollback, meta)
+		}
+
+		return nil, err
+	}
+
+	// nothing is written in tx, so rolling it back only releases the lock.
+	unlock := func() {
+		errRollback := rsc.db.Rollback(tx)
+		if errRollback != nil {
+			log.Printf("[LockRolledOverPeriodInDB] rsc.db.Rollback() got an error: %+v\nMeta: %+v\n", errRollback, meta)
+		}
+	}
+
+	return unlock, nil
+}
+
+// MoveBudgetInDB will move money from the budget of a category to the budget of another category in a period
+// in a single transaction, locking both budgets so a concurrent move can't overwrite it.
+// Destination is created when the destination category isn't budgeted in the period yet.
+// A move that is refused returns ErrBudgetNotFound, ErrBudgetCurrencyMismatch or ErrBudgetInsufficient.
+func (rsc *Resource) MoveBudgetInDB(ctx context.Context, param MoveBudgetParam, destination entity.Budget) error {
+	meta := map[string]interface{}{
+		"amount":           param.Amount,
+		"from_category_id": param.FromCategoryID,
+		"period_start":     param.Period.Start,
+		"to_category_id":   param.ToCategoryID,
+		"user_id":          param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[MoveBudgetInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[MoveBudgetInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	locked, err := rsc.db.GetBudgetsForUpdate(ctx, tx, pgsql.GetBudgetsForUpdateParam{
+		End:            param.Period.End,
+		FromCategoryID: param.FromCategoryID,
+		Start:          param.Period.Start,
+		ToCategoryID:   param.ToCategoryID,
+		UserID:         param.UserID,
+	})
+	if err != nil {
+		log.Printf("[MoveBudgetInDB] rsc.db.GetBudgetsForUpdate() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	budgets := make([]entity.Budget, 0, len(locked))
+	for _, budget := range locked {
+		budgets = append(budgets, convertBudget(budget))
+	}
+
+	from, found := findBudget(budgets, param.FromCategoryID)
+	if !found {
+		err = ErrBudgetNotFound
+		return err
+	}
+
+	to, budgeted := findBudget(budgets, param.ToCategoryID)
+	if !budgeted {
+		to = destination
+	}
+
+	if to.Currency != from.Currency {
+		err = ErrBudgetCurrencyMismatch
+		return err
+	}
+
+	err = moveMoney(&from, &to, param.Amount)
+	if err != nil {
+		return err
+	}
+
+	// money is only moved in envelope mode, so both budgets are assigned from what is left to assign from now on.
+	from.Envelope = true
+	to.Envelope = true
+
+	_, err = rsc.db.UpdateBudgetAmount(ctx, tx, convertUpdateBudgetAmount(from))
+	if err != nil {
+		log.Printf("[MoveBudgetInDB] rsc.db.UpdateBudgetAmount() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	if budgeted {
+		_, err = rsc.db.UpdateBudgetAmount(ctx, tx, convertUpdateBudgetAmount(to))
+		if err != nil {
+			log.Printf("[MoveBudgetInDB] rsc.db.UpdateBudgetAmount() got an error: %+v\nMeta: %+v\n", err, meta)
+			return err
+		}
+	} else {
+		var inserted bool
+		inserted, err = rsc.db.InsertBudget(ctx, tx, pgsql.InsertBudgetParam{
+			Amount:       to.Amount,
+			CarriedOver:  to.CarriedOver,
+			CarryPending: to.CarryPending,
+			CategoryID:   to.CategoryID,
+			Currency:     to.Currency,
+			Envelope:     to.Envelope,
+			PeriodStart:  to.PeriodStart,
+			RolloverMode: to.RolloverMode,
+			UserID:       to.UserID,
+		})
+		if err != nil {
+			log.Printf("[MoveBudgetInDB] rsc.db.InsertBudget() got an error: %+v\nMeta: %+v\n", err, meta)
+			return err
+		}
+
+		// the destination is budgeted or archived by another request after it was locked.
+		if !inserted {
+			err = errBudgetMoveConflict
+			return err
+		}
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[MoveBudgetInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// ReleaseBudgetsInDB will save what budgets give back to what is left to assign in a single transaction.
+// Budgets that are already released are skipped. It returns how many budgets are released.
+func (rsc *Resource) ReleaseBudgetsInDB(ctx context.Context, budgets []ReleasedBudget) (int64, error) {
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[ReleaseBudgetsInDB] rsc.db.BeginTX() got an error: %+v\n", err)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[ReleaseBudgetsInDB] rsc.rollbackTX() got an error: %+v\n", err)
+		}
+	}()
+
+	var released int64
+	for _, budget := range budgets {
+		var ok bool
+		ok, err = rsc.db.ReleaseBudget(ctx, tx, pgsql.ReleaseBudgetParam{
+			CategoryID:  budget.CategoryID,
+			PeriodStart: budget.PeriodStart,
+			Released:    budget.Released,
+			UserID:      budget.UserID,
+		})
+		if err != nil {
+			meta := map[string]interface{}{
+				"category_id":  budget.CategoryID,
+				"period_start": budget.PeriodStart,
+				"user_id":      budget.UserID,
+			}
+
+			log.Printf("[ReleaseBudgetsInDB] rsc.db.ReleaseBudget() got an error: %+v\nMeta: %+v\n", err, meta)
+			return 0, err
+		}
+
+		if ok {
+			released++
+		}
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[ReleaseBudgetsInDB] rsc.db.Commit() got an error: %+v\n", errCommit)
+		return 0, errCommit
+	}
+
+	return released, nil
+}
+
+// SettleBudgetCarriesInDB will fix what budgets carry over from the previous period in a single transaction.
+// Budgets whose carry is already fixed are skipped. It returns how many budgets are settled.
+func (rsc *Resource) SettleBudgetCarriesInDB(ctx context.Context, budgets []entity.Budget) (int64, error) {
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[SettleBudgetCarriesInDB] rsc.db.BeginTX() got an error: %+v\n", err)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[SettleBudgetCarriesInDB] rsc.rollbackTX() got an error: %+v\n", err)
+		}
+	}()
+
+	var settled int64
+	for _, budget := range budgets {
+		var ok bool
+		ok, err = rsc.db.SettleBudgetCarry(ctx, tx, pgsql.SettleBudgetCarryParam{
+			CarriedOver: budget.CarriedOver,
+			CategoryID:  budget.CategoryID,
+			PeriodStart: budget.PeriodStart,
+			UserID:      budget.UserID,
+		})
+		if err != nil {
+			meta := map[string]interface{}{
+				"category_id":  budget.CategoryID,
+				"period_start": budget.PeriodStart,
+				"user_id":      budget.UserID,
+			}
+
+			log.Printf("[SettleBudgetCarriesInDB] rsc.db.SettleBudgetCarry() got an error: %+v\nMeta: %+v\n", err, meta)
+			return 0, err
+		}
+
+		if ok {
+			settled++
+		}
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[SettleBudgetCarriesInDB] rsc.db.Commit() got an error: %+v\n", errCommit)
+		return 0, errCommit
+	}
+
+	return settled, nil
+}
+
+// UpsertBudgetToDB will set the budget of a category of a user in the period starting on budget.PeriodStart,
+// replacing the budget that is already set for the category in that period.
+// The carried over amount of a budget that is already set is kept. It returns id of the budget.
+func (rsc *Resource) UpsertBudgetToDB(ctx context.Context, budget entity.Budget) (int64, error) {
+	meta := map[string]interface{}{
+		"category_id":  budget.CategoryID,
+		"period_start": budget.PeriodStart,
+		"user_id":      budget.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpsertBudgetToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpsertBudgetToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	id, err := rsc.db.UpsertBudget(ctx, tx, pgsql.UpsertBudgetParam{
+		Amount:       budget.Amount,
+		CarriedOver:  budget.CarriedOver,
+		CarryPending: budget.CarryPending,
+		CategoryID:   budget.CategoryID,
+		Currency:     budget.Currency,
+		Envelope:     budget.Envelope,
+		PeriodStart:  budget.PeriodStart,
+		RolloverMode: budget.RolloverMode,
+		UserID:       budget.UserID,
+	})
+	if err != nil {
+		log.Printf("[UpsertBudgetToDB] rsc.db.UpsertBudget() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpsertBudgetToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return 0, errCommit
+	}
+
+	return id, nil
+}
+
+// UpsertRolledOverPeriodToDB will save period as the latest period budgets of a user are rolled over into.
+// A period before the one that is already saved is ignored.
+func (rsc *Resource) UpsertRolledOverPeriodToDB(ctx context.Context, userID int64, period entity.Period) error {
+	meta := map[string]interface{}{
+		"period_start": period.Start,
+		"user_id":      userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpsertRolledOverPeriodToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpsertRolledOverPeriodToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.db.UpsertBudgetRollover(ctx, tx, pgsql.UpsertBudgetRolloverParam{
+		PeriodStart: period.Start,
+		UserID:      userID,
+	})
+	if err != nil {
+		log.Printf("[UpsertRolledOverPeriodToDB] rsc.db.UpsertBudgetRollover() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpsertRolledOverPeriodToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// rollbackTX will rollback a transaction if any error occured.
+func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errRollback := rsc.db.Rollback(tx)
+	if errRollback != nil {
+		log.Printf("[rollbackTX] rsc.db.Rollback() got an error: %+v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// convertBudget will convert user's budget saved in database.
+func convertBudget(budget pgsql.Budget) entity.Budget {
+	return entity.Budget{
+		Amount:       budget.Amount,
+		CarriedOver:  budget.CarriedOver,
+		CarryPending: budget.CarryPending,
+		CategoryID:   budget.CategoryID,
+		CreatedAt:    budget.CreatedAt,
+		Currency:     budget.Currency,
+		Envelope:     budget.Envelope,
+		ID:           budget.ID,
+		PeriodStart:  budget.PeriodStart,
+		RolloverMode: budget.RolloverMode,
+		Spent:        budget.Spent,
+		UpdatedAt:    budget.UpdatedAt.Time,
+		UserID:       budget.UserID,
+	}
+}
+
+// convertUpdateBudgetAmount will convert the money of a budget into parameters to save it.
+func convertUpdateBudgetAmount(budget entity.Budget) pgsql.UpdateBudgetAmountParam {
+	return pgsql.UpdateBudgetAmountParam{
+		Amount:      budget.Amount,
+		CarriedOver: budget.CarriedOver,
+		CategoryID:  budget.CategoryID,
+		Envelope:    budget.Envelope,
+		PeriodStart: budget.PeriodStart,
+		UserID:      budget.UserID,
+	}
+}
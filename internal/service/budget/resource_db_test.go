@@ -0,0 +1,1074 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+var (
+	mockPeriod = entity.Period{
+		End:   time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockPreviousPeriod = entity.Period{
+		End:   time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+func TestResource_DeleteBudgetInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.DeleteBudgetParam{
+		CategoryID:  1,
+		PeriodStart: mockPeriod.Start,
+		UserID:      123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteBudget_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteBudget(context.Background(), &sql.Tx{}, mockParam).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteBudget(context.Background(), &sql.Tx{}, mockParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_deleted",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().DeleteBudget(context.Background(), &sql.Tx{}, mockParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.DeleteBudgetInDB(context.Background(), 123, 1, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetAvailableToAssignFromDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.GetAvailableToAssignParam{
+		End:         mockPeriod.End,
+		PeriodStart: mockPeriod.Start,
+		UserID:      123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AvailableToAssign
+		wantErr    error
+	}{
+		{
+			name: "when_GetAvailableToAssign_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetAvailableToAssign(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_available_to_assign",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetAvailableToAssign(context.Background(), mockParam).Return([]pgsql.AvailableToAssign{
+					{
+						Amount:   2500000,
+						Currency: "IDR",
+					},
+					{
+						Amount:   -1000,
+						Currency: "USD",
+					},
+				}, nil)
+			},
+			want: []AvailableToAssign{
+				{
+					Amount:   2500000,
+					Currency: "IDR",
+				},
+				{
+					Amount:   -1000,
+					Currency: "USD",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetAvailableToAssignFromDB(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetBudgetsByPeriodFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.GetBudgetsParam{
+		End:    mockPeriod.End,
+		Start:  mockPeriod.Start,
+		UserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []entity.Budget
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriod_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetBudgetsByPeriod(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_budgets",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetBudgetsByPeriod(context.Background(), mockParam).Return([]pgsql.Budget{
+					{
+						Amount:       1500000,
+						CarriedOver:  -25000,
+						CategoryID:   1,
+						CreatedAt:    mockTime,
+						Currency:     "IDR",
+						ID:           10,
+						PeriodStart:  mockPeriod.Start,
+						RolloverMode: entity.RolloverModeBoth,
+						Spent:        250000,
+						UpdatedAt:    sql.NullTime{Time: mockTime, Valid: true},
+						UserID:       123,
+					},
+				}, nil)
+			},
+			want: []entity.Budget{
+				{
+					Amount:       1500000,
+					CarriedOver:  -25000,
+					CategoryID:   1,
+					CreatedAt:    mockTime,
+					Currency:     "IDR",
+					ID:           10,
+					PeriodStart:  mockPeriod.Start,
+					RolloverMode: entity.RolloverModeBoth,
+					Spent:        250000,
+					UpdatedAt:    mockTime,
+					UserID:       123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetBudgetsByPeriodFromDB(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetRolledOverPeriodStartFromDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       time.Time
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetRolloverByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetBudgetRolloverByUserID(context.Background(), int64(123)).Return(pgsql.BudgetRollover{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_never_rolled_over_then_return_zero_time",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetBudgetRolloverByUserID(context.Background(), int64(123)).Return(pgsql.BudgetRollover{}, nil)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_period_start",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetBudgetRolloverByUserID(context.Background(), int64(123)).Return(pgsql.BudgetRollover{
+					PeriodStart: mockPeriod.Start,
+					UserID:      123,
+				}, nil)
+			},
+			want: mockPeriod.Start,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetRolledOverPeriodStartFromDB(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertBudgetsToDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			Amount:       1500000,
+			CarriedOver:  25000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarryPending: true,
+			CategoryID:   2,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeNone,
+			UserID:       123,
+		},
+	}
+	mockParams := []pgsql.InsertBudgetParam{
+		{
+			Amount:       1500000,
+			CarriedOver:  25000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarryPending: true,
+			CategoryID:   2,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeNone,
+			UserID:       123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertBudget_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_inserted_count",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.InsertBudgetsToDB(context.Background(), mockBudgets)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_LockRolledOverPeriodInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantUnlock bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_LockBudgetRollover_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().LockBudgetRollover(context.Background(), &sql.Tx{}, int64(123)).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_release_lock_then_only_log_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().LockBudgetRollover(context.Background(), &sql.Tx{}, int64(123)).Return(nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantUnlock: true,
+		},
+		{
+			name: "when_no_error_occured_then_return_unlock_that_releases_lock",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().LockBudgetRollover(context.Background(), &sql.Tx{}, int64(123)).Return(nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantUnlock: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			unlock, err := rsc.LockRolledOverPeriodInDB(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantUnlock, unlock != nil)
+			if unlock != nil {
+				unlock()
+			}
+		})
+	}
+}
+
+func TestResource_MoveBudgetInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := MoveBudgetParam{
+		Amount:         150000,
+		FromCategoryID: 1,
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		ToCategoryID:   5,
+		UserID:         123,
+	}
+	mockLockParam := pgsql.GetBudgetsForUpdateParam{
+		End:            mockPeriod.End,
+		FromCategoryID: 1,
+		Start:          mockPeriod.Start,
+		ToCategoryID:   5,
+		UserID:         123,
+	}
+	mockLocked := []pgsql.Budget{
+		{
+			Amount:       100000,
+			CarriedOver:  200000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			ID:           10,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			Spent:        50000,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CategoryID:   5,
+			Currency:     "IDR",
+			ID:           12,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+	}
+	mockDestination := entity.Budget{
+		CarriedOver:  -100000,
+		CategoryID:   5,
+		Currency:     "IDR",
+		PeriodStart:  mockPeriod.Start,
+		RolloverMode: entity.RolloverModeBoth,
+		UserID:       123,
+	}
+	mockFromParam := pgsql.UpdateBudgetAmountParam{
+		CarriedOver: 150000,
+		CategoryID:  1,
+		Envelope:    true,
+		PeriodStart: mockPeriod.Start,
+		UserID:      123,
+	}
+	mockToParam := pgsql.UpdateBudgetAmountParam{
+		Amount:      600000,
+		CarriedOver: 50000,
+		CategoryID:  5,
+		Envelope:    true,
+		PeriodStart: mockPeriod.Start,
+		UserID:      123,
+	}
+	mockInsertParam := pgsql.InsertBudgetParam{
+		Amount:       100000,
+		CarriedOver:  -50000,
+		CategoryID:   5,
+		Currency:     "IDR",
+		Envelope:     true,
+		PeriodStart:  mockPeriod.Start,
+		RolloverMode: entity.RolloverModeBoth,
+		UserID:       123,
+	}
+
+	type args struct {
+		param       MoveBudgetParam
+		destination entity.Budget
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetBudgetsForUpdate_error_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(nil, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_source_not_budgeted_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked[1:], nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrBudgetNotFound,
+		},
+		{
+			name: "when_currency_differs_then_rollback_transaction_then_return_error",
+			args: args{
+				param: mockParam,
+				destination: entity.Budget{
+					CategoryID:   5,
+					Currency:     "USD",
+					PeriodStart:  mockPeriod.Start,
+					RolloverMode: entity.RolloverModeNone,
+					UserID:       123,
+				},
+			},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked[:1], nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrBudgetCurrencyMismatch,
+		},
+		{
+			name: "when_amount_more_than_unspent_then_rollback_transaction_then_return_error",
+			args: args{
+				param: MoveBudgetParam{
+					Amount:         250001,
+					FromCategoryID: 1,
+					Period:         mockPeriod,
+					PreviousPeriod: mockPreviousPeriod,
+					ToCategoryID:   5,
+					UserID:         123,
+				},
+				destination: mockDestination,
+			},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrBudgetInsufficient,
+		},
+		{
+			name: "when_UpdateBudgetAmount_of_source_error_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateBudgetAmount_of_destination_error_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockToParam).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertBudget_error_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked[:1], nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockInsertParam).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_destination_created_by_another_request_then_rollback_transaction_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked[:1], nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockInsertParam).Return(false, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: errBudgetMoveConflict,
+		},
+		{
+			name: "when_Commit_error_then_return_error",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockToParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_destination_not_budgeted_then_create_it",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked[:1], nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().InsertBudget(context.Background(), &sql.Tx{}, mockInsertParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_move_assigned_amount_before_carried_over",
+			args: args{param: mockParam, destination: mockDestination},
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetBudgetsForUpdate(context.Background(), &sql.Tx{}, mockLockParam).Return(mockLocked, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockFromParam).Return(true, nil)
+				mf.db.EXPECT().UpdateBudgetAmount(context.Background(), &sql.Tx{}, mockToParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.MoveBudgetInDB(context.Background(), test.args.param, test.args.destination)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_ReleaseBudgetsInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockBudgets := []ReleasedBudget{
+		{
+			CategoryID:  1,
+			PeriodStart: mockPeriod.Start,
+			Released:    25000,
+			UserID:      123,
+		},
+		{
+			CategoryID:  2,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+	}
+	mockParams := []pgsql.ReleaseBudgetParam{
+		{
+			CategoryID:  1,
+			PeriodStart: mockPeriod.Start,
+			Released:    25000,
+			UserID:      123,
+		},
+		{
+			CategoryID:  2,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ReleaseBudget_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Commit_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_released_count",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().ReleaseBudget(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.ReleaseBudgetsInDB(context.Background(), mockBudgets)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_SettleBudgetCarriesInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			CarriedOver: 25000,
+			CategoryID:  1,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+		{
+			CategoryID:  2,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+	}
+	mockParams := []pgsql.SettleBudgetCarryParam{
+		{
+			CarriedOver: 25000,
+			CategoryID:  1,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+		{
+			CategoryID:  2,
+			PeriodStart: mockPeriod.Start,
+			UserID:      123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SettleBudgetCarry_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_Commit_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[1]).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_settled_count",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[0]).Return(true, nil)
+				mf.db.EXPECT().SettleBudgetCarry(context.Background(), &sql.Tx{}, mockParams[1]).Return(false, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.SettleBudgetCarriesInDB(context.Background(), mockBudgets)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpsertBudgetToDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.UpsertBudgetParam{
+		Amount:       1500000,
+		CarriedOver:  25000,
+		CategoryID:   1,
+		Currency:     "IDR",
+		PeriodStart:  mockPeriod.Start,
+		RolloverMode: entity.RolloverModePositive,
+		UserID:       123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpsertBudget_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudget(context.Background(), &sql.Tx{}, mockParam).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudget(context.Background(), &sql.Tx{}, mockParam).Return(int64(10), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudget(context.Background(), &sql.Tx{}, mockParam).Return(int64(10), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.UpsertBudgetToDB(context.Background(), entity.Budget{
+				Amount:       1500000,
+				CarriedOver:  25000,
+				CategoryID:   1,
+				Currency:     "IDR",
+				PeriodStart:  mockPeriod.Start,
+				RolloverMode: entity.RolloverModePositive,
+				UserID:       123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpsertRolledOverPeriodToDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.UpsertBudgetRolloverParam{
+		PeriodStart: mockPeriod.Start,
+		UserID:      123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpsertBudgetRollover_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudgetRollover(context.Background(), &sql.Tx{}, mockParam).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudgetRollover(context.Background(), &sql.Tx{}, mockParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpsertBudgetRollover(context.Background(), &sql.Tx{}, mockParam).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpsertRolledOverPeriodToDB(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
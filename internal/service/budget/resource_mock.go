@@ -0,0 +1,258 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./resource.go
+
+// Package budget is a generated GoMock package.
+package budget
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	pgsql "github.com/arifinhermawan/bubi/internal/repository/pgsql"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// BeginTX mocks base method.
+func (m *MockdbRepoProvider) BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTX", ctx, options)
+	ret0, _ := ret[0].(*sql.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTX indicates an expected call of BeginTX.
+func (mr *MockdbRepoProviderMockRecorder) BeginTX(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTX", reflect.TypeOf((*MockdbRepoProvider)(nil).BeginTX), ctx, options)
+}
+
+// Commit mocks base method.
+func (m *MockdbRepoProvider) Commit(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockdbRepoProviderMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockdbRepoProvider)(nil).Commit), tx)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockdbRepoProvider) DeleteBudget(ctx context.Context, tx *sql.Tx, param pgsql.DeleteBudgetParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockdbRepoProviderMockRecorder) DeleteBudget(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteBudget), ctx, tx, param)
+}
+
+// GetAvailableToAssign mocks base method.
+func (m *MockdbRepoProvider) GetAvailableToAssign(ctx context.Context, param pgsql.GetAvailableToAssignParam) ([]pgsql.AvailableToAssign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableToAssign", ctx, param)
+	ret0, _ := ret[0].([]pgsql.AvailableToAssign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableToAssign indicates an expected call of GetAvailableToAssign.
+func (mr *MockdbRepoProviderMockRecorder) GetAvailableToAssign(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableToAssign", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAvailableToAssign), ctx, param)
+}
+
+// GetBudgetRolloverByUserID mocks base method.
+func (m *MockdbRepoProvider) GetBudgetRolloverByUserID(ctx context.Context, userID int64) (pgsql.BudgetRollover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetRolloverByUserID", ctx, userID)
+	ret0, _ := ret[0].(pgsql.BudgetRollover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetRolloverByUserID indicates an expected call of GetBudgetRolloverByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetBudgetRolloverByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetRolloverByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetBudgetRolloverByUserID), ctx, userID)
+}
+
+// GetBudgetsByPeriod mocks base method.
+func (m *MockdbRepoProvider) GetBudgetsByPeriod(ctx context.Context, param pgsql.GetBudgetsParam) ([]pgsql.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetsByPeriod", ctx, param)
+	ret0, _ := ret[0].([]pgsql.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetsByPeriod indicates an expected call of GetBudgetsByPeriod.
+func (mr *MockdbRepoProviderMockRecorder) GetBudgetsByPeriod(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetsByPeriod", reflect.TypeOf((*MockdbRepoProvider)(nil).GetBudgetsByPeriod), ctx, param)
+}
+
+// GetBudgetsForUpdate mocks base method.
+func (m *MockdbRepoProvider) GetBudgetsForUpdate(ctx context.Context, tx *sql.Tx, param pgsql.GetBudgetsForUpdateParam) ([]pgsql.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetsForUpdate", ctx, tx, param)
+	ret0, _ := ret[0].([]pgsql.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetsForUpdate indicates an expected call of GetBudgetsForUpdate.
+func (mr *MockdbRepoProviderMockRecorder) GetBudgetsForUpdate(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetsForUpdate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetBudgetsForUpdate), ctx, tx, param)
+}
+
+// InsertBudget mocks base method.
+func (m *MockdbRepoProvider) InsertBudget(ctx context.Context, tx *sql.Tx, param pgsql.InsertBudgetParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBudget", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBudget indicates an expected call of InsertBudget.
+func (mr *MockdbRepoProviderMockRecorder) InsertBudget(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBudget", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertBudget), ctx, tx, param)
+}
+
+// LockBudgetRollover mocks base method.
+func (m *MockdbRepoProvider) LockBudgetRollover(ctx context.Context, tx *sql.Tx, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockBudgetRollover", ctx, tx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockBudgetRollover indicates an expected call of LockBudgetRollover.
+func (mr *MockdbRepoProviderMockRecorder) LockBudgetRollover(ctx, tx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockBudgetRollover", reflect.TypeOf((*MockdbRepoProvider)(nil).LockBudgetRollover), ctx, tx, userID)
+}
+
+// ReleaseBudget mocks base method.
+func (m *MockdbRepoProvider) ReleaseBudget(ctx context.Context, tx *sql.Tx, param pgsql.ReleaseBudgetParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBudget", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseBudget indicates an expected call of ReleaseBudget.
+func (mr *MockdbRepoProviderMockRecorder) ReleaseBudget(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBudget", reflect.TypeOf((*MockdbRepoProvider)(nil).ReleaseBudget), ctx, tx, param)
+}
+
+// Rollback mocks base method.
+func (m *MockdbRepoProvider) Rollback(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockdbRepoProviderMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockdbRepoProvider)(nil).Rollback), tx)
+}
+
+// SettleBudgetCarry mocks base method.
+func (m *MockdbRepoProvider) SettleBudgetCarry(ctx context.Context, tx *sql.Tx, param pgsql.SettleBudgetCarryParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleBudgetCarry", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleBudgetCarry indicates an expected call of SettleBudgetCarry.
+func (mr *MockdbRepoProviderMockRecorder) SettleBudgetCarry(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleBudgetCarry", reflect.TypeOf((*MockdbRepoProvider)(nil).SettleBudgetCarry), ctx, tx, param)
+}
+
+// UpdateBudgetAmount mocks base method.
+func (m *MockdbRepoProvider) UpdateBudgetAmount(ctx context.Context, tx *sql.Tx, param pgsql.UpdateBudgetAmountParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudgetAmount", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBudgetAmount indicates an expected call of UpdateBudgetAmount.
+func (mr *MockdbRepoProviderMockRecorder) UpdateBudgetAmount(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetAmount", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateBudgetAmount), ctx, tx, param)
+}
+
+// UpsertBudget mocks base method.
+func (m *MockdbRepoProvider) UpsertBudget(ctx context.Context, tx *sql.Tx, param pgsql.UpsertBudgetParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBudget", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBudget indicates an expected call of UpsertBudget.
+func (mr *MockdbRepoProviderMockRecorder) UpsertBudget(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBudget", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertBudget), ctx, tx, param)
+}
+
+// UpsertBudgetRollover mocks base method.
+func (m *MockdbRepoProvider) UpsertBudgetRollover(ctx context.Context, tx *sql.Tx, param pgsql.UpsertBudgetRolloverParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBudgetRollover", ctx, tx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBudgetRollover indicates an expected call of UpsertBudgetRollover.
+func (mr *MockdbRepoProviderMockRecorder) UpsertBudgetRollover(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBudgetRollover", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertBudgetRollover), ctx, tx, param)
+}
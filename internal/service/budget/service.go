@@ -0,0 +1,90 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+//go:generate mockgen -source=./service.go -destination=./service_mock.go -package=budget
+
+// resourceProvider holds all methods from resource that wil be used in budget's service.
+type resourceProvider interface {
+	// DeleteBudgetInDB will delete the budget of a category of a user in a period.
+	// It returns false if the category isn't budgeted in the period.
+	DeleteBudgetInDB(ctx context.Context, userID, categoryID int64, period entity.Period) (bool, error)
+
+	// GetAvailableToAssignFromDB will count, per currency, the income of a user until the end of a period
+	// that isn't assigned to any envelope budget up to that period, ordered by the currency.
+	// What envelope budgets of the periods before don't carry over is given back to it.
+	GetAvailableToAssignFromDB(ctx context.Context, userID int64, period entity.Period) ([]AvailableToAssign, error)
+
+	// GetBudgetsByPeriodFromDB will fetch every budget of a user in a period alongside how much is spent on them,
+	// ordered by their category.
+	GetBudgetsByPeriodFromDB(ctx context.Context, userID int64, period entity.Period) ([]entity.Budget, error)
+
+	// GetRolledOverPeriodStartFromDB will fetch start of the latest period budgets of a user are rolled over into.
+	// It returns zero time if they have never been rolled over.
+	GetRolledOverPeriodStartFromDB(ctx context.Context, userID int64) (time.Time, error)
+
+	// InsertBudgetsToDB will create budgets in a single transaction.
+	// Budgets of archived categories and of categories that are already budgeted in their period are skipped.
+	// It returns how many budgets are created.
+	InsertBudgetsToDB(ctx context.Context, budgets []entity.Budget) (int64, error)
+
+	// LockRolledOverPeriodInDB will take the lock of rolling over budgets of a user,
+	// waiting while another request holds it. The lock is held until the returned function is called.
+	LockRolledOverPeriodInDB(ctx context.Context, userID int64) (func(), error)
+
+	// MoveBudgetInDB will move money from the budget of a category to the budget of another category in a period
+	// in a single transaction, locking both budgets so a concurrent move can't overwrite it.
+	// Destination is created when the destination category isn't budgeted in the period yet.
+	// A move that is refused returns ErrBudgetNotFound, ErrBudgetCurrencyMismatch or ErrBudgetInsufficient.
+	MoveBudgetInDB(ctx context.Context, param MoveBudgetParam, destination entity.Budget) error
+
+	// ReleaseBudgetsInDB will save what budgets give back to what is left to assign in a single transaction.
+	// Budgets that are already released are skipped. It returns how many budgets are released.
+	ReleaseBudgetsInDB(ctx context.Context, budgets []ReleasedBudget) (int64, error)
+
+	// SettleBudgetCarriesInDB will fix what budgets carry over from the previous period in a single transaction.
+	// Budgets whose carry is already fixed are skipped. It returns how many budgets are settled.
+	SettleBudgetCarriesInDB(ctx context.Context, budgets []entity.Budget) (int64, error)
+
+	// UpsertBudgetToDB will set the budget of a category of a user in the period starting on budget.PeriodStart,
+	// replacing the budget that is already set for the category in that period.
+	// The carried over amount of a budget that is already set is kept. It returns id of the budget.
+	UpsertBudgetToDB(ctx context.Context, budget entity.Budget) (int64, error)
+
+	// UpsertRolledOverPeriodToDB will save period as the latest period budgets of a user are rolled over into.
+	// A period before the one that is already saved is ignored.
+	UpsertRolledOverPeriodToDB(ctx context.Context, userID int64, period entity.Period) error
+}
+
+// infraProvider holds all methods from infra that will be needed in budget's service.
+type infraProvider interface {
+	// GetTimeGMT7 will get current time in GMT+7
+	GetTimeGMT7() time.Time
+}
+
+// BudgetServiceParam holds all parameters needed to instantiate
+// a new instance of Service.
+type BudgetServiceParam struct {
+	Infra infraProvider
+	Rsc   resourceProvider
+}
+
+type Service struct {
+	infra infraProvider
+	rsc   resourceProvider
+}
+
+// NewService will instantiate a new instance of Service.
+func NewService(param BudgetServiceParam) *Service {
+	return &Service{
+		infra: param.Infra,
+		rsc:   param.Rsc,
+	}
+}
@@ -0,0 +1,512 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+var (
+	// ErrBudgetCurrencyMismatch is returned when money is moved between budgets of different currencies.
+	ErrBudgetCurrencyMismatch = errors.New("budgets have different currencies")
+
+	// ErrBudgetInsufficient is returned when a budget doesn't have enough unspent money to move.
+	ErrBudgetInsufficient = errors.New("budget doesn't have enough to move")
+
+	// ErrBudgetNotFound is returned when the category isn't budgeted in the requested period.
+	ErrBudgetNotFound = errors.New("budget not found")
+
+	errBudgetMoveConflict = errors.New("budget is changed by another request while moving money")
+)
+
+// CopyBudgets will copy every budget of a user in from period into to period,
+// carrying over what is left of them according to their rollover mode once from period has ended.
+// The copies are set in budgetingMode, the budgeting mode of the user.
+// Budgets of archived categories and of categories that are already budgeted in to period are skipped.
+// It returns how many budgets are copied.
+func (svc *Service) CopyBudgets(ctx context.Context, userID int64, budgetingMode string, from, to entity.Period) (int64, error) {
+	copied, err := svc.carryBudgets(ctx, userID, from, to, false, true, budgetingMode == entity.BudgetingModeEnvelope)
+	if err != nil {
+		meta := map[string]interface{}{
+			"from":    from.Start,
+			"to":      to.Start,
+			"user_id": userID,
+		}
+
+		log.Printf("[CopyBudgets] svc.carryBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return copied, nil
+}
+
+// DeleteBudget will delete the budget of a category of a user in a period.
+// If the category isn't budgeted in the period, it will return ErrBudgetNotFound.
+func (svc *Service) DeleteBudget(ctx context.Context, userID, categoryID int64, period entity.Period) error {
+	meta := map[string]interface{}{
+		"category_id":  categoryID,
+		"period_start": period.Start,
+		"user_id":      userID,
+	}
+
+	deleted, err := svc.rsc.DeleteBudgetInDB(ctx, userID, categoryID, period)
+	if err != nil {
+		log.Printf("[DeleteBudget] svc.rsc.DeleteBudgetInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !deleted {
+		log.Printf("[DeleteBudget] budget not found\nMeta:%+v\n", meta)
+		return ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+// GetAvailableToAssign will count, per currency, the income of a user until the end of a period
+// that isn't assigned to any envelope budget up to that period, ordered by the currency.
+// What envelope budgets of the periods before don't carry over is given back to it.
+func (svc *Service) GetAvailableToAssign(ctx context.Context, userID int64, period entity.Period) ([]AvailableToAssign, error) {
+	available, err := svc.rsc.GetAvailableToAssignFromDB(ctx, userID, period)
+	if err != nil {
+		meta := map[string]interface{}{
+			"period_start": period.Start,
+			"user_id":      userID,
+		}
+
+		log.Printf("[GetAvailableToAssign] svc.rsc.GetAvailableToAssignFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return available, nil
+}
+
+// GetRolledOverPeriodStart will fetch start of the latest period budgets of a user are rolled over into.
+// It returns zero time if they have never been rolled over.
+func (svc *Service) GetRolledOverPeriodStart(ctx context.Context, userID int64) (time.Time, error) {
+	periodStart, err := svc.rsc.GetRolledOverPeriodStartFromDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[GetRolledOverPeriodStart] svc.rsc.GetRolledOverPeriodStartFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return time.Time{}, err
+	}
+
+	return periodStart, nil
+}
+
+// ListBudgets will fetch every budget of a user in a period alongside how much is spent on them,
+// ordered by their category.
+func (svc *Service) ListBudgets(ctx context.Context, userID int64, period entity.Period) ([]Budget, error) {
+	budgets, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, userID, period)
+	if err != nil {
+		meta := map[string]interface{}{
+			"period_start": period.Start,
+			"user_id":      userID,
+		}
+
+		log.Printf("[ListBudgets] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		result = append(result, Budget(budget))
+	}
+
+	return result, nil
+}
+
+// LockRollOver will take the lock of rolling over budgets of a user, waiting while another request holds it,
+// so budgets of the user are only rolled over by one request at a time. The lock is held until the returned function is called.
+func (svc *Service) LockRollOver(ctx context.Context, userID int64) (func(), error) {
+	unlock, err := svc.rsc.LockRolledOverPeriodInDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[LockRollOver] svc.rsc.LockRolledOverPeriodInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	return unlock, nil
+}
+
+// MoveBudget will move money from the budget of a category to the budget of another category in a period.
+// Only what is left unspent of the source can be moved, including what it carried over,
+// otherwise it returns ErrBudgetInsufficient. The amount assigned to the source is moved first.
+// If the source category isn't budgeted in the period, it will return ErrBudgetNotFound.
+// The destination is created when it isn't budgeted yet, carrying over from its budget in the previous period
+// once that period has ended, or following the rollover mode of the source when it has none.
+func (svc *Service) MoveBudget(ctx context.Context, param MoveBudgetParam) error {
+	meta := map[string]interface{}{
+		"amount":           param.Amount,
+		"from_category_id": param.FromCategoryID,
+		"period_start":     param.Period.Start,
+		"to_category_id":   param.ToCategoryID,
+		"user_id":          param.UserID,
+	}
+
+	budgets, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, param.UserID, param.Period)
+	if err != nil {
+		log.Printf("[MoveBudget] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	from, found := findBudget(budgets, param.FromCategoryID)
+	if !found {
+		log.Printf("[MoveBudget] budget not found\nMeta:%+v\n", meta)
+		return ErrBudgetNotFound
+	}
+
+	destination, found := findBudget(budgets, param.ToCategoryID)
+	if !found {
+		destination, err = svc.newMoveDestination(ctx, param, from)
+		if err != nil {
+			log.Printf("[MoveBudget] svc.newMoveDestination() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+	}
+
+	err = svc.rsc.MoveBudgetInDB(ctx, param, destination)
+	if err != nil {
+		log.Printf("[MoveBudget] svc.rsc.MoveBudgetInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// RollOverBudgets will continue every budget of a user in from period that has a rollover mode
+// other than none into to period, carrying over what is left of them.
+// Categories that are already budgeted in to period keep their budget.
+// It returns how many budgets are rolled over.
+func (svc *Service) RollOverBudgets(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	rolled, err := svc.carryBudgets(ctx, userID, from, to, true, true, false)
+	if err != nil {
+		meta := map[string]interface{}{
+			"from":    from.Start,
+			"to":      to.Start,
+			"user_id": userID,
+		}
+
+		log.Printf("[RollOverBudgets] svc.carryBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return rolled, nil
+}
+
+// RollOverEnvelopes will continue every budget of a user in from period that has a rollover mode
+// other than none into to period as an envelope, which carries over what is left of its source
+// but starts without any money assigned to it.
+// Categories that are already budgeted in to period keep their budget.
+// It returns how many budgets are rolled over.
+func (svc *Service) RollOverEnvelopes(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	rolled, err := svc.carryBudgets(ctx, userID, from, to, true, false, true)
+	if err != nil {
+		meta := map[string]interface{}{
+			"from":    from.Start,
+			"to":      to.Start,
+			"user_id": userID,
+		}
+
+		log.Printf("[RollOverEnvelopes] svc.carryBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return rolled, nil
+}
+
+// ReleaseUnspent will give back to what a user has left to assign what budgets in period don't carry over
+// into the next period once period has ended, which is what is left of them when they don't roll over.
+// Budgets that are already released are skipped. Nothing is released while period hasn't ended.
+// It returns how many budgets are released.
+func (svc *Service) ReleaseUnspent(ctx context.Context, userID int64, period entity.Period) (int64, error) {
+	meta := map[string]interface{}{
+		"period_start": period.Start,
+		"user_id":      userID,
+	}
+
+	if !svc.hasEnded(period) {
+		return 0, nil
+	}
+
+	budgets, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, userID, period)
+	if err != nil {
+		log.Printf("[ReleaseUnspent] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	if len(budgets) == 0 {
+		return 0, nil
+	}
+
+	released := make([]ReleasedBudget, 0, len(budgets))
+	for _, budget := range budgets {
+		released = append(released, ReleasedBudget{
+			CategoryID:  budget.CategoryID,
+			PeriodStart: budget.PeriodStart,
+			Released:    budget.Amount + budget.CarriedOver - budget.Spent - budgetRollover(budget),
+			UserID:      userID,
+		})
+	}
+
+	count, err := svc.rsc.ReleaseBudgetsInDB(ctx, released)
+	if err != nil {
+		log.Printf("[ReleaseUnspent] svc.rsc.ReleaseBudgetsInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SaveRolledOverPeriod will save period as the latest period budgets of a user are rolled over into.
+// A period before the one that is already saved is ignored.
+func (svc *Service) SaveRolledOverPeriod(ctx context.Context, userID int64, period entity.Period) error {
+	err := svc.rsc.UpsertRolledOverPeriodToDB(ctx, userID, period)
+	if err != nil {
+		meta := map[string]interface{}{
+			"period_start": period.Start,
+			"user_id":      userID,
+		}
+
+		log.Printf("[SaveRolledOverPeriod] svc.rsc.UpsertRolledOverPeriodToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// SettleCarriedOver will fix what budgets of a user in to period carry over from their budget in from period,
+// for budgets whose carry was left pending because from period hasn't ended when they were created.
+// A budget without one in from period carries over nothing. Nothing is settled while from period hasn't ended.
+// It returns how many budgets are settled.
+func (svc *Service) SettleCarriedOver(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	meta := map[string]interface{}{
+		"from":    from.Start,
+		"to":      to.Start,
+		"user_id": userID,
+	}
+
+	if !svc.hasEnded(from) {
+		return 0, nil
+	}
+
+	budgets, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, userID, to)
+	if err != nil {
+		log.Printf("[SettleCarriedOver] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	pending := make([]entity.Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		if budget.CarryPending {
+			pending = append(pending, budget)
+		}
+	}
+
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	previous, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, userID, from)
+	if err != nil {
+		log.Printf("[SettleCarriedOver] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	for i := range pending {
+		pending[i].CarriedOver = 0
+		if budget, found := findBudget(previous, pending[i].CategoryID); found {
+			pending[i].CarriedOver = budgetRollover(budget)
+		}
+	}
+
+	settled, err := svc.rsc.SettleBudgetCarriesInDB(ctx, pending)
+	if err != nil {
+		log.Printf("[SettleCarriedOver] svc.rsc.SettleBudgetCarriesInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return 0, err
+	}
+
+	return settled, nil
+}
+
+// SetBudget will set the budget of a category of a user in a period,
+// replacing the budget that is already set for the category in that period.
+// A new budget carries over from the budget of the category in the previous period,
+// while a budget that is already set keeps what it has carried over.
+// When the previous period hasn't ended yet, the carry is left pending until SettleCarriedOver.
+func (svc *Service) SetBudget(ctx context.Context, param SetBudgetParam) error {
+	meta := map[string]interface{}{
+		"category_id":  param.CategoryID,
+		"period_start": param.Period.Start,
+		"user_id":      param.UserID,
+	}
+
+	carryPending := !svc.hasEnded(param.PreviousPeriod)
+
+	var carriedOver int64
+	if !carryPending {
+		previous, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, param.UserID, param.PreviousPeriod)
+		if err != nil {
+			log.Printf("[SetBudget] svc.rsc.GetBudgetsByPeriodFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+			return err
+		}
+
+		if budget, found := findBudget(previous, param.CategoryID); found {
+			carriedOver = budgetRollover(budget)
+		}
+	}
+
+	_, err := svc.rsc.UpsertBudgetToDB(ctx, entity.Budget{
+		Amount:       param.Amount,
+		CarriedOver:  carriedOver,
+		CarryPending: carryPending,
+		CategoryID:   param.CategoryID,
+		Currency:     param.Currency,
+		Envelope:     param.BudgetingMode == entity.BudgetingModeEnvelope,
+		PeriodStart:  param.Period.Start,
+		RolloverMode: param.RolloverMode,
+		UserID:       param.UserID,
+	})
+	if err != nil {
+		log.Printf("[SetBudget] svc.rsc.UpsertBudgetToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// carryBudgets will create budgets in to period from the budgets of a user in from period,
+// each carrying over what is left of its source, or left pending while from period hasn't ended.
+// When rolloverOnly is true, budgets with rollover mode none are left out. When keepAmount is false,
+// the created budgets start with an amount of zero. Envelope tells whether they are set in envelope mode.
+func (svc *Service) carryBudgets(ctx context.Context, userID int64, from, to entity.Period, rolloverOnly, keepAmount, envelope bool) (int64, error) {
+	budgets, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, userID, from)
+	if err != nil {
+		return 0, err
+	}
+
+	carryPending := !svc.hasEnded(from)
+
+	carried := make([]entity.Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		if rolloverOnly && budget.RolloverMode == entity.RolloverModeNone {
+			continue
+		}
+
+		var amount int64
+		if keepAmount {
+			amount = budget.Amount
+		}
+
+		var carriedOver int64
+		if !carryPending {
+			carriedOver = budgetRollover(budget)
+		}
+
+		carried = append(carried, entity.Budget{
+			Amount:       amount,
+			CarriedOver:  carriedOver,
+			CarryPending: carryPending,
+			CategoryID:   budget.CategoryID,
+			Currency:     budget.Currency,
+			Envelope:     envelope,
+			PeriodStart:  to.Start,
+			RolloverMode: budget.RolloverMode,
+			UserID:       userID,
+		})
+	}
+
+	if len(carried) == 0 {
+		return 0, nil
+	}
+
+	return svc.rsc.InsertBudgetsToDB(ctx, carried)
+}
+
+// newMoveDestination will prepare the budget of the destination category of a move that isn't budgeted yet,
+// carrying over from its budget in the previous period or following the rollover mode of source when it has none.
+// The carry is left pending while the previous period hasn't ended.
+func (svc *Service) newMoveDestination(ctx context.Context, param MoveBudgetParam, source entity.Budget) (entity.Budget, error) {
+	previous, err := svc.rsc.GetBudgetsByPeriodFromDB(ctx, param.UserID, param.PreviousPeriod)
+	if err != nil {
+		return entity.Budget{}, err
+	}
+
+	carryPending := !svc.hasEnded(param.PreviousPeriod)
+
+	destination := entity.Budget{
+		CarryPending: carryPending,
+		CategoryID:   param.ToCategoryID,
+		Currency:     source.Currency,
+		Envelope:     true,
+		PeriodStart:  param.Period.Start,
+		RolloverMode: source.RolloverMode,
+		UserID:       param.UserID,
+	}
+
+	if budget, found := findBudget(previous, param.ToCategoryID); found {
+		destination.Currency = budget.Currency
+		destination.RolloverMode = budget.RolloverMode
+		if !carryPending {
+			destination.CarriedOver = budgetRollover(budget)
+		}
+	}
+
+	return destination, nil
+}
+
+// hasEnded will check whether period is over, only then what is spent in it can't change anymore.
+func (svc *Service) hasEnded(period entity.Period) bool {
+	return !svc.infra.GetTimeGMT7().Before(period.End)
+}
+
+// moveMoney will move amount from the budget of from to the budget of to.
+// Only what is left unspent of from can be moved, otherwise it returns ErrBudgetInsufficient.
+// It's taken from the amount assigned to from first, then from what from carried over, and each part
+// is added to the same part of to, so what is assigned to budgets in total doesn't change.
+func moveMoney(from, to *entity.Budget, amount int64) error {
+	if amount > from.Amount+from.CarriedOver-from.Spent {
+		return ErrBudgetInsufficient
+	}
+
+	assigned := amount
+	if assigned > from.Amount {
+		assigned = from.Amount
+	}
+
+	carried := amount - assigned
+
+	from.Amount -= assigned
+	from.CarriedOver -= carried
+	to.Amount += assigned
+	to.CarriedOver += carried
+
+	return nil
+}
+
+// findBudget will find the budget of a category among budgets.
+func findBudget(budgets []entity.Budget, categoryID int64) (entity.Budget, bool) {
+	for _, budget := range budgets {
+		if budget.CategoryID == categoryID {
+			return budget, true
+		}
+	}
+
+	return entity.Budget{}, false
+}
+
+// budgetRollover will calculate how much a budget carries into the next period.
+func budgetRollover(budget entity.Budget) int64 {
+	return entity.BudgetRollover(budget.RolloverMode, budget.Amount+budget.CarriedOver-budget.Spent)
+}
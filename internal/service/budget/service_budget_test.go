@@ -0,0 +1,1142 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+var (
+	mockNow = time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// mockPreviousNow is a time in mockPreviousPeriod, before it has ended.
+	mockPreviousNow = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var mockPreviousBudgets = []entity.Budget{
+	{
+		Amount:       1000000,
+		CategoryID:   1,
+		Currency:     "IDR",
+		ID:           1,
+		PeriodStart:  mockPreviousPeriod.Start,
+		RolloverMode: entity.RolloverModePositive,
+		Spent:        400000,
+		UserID:       123,
+	},
+	{
+		Amount:       500000,
+		CarriedOver:  -100000,
+		CategoryID:   2,
+		Currency:     "IDR",
+		ID:           2,
+		PeriodStart:  mockPreviousPeriod.Start,
+		RolloverMode: entity.RolloverModeBoth,
+		Spent:        500000,
+		UserID:       123,
+	},
+	{
+		Amount:       300000,
+		CategoryID:   3,
+		Currency:     "IDR",
+		ID:           3,
+		PeriodStart:  mockPreviousPeriod.Start,
+		RolloverMode: entity.RolloverModeNone,
+		Spent:        450000,
+		UserID:       123,
+	},
+}
+
+func TestService_CopyBudgets(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			Amount:       1000000,
+			CarriedOver:  600000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			Envelope:     true,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarriedOver:  -100000,
+			CategoryID:   2,
+			Currency:     "IDR",
+			Envelope:     true,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+		{
+			Amount:       300000,
+			CategoryID:   3,
+			Currency:     "IDR",
+			Envelope:     true,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeNone,
+			UserID:       123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_previous_period_has_no_budget_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return([]entity.Budget{}, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+			},
+		},
+		{
+			name: "when_InsertBudgetsToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_previous_period_has_not_ended_then_leave_carry_pending",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockPreviousNow)
+
+				budgets := make([]entity.Budget, 0, len(mockBudgets))
+				for _, budget := range mockBudgets {
+					budget.CarriedOver = 0
+					budget.CarryPending = true
+					budgets = append(budgets, budget)
+				}
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), budgets).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+		{
+			name: "when_no_error_occured_then_return_copied_count",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.CopyBudgets(context.Background(), 123, entity.BudgetingModeEnvelope, mockPreviousPeriod, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_DeleteBudget(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_DeleteBudgetInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteBudgetInDB(context.Background(), int64(123), int64(1), mockPeriod).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteBudgetInDB(context.Background(), int64(123), int64(1), mockPeriod).Return(false, nil)
+			},
+			wantErr: ErrBudgetNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteBudgetInDB(context.Background(), int64(123), int64(1), mockPeriod).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.DeleteBudget(context.Background(), 123, 1, mockPeriod)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetAvailableToAssign(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockAvailable := []AvailableToAssign{
+		{
+			Amount:   2500000,
+			Currency: "IDR",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []AvailableToAssign
+		wantErr    error
+	}{
+		{
+			name: "when_GetAvailableToAssignFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetAvailableToAssignFromDB(context.Background(), int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_available_to_assign",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetAvailableToAssignFromDB(context.Background(), int64(123), mockPeriod).Return(mockAvailable, nil)
+			},
+			want: mockAvailable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetAvailableToAssign(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetRolledOverPeriodStart(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       time.Time
+		wantErr    error
+	}{
+		{
+			name: "when_GetRolledOverPeriodStartFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRolledOverPeriodStartFromDB(context.Background(), int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_period_start",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetRolledOverPeriodStartFromDB(context.Background(), int64(123)).Return(mockPeriod.Start, nil)
+			},
+			want: mockPeriod.Start,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetRolledOverPeriodStart(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListBudgets(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Budget
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_budgets",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return([]entity.Budget{
+					{Amount: 1500000, CategoryID: 1, ID: 10, Spent: 250000},
+				}, nil)
+			},
+			want: []Budget{
+				{Amount: 1500000, CategoryID: 1, ID: 10, Spent: 250000},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListBudgets(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_LockRollOver(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	var unlocked bool
+	mockUnlock := func() {
+		unlocked = true
+	}
+
+	tests := []struct {
+		name         string
+		mockFields   func(mockFields)
+		wantUnlocked bool
+		wantErr      error
+	}{
+		{
+			name: "when_LockRolledOverPeriodInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().LockRolledOverPeriodInDB(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_unlock",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().LockRolledOverPeriodInDB(context.Background(), int64(123)).Return(mockUnlock, nil)
+			},
+			wantUnlocked: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unlocked = false
+
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			unlock, err := svc.LockRollOver(context.Background(), 123)
+			assert.Equal(t, test.wantErr, err)
+			if unlock != nil {
+				unlock()
+			}
+			assert.Equal(t, test.wantUnlocked, unlocked)
+		})
+	}
+}
+
+func TestService_MoveBudget(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			Amount:       1000000,
+			CarriedOver:  200000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			ID:           10,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			Spent:        900000,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarriedOver:  300000,
+			CategoryID:   5,
+			Currency:     "IDR",
+			ID:           12,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			Spent:        100000,
+			UserID:       123,
+		},
+	}
+	mockParam := MoveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 1,
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		ToCategoryID:   5,
+		UserID:         123,
+	}
+	mockNewParam := MoveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 1,
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		ToCategoryID:   2,
+		UserID:         123,
+	}
+
+	type args struct {
+		param MoveBudgetParam
+	}
+	tests := []struct {
+		name       string
+		args       args
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			args: args{param: mockParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_source_not_budgeted_then_return_error",
+			args: args{
+				param: MoveBudgetParam{
+					Amount:         250000,
+					FromCategoryID: 3,
+					Period:         mockPeriod,
+					PreviousPeriod: mockPreviousPeriod,
+					ToCategoryID:   5,
+					UserID:         123,
+				},
+			},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+			},
+			wantErr: ErrBudgetNotFound,
+		},
+		{
+			name: "when_destination_not_budgeted_and_GetBudgetsByPeriodFromDB_error_then_return_error",
+			args: args{param: mockNewParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_destination_not_budgeted_in_any_period_then_follow_source",
+			args: args{
+				param: MoveBudgetParam{
+					Amount:         250000,
+					FromCategoryID: 1,
+					Period:         mockPeriod,
+					PreviousPeriod: mockPreviousPeriod,
+					ToCategoryID:   6,
+					UserID:         123,
+				},
+			},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().MoveBudgetInDB(context.Background(), MoveBudgetParam{
+					Amount:         250000,
+					FromCategoryID: 1,
+					Period:         mockPeriod,
+					PreviousPeriod: mockPreviousPeriod,
+					ToCategoryID:   6,
+					UserID:         123,
+				}, entity.Budget{
+					CategoryID:   6,
+					Currency:     "IDR",
+					Envelope:     true,
+					PeriodStart:  mockPeriod.Start,
+					RolloverMode: entity.RolloverModePositive,
+					UserID:       123,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "when_destination_budgeted_in_previous_period_then_carry_over_from_it",
+			args: args{param: mockNewParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().MoveBudgetInDB(context.Background(), mockNewParam, entity.Budget{
+					CarriedOver:  -100000,
+					CategoryID:   2,
+					Currency:     "IDR",
+					Envelope:     true,
+					PeriodStart:  mockPeriod.Start,
+					RolloverMode: entity.RolloverModeBoth,
+					UserID:       123,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "when_previous_period_has_not_ended_then_leave_carry_of_destination_pending",
+			args: args{param: mockNewParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockPreviousNow)
+				mf.rsc.EXPECT().MoveBudgetInDB(context.Background(), mockNewParam, entity.Budget{
+					CarryPending: true,
+					CategoryID:   2,
+					Currency:     "IDR",
+					Envelope:     true,
+					PeriodStart:  mockPeriod.Start,
+					RolloverMode: entity.RolloverModeBoth,
+					UserID:       123,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "when_MoveBudgetInDB_error_then_return_error",
+			args: args{param: mockParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().MoveBudgetInDB(context.Background(), mockParam, mockBudgets[1]).Return(ErrBudgetInsufficient)
+			},
+			wantErr: ErrBudgetInsufficient,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			args: args{param: mockParam},
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().MoveBudgetInDB(context.Background(), mockParam, mockBudgets[1]).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.MoveBudget(context.Background(), test.args.param)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ReleaseUnspent(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockReleased := []ReleasedBudget{
+		{
+			CategoryID:  1,
+			PeriodStart: mockPreviousPeriod.Start,
+			UserID:      123,
+		},
+		{
+			CategoryID:  2,
+			PeriodStart: mockPreviousPeriod.Start,
+			UserID:      123,
+		},
+		{
+			CategoryID:  3,
+			PeriodStart: mockPreviousPeriod.Start,
+			Released:    -150000,
+			UserID:      123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_period_has_not_ended_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockPreviousNow)
+			},
+		},
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_period_has_no_budget_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return([]entity.Budget{}, nil)
+			},
+		},
+		{
+			name: "when_ReleaseBudgetsInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().ReleaseBudgetsInDB(context.Background(), mockReleased).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_release_what_is_not_carried_over",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().ReleaseBudgetsInDB(context.Background(), mockReleased).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.ReleaseUnspent(context.Background(), 123, mockPreviousPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RollOverBudgets(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			Amount:       1000000,
+			CarriedOver:  600000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarriedOver:  -100000,
+			CategoryID:   2,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_budget_rolls_over_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets[2:], nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+			},
+		},
+		{
+			name: "when_InsertBudgetsToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_rolled_over_count",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(1), nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.RollOverBudgets(context.Background(), 123, mockPreviousPeriod, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_RollOverEnvelopes(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			CarriedOver:  600000,
+			CategoryID:   1,
+			Currency:     "IDR",
+			Envelope:     true,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			UserID:       123,
+		},
+		{
+			CarriedOver:  -100000,
+			CategoryID:   2,
+			Currency:     "IDR",
+			Envelope:     true,
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_budget_rolls_over_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets[2:], nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+			},
+		},
+		{
+			name: "when_InsertBudgetsToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_rolled_over_count_without_amount",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().InsertBudgetsToDB(context.Background(), mockBudgets).Return(int64(2), nil)
+			},
+			want: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.RollOverEnvelopes(context.Background(), 123, mockPreviousPeriod, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SaveRolledOverPeriod(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpsertRolledOverPeriodToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpsertRolledOverPeriodToDB(context.Background(), int64(123), mockPeriod).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpsertRolledOverPeriodToDB(context.Background(), int64(123), mockPeriod).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.SaveRolledOverPeriod(context.Background(), 123, mockPeriod)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SettleCarriedOver(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockBudgets := []entity.Budget{
+		{
+			Amount:       1000000,
+			CarryPending: true,
+			CategoryID:   1,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModePositive,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarriedOver:  -100000,
+			CategoryID:   2,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+		{
+			Amount:       200000,
+			CarryPending: true,
+			CategoryID:   4,
+			Currency:     "IDR",
+			PeriodStart:  mockPeriod.Start,
+			RolloverMode: entity.RolloverModeNone,
+			UserID:       123,
+		},
+	}
+
+	mockSettled := []entity.Budget{mockBudgets[0], mockBudgets[2]}
+	mockSettled[0].CarriedOver = 600000
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_from_period_has_not_ended_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockPreviousNow)
+			},
+		},
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_for_to_period_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_carry_is_pending_then_return_zero",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets[1:2], nil)
+			},
+		},
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_for_from_period_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SettleBudgetCarriesInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().SettleBudgetCarriesInDB(context.Background(), mockSettled).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_settled_count",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().SettleBudgetCarriesInDB(context.Background(), mockSettled).Return(int64(2), nil)
+			},
+			want: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			got, err := svc.SettleCarriedOver(context.Background(), 123, mockPreviousPeriod, mockPeriod)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_SetBudget(t *testing.T) {
+	type mockFields struct {
+		infra *MockinfraProvider
+		rsc   *MockresourceProvider
+	}
+
+	mockParam := SetBudgetParam{
+		Amount:         1500000,
+		BudgetingMode:  entity.BudgetingModeEnvelope,
+		CategoryID:     1,
+		Currency:       "IDR",
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		RolloverMode:   entity.RolloverModeBoth,
+		UserID:         123,
+	}
+	mockBudget := entity.Budget{
+		Amount:       1500000,
+		CarriedOver:  600000,
+		CategoryID:   1,
+		Currency:     "IDR",
+		Envelope:     true,
+		PeriodStart:  mockPeriod.Start,
+		RolloverMode: entity.RolloverModeBoth,
+		UserID:       123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetBudgetsByPeriodFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpsertBudgetToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().UpsertBudgetToDB(context.Background(), mockBudget).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_previous_period_has_not_ended_then_leave_carry_pending",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockPreviousNow)
+
+				budget := mockBudget
+				budget.CarriedOver = 0
+				budget.CarryPending = true
+				mf.rsc.EXPECT().UpsertBudgetToDB(context.Background(), budget).Return(int64(10), nil)
+			},
+		},
+		{
+			name: "when_category_not_budgeted_in_previous_period_then_carry_nothing",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets[1:], nil)
+
+				budget := mockBudget
+				budget.CarriedOver = 0
+				mf.rsc.EXPECT().UpsertBudgetToDB(context.Background(), budget).Return(int64(10), nil)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.infra.EXPECT().GetTimeGMT7().Return(mockNow)
+				mf.rsc.EXPECT().GetBudgetsByPeriodFromDB(context.Background(), int64(123), mockPreviousPeriod).Return(mockPreviousBudgets, nil)
+				mf.rsc.EXPECT().UpsertBudgetToDB(context.Background(), mockBudget).Return(int64(10), nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				infra: NewMockinfraProvider(ctrl),
+				rsc:   NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				infra: mockFields.infra,
+				rsc:   mockFields.rsc,
+			}
+
+			err := svc.SetBudget(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
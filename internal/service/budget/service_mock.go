@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package budget is a generated GoMock package.
+package budget
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockresourceProvider is a mock of resourceProvider interface.
+type MockresourceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceProviderMockRecorder
+}
+
+// MockresourceProviderMockRecorder is the mock recorder for MockresourceProvider.
+type MockresourceProviderMockRecorder struct {
+	mock *MockresourceProvider
+}
+
+// NewMockresourceProvider creates a new mock instance.
+func NewMockresourceProvider(ctrl *gomock.Controller) *MockresourceProvider {
+	mock := &MockresourceProvider{ctrl: ctrl}
+	mock.recorder = &MockresourceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceProvider) EXPECT() *MockresourceProviderMockRecorder {
+	return m.recorder
+}
+
+// DeleteBudgetInDB mocks base method.
+func (m *MockresourceProvider) DeleteBudgetInDB(ctx context.Context, userID, categoryID int64, period entity.Period) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudgetInDB", ctx, userID, categoryID, period)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBudgetInDB indicates an expected call of DeleteBudgetInDB.
+func (mr *MockresourceProviderMockRecorder) DeleteBudgetInDB(ctx, userID, categoryID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeleteBudgetInDB), ctx, userID, categoryID, period)
+}
+
+// GetAvailableToAssignFromDB mocks base method.
+func (m *MockresourceProvider) GetAvailableToAssignFromDB(ctx context.Context, userID int64, period entity.Period) ([]AvailableToAssign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableToAssignFromDB", ctx, userID, period)
+	ret0, _ := ret[0].([]AvailableToAssign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableToAssignFromDB indicates an expected call of GetAvailableToAssignFromDB.
+func (mr *MockresourceProviderMockRecorder) GetAvailableToAssignFromDB(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableToAssignFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetAvailableToAssignFromDB), ctx, userID, period)
+}
+
+// GetBudgetsByPeriodFromDB mocks base method.
+func (m *MockresourceProvider) GetBudgetsByPeriodFromDB(ctx context.Context, userID int64, period entity.Period) ([]entity.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetsByPeriodFromDB", ctx, userID, period)
+	ret0, _ := ret[0].([]entity.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetsByPeriodFromDB indicates an expected call of GetBudgetsByPeriodFromDB.
+func (mr *MockresourceProviderMockRecorder) GetBudgetsByPeriodFromDB(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetsByPeriodFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetBudgetsByPeriodFromDB), ctx, userID, period)
+}
+
+// GetRolledOverPeriodStartFromDB mocks base method.
+func (m *MockresourceProvider) GetRolledOverPeriodStartFromDB(ctx context.Context, userID int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolledOverPeriodStartFromDB", ctx, userID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolledOverPeriodStartFromDB indicates an expected call of GetRolledOverPeriodStartFromDB.
+func (mr *MockresourceProviderMockRecorder) GetRolledOverPeriodStartFromDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolledOverPeriodStartFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetRolledOverPeriodStartFromDB), ctx, userID)
+}
+
+// InsertBudgetsToDB mocks base method.
+func (m *MockresourceProvider) InsertBudgetsToDB(ctx context.Context, budgets []entity.Budget) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBudgetsToDB", ctx, budgets)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBudgetsToDB indicates an expected call of InsertBudgetsToDB.
+func (mr *MockresourceProviderMockRecorder) InsertBudgetsToDB(ctx, budgets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBudgetsToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertBudgetsToDB), ctx, budgets)
+}
+
+// LockRolledOverPeriodInDB mocks base method.
+func (m *MockresourceProvider) LockRolledOverPeriodInDB(ctx context.Context, userID int64) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockRolledOverPeriodInDB", ctx, userID)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockRolledOverPeriodInDB indicates an expected call of LockRolledOverPeriodInDB.
+func (mr *MockresourceProviderMockRecorder) LockRolledOverPeriodInDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRolledOverPeriodInDB", reflect.TypeOf((*MockresourceProvider)(nil).LockRolledOverPeriodInDB), ctx, userID)
+}
+
+// MoveBudgetInDB mocks base method.
+func (m *MockresourceProvider) MoveBudgetInDB(ctx context.Context, param MoveBudgetParam, destination entity.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBudgetInDB", ctx, param, destination)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveBudgetInDB indicates an expected call of MoveBudgetInDB.
+func (mr *MockresourceProviderMockRecorder) MoveBudgetInDB(ctx, param, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBudgetInDB", reflect.TypeOf((*MockresourceProvider)(nil).MoveBudgetInDB), ctx, param, destination)
+}
+
+// ReleaseBudgetsInDB mocks base method.
+func (m *MockresourceProvider) ReleaseBudgetsInDB(ctx context.Context, budgets []ReleasedBudget) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBudgetsInDB", ctx, budgets)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseBudgetsInDB indicates an expected call of ReleaseBudgetsInDB.
+func (mr *MockresourceProviderMockRecorder) ReleaseBudgetsInDB(ctx, budgets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBudgetsInDB", reflect.TypeOf((*MockresourceProvider)(nil).ReleaseBudgetsInDB), ctx, budgets)
+}
+
+// SettleBudgetCarriesInDB mocks base method.
+func (m *MockresourceProvider) SettleBudgetCarriesInDB(ctx context.Context, budgets []entity.Budget) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleBudgetCarriesInDB", ctx, budgets)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleBudgetCarriesInDB indicates an expected call of SettleBudgetCarriesInDB.
+func (mr *MockresourceProviderMockRecorder) SettleBudgetCarriesInDB(ctx, budgets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleBudgetCarriesInDB", reflect.TypeOf((*MockresourceProvider)(nil).SettleBudgetCarriesInDB), ctx, budgets)
+}
+
+// UpsertBudgetToDB mocks base method.
+func (m *MockresourceProvider) UpsertBudgetToDB(ctx context.Context, budget entity.Budget) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBudgetToDB", ctx, budget)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBudgetToDB indicates an expected call of UpsertBudgetToDB.
+func (mr *MockresourceProviderMockRecorder) UpsertBudgetToDB(ctx, budget interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBudgetToDB", reflect.TypeOf((*MockresourceProvider)(nil).UpsertBudgetToDB), ctx, budget)
+}
+
+// UpsertRolledOverPeriodToDB mocks base method.
+func (m *MockresourceProvider) UpsertRolledOverPeriodToDB(ctx context.Context, userID int64, period entity.Period) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRolledOverPeriodToDB", ctx, userID, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRolledOverPeriodToDB indicates an expected call of UpsertRolledOverPeriodToDB.
+func (mr *MockresourceProviderMockRecorder) UpsertRolledOverPeriodToDB(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRolledOverPeriodToDB", reflect.TypeOf((*MockresourceProvider)(nil).UpsertRolledOverPeriodToDB), ctx, userID, period)
+}
+
+// MockinfraProvider is a mock of infraProvider interface.
+type MockinfraProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockinfraProviderMockRecorder
+}
+
+// MockinfraProviderMockRecorder is the mock recorder for MockinfraProvider.
+type MockinfraProviderMockRecorder struct {
+	mock *MockinfraProvider
+}
+
+// NewMockinfraProvider creates a new mock instance.
+func NewMockinfraProvider(ctrl *gomock.Controller) *MockinfraProvider {
+	mock := &MockinfraProvider{ctrl: ctrl}
+	mock.recorder = &MockinfraProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinfraProvider) EXPECT() *MockinfraProviderMockRecorder {
+	return m.recorder
+}
+
+// GetTimeGMT7 mocks base method.
+func (m *MockinfraProvider) GetTimeGMT7() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeGMT7")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetTimeGMT7 indicates an expected call of GetTimeGMT7.
+func (mr *MockinfraProviderMockRecorder) GetTimeGMT7() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeGMT7", reflect.TypeOf((*MockinfraProvider)(nil).GetTimeGMT7))
+}
@@ -0,0 +1,22 @@
+package budget
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockInfra := NewMockinfraProvider(ctrl)
+	mockResource := NewMockresourceProvider(ctrl)
+
+	want := &Service{
+		infra: mockInfra,
+		rsc:   mockResource,
+	}
+	assert.Equal(t, want, NewService(BudgetServiceParam{Infra: mockInfra, Rsc: mockResource}))
+}
@@ -0,0 +1,54 @@
+package budget
+
+import (
+	// golang package
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// AvailableToAssign holds how much of the income of a user in a currency isn't assigned to any budget yet.
+// Amount is negative when more is assigned than earned.
+type AvailableToAssign struct {
+	Amount   int64
+	Currency string
+}
+
+// Budget is an entity representational of Budget.
+type Budget entity.Budget
+
+// MoveBudgetParam represents parameters needed to move money from the budget of a category
+// to the budget of another category in a period.
+// PreviousPeriod is the period before Period, a budget created for ToCategoryID carries over from it.
+type MoveBudgetParam struct {
+	Amount         int64
+	FromCategoryID int64
+	Period         entity.Period
+	PreviousPeriod entity.Period
+	ToCategoryID   int64
+	UserID         int64
+}
+
+// ReleasedBudget holds what the budget of a category in a period gives back to what is left to assign
+// once the period has ended, it's negative when the overspent amount isn't carried over.
+type ReleasedBudget struct {
+	CategoryID  int64
+	PeriodStart time.Time
+	Released    int64
+	UserID      int64
+}
+
+// SetBudgetParam represents parameters needed to set the budget of a category in a period.
+// PreviousPeriod is the period before Period, the budget carries over from the budget of the category in it.
+// BudgetingMode is the budgeting mode of the user the budget is set in.
+type SetBudgetParam struct {
+	Amount         int64
+	BudgetingMode  string
+	CategoryID     int64
+	Currency       string
+	Period         entity.Period
+	PreviousPeriod entity.Period
+	RolloverMode   string
+	UserID         int64
+}
@@ -0,0 +1,151 @@
+package category
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// defaultCategory describes a category that every new user starts with.
+// Names holds its name in every supported language.
+type defaultCategory struct {
+	Color         string
+	Icon          string
+	Names         map[string]string
+	Subcategories []defaultCategory
+	Type          string
+}
+
+// defaultCategories is the category set that is created when a user signs up.
+var defaultCategories = []defaultCategory{
+	{
+		Color: "#FF9800",
+		Icon:  "restaurant",
+		Names: map[string]string{entity.LanguageEnglish: "Food & Drinks", entity.LanguageIndonesian: "Makanan & Minuman"},
+		Subcategories: []defaultCategory{
+			{Icon: "local_grocery_store", Names: map[string]string{entity.LanguageEnglish: "Groceries", entity.LanguageIndonesian: "Belanja Dapur"}},
+			{Icon: "restaurant_menu", Names: map[string]string{entity.LanguageEnglish: "Restaurants", entity.LanguageIndonesian: "Makan di Luar"}},
+			{Icon: "local_cafe", Names: map[string]string{entity.LanguageEnglish: "Coffee & Snacks", entity.LanguageIndonesian: "Kopi & Jajan"}},
+		},
+		Type: entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#2196F3",
+		Icon:  "directions_car",
+		Names: map[string]string{entity.LanguageEnglish: "Transportation", entity.LanguageIndonesian: "Transportasi"},
+		Subcategories: []defaultCategory{
+			{Icon: "local_gas_station", Names: map[string]string{entity.LanguageEnglish: "Fuel", entity.LanguageIndonesian: "Bensin"}},
+			{Icon: "directions_bus", Names: map[string]string{entity.LanguageEnglish: "Public Transport", entity.LanguageIndonesian: "Transportasi Umum"}},
+			{Icon: "local_taxi", Names: map[string]string{entity.LanguageEnglish: "Ride Hailing", entity.LanguageIndonesian: "Ojek & Taksi Online"}},
+			{Icon: "local_parking", Names: map[string]string{entity.LanguageEnglish: "Parking & Tolls", entity.LanguageIndonesian: "Parkir & Tol"}},
+		},
+		Type: entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#9C27B0",
+		Icon:  "home",
+		Names: map[string]string{entity.LanguageEnglish: "Housing", entity.LanguageIndonesian: "Tempat Tinggal"},
+		Subcategories: []defaultCategory{
+			{Icon: "vpn_key", Names: map[string]string{entity.LanguageEnglish: "Rent", entity.LanguageIndonesian: "Sewa & Kos"}},
+			{Icon: "bolt", Names: map[string]string{entity.LanguageEnglish: "Electricity", entity.LanguageIndonesian: "Listrik"}},
+			{Icon: "water_drop", Names: map[string]string{entity.LanguageEnglish: "Water", entity.LanguageIndonesian: "Air"}},
+			{Icon: "wifi", Names: map[string]string{entity.LanguageEnglish: "Internet & Phone", entity.LanguageIndonesian: "Internet & Pulsa"}},
+		},
+		Type: entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#E91E63",
+		Icon:  "shopping_bag",
+		Names: map[string]string{entity.LanguageEnglish: "Shopping", entity.LanguageIndonesian: "Belanja"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#F44336",
+		Icon:  "local_hospital",
+		Names: map[string]string{entity.LanguageEnglish: "Health", entity.LanguageIndonesian: "Kesehatan"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#3F51B5",
+		Icon:  "school",
+		Names: map[string]string{entity.LanguageEnglish: "Education", entity.LanguageIndonesian: "Pendidikan"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#00BCD4",
+		Icon:  "movie",
+		Names: map[string]string{entity.LanguageEnglish: "Entertainment", entity.LanguageIndonesian: "Hiburan"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#009688",
+		Icon:  "volunteer_activism",
+		Names: map[string]string{entity.LanguageEnglish: "Charity & Zakat", entity.LanguageIndonesian: "Sedekah & Zakat"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#9E9E9E",
+		Icon:  "more_horiz",
+		Names: map[string]string{entity.LanguageEnglish: "Others", entity.LanguageIndonesian: "Lainnya"},
+		Type:  entity.TransactionTypeExpense,
+	},
+	{
+		Color: "#4CAF50",
+		Icon:  "payments",
+		Names: map[string]string{entity.LanguageEnglish: "Salary", entity.LanguageIndonesian: "Gaji"},
+		Type:  entity.TransactionTypeIncome,
+	},
+	{
+		Color: "#8BC34A",
+		Icon:  "card_giftcard",
+		Names: map[string]string{entity.LanguageEnglish: "Bonus & THR", entity.LanguageIndonesian: "Bonus & THR"},
+		Type:  entity.TransactionTypeIncome,
+	},
+	{
+		Color: "#CDDC39",
+		Icon:  "storefront",
+		Names: map[string]string{entity.LanguageEnglish: "Business", entity.LanguageIndonesian: "Usaha"},
+		Type:  entity.TransactionTypeIncome,
+	},
+	{
+		Color: "#FFC107",
+		Icon:  "trending_up",
+		Names: map[string]string{entity.LanguageEnglish: "Investment", entity.LanguageIndonesian: "Investasi"},
+		Type:  entity.TransactionTypeIncome,
+	},
+	{
+		Color: "#9E9E9E",
+		Icon:  "more_horiz",
+		Names: map[string]string{entity.LanguageEnglish: "Other Income", entity.LanguageIndonesian: "Pemasukan Lainnya"},
+		Type:  entity.TransactionTypeIncome,
+	},
+}
+
+// buildDefaultCategoryTrees will name categories in defaults using language,
+// a subcategory has the type and color of its parent.
+func buildDefaultCategoryTrees(defaults []defaultCategory, language, categoryType, color string) []CategoryTree {
+	result := make([]CategoryTree, 0, len(defaults))
+	for _, category := range defaults {
+		tree := CategoryTree{
+			Color: category.Color,
+			Icon:  category.Icon,
+			Name:  category.Names[language],
+			Type:  category.Type,
+		}
+
+		if tree.Type == "" {
+			tree.Type = categoryType
+		}
+
+		if tree.Color == "" {
+			tree.Color = color
+		}
+
+		if len(category.Subcategories) > 0 {
+			tree.Subcategories = buildDefaultCategoryTrees(category.Subcategories, language, tree.Type, tree.Color)
+		}
+
+		result = append(result, tree)
+	}
+
+	return result
+}
@@ -0,0 +1,74 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+//go:generate mockgen -source=./resource.go -destination=./resource_mock.go -package=category
+
+// dbRepoProvider holds all methods from db repo that wil be used in category's resource.
+type dbRepoProvider interface {
+	// ArchiveSubcategories will archive every subcategory of a category of a user that isn't archived yet.
+	ArchiveSubcategories(ctx context.Context, tx *sql.Tx, userID, parentID int64) error
+
+	// BeginTX will start a new transaction.
+	BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error)
+
+	// Commit will commit the transaction.
+	Commit(tx *sql.Tx) error
+
+	// DeleteCategory will delete a category of a user.
+	// It returns false if the user doesn't have the category.
+	DeleteCategory(ctx context.Context, tx *sql.Tx, userID, categoryID int64) (bool, error)
+
+	// GetCategoriesByUserID will fetch every category of a user, ordered by their type and name.
+	GetCategoriesByUserID(ctx context.Context, userID int64) ([]pgsql.Category, error)
+
+	// GetCategoryByID will fetch a category of a user based on its id.
+	// It returns an empty category if the user doesn't have the category.
+	GetCategoryByID(ctx context.Context, userID, categoryID int64) (pgsql.Category, error)
+
+	// InsertCategory will create a new entry in table category in database.
+	// It returns id of the new entry.
+	InsertCategory(ctx context.Context, tx *sql.Tx, param pgsql.InsertCategoryParam) (int64, error)
+
+	// MergeBudgets will sum every budget of a category of a user into the budget of another category in the same period,
+	// or move it to the other category when that category isn't budgeted in the period.
+	// It returns how many budgets are left unmerged because they are in another currency than the budget they'd be summed into.
+	MergeBudgets(ctx context.Context, tx *sql.Tx, param pgsql.MergeBudgetsParam) (int64, error)
+
+	// MoveSubcategories will move every subcategory of a category of a user under another category.
+	MoveSubcategories(ctx context.Context, tx *sql.Tx, param pgsql.MoveSubcategoriesParam) error
+
+	// Rollback will aborts the transaction.
+	Rollback(tx *sql.Tx) error
+
+	// UpdateCategory will update a category of a user.
+	// It returns false if the user doesn't have the category.
+	UpdateCategory(ctx context.Context, tx *sql.Tx, param pgsql.UpdateCategoryParam) (bool, error)
+
+	// UpdateTransactionsCategory will move every transaction of a user in a category into another category.
+	UpdateTransactionsCategory(ctx context.Context, tx *sql.Tx, userID, fromCategoryID, toCategoryID int64) error
+}
+
+// CategoryResourceParam holds all parameters needed to instantiate
+// a new instance of Resource.
+type CategoryResourceParam struct {
+	DB dbRepoProvider
+}
+
+type Resource struct {
+	db dbRepoProvider
+}
+
+// NewResource will instantiate a new instance of Resource.
+func NewResource(param CategoryResourceParam) *Resource {
+	return &Resource{
+		db: param.DB,
+	}
+}
@@ -0,0 +1,318 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+// GetCategoriesByUserIDFromDB will fetch every category of a user, ordered by their type and name.
+func (rsc *Resource) GetCategoriesByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Category, error) {
+	categories, err := rsc.db.GetCategoriesByUserID(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[GetCategoriesByUserIDFromDB] rsc.db.GetCategoriesByUserID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]entity.Category, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, convertCategory(category))
+	}
+
+	return result, nil
+}
+
+// GetCategoryByIDFromDB will fetch a category of a user based on its id.
+// It returns an empty category if the user doesn't have the category.
+func (rsc *Resource) GetCategoryByIDFromDB(ctx context.Context, userID, categoryID int64) (entity.Category, error) {
+	category, err := rsc.db.GetCategoryByID(ctx, userID, categoryID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"category_id": categoryID,
+			"user_id":     userID,
+		}
+
+		log.Printf("[GetCategoryByIDFromDB] rsc.db.GetCategoryByID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return entity.Category{}, err
+	}
+
+	return convertCategory(category), nil
+}
+
+// InsertCategoryToDB will create a new category for a user.
+// It returns id of the new category.
+func (rsc *Resource) InsertCategoryToDB(ctx context.Context, param CreateCategoryParam) (int64, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertCategoryToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertCategoryToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	id, err := rsc.db.InsertCategory(ctx, tx, pgsql.InsertCategoryParam{
+		Color:    param.Color,
+		Icon:     param.Icon,
+		Name:     param.Name,
+		ParentID: sql.NullInt64{Int64: param.ParentID, Valid: param.ParentID != 0},
+		Type:     param.Type,
+		UserID:   param.UserID,
+	})
+	if err != nil {
+		log.Printf("[InsertCategoryToDB] rsc.db.InsertCategory() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertCategoryToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return 0, errCommit
+	}
+
+	return id, nil
+}
+
+// InsertCategoryTreesToDB will create every category in trees, along with their subcategories, for a user.
+// Either every category is created or none of them is.
+func (rsc *Resource) InsertCategoryTreesToDB(ctx context.Context, userID int64, trees []CategoryTree) error {
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertCategoryTreesToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertCategoryTreesToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	err = rsc.insertCategoryTrees(ctx, tx, userID, 0, trees)
+	if err != nil {
+		log.Printf("[InsertCategoryTreesToDB] rsc.insertCategoryTrees() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertCategoryTreesToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// MergeCategoriesInDB will move every transaction, subcategory and budget of the source category
+// into the target category, then delete the source category.
+// Budgets of the source category are summed into budgets of the target category in the same period,
+// since deleting the source category would delete its budgets.
+// If the user doesn't have the source category, it will return ErrCategoryNotFound.
+// If both categories are budgeted in a period in different currencies, it will return ErrBudgetCurrencyMismatch.
+func (rsc *Resource) MergeCategoriesInDB(ctx context.Context, param MergeCategoriesParam) error {
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[MergeCategoriesInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, param)
+		}
+	}()
+
+	err = rsc.db.UpdateTransactionsCategory(ctx, tx, param.UserID, param.SourceID, param.TargetID)
+	if err != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.UpdateTransactionsCategory() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	unmerged, err := rsc.db.MergeBudgets(ctx, tx, pgsql.MergeBudgetsParam{
+		FromCategoryID: param.SourceID,
+		ToCategoryID:   param.TargetID,
+		UserID:         param.UserID,
+	})
+	if err != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.MergeBudgets() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	if unmerged > 0 {
+		err = ErrBudgetCurrencyMismatch
+		return err
+	}
+
+	err = rsc.db.MoveSubcategories(ctx, tx, pgsql.MoveSubcategoriesParam{
+		FromParentID: param.SourceID,
+		ToParentID:   param.TargetID,
+		UserID:       param.UserID,
+	})
+	if err != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.MoveSubcategories() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	deleted, err := rsc.db.DeleteCategory(ctx, tx, param.UserID, param.SourceID)
+	if err != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.DeleteCategory() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	if !deleted {
+		err = ErrCategoryNotFound
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[MergeCategoriesInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, param)
+		return errCommit
+	}
+
+	return nil
+}
+
+// UpdateCategoryInDB will update a category of a user.
+// Archiving a category archives its subcategories as well.
+// It returns false if the user doesn't have the category.
+func (rsc *Resource) UpdateCategoryInDB(ctx context.Context, param UpdateCategoryParam) (bool, error) {
+	meta := map[string]interface{}{
+		"category_id": param.ID,
+		"user_id":     param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateCategoryInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateCategoryInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	updated, err := rsc.db.UpdateCategory(ctx, tx, pgsql.UpdateCategoryParam{
+		Archived: param.Archived,
+		Color:    param.Color,
+		Icon:     param.Icon,
+		ID:       param.ID,
+		Name:     param.Name,
+		ParentID: sql.NullInt64{Int64: param.ParentID, Valid: param.ParentID != 0},
+		UserID:   param.UserID,
+	})
+	if err != nil {
+		log.Printf("[UpdateCategoryInDB] rsc.db.UpdateCategory() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	if updated && param.Archived {
+		err = rsc.db.ArchiveSubcategories(ctx, tx, param.UserID, param.ID)
+		if err != nil {
+			log.Printf("[UpdateCategoryInDB] rsc.db.ArchiveSubcategories() got an error: %+v\nMeta: %+v\n", err, meta)
+			return false, err
+		}
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateCategoryInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return updated, nil
+}
+
+// insertCategoryTrees will create every category in trees under parentID within tx,
+// followed by their subcategories.
+func (rsc *Resource) insertCategoryTrees(ctx context.Context, tx *sql.Tx, userID, parentID int64, trees []CategoryTree) error {
+	for _, tree := range trees {
+		id, err := rsc.db.InsertCategory(ctx, tx, pgsql.InsertCategoryParam{
+			Color:    tree.Color,
+			Icon:     tree.Icon,
+			Name:     tree.Name,
+			ParentID: sql.NullInt64{Int64: parentID, Valid: parentID != 0},
+			Type:     tree.Type,
+			UserID:   userID,
+		})
+		if err != nil {
+			meta := map[string]interface{}{
+				"name":      tree.Name,
+				"parent_id": parentID,
+				"user_id":   userID,
+			}
+
+			log.Printf("[insertCategoryTrees] rsc.db.InsertCategory() got an error: %+v\nMeta: %+v\n", err, meta)
+			return err
+		}
+
+		err = rsc.insertCategoryTrees(ctx, tx, userID, id, tree.Subcategories)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollbackTX will rollback a transaction if any error occured.
+func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errRollback := rsc.db.Rollback(tx)
+	if errRollback != nil {
+		log.Printf("[rollbackTX] rsc.db.Rollback() got an error: %+v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// convertCategory will convert user's category saved in database.
+func convertCategory(category pgsql.Category) entity.Category {
+	return entity.Category{
+		ArchivedAt: category.ArchivedAt.Time,
+		Color:      category.Color,
+		CreatedAt:  category.CreatedAt,
+		Icon:       category.Icon,
+		ID:         category.ID,
+		Name:       category.Name,
+		ParentID:   category.ParentID.Int64,
+		Type:       category.Type,
+		UpdatedAt:  category.UpdatedAt.Time,
+		UserID:     category.UserID,
+	}
+}
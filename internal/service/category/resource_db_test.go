@@ -0,0 +1,568 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+func TestResource_GetCategoriesByUserIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []entity.Category
+		wantErr    error
+	}{
+		{
+			name: "when_GetCategoriesByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetCategoriesByUserID(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_categories",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetCategoriesByUserID(context.Background(), int64(123)).Return([]pgsql.Category{
+					{ID: 1, Name: "Food", Type: entity.TransactionTypeExpense, UserID: 123},
+					{
+						ArchivedAt: sql.NullTime{Time: mockTime, Valid: true},
+						ID:         2,
+						Name:       "Coffee",
+						ParentID:   sql.NullInt64{Int64: 1, Valid: true},
+						Type:       entity.TransactionTypeExpense,
+						UserID:     123,
+					},
+				}, nil)
+			},
+			want: []entity.Category{
+				{ID: 1, Name: "Food", Type: entity.TransactionTypeExpense, UserID: 123},
+				{ArchivedAt: mockTime, ID: 2, Name: "Coffee", ParentID: 1, Type: entity.TransactionTypeExpense, UserID: 123},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetCategoriesByUserIDFromDB(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetCategoryByIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       entity.Category
+		wantErr    error
+	}{
+		{
+			name: "when_GetCategoryByID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetCategoryByID(context.Background(), int64(123), int64(1)).Return(pgsql.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_category",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetCategoryByID(context.Background(), int64(123), int64(1)).Return(pgsql.Category{
+					Color:     "#795548",
+					CreatedAt: mockTime,
+					Icon:      "local_cafe",
+					ID:        1,
+					Name:      "Coffee",
+					ParentID:  sql.NullInt64{Int64: 3, Valid: true},
+					Type:      entity.TransactionTypeExpense,
+					UpdatedAt: sql.NullTime{Time: mockTime, Valid: true},
+					UserID:    123,
+				}, nil)
+			},
+			want: entity.Category{
+				Color:     "#795548",
+				CreatedAt: mockTime,
+				Icon:      "local_cafe",
+				ID:        1,
+				Name:      "Coffee",
+				ParentID:  3,
+				Type:      entity.TransactionTypeExpense,
+				UpdatedAt: mockTime,
+				UserID:    123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetCategoryByIDFromDB(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertCategoryToDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockInsert := pgsql.InsertCategoryParam{
+		Color:    "#795548",
+		Icon:     "local_cafe",
+		Name:     "Coffee",
+		ParentID: sql.NullInt64{Int64: 3, Valid: true},
+		Type:     entity.TransactionTypeExpense,
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertCategory_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockInsert).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockInsert).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockInsert).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.InsertCategoryToDB(context.Background(), CreateCategoryParam{
+				Color:    "#795548",
+				Icon:     "local_cafe",
+				Name:     "Coffee",
+				ParentID: 3,
+				Type:     entity.TransactionTypeExpense,
+				UserID:   123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertCategoryTreesToDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockTrees := []CategoryTree{
+		{
+			Color: "#FF9800",
+			Icon:  "restaurant",
+			Name:  "Food",
+			Subcategories: []CategoryTree{
+				{Color: "#FF9800", Icon: "local_cafe", Name: "Coffee", Type: entity.TransactionTypeExpense},
+			},
+			Type: entity.TransactionTypeExpense,
+		},
+	}
+	mockParent := pgsql.InsertCategoryParam{
+		Color:  "#FF9800",
+		Icon:   "restaurant",
+		Name:   "Food",
+		Type:   entity.TransactionTypeExpense,
+		UserID: 123,
+	}
+	mockChild := pgsql.InsertCategoryParam{
+		Color:    "#FF9800",
+		Icon:     "local_cafe",
+		Name:     "Coffee",
+		ParentID: sql.NullInt64{Int64: 1, Valid: true},
+		Type:     entity.TransactionTypeExpense,
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertCategory_error_for_parent_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockParent).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertCategory_error_for_subcategory_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockParent).Return(int64(1), nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockChild).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockParent).Return(int64(1), nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockChild).Return(int64(2), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockParent).Return(int64(1), nil)
+				mf.db.EXPECT().InsertCategory(context.Background(), &sql.Tx{}, mockChild).Return(int64(2), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.InsertCategoryTreesToDB(context.Background(), 123, mockTrees)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_MergeCategoriesInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockMerge := pgsql.MergeBudgetsParam{
+		FromCategoryID: 3,
+		ToCategoryID:   5,
+		UserID:         123,
+	}
+	mockMove := pgsql.MoveSubcategoriesParam{
+		FromParentID: 3,
+		ToParentID:   5,
+		UserID:       123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateTransactionsCategory_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_MergeBudgets_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budgets_in_different_currencies_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(1), nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrBudgetCurrencyMismatch,
+		},
+		{
+			name: "when_MoveSubcategories_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), nil)
+				mf.db.EXPECT().MoveSubcategories(context.Background(), &sql.Tx{}, mockMove).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DeleteCategory_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), nil)
+				mf.db.EXPECT().MoveSubcategories(context.Background(), &sql.Tx{}, mockMove).Return(nil)
+				mf.db.EXPECT().DeleteCategory(context.Background(), &sql.Tx{}, int64(123), int64(3)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_source_category_not_exist_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), nil)
+				mf.db.EXPECT().MoveSubcategories(context.Background(), &sql.Tx{}, mockMove).Return(nil)
+				mf.db.EXPECT().DeleteCategory(context.Background(), &sql.Tx{}, int64(123), int64(3)).Return(false, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil)
+				mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), nil)
+				mf.db.EXPECT().MoveSubcategories(context.Background(), &sql.Tx{}, mockMove).Return(nil)
+				mf.db.EXPECT().DeleteCategory(context.Background(), &sql.Tx{}, int64(123), int64(3)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_merge_budgets_before_deleting_source_then_return_nil",
+			mockFields: func(mf mockFields) {
+				gomock.InOrder(
+					mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil),
+					mf.db.EXPECT().UpdateTransactionsCategory(context.Background(), &sql.Tx{}, int64(123), int64(3), int64(5)).Return(nil),
+					mf.db.EXPECT().MergeBudgets(context.Background(), &sql.Tx{}, mockMerge).Return(int64(0), nil),
+					mf.db.EXPECT().MoveSubcategories(context.Background(), &sql.Tx{}, mockMove).Return(nil),
+					mf.db.EXPECT().DeleteCategory(context.Background(), &sql.Tx{}, int64(123), int64(3)).Return(true, nil),
+					mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil),
+				)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.MergeCategoriesInDB(context.Background(), MergeCategoriesParam{
+				SourceID: 3,
+				TargetID: 5,
+				UserID:   123,
+			})
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateCategoryInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockUpdate := pgsql.UpdateCategoryParam{
+		Archived: true,
+		Color:    "#FF9800",
+		Icon:     "restaurant",
+		ID:       1,
+		Name:     "Food",
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		param      UpdateCategoryParam
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateCategory_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateCategory(context.Background(), &sql.Tx{}, mockUpdate).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_category_not_exist_then_return_false",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateCategory(context.Background(), &sql.Tx{}, mockUpdate).Return(false, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_ArchiveSubcategories_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateCategory(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().ArchiveSubcategories(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateCategory(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().ArchiveSubcategories(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_archived_then_archive_subcategories_then_return_true",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateCategory(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().ArchiveSubcategories(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.UpdateCategoryInDB(context.Background(), UpdateCategoryParam{
+				Archived: true,
+				Color:    "#FF9800",
+				Icon:     "restaurant",
+				ID:       1,
+				Name:     "Food",
+				UserID:   123,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./resource.go
+
+// Package category is a generated GoMock package.
+package category
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	pgsql "github.com/arifinhermawan/bubi/internal/repository/pgsql"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// ArchiveSubcategories mocks base method.
+func (m *MockdbRepoProvider) ArchiveSubcategories(ctx context.Context, tx *sql.Tx, userID, parentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveSubcategories", ctx, tx, userID, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveSubcategories indicates an expected call of ArchiveSubcategories.
+func (mr *MockdbRepoProviderMockRecorder) ArchiveSubcategories(ctx, tx, userID, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveSubcategories", reflect.TypeOf((*MockdbRepoProvider)(nil).ArchiveSubcategories), ctx, tx, userID, parentID)
+}
+
+// BeginTX mocks base method.
+func (m *MockdbRepoProvider) BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTX", ctx, options)
+	ret0, _ := ret[0].(*sql.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTX indicates an expected call of BeginTX.
+func (mr *MockdbRepoProviderMockRecorder) BeginTX(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTX", reflect.TypeOf((*MockdbRepoProvider)(nil).BeginTX), ctx, options)
+}
+
+// Commit mocks base method.
+func (m *MockdbRepoProvider) Commit(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockdbRepoProviderMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockdbRepoProvider)(nil).Commit), tx)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockdbRepoProvider) DeleteCategory(ctx context.Context, tx *sql.Tx, userID, categoryID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, tx, userID, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockdbRepoProviderMockRecorder) DeleteCategory(ctx, tx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteCategory), ctx, tx, userID, categoryID)
+}
+
+// GetCategoriesByUserID mocks base method.
+func (m *MockdbRepoProvider) GetCategoriesByUserID(ctx context.Context, userID int64) ([]pgsql.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesByUserID", ctx, userID)
+	ret0, _ := ret[0].([]pgsql.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoriesByUserID indicates an expected call of GetCategoriesByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetCategoriesByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetCategoriesByUserID), ctx, userID)
+}
+
+// GetCategoryByID mocks base method.
+func (m *MockdbRepoProvider) GetCategoryByID(ctx context.Context, userID, categoryID int64) (pgsql.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, userID, categoryID)
+	ret0, _ := ret[0].(pgsql.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockdbRepoProviderMockRecorder) GetCategoryByID(ctx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetCategoryByID), ctx, userID, categoryID)
+}
+
+// InsertCategory mocks base method.
+func (m *MockdbRepoProvider) InsertCategory(ctx context.Context, tx *sql.Tx, param pgsql.InsertCategoryParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCategory", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertCategory indicates an expected call of InsertCategory.
+func (mr *MockdbRepoProviderMockRecorder) InsertCategory(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertCategory), ctx, tx, param)
+}
+
+// MergeBudgets mocks base method.
+func (m *MockdbRepoProvider) MergeBudgets(ctx context.Context, tx *sql.Tx, param pgsql.MergeBudgetsParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeBudgets", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeBudgets indicates an expected call of MergeBudgets.
+func (mr *MockdbRepoProviderMockRecorder) MergeBudgets(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeBudgets", reflect.TypeOf((*MockdbRepoProvider)(nil).MergeBudgets), ctx, tx, param)
+}
+
+// MoveSubcategories mocks base method.
+func (m *MockdbRepoProvider) MoveSubcategories(ctx context.Context, tx *sql.Tx, param pgsql.MoveSubcategoriesParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSubcategories", ctx, tx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveSubcategories indicates an expected call of MoveSubcategories.
+func (mr *MockdbRepoProviderMockRecorder) MoveSubcategories(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSubcategories", reflect.TypeOf((*MockdbRepoProvider)(nil).MoveSubcategories), ctx, tx, param)
+}
+
+// Rollback mocks base method.
+func (m *MockdbRepoProvider) Rollback(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockdbRepoProviderMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockdbRepoProvider)(nil).Rollback), tx)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockdbRepoProvider) UpdateCategory(ctx context.Context, tx *sql.Tx, param pgsql.UpdateCategoryParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockdbRepoProviderMockRecorder) UpdateCategory(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateCategory), ctx, tx, param)
+}
+
+// UpdateTransactionsCategory mocks base method.
+func (m *MockdbRepoProvider) UpdateTransactionsCategory(ctx context.Context, tx *sql.Tx, userID, fromCategoryID, toCategoryID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionsCategory", ctx, tx, userID, fromCategoryID, toCategoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransactionsCategory indicates an expected call of UpdateTransactionsCategory.
+func (mr *MockdbRepoProviderMockRecorder) UpdateTransactionsCategory(ctx, tx, userID, fromCategoryID, toCategoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionsCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateTransactionsCategory), ctx, tx, userID, fromCategoryID, toCategoryID)
+}
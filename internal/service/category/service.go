@@ -0,0 +1,57 @@
+package category
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+//go:generate mockgen -source=./service.go -destination=./service_mock.go -package=category
+
+// resourceProvider holds all methods from resource that wil be used in category's service.
+type resourceProvider interface {
+	// GetCategoriesByUserIDFromDB will fetch every category of a user, ordered by their type and name.
+	GetCategoriesByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Category, error)
+
+	// GetCategoryByIDFromDB will fetch a category of a user based on its id.
+	// It returns an empty category if the user doesn't have the category.
+	GetCategoryByIDFromDB(ctx context.Context, userID, categoryID int64) (entity.Category, error)
+
+	// InsertCategoryToDB will create a new category for a user.
+	// It returns id of the new category.
+	InsertCategoryToDB(ctx context.Context, param CreateCategoryParam) (int64, error)
+
+	// InsertCategoryTreesToDB will create every category in trees, along with their subcategories, for a user.
+	// Either every category is created or none of them is.
+	InsertCategoryTreesToDB(ctx context.Context, userID int64, trees []CategoryTree) error
+
+	// MergeCategoriesInDB will move every transaction, subcategory and budget of the source category
+	// into the target category, then delete the source category.
+	// If the user doesn't have the source category, it will return ErrCategoryNotFound.
+	// If both categories are budgeted in a period in different currencies, it will return ErrBudgetCurrencyMismatch.
+	MergeCategoriesInDB(ctx context.Context, param MergeCategoriesParam) error
+
+	// UpdateCategoryInDB will update a category of a user.
+	// Archiving a category archives its subcategories as well.
+	// It returns false if the user doesn't have the category.
+	UpdateCategoryInDB(ctx context.Context, param UpdateCategoryParam) (bool, error)
+}
+
+// CategoryServiceParam holds all parameters needed to instantiate
+// a new instance of Service.
+type CategoryServiceParam struct {
+	Rsc resourceProvider
+}
+
+type Service struct {
+	rsc resourceProvider
+}
+
+// NewService will instantiate a new instance of Service.
+func NewService(param CategoryServiceParam) *Service {
+	return &Service{
+		rsc: param.Rsc,
+	}
+}
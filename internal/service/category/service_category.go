@@ -0,0 +1,141 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+var (
+	// ErrBudgetCurrencyMismatch is returned when categories can't be merged because they are budgeted
+	// in different currencies in the same period.
+	ErrBudgetCurrencyMismatch = errors.New("categories are budgeted in different currencies")
+
+	// ErrCategoryNotFound is returned when a user doesn't have the requested category.
+	ErrCategoryNotFound = errors.New("category not found")
+)
+
+// CreateCategory will create a new category for a user and return it.
+func (svc *Service) CreateCategory(ctx context.Context, param CreateCategoryParam) (Category, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	id, err := svc.rsc.InsertCategoryToDB(ctx, param)
+	if err != nil {
+		log.Printf("[CreateCategory] svc.rsc.InsertCategoryToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	category, err := svc.GetCategory(ctx, param.UserID, id)
+	if err != nil {
+		log.Printf("[CreateCategory] svc.GetCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	return category, nil
+}
+
+// CreateDefaultCategories will create the default category set for a user, named in language.
+// Indonesian is used when language isn't supported.
+func (svc *Service) CreateDefaultCategories(ctx context.Context, userID int64, language string) error {
+	if !entity.IsLanguage(language) {
+		language = entity.LanguageIndonesian
+	}
+
+	err := svc.rsc.InsertCategoryTreesToDB(ctx, userID, buildDefaultCategoryTrees(defaultCategories, language, "", ""))
+	if err != nil {
+		meta := map[string]interface{}{
+			"language": language,
+			"user_id":  userID,
+		}
+
+		log.Printf("[CreateDefaultCategories] svc.rsc.InsertCategoryTreesToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// GetCategory will fetch a category of a user.
+// If the user doesn't have the category, it will return ErrCategoryNotFound.
+func (svc *Service) GetCategory(ctx context.Context, userID, categoryID int64) (Category, error) {
+	meta := map[string]interface{}{
+		"category_id": categoryID,
+		"user_id":     userID,
+	}
+
+	category, err := svc.rsc.GetCategoryByIDFromDB(ctx, userID, categoryID)
+	if err != nil {
+		log.Printf("[GetCategory] svc.rsc.GetCategoryByIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	if category.ID == 0 {
+		log.Printf("[GetCategory] category not found\nMeta:%+v\n", meta)
+		return Category{}, ErrCategoryNotFound
+	}
+
+	return Category(category), nil
+}
+
+// ListCategories will fetch every category of a user, ordered by their type and name.
+func (svc *Service) ListCategories(ctx context.Context, userID int64) ([]Category, error) {
+	categories, err := svc.rsc.GetCategoriesByUserIDFromDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[ListCategories] svc.rsc.GetCategoriesByUserIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Category, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, Category(category))
+	}
+
+	return result, nil
+}
+
+// MergeCategories will move every transaction, subcategory and budget of the source category
+// into the target category, then delete the source category.
+// If the user doesn't have the source category, it will return ErrCategoryNotFound.
+// If both categories are budgeted in a period in different currencies, it will return ErrBudgetCurrencyMismatch.
+func (svc *Service) MergeCategories(ctx context.Context, param MergeCategoriesParam) error {
+	err := svc.rsc.MergeCategoriesInDB(ctx, param)
+	if err != nil {
+		log.Printf("[MergeCategories] svc.rsc.MergeCategoriesInDB() got an error: %+v\nMeta:%+v\n", err, param)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCategory will update a category of a user.
+// If the user doesn't have the category, it will return ErrCategoryNotFound.
+func (svc *Service) UpdateCategory(ctx context.Context, param UpdateCategoryParam) error {
+	meta := map[string]interface{}{
+		"category_id": param.ID,
+		"user_id":     param.UserID,
+	}
+
+	updated, err := svc.rsc.UpdateCategoryInDB(ctx, param)
+	if err != nil {
+		log.Printf("[UpdateCategory] svc.rsc.UpdateCategoryInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !updated {
+		log.Printf("[UpdateCategory] category not found\nMeta:%+v\n", meta)
+		return ErrCategoryNotFound
+	}
+
+	return nil
+}
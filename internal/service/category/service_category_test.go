@@ -0,0 +1,391 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_CreateCategory(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := CreateCategoryParam{
+		Color:  "#FF9800",
+		Icon:   "restaurant",
+		Name:   "Food",
+		Type:   entity.TransactionTypeExpense,
+		UserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name: "when_InsertCategoryToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryToDB(context.Background(), mockParam).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetCategory_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetCategoryByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_category",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetCategoryByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Category{
+					Color:  "#FF9800",
+					Icon:   "restaurant",
+					ID:     1,
+					Name:   "Food",
+					Type:   entity.TransactionTypeExpense,
+					UserID: 123,
+				}, nil)
+			},
+			want: Category{
+				Color:  "#FF9800",
+				Icon:   "restaurant",
+				ID:     1,
+				Name:   "Food",
+				Type:   entity.TransactionTypeExpense,
+				UserID: 123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.CreateCategory(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_CreateDefaultCategories(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		language   string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:     "when_InsertCategoryTreesToDB_error_then_return_error",
+			language: entity.LanguageEnglish,
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryTreesToDB(context.Background(), int64(123), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:     "when_language_is_english_then_create_english_categories",
+			language: entity.LanguageEnglish,
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryTreesToDB(context.Background(), int64(123), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, trees []CategoryTree) error {
+						assert.Equal(t, "Food & Drinks", trees[0].Name)
+						return nil
+					})
+			},
+		},
+		{
+			name:     "when_language_not_supported_then_create_indonesian_categories",
+			language: "fr",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertCategoryTreesToDB(context.Background(), int64(123), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, trees []CategoryTree) error {
+						assert.Equal(t, "Makanan & Minuman", trees[0].Name)
+						return nil
+					})
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.CreateDefaultCategories(context.Background(), 123, test.language)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetCategory(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name: "when_GetCategoryByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetCategoryByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_category_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetCategoryByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Category{}, nil)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_category",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetCategoryByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Category{
+					ID:     1,
+					Name:   "Food",
+					Type:   entity.TransactionTypeExpense,
+					UserID: 123,
+				}, nil)
+			},
+			want: Category{
+				ID:     1,
+				Name:   "Food",
+				Type:   entity.TransactionTypeExpense,
+				UserID: 123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetCategory(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListCategories(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Category
+		wantErr    error
+	}{
+		{
+			name: "when_GetCategoriesByUserIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetCategoriesByUserIDFromDB(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_categories",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetCategoriesByUserIDFromDB(context.Background(), int64(123)).Return([]entity.Category{
+					{ID: 1, Name: "Food", Type: entity.TransactionTypeExpense, UserID: 123},
+				}, nil)
+			},
+			want: []Category{
+				{ID: 1, Name: "Food", Type: entity.TransactionTypeExpense, UserID: 123},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListCategories(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_MergeCategories(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := MergeCategoriesParam{
+		SourceID: 3,
+		TargetID: 5,
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_MergeCategoriesInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().MergeCategoriesInDB(context.Background(), mockParam).Return(ErrCategoryNotFound)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().MergeCategoriesInDB(context.Background(), mockParam).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.MergeCategories(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_UpdateCategory(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := UpdateCategoryParam{
+		ID:     1,
+		Name:   "Food",
+		UserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateCategoryInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateCategoryInDB(context.Background(), mockParam).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_category_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateCategoryInDB(context.Background(), mockParam).Return(false, nil)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateCategoryInDB(context.Background(), mockParam).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.UpdateCategory(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func Test_buildDefaultCategoryTrees(t *testing.T) {
+	defaults := []defaultCategory{
+		{
+			Color: "#FF9800",
+			Icon:  "restaurant",
+			Names: map[string]string{entity.LanguageEnglish: "Food", entity.LanguageIndonesian: "Makanan"},
+			Subcategories: []defaultCategory{
+				{Icon: "local_cafe", Names: map[string]string{entity.LanguageEnglish: "Coffee", entity.LanguageIndonesian: "Kopi"}},
+			},
+			Type: entity.TransactionTypeExpense,
+		},
+	}
+
+	got := buildDefaultCategoryTrees(defaults, entity.LanguageIndonesian, "", "")
+	assert.Equal(t, []CategoryTree{
+		{
+			Color: "#FF9800",
+			Icon:  "restaurant",
+			Name:  "Makanan",
+			Subcategories: []CategoryTree{
+				{Color: "#FF9800", Icon: "local_cafe", Name: "Kopi", Type: entity.TransactionTypeExpense},
+			},
+			Type: entity.TransactionTypeExpense,
+		},
+	}, got)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package category is a generated GoMock package.
+package category
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockresourceProvider is a mock of resourceProvider interface.
+type MockresourceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceProviderMockRecorder
+}
+
+// MockresourceProviderMockRecorder is the mock recorder for MockresourceProvider.
+type MockresourceProviderMockRecorder struct {
+	mock *MockresourceProvider
+}
+
+// NewMockresourceProvider creates a new mock instance.
+func NewMockresourceProvider(ctrl *gomock.Controller) *MockresourceProvider {
+	mock := &MockresourceProvider{ctrl: ctrl}
+	mock.recorder = &MockresourceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceProvider) EXPECT() *MockresourceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetCategoriesByUserIDFromDB mocks base method.
+func (m *MockresourceProvider) GetCategoriesByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesByUserIDFromDB", ctx, userID)
+	ret0, _ := ret[0].([]entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoriesByUserIDFromDB indicates an expected call of GetCategoriesByUserIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetCategoriesByUserIDFromDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesByUserIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetCategoriesByUserIDFromDB), ctx, userID)
+}
+
+// GetCategoryByIDFromDB mocks base method.
+func (m *MockresourceProvider) GetCategoryByIDFromDB(ctx context.Context, userID, categoryID int64) (entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByIDFromDB", ctx, userID, categoryID)
+	ret0, _ := ret[0].(entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByIDFromDB indicates an expected call of GetCategoryByIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetCategoryByIDFromDB(ctx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetCategoryByIDFromDB), ctx, userID, categoryID)
+}
+
+// InsertCategoryToDB mocks base method.
+func (m *MockresourceProvider) InsertCategoryToDB(ctx context.Context, param CreateCategoryParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCategoryToDB", ctx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertCategoryToDB indicates an expected call of InsertCategoryToDB.
+func (mr *MockresourceProviderMockRecorder) InsertCategoryToDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCategoryToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertCategoryToDB), ctx, param)
+}
+
+// InsertCategoryTreesToDB mocks base method.
+func (m *MockresourceProvider) InsertCategoryTreesToDB(ctx context.Context, userID int64, trees []CategoryTree) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCategoryTreesToDB", ctx, userID, trees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertCategoryTreesToDB indicates an expected call of InsertCategoryTreesToDB.
+func (mr *MockresourceProviderMockRecorder) InsertCategoryTreesToDB(ctx, userID, trees interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCategoryTreesToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertCategoryTreesToDB), ctx, userID, trees)
+}
+
+// MergeCategoriesInDB mocks base method.
+func (m *MockresourceProvider) MergeCategoriesInDB(ctx context.Context, param MergeCategoriesParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategoriesInDB", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeCategoriesInDB indicates an expected call of MergeCategoriesInDB.
+func (mr *MockresourceProviderMockRecorder) MergeCategoriesInDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategoriesInDB", reflect.TypeOf((*MockresourceProvider)(nil).MergeCategoriesInDB), ctx, param)
+}
+
+// UpdateCategoryInDB mocks base method.
+func (m *MockresourceProvider) UpdateCategoryInDB(ctx context.Context, param UpdateCategoryParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryInDB", ctx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryInDB indicates an expected call of UpdateCategoryInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateCategoryInDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateCategoryInDB), ctx, param)
+}
@@ -0,0 +1,48 @@
+package category
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// Category is an entity representational of Category.
+type Category entity.Category
+
+// CategoryTree describes a category to be created along with its subcategories.
+type CategoryTree struct {
+	Color         string
+	Icon          string
+	Name          string
+	Subcategories []CategoryTree
+	Type          string
+}
+
+// CreateCategoryParam represents parameters needed to create a category.
+// A zero ParentID means the category is a top level category.
+type CreateCategoryParam struct {
+	Color    string
+	Icon     string
+	Name     string
+	ParentID int64
+	Type     string
+	UserID   int64
+}
+
+// MergeCategoriesParam represents parameters needed to merge a category into another category.
+type MergeCategoriesParam struct {
+	SourceID int64
+	TargetID int64
+	UserID   int64
+}
+
+// UpdateCategoryParam represents parameters needed to update a category.
+// A zero ParentID means the category is a top level category.
+type UpdateCategoryParam struct {
+	Archived bool
+	Color    string
+	Icon     string
+	ID       int64
+	Name     string
+	ParentID int64
+	UserID   int64
+}
@@ -0,0 +1,70 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+//go:generate mockgen -source=./resource.go -destination=./resource_mock.go -package=transaction
+
+// dbRepoProvider holds all methods from db repo that wil be used in transaction's resource.
+type dbRepoProvider interface {
+	// AdjustWalletBalance will add param.Amount to the balance of a wallet of a user.
+	// It returns false if the user doesn't have the wallet.
+	AdjustWalletBalance(ctx context.Context, tx *sql.Tx, param pgsql.AdjustWalletBalanceParam) (bool, error)
+
+	// BeginTX will start a new transaction.
+	BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error)
+
+	// Commit will commit the transaction.
+	Commit(tx *sql.Tx) error
+
+	// DeleteTransaction will delete a transaction of a user.
+	// It returns false if the user doesn't have the transaction.
+	DeleteTransaction(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (bool, error)
+
+	// GetTransactionAmountForUpdate will fetch amount, type and wallet of a transaction of a user,
+	// and lock the transaction until tx is over.
+	// It returns an empty result if the user doesn't have the transaction.
+	GetTransactionAmountForUpdate(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (pgsql.TransactionAmount, error)
+
+	// GetTransactionByID will fetch a transaction of a user based on its id.
+	// It returns an empty transaction if the user doesn't have the transaction.
+	GetTransactionByID(ctx context.Context, userID, transactionID int64) (pgsql.Transaction, error)
+
+	// GetTransactionsByUserID will fetch transactions of a user,
+	// ordered from the most recent transaction.
+	GetTransactionsByUserID(ctx context.Context, param pgsql.GetTransactionsParam) ([]pgsql.Transaction, error)
+
+	// InsertTransaction will create a new entry in table transaction in database.
+	// It returns id of the new entry.
+	InsertTransaction(ctx context.Context, tx *sql.Tx, param pgsql.InsertTransactionParam) (int64, error)
+
+	// Rollback will aborts the transaction.
+	Rollback(tx *sql.Tx) error
+
+	// UpdateTransaction will update a transaction of a user.
+	// It returns false if the user doesn't have the transaction.
+	UpdateTransaction(ctx context.Context, tx *sql.Tx, param pgsql.UpdateTransactionParam) (bool, error)
+}
+
+// TransactionResourceParam holds all parameters needed to instantiate
+// a new instance of Resource.
+type TransactionResourceParam struct {
+	DB dbRepoProvider
+}
+
+type Resource struct {
+	db dbRepoProvider
+}
+
+// NewResource will instantiate a new instance of Resource.
+func NewResource(param TransactionResourceParam) *Resource {
+	return &Resource{
+		db: param.DB,
+	}
+}
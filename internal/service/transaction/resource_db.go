@@ -0,0 +1,310 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+// DeleteTransactionInDB will delete a transaction of a user and take it out of the balance of its wallet.
+// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+func (rsc *Resource) DeleteTransactionInDB(ctx context.Context, userID, transactionID int64) error {
+	meta := map[string]interface{}{
+		"transaction_id": transactionID,
+		"user_id":        userID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeleteTransactionInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeleteTransactionInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	current, err := rsc.db.GetTransactionAmountForUpdate(ctx, tx, userID, transactionID)
+	if err != nil {
+		log.Printf("[DeleteTransactionInDB] rsc.db.GetTransactionAmountForUpdate() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	if current.WalletID == 0 {
+		err = ErrTransactionNotFound
+		return err
+	}
+
+	_, err = rsc.db.DeleteTransaction(ctx, tx, userID, transactionID)
+	if err != nil {
+		log.Printf("[DeleteTransactionInDB] rsc.db.DeleteTransaction() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	err = rsc.adjustWalletBalance(ctx, tx, pgsql.AdjustWalletBalanceParam{
+		Amount: -entity.TransactionBalanceChange(current.Type, current.Amount),
+		ID:     current.WalletID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("[DeleteTransactionInDB] rsc.adjustWalletBalance() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[DeleteTransactionInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// GetTransactionByIDFromDB will fetch a transaction of a user based on its id.
+// It returns an empty transaction if the user doesn't have the transaction.
+func (rsc *Resource) GetTransactionByIDFromDB(ctx context.Context, userID, transactionID int64) (entity.Transaction, error) {
+	transaction, err := rsc.db.GetTransactionByID(ctx, userID, transactionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"transaction_id": transactionID,
+			"user_id":        userID,
+		}
+
+		log.Printf("[GetTransactionByIDFromDB] rsc.db.GetTransactionByID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return entity.Transaction{}, err
+	}
+
+	return convertTransaction(transaction), nil
+}
+
+// GetTransactionsFromDB will fetch transactions of a user, ordered from the most recent transaction.
+func (rsc *Resource) GetTransactionsFromDB(ctx context.Context, param ListTransactionsParam) ([]entity.Transaction, error) {
+	transactions, err := rsc.db.GetTransactionsByUserID(ctx, pgsql.GetTransactionsParam{
+		Limit:    param.Limit,
+		Offset:   param.Offset,
+		UserID:   param.UserID,
+		WalletID: param.WalletID,
+	})
+	if err != nil {
+		log.Printf("[GetTransactionsFromDB] rsc.db.GetTransactionsByUserID() got an error: %+v\nMeta: %+v\n", err, param)
+		return nil, err
+	}
+
+	result := make([]entity.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		result = append(result, convertTransaction(transaction))
+	}
+
+	return result, nil
+}
+
+// InsertTransactionToDB will record a new transaction for a user and add it to the balance of its wallet.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+// It returns id of the new transaction.
+func (rsc *Resource) InsertTransactionToDB(ctx context.Context, param CreateTransactionParam) (int64, error) {
+	meta := map[string]interface{}{
+		"user_id":   param.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertTransactionToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertTransactionToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	// the wallet is adjusted first, so a wallet the user doesn't have is refused before anything is written.
+	err = rsc.adjustWalletBalance(ctx, tx, pgsql.AdjustWalletBalanceParam{
+		Amount: entity.TransactionBalanceChange(param.Type, param.Amount),
+		ID:     param.WalletID,
+		UserID: param.UserID,
+	})
+	if err != nil {
+		log.Printf("[InsertTransactionToDB] rsc.adjustWalletBalance() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	id, err := rsc.db.InsertTransaction(ctx, tx, pgsql.InsertTransactionParam{
+		Amount:       param.Amount,
+		CategoryID:   sql.NullInt64{Int64: param.CategoryID, Valid: param.CategoryID != 0},
+		Note:         param.Note,
+		Payee:        param.Payee,
+		Tags:         strings.Join(param.Tags, ","),
+		TransactedAt: param.TransactedAt,
+		Type:         param.Type,
+		UserID:       param.UserID,
+		WalletID:     param.WalletID,
+	})
+	if err != nil {
+		log.Printf("[InsertTransactionToDB] rsc.db.InsertTransaction() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertTransactionToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return 0, errCommit
+	}
+
+	return id, nil
+}
+
+// UpdateTransactionInDB will update a transaction of a user and move the difference
+// to the balance of its wallet. When the transaction is moved to another wallet,
+// it's taken out of the old wallet and added to the new one.
+// If the user doesn't have the transaction, it will return ErrTransactionNotFound,
+// and if the user doesn't have the new wallet, it will return ErrWalletNotFound.
+func (rsc *Resource) UpdateTransactionInDB(ctx context.Context, param UpdateTransactionParam) error {
+	meta := map[string]interface{}{
+		"transaction_id": param.ID,
+		"user_id":        param.UserID,
+		"wallet_id":      param.WalletID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateTransactionInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateTransactionInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	current, err := rsc.db.GetTransactionAmountForUpdate(ctx, tx, param.UserID, param.ID)
+	if err != nil {
+		log.Printf("[UpdateTransactionInDB] rsc.db.GetTransactionAmountForUpdate() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	if current.WalletID == 0 {
+		err = ErrTransactionNotFound
+		return err
+	}
+
+	oldChange := entity.TransactionBalanceChange(current.Type, current.Amount)
+	newChange := entity.TransactionBalanceChange(param.Type, param.Amount)
+
+	adjustments := []pgsql.AdjustWalletBalanceParam{
+		{Amount: newChange - oldChange, ID: param.WalletID, UserID: param.UserID},
+	}
+	if current.WalletID != param.WalletID {
+		adjustments = []pgsql.AdjustWalletBalanceParam{
+			{Amount: newChange, ID: param.WalletID, UserID: param.UserID},
+			{Amount: -oldChange, ID: current.WalletID, UserID: param.UserID},
+		}
+	}
+
+	for _, adjustment := range adjustments {
+		err = rsc.adjustWalletBalance(ctx, tx, adjustment)
+		if err != nil {
+			log.Printf("[UpdateTransactionInDB] rsc.adjustWalletBalance() got an error: %+v\nMeta: %+v\n", err, meta)
+			return err
+		}
+	}
+
+	_, err = rsc.db.UpdateTransaction(ctx, tx, pgsql.UpdateTransactionParam{
+		Amount:       param.Amount,
+		CategoryID:   sql.NullInt64{Int64: param.CategoryID, Valid: param.CategoryID != 0},
+		ID:           param.ID,
+		Note:         param.Note,
+		Payee:        param.Payee,
+		Tags:         strings.Join(param.Tags, ","),
+		TransactedAt: param.TransactedAt,
+		Type:         param.Type,
+		UserID:       param.UserID,
+		WalletID:     param.WalletID,
+	})
+	if err != nil {
+		log.Printf("[UpdateTransactionInDB] rsc.db.UpdateTransaction() got an error: %+v\nMeta: %+v\n", err, meta)
+		return err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateTransactionInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return errCommit
+	}
+
+	return nil
+}
+
+// adjustWalletBalance will add param.Amount to the balance of a wallet within tx.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+func (rsc *Resource) adjustWalletBalance(ctx context.Context, tx *sql.Tx, param pgsql.AdjustWalletBalanceParam) error {
+	adjusted, err := rsc.db.AdjustWalletBalance(ctx, tx, param)
+	if err != nil {
+		log.Printf("[adjustWalletBalance] rsc.db.AdjustWalletBalance() got an error: %+v\nMeta: %+v\n", err, param)
+		return err
+	}
+
+	if !adjusted {
+		return ErrWalletNotFound
+	}
+
+	return nil
+}
+
+// rollbackTX will rollback a transaction if any error occured.
+func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errRollback := rsc.db.Rollback(tx)
+	if errRollback != nil {
+		log.Printf("[rollbackTX] rsc.db.Rollback() got an error: %+v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// convertTransaction will convert user's transaction saved in database.
+func convertTransaction(transaction pgsql.Transaction) entity.Transaction {
+	return entity.Transaction{
+		Amount:       transaction.Amount,
+		CategoryID:   transaction.CategoryID.Int64,
+		CreatedAt:    transaction.CreatedAt,
+		ID:           transaction.ID,
+		Note:         transaction.Note,
+		Payee:        transaction.Payee,
+		Tags:         splitCommaSeparated(transaction.Tags),
+		TransactedAt: transaction.TransactedAt,
+		Type:         transaction.Type,
+		UpdatedAt:    transaction.UpdatedAt.Time,
+		UserID:       transaction.UserID,
+		WalletID:     transaction.WalletID,
+	}
+}
+
+// splitCommaSeparated will split values that are saved as a comma separated list, such as tags.
+func splitCommaSeparated(values string) []string {
+	if values == "" {
+		return nil
+	}
+
+	return strings.Split(values, ",")
+}
@@ -0,0 +1,552 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+func TestResource_DeleteTransactionInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockAmount := pgsql.TransactionAmount{
+		Amount:   25000,
+		Type:     entity.TransactionTypeExpense,
+		WalletID: 7,
+	}
+	mockAdjust := pgsql.AdjustWalletBalanceParam{
+		Amount: 25000,
+		ID:     7,
+		UserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetTransactionAmountForUpdate_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(pgsql.TransactionAmount{}, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_transaction_not_exist_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(pgsql.TransactionAmount{}, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_DeleteTransaction_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockAmount, nil)
+				mf.db.EXPECT().DeleteTransaction(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_AdjustWalletBalance_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockAmount, nil)
+				mf.db.EXPECT().DeleteTransaction(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockAmount, nil)
+				mf.db.EXPECT().DeleteTransaction(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockAmount, nil)
+				mf.db.EXPECT().DeleteTransaction(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.DeleteTransactionInDB(context.Background(), 123, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetTransactionByIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       entity.Transaction
+		wantErr    error
+	}{
+		{
+			name: "when_GetTransactionByID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetTransactionByID(context.Background(), int64(123), int64(1)).Return(pgsql.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_transaction",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetTransactionByID(context.Background(), int64(123), int64(1)).Return(pgsql.Transaction{
+					Amount:       25000,
+					CategoryID:   sql.NullInt64{Int64: 3, Valid: true},
+					CreatedAt:    mockTime,
+					ID:           1,
+					Note:         "lunch",
+					Payee:        "Warteg",
+					Tags:         "food,work",
+					TransactedAt: mockTime,
+					Type:         entity.TransactionTypeExpense,
+					UpdatedAt:    sql.NullTime{Time: mockTime, Valid: true},
+					UserID:       123,
+					WalletID:     7,
+				}, nil)
+			},
+			want: entity.Transaction{
+				Amount:       25000,
+				CategoryID:   3,
+				CreatedAt:    mockTime,
+				ID:           1,
+				Note:         "lunch",
+				Payee:        "Warteg",
+				Tags:         []string{"food", "work"},
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				UpdatedAt:    mockTime,
+				UserID:       123,
+				WalletID:     7,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetTransactionByIDFromDB(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetTransactionsFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := pgsql.GetTransactionsParam{
+		Limit:    10,
+		Offset:   20,
+		UserID:   123,
+		WalletID: 7,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []entity.Transaction
+		wantErr    error
+	}{
+		{
+			name: "when_GetTransactionsByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetTransactionsByUserID(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_transactions",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetTransactionsByUserID(context.Background(), mockParam).Return([]pgsql.Transaction{
+					{
+						Amount:       5000000,
+						CreatedAt:    mockTime,
+						ID:           2,
+						TransactedAt: mockTime,
+						Type:         entity.TransactionTypeIncome,
+						UserID:       123,
+						WalletID:     7,
+					},
+				}, nil)
+			},
+			want: []entity.Transaction{
+				{
+					Amount:       5000000,
+					CreatedAt:    mockTime,
+					ID:           2,
+					TransactedAt: mockTime,
+					Type:         entity.TransactionTypeIncome,
+					UserID:       123,
+					WalletID:     7,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetTransactionsFromDB(context.Background(), ListTransactionsParam{
+				Limit:    10,
+				Offset:   20,
+				UserID:   123,
+				WalletID: 7,
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertTransactionToDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := CreateTransactionParam{
+		Amount:       25000,
+		Note:         "lunch",
+		Payee:        "Warteg",
+		Tags:         []string{"food", "work"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     7,
+	}
+	mockAdjust := pgsql.AdjustWalletBalanceParam{
+		Amount: -25000,
+		ID:     7,
+		UserID: 123,
+	}
+	mockInsert := pgsql.InsertTransactionParam{
+		Amount:       25000,
+		Note:         "lunch",
+		Payee:        "Warteg",
+		Tags:         "food,work",
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     7,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_AdjustWalletBalance_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_not_exist_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(false, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_InsertTransaction_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(true, nil)
+				mf.db.EXPECT().InsertTransaction(context.Background(), &sql.Tx{}, mockInsert).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(true, nil)
+				mf.db.EXPECT().InsertTransaction(context.Background(), &sql.Tx{}, mockInsert).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, mockAdjust).Return(true, nil)
+				mf.db.EXPECT().InsertTransaction(context.Background(), &sql.Tx{}, mockInsert).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.InsertTransactionToDB(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateTransactionInDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	mockParam := UpdateTransactionParam{
+		Amount:       30000,
+		CategoryID:   3,
+		ID:           1,
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     7,
+	}
+	mockUpdate := pgsql.UpdateTransactionParam{
+		Amount:       30000,
+		CategoryID:   sql.NullInt64{Int64: 3, Valid: true},
+		ID:           1,
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     7,
+	}
+	mockSameWallet := pgsql.TransactionAmount{
+		Amount:   25000,
+		Type:     entity.TransactionTypeExpense,
+		WalletID: 7,
+	}
+	mockOtherWallet := pgsql.TransactionAmount{
+		Amount:   25000,
+		Type:     entity.TransactionTypeIncome,
+		WalletID: 8,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetTransactionAmountForUpdate_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(pgsql.TransactionAmount{}, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_transaction_not_exist_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(pgsql.TransactionAmount{}, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_new_wallet_not_exist_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockOtherWallet, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -30000,
+					ID:     7,
+					UserID: 123,
+				}).Return(false, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_UpdateTransaction_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockSameWallet, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -5000,
+					ID:     7,
+					UserID: 123,
+				}).Return(true, nil)
+				mf.db.EXPECT().UpdateTransaction(context.Background(), &sql.Tx{}, mockUpdate).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_unchanged_then_adjust_the_difference_once",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockSameWallet, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -5000,
+					ID:     7,
+					UserID: 123,
+				}).Return(true, nil)
+				mf.db.EXPECT().UpdateTransaction(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_wallet_changed_then_move_the_transaction_between_wallets",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockOtherWallet, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -30000,
+					ID:     7,
+					UserID: 123,
+				}).Return(true, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -25000,
+					ID:     8,
+					UserID: 123,
+				}).Return(true, nil)
+				mf.db.EXPECT().UpdateTransaction(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().GetTransactionAmountForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(mockSameWallet, nil)
+				mf.db.EXPECT().AdjustWalletBalance(context.Background(), &sql.Tx{}, pgsql.AdjustWalletBalanceParam{
+					Amount: -5000,
+					ID:     7,
+					UserID: 123,
+				}).Return(true, nil)
+				mf.db.EXPECT().UpdateTransaction(context.Background(), &sql.Tx{}, mockUpdate).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			err := rsc.UpdateTransactionInDB(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
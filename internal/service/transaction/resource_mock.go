@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./resource.go
+
+// Package transaction is a generated GoMock package.
+package transaction
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	pgsql "github.com/arifinhermawan/bubi/internal/repository/pgsql"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// AdjustWalletBalance mocks base method.
+func (m *MockdbRepoProvider) AdjustWalletBalance(ctx context.Context, tx *sql.Tx, param pgsql.AdjustWalletBalanceParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustWalletBalance", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustWalletBalance indicates an expected call of AdjustWalletBalance.
+func (mr *MockdbRepoProviderMockRecorder) AdjustWalletBalance(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustWalletBalance", reflect.TypeOf((*MockdbRepoProvider)(nil).AdjustWalletBalance), ctx, tx, param)
+}
+
+// BeginTX mocks base method.
+func (m *MockdbRepoProvider) BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTX", ctx, options)
+	ret0, _ := ret[0].(*sql.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTX indicates an expected call of BeginTX.
+func (mr *MockdbRepoProviderMockRecorder) BeginTX(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTX", reflect.TypeOf((*MockdbRepoProvider)(nil).BeginTX), ctx, options)
+}
+
+// Commit mocks base method.
+func (m *MockdbRepoProvider) Commit(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockdbRepoProviderMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockdbRepoProvider)(nil).Commit), tx)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockdbRepoProvider) DeleteTransaction(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, tx, userID, transactionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockdbRepoProviderMockRecorder) DeleteTransaction(ctx, tx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteTransaction), ctx, tx, userID, transactionID)
+}
+
+// GetTransactionAmountForUpdate mocks base method.
+func (m *MockdbRepoProvider) GetTransactionAmountForUpdate(ctx context.Context, tx *sql.Tx, userID, transactionID int64) (pgsql.TransactionAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionAmountForUpdate", ctx, tx, userID, transactionID)
+	ret0, _ := ret[0].(pgsql.TransactionAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionAmountForUpdate indicates an expected call of GetTransactionAmountForUpdate.
+func (mr *MockdbRepoProviderMockRecorder) GetTransactionAmountForUpdate(ctx, tx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionAmountForUpdate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetTransactionAmountForUpdate), ctx, tx, userID, transactionID)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockdbRepoProvider) GetTransactionByID(ctx context.Context, userID, transactionID int64) (pgsql.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, userID, transactionID)
+	ret0, _ := ret[0].(pgsql.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockdbRepoProviderMockRecorder) GetTransactionByID(ctx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetTransactionByID), ctx, userID, transactionID)
+}
+
+// GetTransactionsByUserID mocks base method.
+func (m *MockdbRepoProvider) GetTransactionsByUserID(ctx context.Context, param pgsql.GetTransactionsParam) ([]pgsql.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsByUserID", ctx, param)
+	ret0, _ := ret[0].([]pgsql.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByUserID indicates an expected call of GetTransactionsByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetTransactionsByUserID(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetTransactionsByUserID), ctx, param)
+}
+
+// InsertTransaction mocks base method.
+func (m *MockdbRepoProvider) InsertTransaction(ctx context.Context, tx *sql.Tx, param pgsql.InsertTransactionParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTransaction", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertTransaction indicates an expected call of InsertTransaction.
+func (mr *MockdbRepoProviderMockRecorder) InsertTransaction(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTransaction", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertTransaction), ctx, tx, param)
+}
+
+// Rollback mocks base method.
+func (m *MockdbRepoProvider) Rollback(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockdbRepoProviderMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockdbRepoProvider)(nil).Rollback), tx)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockdbRepoProvider) UpdateTransaction(ctx context.Context, tx *sql.Tx, param pgsql.UpdateTransactionParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockdbRepoProviderMockRecorder) UpdateTransaction(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateTransaction), ctx, tx, param)
+}
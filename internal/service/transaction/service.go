@@ -0,0 +1,53 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+//go:generate mockgen -source=./service.go -destination=./service_mock.go -package=transaction
+
+// resourceProvider holds all methods from resource that wil be used in transaction's service.
+type resourceProvider interface {
+	// DeleteTransactionInDB will delete a transaction of a user and take it out of the balance of its wallet.
+	// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+	DeleteTransactionInDB(ctx context.Context, userID, transactionID int64) error
+
+	// GetTransactionByIDFromDB will fetch a transaction of a user based on its id.
+	// It returns an empty transaction if the user doesn't have the transaction.
+	GetTransactionByIDFromDB(ctx context.Context, userID, transactionID int64) (entity.Transaction, error)
+
+	// GetTransactionsFromDB will fetch transactions of a user, ordered from the most recent transaction.
+	GetTransactionsFromDB(ctx context.Context, param ListTransactionsParam) ([]entity.Transaction, error)
+
+	// InsertTransactionToDB will record a new transaction for a user and add it to the balance of its wallet.
+	// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+	// It returns id of the new transaction.
+	InsertTransactionToDB(ctx context.Context, param CreateTransactionParam) (int64, error)
+
+	// UpdateTransactionInDB will update a transaction of a user and move the difference
+	// to the balance of its wallet.
+	// If the user doesn't have the transaction, it will return ErrTransactionNotFound,
+	// and if the user doesn't have the new wallet, it will return ErrWalletNotFound.
+	UpdateTransactionInDB(ctx context.Context, param UpdateTransactionParam) error
+}
+
+// TransactionServiceParam holds all parameters needed to instantiate
+// a new instance of Service.
+type TransactionServiceParam struct {
+	Rsc resourceProvider
+}
+
+type Service struct {
+	rsc resourceProvider
+}
+
+// NewService will instantiate a new instance of Service.
+func NewService(param TransactionServiceParam) *Service {
+	return &Service{
+		rsc: param.Rsc,
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package transaction is a generated GoMock package.
+package transaction
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockresourceProvider is a mock of resourceProvider interface.
+type MockresourceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceProviderMockRecorder
+}
+
+// MockresourceProviderMockRecorder is the mock recorder for MockresourceProvider.
+type MockresourceProviderMockRecorder struct {
+	mock *MockresourceProvider
+}
+
+// NewMockresourceProvider creates a new mock instance.
+func NewMockresourceProvider(ctrl *gomock.Controller) *MockresourceProvider {
+	mock := &MockresourceProvider{ctrl: ctrl}
+	mock.recorder = &MockresourceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceProvider) EXPECT() *MockresourceProviderMockRecorder {
+	return m.recorder
+}
+
+// DeleteTransactionInDB mocks base method.
+func (m *MockresourceProvider) DeleteTransactionInDB(ctx context.Context, userID, transactionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionInDB", ctx, userID, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionInDB indicates an expected call of DeleteTransactionInDB.
+func (mr *MockresourceProviderMockRecorder) DeleteTransactionInDB(ctx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeleteTransactionInDB), ctx, userID, transactionID)
+}
+
+// GetTransactionByIDFromDB mocks base method.
+func (m *MockresourceProvider) GetTransactionByIDFromDB(ctx context.Context, userID, transactionID int64) (entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByIDFromDB", ctx, userID, transactionID)
+	ret0, _ := ret[0].(entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByIDFromDB indicates an expected call of GetTransactionByIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetTransactionByIDFromDB(ctx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetTransactionByIDFromDB), ctx, userID, transactionID)
+}
+
+// GetTransactionsFromDB mocks base method.
+func (m *MockresourceProvider) GetTransactionsFromDB(ctx context.Context, param ListTransactionsParam) ([]entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsFromDB", ctx, param)
+	ret0, _ := ret[0].([]entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsFromDB indicates an expected call of GetTransactionsFromDB.
+func (mr *MockresourceProviderMockRecorder) GetTransactionsFromDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetTransactionsFromDB), ctx, param)
+}
+
+// InsertTransactionToDB mocks base method.
+func (m *MockresourceProvider) InsertTransactionToDB(ctx context.Context, param CreateTransactionParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTransactionToDB", ctx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertTransactionToDB indicates an expected call of InsertTransactionToDB.
+func (mr *MockresourceProviderMockRecorder) InsertTransactionToDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTransactionToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertTransactionToDB), ctx, param)
+}
+
+// UpdateTransactionInDB mocks base method.
+func (m *MockresourceProvider) UpdateTransactionInDB(ctx context.Context, param UpdateTransactionParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionInDB", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransactionInDB indicates an expected call of UpdateTransactionInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateTransactionInDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateTransactionInDB), ctx, param)
+}
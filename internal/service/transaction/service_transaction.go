@@ -0,0 +1,115 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+)
+
+var (
+	// ErrTransactionNotFound is returned when a user doesn't have the requested transaction.
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrWalletNotFound is returned when a transaction is recorded on a wallet the user doesn't have.
+	ErrWalletNotFound = errors.New("wallet not found")
+)
+
+// CreateTransaction will record a new transaction for a user and return it.
+// The balance of the wallet is adjusted in the same database transaction.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+func (svc *Service) CreateTransaction(ctx context.Context, param CreateTransactionParam) (Transaction, error) {
+	meta := map[string]interface{}{
+		"user_id":   param.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	id, err := svc.rsc.InsertTransactionToDB(ctx, param)
+	if err != nil {
+		log.Printf("[CreateTransaction] svc.rsc.InsertTransactionToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	transaction, err := svc.GetTransaction(ctx, param.UserID, id)
+	if err != nil {
+		log.Printf("[CreateTransaction] svc.GetTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	return transaction, nil
+}
+
+// DeleteTransaction will delete a transaction of a user and take it out of the balance of its wallet.
+// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+func (svc *Service) DeleteTransaction(ctx context.Context, userID, transactionID int64) error {
+	err := svc.rsc.DeleteTransactionInDB(ctx, userID, transactionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"transaction_id": transactionID,
+			"user_id":        userID,
+		}
+
+		log.Printf("[DeleteTransaction] svc.rsc.DeleteTransactionInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// GetTransaction will fetch a transaction of a user.
+// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+func (svc *Service) GetTransaction(ctx context.Context, userID, transactionID int64) (Transaction, error) {
+	meta := map[string]interface{}{
+		"transaction_id": transactionID,
+		"user_id":        userID,
+	}
+
+	transaction, err := svc.rsc.GetTransactionByIDFromDB(ctx, userID, transactionID)
+	if err != nil {
+		log.Printf("[GetTransaction] svc.rsc.GetTransactionByIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	if transaction.ID == 0 {
+		log.Printf("[GetTransaction] transaction not found\nMeta:%+v\n", meta)
+		return Transaction{}, ErrTransactionNotFound
+	}
+
+	return Transaction(transaction), nil
+}
+
+// ListTransactions will fetch transactions of a user, ordered from the most recent transaction.
+func (svc *Service) ListTransactions(ctx context.Context, param ListTransactionsParam) ([]Transaction, error) {
+	transactions, err := svc.rsc.GetTransactionsFromDB(ctx, param)
+	if err != nil {
+		log.Printf("[ListTransactions] svc.rsc.GetTransactionsFromDB() got an error: %+v\nMeta:%+v\n", err, param)
+		return nil, err
+	}
+
+	result := make([]Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		result = append(result, Transaction(transaction))
+	}
+
+	return result, nil
+}
+
+// UpdateTransaction will update a transaction of a user.
+// The balance of the old and new wallet is adjusted in the same database transaction.
+// If the user doesn't have the transaction, it will return ErrTransactionNotFound,
+// and if the user doesn't have the new wallet, it will return ErrWalletNotFound.
+func (svc *Service) UpdateTransaction(ctx context.Context, param UpdateTransactionParam) error {
+	err := svc.rsc.UpdateTransactionInDB(ctx, param)
+	if err != nil {
+		meta := map[string]interface{}{
+			"transaction_id": param.ID,
+			"user_id":        param.UserID,
+			"wallet_id":      param.WalletID,
+		}
+
+		log.Printf("[UpdateTransaction] svc.rsc.UpdateTransactionInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_CreateTransaction(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := CreateTransactionParam{
+		Amount:       25000,
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     7,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Transaction
+		wantErr    error
+	}{
+		{
+			name: "when_InsertTransactionToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertTransactionToDB(context.Background(), mockParam).Return(int64(0), ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_GetTransaction_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertTransactionToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetTransactionByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_transaction",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertTransactionToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetTransactionByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Transaction{
+					Amount:   25000,
+					ID:       1,
+					Type:     entity.TransactionTypeExpense,
+					UserID:   123,
+					WalletID: 7,
+				}, nil)
+			},
+			want: Transaction{
+				Amount:   25000,
+				ID:       1,
+				Type:     entity.TransactionTypeExpense,
+				UserID:   123,
+				WalletID: 7,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.CreateTransaction(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_DeleteTransaction(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_DeleteTransactionInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteTransactionInDB(context.Background(), int64(123), int64(1)).Return(ErrTransactionNotFound)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteTransactionInDB(context.Background(), int64(123), int64(1)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.DeleteTransaction(context.Background(), 123, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetTransaction(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Transaction
+		wantErr    error
+	}{
+		{
+			name: "when_GetTransactionByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetTransactionByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_transaction_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetTransactionByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Transaction{}, nil)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_transaction",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetTransactionByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Transaction{ID: 1, UserID: 123}, nil)
+			},
+			want: Transaction{ID: 1, UserID: 123},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetTransaction(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListTransactions(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := ListTransactionsParam{
+		Limit:  20,
+		UserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Transaction
+		wantErr    error
+	}{
+		{
+			name: "when_GetTransactionsFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetTransactionsFromDB(context.Background(), mockParam).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_transactions",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetTransactionsFromDB(context.Background(), mockParam).Return([]entity.Transaction{{ID: 2}, {ID: 1}}, nil)
+			},
+			want: []Transaction{{ID: 2}, {ID: 1}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListTransactions(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_UpdateTransaction(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+
+	mockParam := UpdateTransactionParam{
+		Amount:   30000,
+		ID:       1,
+		Type:     entity.TransactionTypeIncome,
+		UserID:   123,
+		WalletID: 7,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateTransactionInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateTransactionInDB(context.Background(), mockParam).Return(ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateTransactionInDB(context.Background(), mockParam).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.UpdateTransaction(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	// golang package
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// Transaction is an entity representational of Transaction.
+type Transaction entity.Transaction
+
+// CreateTransactionParam represents parameters needed to record a transaction.
+// A zero CategoryID means the transaction isn't categorized.
+type CreateTransactionParam struct {
+	Amount       int64
+	CategoryID   int64
+	Note         string
+	Payee        string
+	Tags         []string
+	TransactedAt time.Time
+	Type         string
+	UserID       int64
+	WalletID     int64
+}
+
+// ListTransactionsParam represents parameters needed to fetch transactions of a user.
+// Transactions of every wallet are fetched when WalletID is zero.
+type ListTransactionsParam struct {
+	Limit    int
+	Offset   int
+	UserID   int64
+	WalletID int64
+}
+
+// UpdateTransactionParam represents parameters needed to update a transaction.
+// A zero CategoryID means the transaction isn't categorized.
+type UpdateTransactionParam struct {
+	Amount       int64
+	CategoryID   int64
+	ID           int64
+	Note         string
+	Payee        string
+	Tags         []string
+	TransactedAt time.Time
+	Type         string
+	UserID       int64
+	WalletID     int64
+}
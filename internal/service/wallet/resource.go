@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+//go:generate mockgen -source=./resource.go -destination=./resource_mock.go -package=wallet
+
+// dbRepoProvider holds all methods from db repo that wil be used in wallet's resource.
+type dbRepoProvider interface {
+	// BeginTX will start a new transaction.
+	BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error)
+
+	// Commit will commit the transaction.
+	Commit(tx *sql.Tx) error
+
+	// DeleteWallet will delete a wallet of a user.
+	// It returns false if the user doesn't have the wallet.
+	DeleteWallet(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error)
+
+	// GetWalletByID will fetch a wallet of a user based on its id.
+	// It returns an empty wallet if the user doesn't have the wallet.
+	GetWalletByID(ctx context.Context, userID, walletID int64) (pgsql.Wallet, error)
+
+	// GetWalletsByUserID will fetch every wallet of a user, ordered by their display order.
+	GetWalletsByUserID(ctx context.Context, userID int64) ([]pgsql.Wallet, error)
+
+	// HasWalletTransactionsForUpdate will check whether a wallet of a user has any transaction,
+	// and lock the wallet until tx is over so no transaction can be booked to it meanwhile.
+	// It returns false if the user doesn't have the wallet.
+	HasWalletTransactionsForUpdate(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error)
+
+	// InsertWallet will create a new entry in table wallet in database.
+	// It returns id of the new entry.
+	InsertWallet(ctx context.Context, tx *sql.Tx, param pgsql.InsertWalletParam) (int64, error)
+
+	// Rollback will aborts the transaction.
+	Rollback(tx *sql.Tx) error
+
+	// UpdateWallet will update a wallet of a user.
+	// It returns false if the user doesn't have the wallet.
+	UpdateWallet(ctx context.Context, tx *sql.Tx, param pgsql.UpdateWalletParam) (bool, error)
+}
+
+// WalletResourceParam holds all parameters needed to instantiate
+// a new instance of Resource.
+type WalletResourceParam struct {
+	DB dbRepoProvider
+}
+
+type Resource struct {
+	db dbRepoProvider
+}
+
+// NewResource will instantiate a new instance of Resource.
+func NewResource(param WalletResourceParam) *Resource {
+	return &Resource{
+		db: param.DB,
+	}
+}
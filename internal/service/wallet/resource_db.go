@@ -0,0 +1,219 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+// DeleteWalletInDB will delete a wallet of a user.
+// It returns false if the user doesn't have the wallet,
+// and ErrWalletHasTransactions if any transaction is still booked to it.
+func (rsc *Resource) DeleteWalletInDB(ctx context.Context, userID, walletID int64) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id":   userID,
+		"wallet_id": walletID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[DeleteWalletInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[DeleteWalletInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	hasTransactions, err := rsc.db.HasWalletTransactionsForUpdate(ctx, tx, userID, walletID)
+	if err != nil {
+		log.Printf("[DeleteWalletInDB] rsc.db.HasWalletTransactionsForUpdate() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	if hasTransactions {
+		err = ErrWalletHasTransactions
+		return false, err
+	}
+
+	deleted, err := rsc.db.DeleteWallet(ctx, tx, userID, walletID)
+	if err != nil {
+		log.Printf("[DeleteWalletInDB] rsc.db.DeleteWallet() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[DeleteWalletInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return deleted, nil
+}
+
+// GetWalletByIDFromDB will fetch a wallet of a user based on its id.
+// It returns an empty wallet if the user doesn't have the wallet.
+func (rsc *Resource) GetWalletByIDFromDB(ctx context.Context, userID, walletID int64) (entity.Wallet, error) {
+	wallet, err := rsc.db.GetWalletByID(ctx, userID, walletID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":   userID,
+			"wallet_id": walletID,
+		}
+
+		log.Printf("[GetWalletByIDFromDB] rsc.db.GetWalletByID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return entity.Wallet{}, err
+	}
+
+	return convertWallet(wallet), nil
+}
+
+// GetWalletsByUserIDFromDB will fetch every wallet of a user, ordered by their display order.
+func (rsc *Resource) GetWalletsByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Wallet, error) {
+	wallets, err := rsc.db.GetWalletsByUserID(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[GetWalletsByUserIDFromDB] rsc.db.GetWalletsByUserID() got an error: %+v\nMeta: %+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]entity.Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		result = append(result, convertWallet(wallet))
+	}
+
+	return result, nil
+}
+
+// InsertWalletToDB will create a new wallet for a user.
+// It returns id of the new wallet.
+func (rsc *Resource) InsertWalletToDB(ctx context.Context, param CreateWalletParam) (int64, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[InsertWalletToDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[InsertWalletToDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	id, err := rsc.db.InsertWallet(ctx, tx, pgsql.InsertWalletParam{
+		Currency:       param.Currency,
+		Name:           param.Name,
+		OpeningBalance: param.OpeningBalance,
+		Type:           param.Type,
+		UserID:         param.UserID,
+	})
+	if err != nil {
+		log.Printf("[InsertWalletToDB] rsc.db.InsertWallet() got an error: %+v\nMeta: %+v\n", err, meta)
+		return 0, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[InsertWalletToDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return 0, errCommit
+	}
+
+	return id, nil
+}
+
+// UpdateWalletInDB will update a wallet of a user.
+// It returns false if the user doesn't have the wallet.
+func (rsc *Resource) UpdateWalletInDB(ctx context.Context, param UpdateWalletParam) (bool, error) {
+	meta := map[string]interface{}{
+		"user_id":   param.UserID,
+		"wallet_id": param.ID,
+	}
+
+	var err error
+	tx, err := rsc.db.BeginTX(ctx, nil)
+	if err != nil {
+		log.Printf("[UpdateWalletInDB] rsc.db.BeginTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	defer func() {
+		errRollback := rsc.rollbackTX(ctx, tx, err)
+		if errRollback != nil {
+			log.Printf("[UpdateWalletInDB] rsc.rollbackTX() got an error: %+v\nMeta: %+v\n", err, meta)
+		}
+	}()
+
+	updated, err := rsc.db.UpdateWallet(ctx, tx, pgsql.UpdateWalletParam{
+		Archived:       param.Archived,
+		Currency:       param.Currency,
+		DisplayOrder:   param.DisplayOrder,
+		ID:             param.ID,
+		Name:           param.Name,
+		OpeningBalance: param.OpeningBalance,
+		Type:           param.Type,
+		UserID:         param.UserID,
+	})
+	if err != nil {
+		log.Printf("[UpdateWalletInDB] rsc.db.UpdateWallet() got an error: %+v\nMeta: %+v\n", err, meta)
+		return false, err
+	}
+
+	errCommit := rsc.db.Commit(tx)
+	if errCommit != nil {
+		log.Printf("[UpdateWalletInDB] rsc.db.Commit() got an error: %+v\nMeta: %+v\n", errCommit, meta)
+		return false, errCommit
+	}
+
+	return updated, nil
+}
+
+// rollbackTX will rollback a transaction if any error occured.
+func (rsc *Resource) rollbackTX(ctx context.Context, tx *sql.Tx, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errRollback := rsc.db.Rollback(tx)
+	if errRollback != nil {
+		log.Printf("[rollbackTX] rsc.db.Rollback() got an error: %+v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// convertWallet will convert user's wallet saved in database.
+func convertWallet(wallet pgsql.Wallet) entity.Wallet {
+	return entity.Wallet{
+		ArchivedAt:     wallet.ArchivedAt.Time,
+		Balance:        wallet.Balance,
+		CreatedAt:      wallet.CreatedAt,
+		Currency:       wallet.Currency,
+		DisplayOrder:   wallet.DisplayOrder,
+		ID:             wallet.ID,
+		Name:           wallet.Name,
+		OpeningBalance: wallet.OpeningBalance,
+		Type:           wallet.Type,
+		UpdatedAt:      wallet.UpdatedAt.Time,
+		UserID:         wallet.UserID,
+	}
+}
@@ -0,0 +1,407 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/repository/pgsql"
+)
+
+func TestResource_DeleteWalletInDB(t *testing.T) {
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_HasWalletTransactionsForUpdate_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().HasWalletTransactionsForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_has_transactions_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().HasWalletTransactionsForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: ErrWalletHasTransactions,
+		},
+		{
+			name: "when_DeleteWallet_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().HasWalletTransactionsForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, nil)
+				mf.db.EXPECT().DeleteWallet(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().HasWalletTransactionsForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, nil)
+				mf.db.EXPECT().DeleteWallet(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_deleted",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().HasWalletTransactionsForUpdate(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(false, nil)
+				mf.db.EXPECT().DeleteWallet(context.Background(), &sql.Tx{}, int64(123), int64(1)).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.DeleteWalletInDB(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetWalletByIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       entity.Wallet
+		wantErr    error
+	}{
+		{
+			name: "when_GetWalletByID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetWalletByID(context.Background(), int64(123), int64(1)).Return(pgsql.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallet",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetWalletByID(context.Background(), int64(123), int64(1)).Return(pgsql.Wallet{
+					ArchivedAt:     sql.NullTime{Time: mockTime, Valid: true},
+					Balance:        175000,
+					CreatedAt:      mockTime,
+					Currency:       "IDR",
+					DisplayOrder:   2,
+					ID:             1,
+					Name:           "BCA",
+					OpeningBalance: 150000,
+					Type:           entity.WalletTypeBank,
+					UserID:         123,
+				}, nil)
+			},
+			want: entity.Wallet{
+				ArchivedAt:     mockTime,
+				Balance:        175000,
+				CreatedAt:      mockTime,
+				Currency:       "IDR",
+				DisplayOrder:   2,
+				ID:             1,
+				Name:           "BCA",
+				OpeningBalance: 150000,
+				Type:           entity.WalletTypeBank,
+				UserID:         123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetWalletByIDFromDB(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_GetWalletsByUserIDFromDB(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []entity.Wallet
+		wantErr    error
+	}{
+		{
+			name: "when_GetWalletsByUserID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetWalletsByUserID(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallets",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().GetWalletsByUserID(context.Background(), int64(123)).Return([]pgsql.Wallet{
+					{
+						CreatedAt: mockTime,
+						Currency:  "IDR",
+						ID:        1,
+						Name:      "Cash",
+						Type:      entity.WalletTypeCash,
+						UpdatedAt: sql.NullTime{Time: mockTime, Valid: true},
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []entity.Wallet{
+				{
+					CreatedAt: mockTime,
+					Currency:  "IDR",
+					ID:        1,
+					Name:      "Cash",
+					Type:      entity.WalletTypeCash,
+					UpdatedAt: mockTime,
+					UserID:    123,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.GetWalletsByUserIDFromDB(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_InsertWalletToDB(t *testing.T) {
+	mockParam := CreateWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	mockDBParam := pgsql.InsertWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       int64
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InsertWallet_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(0), assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_id",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().InsertWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(int64(1), nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.InsertWalletToDB(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResource_UpdateWalletInDB(t *testing.T) {
+	mockParam := UpdateWalletParam{
+		Archived:       true,
+		Currency:       "IDR",
+		DisplayOrder:   3,
+		ID:             1,
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	mockDBParam := pgsql.UpdateWalletParam{
+		Archived:       true,
+		Currency:       "IDR",
+		DisplayOrder:   3,
+		ID:             1,
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	type mockFields struct {
+		db *MockdbRepoProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       bool
+		wantErr    error
+	}{
+		{
+			name: "when_BeginTX_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_UpdateWallet_error_then_rollback_transaction_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(false, assert.AnError)
+				mf.db.EXPECT().Rollback(&sql.Tx{}).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_failed_to_commit_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_updated",
+			mockFields: func(mf mockFields) {
+				mf.db.EXPECT().BeginTX(context.Background(), nil).Return(&sql.Tx{}, nil)
+				mf.db.EXPECT().UpdateWallet(context.Background(), &sql.Tx{}, mockDBParam).Return(true, nil)
+				mf.db.EXPECT().Commit(&sql.Tx{}).Return(nil)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				db: NewMockdbRepoProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			rsc := Resource{
+				db: mockFields.db,
+			}
+
+			got, err := rsc.UpdateWalletInDB(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
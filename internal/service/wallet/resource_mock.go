@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./resource.go
+
+// Package wallet is a generated GoMock package.
+package wallet
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	pgsql "github.com/arifinhermawan/bubi/internal/repository/pgsql"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// BeginTX mocks base method.
+func (m *MockdbRepoProvider) BeginTX(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTX", ctx, options)
+	ret0, _ := ret[0].(*sql.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTX indicates an expected call of BeginTX.
+func (mr *MockdbRepoProviderMockRecorder) BeginTX(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTX", reflect.TypeOf((*MockdbRepoProvider)(nil).BeginTX), ctx, options)
+}
+
+// Commit mocks base method.
+func (m *MockdbRepoProvider) Commit(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockdbRepoProviderMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockdbRepoProvider)(nil).Commit), tx)
+}
+
+// DeleteWallet mocks base method.
+func (m *MockdbRepoProvider) DeleteWallet(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWallet", ctx, tx, userID, walletID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWallet indicates an expected call of DeleteWallet.
+func (mr *MockdbRepoProviderMockRecorder) DeleteWallet(ctx, tx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWallet", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteWallet), ctx, tx, userID, walletID)
+}
+
+// GetWalletByID mocks base method.
+func (m *MockdbRepoProvider) GetWalletByID(ctx context.Context, userID, walletID int64) (pgsql.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByID", ctx, userID, walletID)
+	ret0, _ := ret[0].(pgsql.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByID indicates an expected call of GetWalletByID.
+func (mr *MockdbRepoProviderMockRecorder) GetWalletByID(ctx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetWalletByID), ctx, userID, walletID)
+}
+
+// GetWalletsByUserID mocks base method.
+func (m *MockdbRepoProvider) GetWalletsByUserID(ctx context.Context, userID int64) ([]pgsql.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]pgsql.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletsByUserID indicates an expected call of GetWalletsByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetWalletsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetWalletsByUserID), ctx, userID)
+}
+
+// HasWalletTransactionsForUpdate mocks base method.
+func (m *MockdbRepoProvider) HasWalletTransactionsForUpdate(ctx context.Context, tx *sql.Tx, userID, walletID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasWalletTransactionsForUpdate", ctx, tx, userID, walletID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasWalletTransactionsForUpdate indicates an expected call of HasWalletTransactionsForUpdate.
+func (mr *MockdbRepoProviderMockRecorder) HasWalletTransactionsForUpdate(ctx, tx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasWalletTransactionsForUpdate", reflect.TypeOf((*MockdbRepoProvider)(nil).HasWalletTransactionsForUpdate), ctx, tx, userID, walletID)
+}
+
+// InsertWallet mocks base method.
+func (m *MockdbRepoProvider) InsertWallet(ctx context.Context, tx *sql.Tx, param pgsql.InsertWalletParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWallet", ctx, tx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWallet indicates an expected call of InsertWallet.
+func (mr *MockdbRepoProviderMockRecorder) InsertWallet(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWallet", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertWallet), ctx, tx, param)
+}
+
+// Rollback mocks base method.
+func (m *MockdbRepoProvider) Rollback(tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockdbRepoProviderMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockdbRepoProvider)(nil).Rollback), tx)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockdbRepoProvider) UpdateWallet(ctx context.Context, tx *sql.Tx, param pgsql.UpdateWalletParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, tx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockdbRepoProviderMockRecorder) UpdateWallet(ctx, tx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateWallet), ctx, tx, param)
+}
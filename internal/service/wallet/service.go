@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+//go:generate mockgen -source=./service.go -destination=./service_mock.go -package=wallet
+
+// resourceProvider holds all methods from resource that wil be used in wallet's service.
+type resourceProvider interface {
+	// DeleteWalletInDB will delete a wallet of a user.
+	// It returns false if the user doesn't have the wallet,
+	// and ErrWalletHasTransactions if any transaction is still booked to it.
+	DeleteWalletInDB(ctx context.Context, userID, walletID int64) (bool, error)
+
+	// GetWalletByIDFromDB will fetch a wallet of a user based on its id.
+	// It returns an empty wallet if the user doesn't have the wallet.
+	GetWalletByIDFromDB(ctx context.Context, userID, walletID int64) (entity.Wallet, error)
+
+	// GetWalletsByUserIDFromDB will fetch every wallet of a user, ordered by their display order.
+	GetWalletsByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Wallet, error)
+
+	// InsertWalletToDB will create a new wallet for a user.
+	// It returns id of the new wallet.
+	InsertWalletToDB(ctx context.Context, param CreateWalletParam) (int64, error)
+
+	// UpdateWalletInDB will update a wallet of a user.
+	// It returns false if the user doesn't have the wallet.
+	UpdateWalletInDB(ctx context.Context, param UpdateWalletParam) (bool, error)
+}
+
+// WalletServiceParam holds all parameters needed to instantiate
+// a new instance of Service.
+type WalletServiceParam struct {
+	Rsc resourceProvider
+}
+
+type Service struct {
+	rsc resourceProvider
+}
+
+// NewService will instantiate a new instance of Service.
+func NewService(param WalletServiceParam) *Service {
+	return &Service{
+		rsc: param.Rsc,
+	}
+}
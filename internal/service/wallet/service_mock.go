@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package wallet is a generated GoMock package.
+package wallet
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockresourceProvider is a mock of resourceProvider interface.
+type MockresourceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceProviderMockRecorder
+}
+
+// MockresourceProviderMockRecorder is the mock recorder for MockresourceProvider.
+type MockresourceProviderMockRecorder struct {
+	mock *MockresourceProvider
+}
+
+// NewMockresourceProvider creates a new mock instance.
+func NewMockresourceProvider(ctrl *gomock.Controller) *MockresourceProvider {
+	mock := &MockresourceProvider{ctrl: ctrl}
+	mock.recorder = &MockresourceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceProvider) EXPECT() *MockresourceProviderMockRecorder {
+	return m.recorder
+}
+
+// DeleteWalletInDB mocks base method.
+func (m *MockresourceProvider) DeleteWalletInDB(ctx context.Context, userID, walletID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWalletInDB", ctx, userID, walletID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWalletInDB indicates an expected call of DeleteWalletInDB.
+func (mr *MockresourceProviderMockRecorder) DeleteWalletInDB(ctx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWalletInDB", reflect.TypeOf((*MockresourceProvider)(nil).DeleteWalletInDB), ctx, userID, walletID)
+}
+
+// GetWalletByIDFromDB mocks base method.
+func (m *MockresourceProvider) GetWalletByIDFromDB(ctx context.Context, userID, walletID int64) (entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByIDFromDB", ctx, userID, walletID)
+	ret0, _ := ret[0].(entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByIDFromDB indicates an expected call of GetWalletByIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetWalletByIDFromDB(ctx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetWalletByIDFromDB), ctx, userID, walletID)
+}
+
+// GetWalletsByUserIDFromDB mocks base method.
+func (m *MockresourceProvider) GetWalletsByUserIDFromDB(ctx context.Context, userID int64) ([]entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletsByUserIDFromDB", ctx, userID)
+	ret0, _ := ret[0].([]entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletsByUserIDFromDB indicates an expected call of GetWalletsByUserIDFromDB.
+func (mr *MockresourceProviderMockRecorder) GetWalletsByUserIDFromDB(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletsByUserIDFromDB", reflect.TypeOf((*MockresourceProvider)(nil).GetWalletsByUserIDFromDB), ctx, userID)
+}
+
+// InsertWalletToDB mocks base method.
+func (m *MockresourceProvider) InsertWalletToDB(ctx context.Context, param CreateWalletParam) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWalletToDB", ctx, param)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWalletToDB indicates an expected call of InsertWalletToDB.
+func (mr *MockresourceProviderMockRecorder) InsertWalletToDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWalletToDB", reflect.TypeOf((*MockresourceProvider)(nil).InsertWalletToDB), ctx, param)
+}
+
+// UpdateWalletInDB mocks base method.
+func (m *MockresourceProvider) UpdateWalletInDB(ctx context.Context, param UpdateWalletParam) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWalletInDB", ctx, param)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWalletInDB indicates an expected call of UpdateWalletInDB.
+func (mr *MockresourceProviderMockRecorder) UpdateWalletInDB(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWalletInDB", reflect.TypeOf((*MockresourceProvider)(nil).UpdateWalletInDB), ctx, param)
+}
@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+)
+
+var (
+	// ErrWalletHasTransactions is returned when a wallet to be deleted still has transactions booked to it.
+	ErrWalletHasTransactions = errors.New("wallet has transactions")
+
+	// ErrWalletNotFound is returned when a user doesn't have the requested wallet.
+	ErrWalletNotFound = errors.New("wallet not found")
+)
+
+// CreateWallet will create a new wallet for a user and return it.
+// The new wallet is placed after every other wallet of the user.
+func (svc *Service) CreateWallet(ctx context.Context, param CreateWalletParam) (Wallet, error) {
+	meta := map[string]interface{}{
+		"name":    param.Name,
+		"user_id": param.UserID,
+	}
+
+	id, err := svc.rsc.InsertWalletToDB(ctx, param)
+	if err != nil {
+		log.Printf("[CreateWallet] svc.rsc.InsertWalletToDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	wallet, err := svc.GetWallet(ctx, param.UserID, id)
+	if err != nil {
+		log.Printf("[CreateWallet] svc.GetWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	return wallet, nil
+}
+
+// DeleteWallet will delete a wallet of a user.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+// A wallet with transactions is refused with ErrWalletHasTransactions, so its history isn't lost.
+func (svc *Service) DeleteWallet(ctx context.Context, userID, walletID int64) error {
+	meta := map[string]interface{}{
+		"user_id":   userID,
+		"wallet_id": walletID,
+	}
+
+	deleted, err := svc.rsc.DeleteWalletInDB(ctx, userID, walletID)
+	if err != nil {
+		log.Printf("[DeleteWallet] svc.rsc.DeleteWalletInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !deleted {
+		log.Printf("[DeleteWallet] wallet not found\nMeta:%+v\n", meta)
+		return ErrWalletNotFound
+	}
+
+	return nil
+}
+
+// GetWallet will fetch a wallet of a user.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+func (svc *Service) GetWallet(ctx context.Context, userID, walletID int64) (Wallet, error) {
+	meta := map[string]interface{}{
+		"user_id":   userID,
+		"wallet_id": walletID,
+	}
+
+	wallet, err := svc.rsc.GetWalletByIDFromDB(ctx, userID, walletID)
+	if err != nil {
+		log.Printf("[GetWallet] svc.rsc.GetWalletByIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	if wallet.ID == 0 {
+		log.Printf("[GetWallet] wallet not found\nMeta:%+v\n", meta)
+		return Wallet{}, ErrWalletNotFound
+	}
+
+	return Wallet(wallet), nil
+}
+
+// ListWallets will fetch every wallet of a user, ordered by their display order.
+func (svc *Service) ListWallets(ctx context.Context, userID int64) ([]Wallet, error) {
+	wallets, err := svc.rsc.GetWalletsByUserIDFromDB(ctx, userID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": userID,
+		}
+
+		log.Printf("[ListWallets] svc.rsc.GetWalletsByUserIDFromDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		result = append(result, Wallet(wallet))
+	}
+
+	return result, nil
+}
+
+// UpdateWallet will update a wallet of a user.
+// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+func (svc *Service) UpdateWallet(ctx context.Context, param UpdateWalletParam) error {
+	meta := map[string]interface{}{
+		"user_id":   param.UserID,
+		"wallet_id": param.ID,
+	}
+
+	updated, err := svc.rsc.UpdateWalletInDB(ctx, param)
+	if err != nil {
+		log.Printf("[UpdateWallet] svc.rsc.UpdateWalletInDB() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if !updated {
+		log.Printf("[UpdateWallet] wallet not found\nMeta:%+v\n", meta)
+		return ErrWalletNotFound
+	}
+
+	return nil
+}
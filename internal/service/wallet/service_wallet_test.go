@@ -0,0 +1,300 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+func TestService_CreateWallet(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockParam := CreateWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+	mockWallet := entity.Wallet{
+		CreatedAt:      mockTime,
+		Currency:       "IDR",
+		DisplayOrder:   1,
+		ID:             1,
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Wallet
+		wantErr    error
+	}{
+		{
+			name: "when_InsertWalletToDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertWalletToDB(context.Background(), mockParam).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetWalletByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertWalletToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetWalletByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallet",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().InsertWalletToDB(context.Background(), mockParam).Return(int64(1), nil)
+				mf.rsc.EXPECT().GetWalletByIDFromDB(context.Background(), int64(123), int64(1)).Return(mockWallet, nil)
+			},
+			want: Wallet(mockWallet),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.CreateWallet(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_DeleteWallet(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_DeleteWalletInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteWalletInDB(context.Background(), int64(123), int64(1)).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteWalletInDB(context.Background(), int64(123), int64(1)).Return(false, nil)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().DeleteWalletInDB(context.Background(), int64(123), int64(1)).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.DeleteWallet(context.Background(), 123, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_GetWallet(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       Wallet
+		wantErr    error
+	}{
+		{
+			name: "when_GetWalletByIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetWalletByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetWalletByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Wallet{}, nil)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallet",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetWalletByIDFromDB(context.Background(), int64(123), int64(1)).Return(entity.Wallet{
+					ID:     1,
+					Name:   "Cash",
+					UserID: 123,
+				}, nil)
+			},
+			want: Wallet{
+				ID:     1,
+				Name:   "Cash",
+				UserID: 123,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.GetWallet(context.Background(), 123, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_ListWallets(t *testing.T) {
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       []Wallet
+		wantErr    error
+	}{
+		{
+			name: "when_GetWalletsByUserIDFromDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetWalletsByUserIDFromDB(context.Background(), int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallets",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().GetWalletsByUserIDFromDB(context.Background(), int64(123)).Return([]entity.Wallet{
+					{ID: 1, Name: "Cash"},
+					{ID: 2, Name: "BCA"},
+				}, nil)
+			},
+			want: []Wallet{
+				{ID: 1, Name: "Cash"},
+				{ID: 2, Name: "BCA"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			got, err := svc.ListWallets(context.Background(), 123)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestService_UpdateWallet(t *testing.T) {
+	mockParam := UpdateWalletParam{
+		Currency: "IDR",
+		ID:       1,
+		Name:     "BCA",
+		Type:     entity.WalletTypeBank,
+		UserID:   123,
+	}
+
+	type mockFields struct {
+		rsc *MockresourceProvider
+	}
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_UpdateWalletInDB_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateWalletInDB(context.Background(), mockParam).Return(false, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateWalletInDB(context.Background(), mockParam).Return(false, nil)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil_error",
+			mockFields: func(mf mockFields) {
+				mf.rsc.EXPECT().UpdateWalletInDB(context.Background(), mockParam).Return(true, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				rsc: NewMockresourceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			svc := &Service{
+				rsc: mockFields.rsc,
+			}
+
+			err := svc.UpdateWallet(context.Background(), mockParam)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package wallet
+
+import (
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+// Wallet is an entity representational of Wallet.
+type Wallet entity.Wallet
+
+// CreateWalletParam represents parameters needed to create a wallet.
+type CreateWalletParam struct {
+	Currency       string
+	Name           string
+	OpeningBalance int64
+	Type           string
+	UserID         int64
+}
+
+// UpdateWalletParam represents parameters needed to update a wallet.
+// Every field is saved as is, so unchanged fields have to be filled with their current value.
+type UpdateWalletParam struct {
+	Archived       bool
+	Currency       string
+	DisplayOrder   int
+	ID             int64
+	Name           string
+	OpeningBalance int64
+	Type           string
+	UserID         int64
+}
@@ -7,98 +7,357 @@ import (
 	"log"
 
 	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/service/account"
 )
 
 var (
+	// ErrAccountDisabled is returned when log in is refused because the account is disabled by an admin.
+	ErrAccountDisabled = errors.New("account disabled")
+
+	// ErrAccountLocked is returned when log in is refused because of too many failed attempts.
+	ErrAccountLocked = errors.New("too many failed log in attempts, account is temporarily locked")
+
+	// ErrLoginThrottled is returned when log in is refused because the previous attempt failed too recently.
+	ErrLoginThrottled = errors.New("too many failed log in attempts, try again later")
+
+	// ErrRefreshTokenInvalid is returned when a refresh token can't be exchanged.
+	ErrRefreshTokenInvalid = errors.New("refresh token not valid")
+
+	errUnauthorized = errors.New("unauthorized!")
 	errUserExist    = errors.New("user already exist!")
 	errUserNotExist = errors.New("user not exist!")
 )
 
 // LogIn handles the log in process for a user.
 // It will check the existence of a user first.
-// If it exist, then it will continue the log in process.
-func (uc *UseCase) LogIn(ctx context.Context, email, password string) (string, error) {
+// If it exist, then it will continue the log in process
+// by starting a new session for the device and issuing
+// a short-lived JWT and a refresh token for that session.
+// If the account has TOTP enabled, it only returns an MFA challenge token
+// that has to be exchanged using LogInMFA.
+// Failed attempts are counted per email and per IP address,
+// and once they pass the configured thresholds it returns a *LoginBlockedError.
+func (uc *UseCase) LogIn(ctx context.Context, param LogInParam) (JWT, error) {
 	meta := map[string]interface{}{
-		"email": email,
+		"email":       param.Email,
+		"device_name": param.DeviceName,
+		"ip_address":  param.IPAddress,
 	}
 
-	acc, err := uc.account.GetUserAccountByEmail(ctx, email)
+	retryAfter, err := uc.account.CheckLoginAttempt(ctx, param.Email, param.IPAddress)
+	if err != nil {
+		log.Printf("[LogIn] uc.account.CheckLoginAttempt() got an error: %+v\nMeta:%+v\n", err, meta)
+		switch {
+		case errors.Is(err, account.ErrAccountLocked):
+			return JWT{}, &LoginBlockedError{Err: ErrAccountLocked, RetryAfter: retryAfter}
+		case errors.Is(err, account.ErrLoginThrottled):
+			return JWT{}, &LoginBlockedError{Err: ErrLoginThrottled, RetryAfter: retryAfter}
+		}
+
+		return JWT{}, err
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, param.Email)
 	if err != nil {
 		log.Printf("[LogIn] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
-		return "", err
+		return JWT{}, err
 	}
 
-	accountNotExist := acc == account.Account{}
+	accountNotExist := acc.ID == 0
 	if accountNotExist {
 		log.Printf("[LogIn] User not exist!\nMeta:%+v\n", meta)
-		return "", errUserNotExist
+		uc.recordLoginFailure(ctx, param.Email, param.IPAddress)
+		return JWT{}, errUserNotExist
 	}
 
-	err = uc.account.CheckPasswordCorrect(ctx, email, password)
+	err = uc.account.CheckPasswordCorrect(ctx, param.Email, param.Password)
 	if err != nil {
 		log.Printf("[LogIn] uc.account.CheckPasswordCorrect() got an error: %+v\nMeta:%+v\n", err, meta)
-		return "", err
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			uc.recordLoginFailure(ctx, param.Email, param.IPAddress)
+			uc.recordAuditEvent(ctx, acc.ID, account.AuditEventLoginFailed)
+		}
+
+		return JWT{}, err
+	}
+
+	return uc.completeLogIn(ctx, acc, account.CreateSessionParam{
+		DeviceName: param.DeviceName,
+		IPAddress:  param.IPAddress,
+		UserAgent:  param.UserAgent,
+	})
+}
+
+// completeLogIn will finish log in of an account whose credential had been verified.
+// A disabled account is refused. If the account has TOTP enabled, it only returns an MFA challenge token.
+// Otherwise it cancels a pending deletion of the account, then starts a new session
+// for the device described by param and issues a short-lived JWT and a refresh token for that session.
+func (uc *UseCase) completeLogIn(ctx context.Context, acc account.Account, param account.CreateSessionParam) (JWT, error) {
+	meta := map[string]interface{}{
+		"device_name": param.DeviceName,
+		"ip_address":  param.IPAddress,
+		"user_id":     acc.ID,
+	}
+
+	err := uc.account.CheckEmailVerified(acc)
+	if err != nil {
+		log.Printf("[completeLogIn] uc.account.CheckEmailVerified() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrEmailNotVerified) {
+			return JWT{}, ErrEmailNotVerified
+		}
+
+		return JWT{}, err
+	}
+
+	if !acc.DisabledAt.IsZero() {
+		log.Printf("[completeLogIn] account disabled\nMeta:%+v\n", meta)
+		return JWT{}, ErrAccountDisabled
 	}
 
-	token, err := uc.account.GenerateJWT(ctx, acc.ID, email)
+	// failed attempts are kept until the TOTP code is verified,
+	// so guessing the code is limited the same way as guessing the password.
+	if !acc.TOTPEnabledAt.IsZero() {
+		challengeToken, err := uc.account.CreateMFAChallenge(ctx, acc)
+		if err != nil {
+			log.Printf("[completeLogIn] uc.account.CreateMFAChallenge() got an error: %+v\nMeta:%+v\n", err, meta)
+			return JWT{}, err
+		}
+
+		return JWT{MFAChallengeToken: challengeToken}, nil
+	}
+
+	uc.resetLoginFailures(ctx, acc.Email)
+
+	err = uc.cancelAccountDeletion(ctx, acc)
 	if err != nil {
-		log.Printf("[LogIn] uc.account.CheckIsAccountExist() got an error: %+v\nMeta:%+v\n", err, meta)
-		return "", err
+		log.Printf("[completeLogIn] uc.cancelAccountDeletion() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
 	}
 
-	return token, nil
+	param.Role = acc.Role
+	param.UserID = acc.ID
+	return uc.issueJWT(ctx, param, acc.Email)
 }
 
-// LogOut handles the log out process for a user.
-func (uc *UseCase) LogOut(ctx context.Context, userID int64) error {
+// issueJWT will start a new session for a device and issue
+// a short-lived JWT and a refresh token for that session.
+func (uc *UseCase) issueJWT(ctx context.Context, param account.CreateSessionParam, email string) (JWT, error) {
 	meta := map[string]interface{}{
-		"user_id": userID,
+		"device_name": param.DeviceName,
+		"user_id":     param.UserID,
+	}
+
+	session, err := uc.account.NewSession(param)
+	if err != nil {
+		log.Printf("[issueJWT] uc.account.NewSession() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
 	}
 
-	err := uc.account.InvalidateJWT(ctx, userID)
+	token, err := uc.account.GenerateJWT(ctx, session, email)
 	if err != nil {
-		log.Printf("[LogOut] uc.account.InvalidateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[issueJWT] uc.account.GenerateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
+	}
+
+	refreshToken, err := uc.account.GenerateRefreshToken(ctx, session, email)
+	if err != nil {
+		log.Printf("[issueJWT] uc.account.GenerateRefreshToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
+	}
+
+	uc.recordAuditEvent(ctx, param.UserID, account.AuditEventLoginSucceeded)
+
+	return JWT{
+		RefreshToken: refreshToken,
+		Token:        token,
+	}, nil
+}
+
+// recordLoginFailure will count a failed log in attempt.
+// Failing to count it shouldn't change the result of the log in,
+// so the error is only logged.
+func (uc *UseCase) recordLoginFailure(ctx context.Context, email, ipAddress string) {
+	err := uc.account.RecordLoginFailure(ctx, email, ipAddress)
+	if err != nil {
+		meta := map[string]interface{}{
+			"email":      email,
+			"ip_address": ipAddress,
+		}
+
+		log.Printf("[recordLoginFailure] uc.account.RecordLoginFailure() got an error: %+v\nMeta:%+v\n", err, meta)
+	}
+}
+
+// resetLoginFailures will clear failed log in attempts of an email.
+// Failed attempts will expire on their own, so the error is only logged.
+func (uc *UseCase) resetLoginFailures(ctx context.Context, email string) {
+	err := uc.account.ResetLoginFailures(ctx, email)
+	if err != nil {
+		meta := map[string]interface{}{
+			"email": email,
+		}
+
+		log.Printf("[resetLoginFailures] uc.account.ResetLoginFailures() got an error: %+v\nMeta:%+v\n", err, meta)
+	}
+}
+
+// LogOut handles the log out process for the user acting on ctx.
+// Only the session used by the request is revoked,
+// other devices of the user stay logged in.
+func (uc *UseCase) LogOut(ctx context.Context) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[LogOut] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"user_id":    principal.UserID,
+		"session_id": principal.SessionID,
+	}
+
+	err := uc.account.RevokeSession(ctx, principal.UserID, principal.SessionID)
+	if err != nil {
+		log.Printf("[LogOut] uc.account.RevokeSession() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventLogout)
+
 	return nil
 }
 
-// UpdateUserAccount will update information of a user account.
-// Field that will be updated are: first_name, last_name, and record_period.
+// RefreshToken will exchange a refresh token with a new JWT and refresh token.
+// The given refresh token can't be used again afterward, and the new JWT carries
+// the current role and email of the account.
+// It's refused when the account is disabled or pending deletion.
+func (uc *UseCase) RefreshToken(ctx context.Context, refreshToken string) (JWT, error) {
+	owner, newRefreshToken, err := uc.account.RotateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		log.Printf("[RefreshToken] uc.account.RotateRefreshToken() got an error: %+v\n", err)
+		if errors.Is(err, account.ErrRefreshTokenInvalid) || errors.Is(err, account.ErrRefreshTokenReused) {
+			return JWT{}, ErrRefreshTokenInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	meta := map[string]interface{}{
+		"user_id":    owner.UserID,
+		"session_id": owner.SessionID,
+	}
+
+	session, err := uc.account.GetSession(ctx, owner.UserID, owner.SessionID)
+	if err != nil {
+		log.Printf("[RefreshToken] uc.account.GetSession() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrSessionNotFound) {
+			return JWT{}, ErrRefreshTokenInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	// revoking sessions of an account that is disabled or pending deletion may have failed,
+	// so the state of the account is checked again before issuing a new JWT.
+	acc, err := uc.account.GetUserAccountByID(ctx, owner.UserID)
+	if err != nil {
+		log.Printf("[RefreshToken] uc.account.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return JWT{}, ErrRefreshTokenInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	if !acc.DisabledAt.IsZero() {
+		log.Printf("[RefreshToken] account disabled\nMeta:%+v\n", meta)
+		return JWT{}, ErrAccountDisabled
+	}
+
+	if !acc.DeletionRequestedAt.IsZero() {
+		log.Printf("[RefreshToken] account pending deletion\nMeta:%+v\n", meta)
+		return JWT{}, ErrRefreshTokenInvalid
+	}
+
+	// role and email may have changed since the session was created,
+	// so the new JWT carries them from the account and the session keeps the current role.
+	session.Role = acc.Role
+	token, err := uc.account.GenerateJWT(ctx, session, acc.Email)
+	if err != nil {
+		log.Printf("[RefreshToken] uc.account.GenerateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
+	}
+
+	return JWT{
+		RefreshToken: newRefreshToken,
+		Token:        token,
+	}, nil
+}
+
+// UpdateUserAccount will update information of the user acting on ctx.
+// Field that will be updated are: first_name, last_name, record_period, timezone, and budgeting_mode.
 func (uc *UseCase) UpdateUserAccount(ctx context.Context, param UpdateUserAccountParam) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[UpdateUserAccount] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
 	meta := map[string]interface{}{
-		"first_name":    param.FirstName,
-		"last_name":     param.LastName,
-		"record_period": param.RecordPeriod,
-		"user_id":       param.UserID,
+		"budgeting_mode": param.BudgetingMode,
+		"first_name":     param.FirstName,
+		"last_name":      param.LastName,
+		"record_period":  param.RecordPeriod,
+		"timezone":       param.Timezone,
+		"user_id":        principal.UserID,
 	}
 
-	err := uc.account.UpdateUserAccount(ctx, account.UpdateUserAccountParam(param))
+	err := uc.account.UpdateUserAccount(ctx, account.UpdateUserAccountParam{
+		BudgetingMode: param.BudgetingMode,
+		FirstName:     param.FirstName,
+		LastName:      param.LastName,
+		RecordPeriod:  param.RecordPeriod,
+		Timezone:      param.Timezone,
+		UserID:        principal.UserID,
+	})
 	if err != nil {
 		log.Printf("[UpdateUserAccount] uc.account.UpdateUserAccount() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventProfileUpdated)
+
 	return nil
 }
 
 // UserSignUp will process the creation of user account.
 // Before creating a new account, it'll check whether that account exist or not.
-// If it's a new account, then it'll create a new user account.
-func (uc *UseCase) UserSignUp(ctx context.Context, email, password string) error {
+// If it's a new account, then it'll create a new user account with the default
+// categories named in language, and send a verification link to its email.
+// A password that violates the password policy is refused with ValidationError.
+func (uc *UseCase) UserSignUp(ctx context.Context, email, password, language string) error {
 	meta := map[string]interface{}{
-		"email": email,
+		"email":    email,
+		"language": language,
+	}
+
+	err := uc.validatePassword(account.ValidatePasswordParam{
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		log.Printf("[UserSignUp] uc.validatePassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
 	}
 
 	acc, err := uc.account.GetUserAccountByEmail(ctx, email)
 	if err != nil {
-		log.Printf("[LogIn] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		log.Printf("[UserSignUp] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
-	accountExist := acc != account.Account{}
+	accountExist := acc.ID != 0
 	if accountExist {
 		log.Printf("[UserSignUp] User already exist!\nMeta:%+v\n", meta)
 		return errUserExist
@@ -110,29 +369,78 @@ func (uc *UseCase) UserSignUp(ctx context.Context, email, password string) error
 		return err
 	}
 
+	// the default categories are part of a usable account, so failing to create them fails the signup.
+	acc, err = uc.account.GetUserAccountByEmail(ctx, email)
+	if err != nil {
+		log.Printf("[UserSignUp] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = uc.category.CreateDefaultCategories(ctx, acc.ID, language)
+	if err != nil {
+		log.Printf("[UserSignUp] uc.category.CreateDefaultCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, acc.ID, account.AuditEventSignUp)
+	uc.sendEmailVerification(ctx, acc)
+
 	return nil
 }
 
-// UpdatePassword will update user's password.
+// UpdatePassword will update password of the user acting on ctx.
 // It will check whether the old password correct or not.
-// If it correct, then it will continue the update password process.
+// If it correct, then it will continue the update password process
+// and revoke every session of the user.
+// A password that violates the password policy is refused with ValidationError.
 func (uc *UseCase) UpdatePassword(ctx context.Context, param UpdatePasswordParam) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[UpdatePassword] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
 	meta := map[string]interface{}{
-		"email":   param.Email,
-		"user_id": param.UserID,
+		"email":   principal.Email,
+		"user_id": principal.UserID,
 	}
 
-	err := uc.account.CheckPasswordCorrect(ctx, param.Email, param.OldPassword)
+	err := uc.account.CheckPasswordCorrect(ctx, principal.Email, param.OldPassword)
 	if err != nil {
 		log.Printf("[UpdatePassword] uc.account.CheckPasswordCorrect() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
-	err = uc.account.UpdateUserPassword(ctx, param.UserID, param.Password)
+	acc, err := uc.account.GetUserAccountByID(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[UpdatePassword] uc.account.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = uc.validatePassword(account.ValidatePasswordParam{
+		Email:     acc.Email,
+		FirstName: acc.FirstName,
+		LastName:  acc.LastName,
+		Password:  param.Password,
+	})
+	if err != nil {
+		log.Printf("[UpdatePassword] uc.validatePassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = uc.account.UpdateUserPassword(ctx, principal.UserID, param.Password)
 	if err != nil {
 		log.Printf("[UpdatePassword] uc.account.UpdateUserPassword() got an error: %+v\nMeta:%+v\n", err, meta)
 		return err
 	}
 
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventPasswordChanged)
+
+	err = uc.account.InvalidateJWT(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[UpdatePassword] uc.account.InvalidateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
 	return nil
 }
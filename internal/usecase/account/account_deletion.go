@@ -0,0 +1,87 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+// DeleteAccount will schedule deletion of the account of the user acting on ctx.
+// User's current password is needed to do so. Every session of the user is revoked,
+// and the account is purged once the grace period has passed unless the user logs in again.
+// It returns the time the account will be purged at.
+func (uc *UseCase) DeleteAccount(ctx context.Context, password string) (time.Time, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[DeleteAccount] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return time.Time{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"user_id": principal.UserID,
+	}
+
+	err := uc.account.CheckPasswordCorrect(ctx, principal.Email, password)
+	if err != nil {
+		log.Printf("[DeleteAccount] uc.account.CheckPasswordCorrect() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			return time.Time{}, ErrIncorrectPassword
+		}
+
+		return time.Time{}, err
+	}
+
+	purgeAt, err := uc.account.RequestAccountDeletion(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[DeleteAccount] uc.account.RequestAccountDeletion() got an error: %+v\nMeta:%+v\n", err, meta)
+		return time.Time{}, err
+	}
+
+	return purgeAt, nil
+}
+
+// PurgeDeletedAccounts will permanently delete every account whose grace period has passed.
+// It's meant to be run periodically by a background job.
+func (uc *UseCase) PurgeDeletedAccounts(ctx context.Context) error {
+	userIDs, err := uc.account.PurgeAccountsPendingDeletion(ctx)
+	if err != nil {
+		log.Printf("[PurgeDeletedAccounts] uc.account.PurgeAccountsPendingDeletion() got an error: %+v\n", err)
+		return err
+	}
+
+	if len(userIDs) > 0 {
+		meta := map[string]interface{}{
+			"user_ids": userIDs,
+		}
+
+		log.Printf("[PurgeDeletedAccounts] accounts purged\nMeta:%+v\n", meta)
+	}
+
+	return nil
+}
+
+// cancelAccountDeletion will cancel deletion of an account that is pending deletion.
+// It's called once the owner of the account logs in again.
+func (uc *UseCase) cancelAccountDeletion(ctx context.Context, acc account.Account) error {
+	if acc.DeletionRequestedAt.IsZero() {
+		return nil
+	}
+
+	err := uc.account.CancelAccountDeletion(ctx, acc.ID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": acc.ID,
+		}
+
+		log.Printf("[cancelAccountDeletion] uc.account.CancelAccountDeletion() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
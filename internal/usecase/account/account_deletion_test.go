@@ -0,0 +1,142 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_DeleteAccount(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockPurgeAt := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       time.Time
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_password_incorrect_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(account.ErrIncorrectPassword)
+			},
+			wantErr: ErrIncorrectPassword,
+		},
+		{
+			name: "when_CheckPasswordCorrect_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RequestAccountDeletion_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().RequestAccountDeletion(ctx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_purge_time",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().RequestAccountDeletion(ctx, int64(123)).Return(mockPurgeAt, nil)
+			},
+			want: mockPurgeAt,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.DeleteAccount(test.ctx, "pass")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_PurgeDeletedAccounts(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_PurgeAccountsPendingDeletion_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().PurgeAccountsPendingDeletion(context.Background()).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_nothing_purged_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().PurgeAccountsPendingDeletion(context.Background()).Return(nil, nil)
+			},
+		},
+		{
+			name: "when_accounts_purged_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().PurgeAccountsPendingDeletion(context.Background()).Return([]int64{1, 2}, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.PurgeDeletedAccounts(context.Background())
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	// golang package
 	"context"
 	"testing"
+	"time"
 
 	// external package
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
 	"github.com/arifinhermawan/bubi/internal/service/account"
 )
 
@@ -18,40 +20,93 @@ func TestUseCase_LogIn(t *testing.T) {
 		accountSvc *MockaccountServiceProvider
 	}
 
-	type args struct {
-		email    string
-		password string
+	mockParam := LogInParam{
+		DeviceName: "device",
+		Email:      "email",
+		IPAddress:  "127.0.0.1",
+		Password:   "pass",
+		UserAgent:  "agent",
+	}
+	mockSessionParam := account.CreateSessionParam{
+		DeviceName: "device",
+		IPAddress:  "127.0.0.1",
+		UserAgent:  "agent",
+		UserID:     123,
+	}
+	mockSession := account.Session{
+		SessionID: "session",
+		UserID:    123,
 	}
+	mockTOTPAccount := account.Account{
+		ID:            123,
+		TOTPEnabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mockDeletionAccount := account.Account{
+		DeletionRequestedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Email:               "email",
+		ID:                  123,
+	}
+	mockDisabledAccount := account.Account{
+		DisabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Email:      "email",
+		ID:         123,
+	}
+	mockAdminAccount := account.Account{
+		Email: "email",
+		ID:    123,
+		Role:  entity.RoleAdmin,
+	}
+	mockAdminSessionParam := mockSessionParam
+	mockAdminSessionParam.Role = entity.RoleAdmin
+
 	tests := []struct {
 		name       string
-		args       args
 		mockFields func(mockFields)
-		want       string
+		want       JWT
 		wantErr    error
 	}{
+		{
+			name: "when_CheckLoginAttempt_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_locked_then_return_login_blocked_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Minute, account.ErrAccountLocked)
+			},
+			wantErr: &LoginBlockedError{Err: ErrAccountLocked, RetryAfter: time.Minute},
+		},
+		{
+			name: "when_login_throttled_then_return_login_blocked_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Second, account.ErrLoginThrottled)
+			},
+			wantErr: &LoginBlockedError{Err: ErrLoginThrottled, RetryAfter: time.Second},
+		},
 		{
 			name: "when_GetUserAccountByEmail_error_then_return_error",
-			args: args{email: "email"},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
 			name: "when_account_not_exist_then_return_error",
-			args: args{email: "email"},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().RecordLoginFailure(context.Background(), "email", "127.0.0.1").Return(nil)
 			},
 			wantErr: errUserNotExist,
 		},
 		{
 			name: "when_CheckPasswordCorrect_error_then_return_error",
-			args: args{
-				email:    "email",
-				password: "pass",
-			},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
 					ID: 123,
 				}, nil)
@@ -60,36 +115,205 @@ func TestUseCase_LogIn(t *testing.T) {
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_GenerateJWT_error_then_return_error",
-			args: args{
-				email:    "email",
-				password: "pass",
+			name: "when_password_incorrect_then_record_failure_and_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					ID: 123,
+				}, nil)
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(account.ErrIncorrectPassword)
+				mf.accountSvc.EXPECT().RecordLoginFailure(context.Background(), "email", "127.0.0.1").Return(assert.AnError)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginFailed).Return(nil)
 			},
+			wantErr: account.ErrIncorrectPassword,
+		},
+		{
+			name: "when_email_not_verified_then_return_error",
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
 					ID: 123,
 				}, nil)
 
 				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
-				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), int64(123), "email").Return("", assert.AnError)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{ID: 123}).Return(account.ErrEmailNotVerified)
+			},
+			wantErr: ErrEmailNotVerified,
+		},
+		{
+			name: "when_CheckEmailVerified_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					ID: 123,
+				}, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{ID: 123}).Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
-			name: "when_no_error_occured_then_return_nil",
-			args: args{
-				email:    "email",
-				password: "pass",
+			name: "when_account_disabled_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockDisabledAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockDisabledAccount).Return(nil)
+			},
+			wantErr: ErrAccountDisabled,
+		},
+		{
+			name: "when_totp_enabled_and_CreateMFAChallenge_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockTOTPAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockTOTPAccount).Return(nil)
+				mf.accountSvc.EXPECT().CreateMFAChallenge(context.Background(), mockTOTPAccount).Return("", assert.AnError)
 			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_totp_enabled_then_return_mfa_challenge_token_only",
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockTOTPAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockTOTPAccount).Return(nil)
+				mf.accountSvc.EXPECT().CreateMFAChallenge(context.Background(), mockTOTPAccount).Return("challenge", nil)
+			},
+			want: JWT{
+				MFAChallengeToken: "challenge",
+			},
+		},
+		{
+			name: "when_CancelAccountDeletion_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockDeletionAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockDeletionAccount).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().CancelAccountDeletion(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_pending_deletion_then_cancel_deletion_and_return_tokens",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockDeletionAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockDeletionAccount).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().CancelAccountDeletion(context.Background(), int64(123)).Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
+			},
+		},
+		{
+			name: "when_NewSession_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
-					ID: 123,
+					Email: "email",
+					ID:    123,
+				}, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{Email: "email", ID: 123}).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(account.Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GenerateJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
 				}, nil)
 
 				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
-				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), int64(123), "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{Email: "email", ID: 123}).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GenerateRefreshToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{Email: "email", ID: 123}).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(account.Account{Email: "email", ID: 123}).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
+			},
+		},
+		{
+			name: "when_account_has_role_then_carry_it_in_session",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckLoginAttempt(context.Background(), "email", "127.0.0.1").Return(time.Duration(0), nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAdminAccount, nil)
+
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockAdminAccount).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockAdminSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
 			},
-			want: "abc",
 		},
 	}
 	for _, test := range tests {
@@ -104,7 +328,7 @@ func TestUseCase_LogIn(t *testing.T) {
 				account: mockFields.accountSvc,
 			}
 
-			got, err := uc.LogIn(context.Background(), test.args.email, test.args.password)
+			got, err := uc.LogIn(context.Background(), mockParam)
 			assert.Equal(t, test.want, got)
 			assert.Equal(t, test.wantErr, err)
 		})
@@ -116,28 +340,46 @@ func TestUseCase_LogOut(t *testing.T) {
 		accountSvc *MockaccountServiceProvider
 	}
 
-	type args struct {
-		userID int64
-	}
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:     "email",
+		SessionID: "session",
+		UserID:    1234,
+	})
+
 	tests := []struct {
 		name       string
-		args       args
+		ctx        context.Context
 		mockFields func(mockFields)
 		wantErr    error
 	}{
 		{
-			name: "when_InvalidateJWT_then_return_error",
-			args: args{userID: 1234},
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_RevokeSession_then_return_error",
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().InvalidateJWT(context.Background(), int64(1234)).Return(assert.AnError)
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(1234), "session").Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "when_RecordAuditEvent_error_then_still_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(1234), "session").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(1234), account.AuditEventLogout).Return(assert.AnError)
+			},
+		},
 		{
 			name: "when_no_error_occured_then_return_nil",
-			args: args{userID: 1234},
+			ctx:  ctx,
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().InvalidateJWT(context.Background(), int64(1234)).Return(nil)
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(1234), "session").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(1234), account.AuditEventLogout).Return(nil)
 			},
 		},
 	}
@@ -153,44 +395,222 @@ func TestUseCase_LogOut(t *testing.T) {
 				account: mockFields.accountSvc,
 			}
 
-			err := uc.LogOut(context.Background(), test.args.userID)
+			err := uc.LogOut(test.ctx)
 			assert.Equal(t, test.wantErr, err)
 
 		})
 	}
 }
 
+func TestUseCase_RefreshToken(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockOwner := account.RefreshToken{
+		Email:     "email",
+		FamilyID:  "family",
+		SessionID: "session",
+		UserID:    123,
+	}
+	mockSession := account.Session{
+		Role:      entity.RoleUser,
+		SessionID: "session",
+		UserID:    123,
+	}
+	mockAccount := account.Account{
+		Email: "new_email",
+		ID:    123,
+		Role:  entity.RoleSupport,
+	}
+	mockRefreshedSession := mockSession
+	mockRefreshedSession.Role = entity.RoleSupport
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       JWT
+		wantErr    error
+	}{
+		{
+			name: "when_refresh_token_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(account.RefreshToken{}, "", account.ErrRefreshTokenInvalid)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_refresh_token_reused_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(account.RefreshToken{}, "", account.ErrRefreshTokenReused)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_RotateRefreshToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(account.RefreshToken{}, "", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_session_revoked_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(account.Session{}, account.ErrSessionNotFound)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_GetSession_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(account.Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_found_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{}, account.ErrAccountNotFound)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_disabled_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{
+					DisabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					ID:         123,
+				}, nil)
+			},
+			wantErr: ErrAccountDisabled,
+		},
+		{
+			name: "when_account_pending_deletion_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{
+					DeletionRequestedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					ID:                  123,
+				}, nil)
+			},
+			wantErr: ErrRefreshTokenInvalid,
+		},
+		{
+			name: "when_GenerateJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockRefreshedSession, "new_email").Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_new_tokens_with_current_role_and_email",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RotateRefreshToken(context.Background(), "refresh").Return(mockOwner, "new_refresh", nil)
+				mf.accountSvc.EXPECT().GetSession(context.Background(), int64(123), "session").Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockRefreshedSession, "new_email").Return("token", nil)
+			},
+			want: JWT{
+				RefreshToken: "new_refresh",
+				Token:        "token",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.RefreshToken(context.Background(), "refresh")
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
 func TestUseCase_UpdateUserAccount(t *testing.T) {
 	type mockFields struct {
 		accountSvc *MockaccountServiceProvider
 	}
 
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
 	mockArgs := UpdateUserAccountParam{
-		FirstName:    "Ji Eun",
-		LastName:     "Lee",
-		RecordPeriod: 25,
-		UserID:       123,
+		BudgetingMode: "envelope",
+		FirstName:     "Ji Eun",
+		LastName:      "Lee",
+		RecordPeriod:  25,
+		Timezone:      "Asia/Makassar",
+	}
+
+	mockSvcParam := account.UpdateUserAccountParam{
+		BudgetingMode: "envelope",
+		FirstName:     "Ji Eun",
+		LastName:      "Lee",
+		RecordPeriod:  25,
+		Timezone:      "Asia/Makassar",
+		UserID:        123,
 	}
 
 	tests := []struct {
 		name       string
+		ctx        context.Context
 		args       UpdateUserAccountParam
 		mockFields func(mockFields)
 		wantErr    error
 	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			args:       mockArgs,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
 		{
 			name: "when_UpdateUserAccount_error_then_return_error",
+			ctx:  ctx,
 			args: mockArgs,
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().UpdateUserAccount(context.Background(), account.UpdateUserAccountParam(mockArgs)).Return(assert.AnError)
+				mf.accountSvc.EXPECT().UpdateUserAccount(ctx, mockSvcParam).Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
 			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
 			args: mockArgs,
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().UpdateUserAccount(context.Background(), account.UpdateUserAccountParam(mockArgs)).Return(nil)
+				mf.accountSvc.EXPECT().UpdateUserAccount(ctx, mockSvcParam).Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventProfileUpdated).Return(nil)
 			},
 			wantErr: nil,
 		},
@@ -207,7 +627,7 @@ func TestUseCase_UpdateUserAccount(t *testing.T) {
 				account: mockFields.accountSvc,
 			}
 
-			err := uc.UpdateUserAccount(context.Background(), test.args)
+			err := uc.UpdateUserAccount(test.ctx, test.args)
 			assert.Equal(t, test.wantErr, err)
 		})
 	}
@@ -215,10 +635,12 @@ func TestUseCase_UpdateUserAccount(t *testing.T) {
 
 func TestUseCase_UserSignUp(t *testing.T) {
 	type mockFields struct {
-		accountSvc *MockaccountServiceProvider
+		accountSvc  *MockaccountServiceProvider
+		categorySvc *MockcategoryServiceProvider
 	}
 	type args struct {
 		email    string
+		language string
 		password string
 	}
 	tests := []struct {
@@ -227,10 +649,26 @@ func TestUseCase_UserSignUp(t *testing.T) {
 		mockFields func(mockFields)
 		wantErr    error
 	}{
+		{
+			name: "when_password_violates_policy_then_return_validation_error",
+			args: args{
+				email:    "email",
+				password: "pass",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "pass"}).Return(&account.PasswordPolicyError{
+					Violations: []account.PasswordViolation{{Code: account.PasswordViolationTooShort, Message: "too short"}},
+				})
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{{Code: account.PasswordViolationTooShort, Field: "password", Message: "too short"}},
+			},
+		},
 		{
 			name: "when_GetUserAccountByEmail_error_then_return_error",
 			args: args{email: "email"},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: ""}).Return(nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
 			},
 			wantErr: assert.AnError,
@@ -239,6 +677,7 @@ func TestUseCase_UserSignUp(t *testing.T) {
 			name: "when_account_exist_then_return_error",
 			args: args{email: "email"},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: ""}).Return(nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{ID: 123}, nil)
 			},
 			wantErr: errUserExist,
@@ -250,20 +689,73 @@ func TestUseCase_UserSignUp(t *testing.T) {
 				password: "passw0rd",
 			},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "passw0rd"}).Return(nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
 				mf.accountSvc.EXPECT().InsertUserAccount(context.Background(), "email", "passw0rd").Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "when_failed_to_fetch_created_account_then_return_error",
+			args: args{
+				email:    "email",
+				password: "passw0rd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "passw0rd"}).Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().InsertUserAccount(context.Background(), "email", "passw0rd").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendEmailVerificationToken_error_then_still_return_nil_error",
+			args: args{
+				email:    "email",
+				password: "passw0rd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "passw0rd"}).Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().InsertUserAccount(context.Background(), "email", "passw0rd").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{Email: "email", ID: 123}, nil)
+				mf.categorySvc.EXPECT().CreateDefaultCategories(context.Background(), int64(123), "").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventSignUp).Return(nil)
+				mf.accountSvc.EXPECT().SendEmailVerificationToken(context.Background(), int64(123), "email").Return(assert.AnError)
+			},
+		},
+		{
+			name: "when_CreateDefaultCategories_error_then_return_error",
+			args: args{
+				email:    "email",
+				language: "en",
+				password: "passw0rd",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "passw0rd"}).Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().InsertUserAccount(context.Background(), "email", "passw0rd").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{Email: "email", ID: 123}, nil)
+				mf.categorySvc.EXPECT().CreateDefaultCategories(context.Background(), int64(123), "en").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
 		{
 			name: "when_no_error_occured_then_return_nil_error",
 			args: args{
 				email:    "email",
+				language: "en",
 				password: "passw0rd",
 			},
 			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", Password: "passw0rd"}).Return(nil)
 				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
 				mf.accountSvc.EXPECT().InsertUserAccount(context.Background(), "email", "passw0rd").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{Email: "email", ID: 123}, nil)
+				mf.categorySvc.EXPECT().CreateDefaultCategories(context.Background(), int64(123), "en").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventSignUp).Return(nil)
+				mf.accountSvc.EXPECT().SendEmailVerificationToken(context.Background(), int64(123), "email").Return(nil)
 			},
 		},
 	}
@@ -271,15 +763,17 @@ func TestUseCase_UserSignUp(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockFields := mockFields{
-				accountSvc: NewMockaccountServiceProvider(ctrl),
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
 			}
 			test.mockFields(mockFields)
 
 			uc := &UseCase{
-				account: mockFields.accountSvc,
+				account:  mockFields.accountSvc,
+				category: mockFields.categorySvc,
 			}
 
-			err := uc.UserSignUp(context.Background(), test.args.email, test.args.password)
+			err := uc.UserSignUp(context.Background(), test.args.email, test.args.password, test.args.language)
 			assert.Equal(t, test.wantErr, err)
 		})
 	}
@@ -289,48 +783,116 @@ func TestUseCase_UpdatePassword(t *testing.T) {
 	type mockFields struct {
 		accountSvc *MockaccountServiceProvider
 	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
 	tests := []struct {
 		name       string
+		ctx        context.Context
 		args       UpdatePasswordParam
 		mockFields func(mockFields)
 		wantErr    error
 	}{
+		{
+			name: "when_principal_not_exist_then_return_error",
+			ctx:  context.Background(),
+			args: UpdatePasswordParam{
+				OldPassword: "oldpass",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
 		{
 			name: "when_CheckPasswordCorrect_error_then_return_error",
+			ctx:  ctx,
 			args: UpdatePasswordParam{
-				Email:       "email",
 				OldPassword: "oldpass",
 			},
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "oldpass").Return(assert.AnError)
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  ctx,
+			args: UpdatePasswordParam{
+				OldPassword: "oldpass",
+				Password:    "password",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
+		{
+			name: "when_password_violates_policy_then_return_validation_error",
+			ctx:  ctx,
+			args: UpdatePasswordParam{
+				OldPassword: "oldpass",
+				Password:    "password",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{Email: "email", FirstName: "Ji Eun", ID: 123, LastName: "Lee"}, nil)
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", FirstName: "Ji Eun", LastName: "Lee", Password: "password"}).Return(&account.PasswordPolicyError{
+					Violations: []account.PasswordViolation{{Code: account.PasswordViolationBreached, Message: "breached"}},
+				})
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{{Code: account.PasswordViolationBreached, Field: "password", Message: "breached"}},
+			},
+		},
 		{
 			name: "when_UpdateUserPassword_error_then_return_error",
+			ctx:  ctx,
+			args: UpdatePasswordParam{
+				OldPassword: "oldpass",
+				Password:    "password",
+			},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{Email: "email", FirstName: "Ji Eun", ID: 123, LastName: "Lee"}, nil)
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", FirstName: "Ji Eun", LastName: "Lee", Password: "password"}).Return(nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(ctx, int64(123), "password").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InvalidateJWT_error_then_return_error",
+			ctx:  ctx,
 			args: UpdatePasswordParam{
-				Email:       "email",
 				OldPassword: "oldpass",
 				Password:    "password",
-				UserID:      123,
 			},
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "oldpass").Return(nil)
-				mf.accountSvc.EXPECT().UpdateUserPassword(context.Background(), int64(123), "password").Return(assert.AnError)
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{Email: "email", FirstName: "Ji Eun", ID: 123, LastName: "Lee"}, nil)
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", FirstName: "Ji Eun", LastName: "Lee", Password: "password"}).Return(nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(ctx, int64(123), "password").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventPasswordChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(assert.AnError)
 			},
 			wantErr: assert.AnError,
 		},
 		{
 			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
 			args: UpdatePasswordParam{
-				Email:       "email",
 				OldPassword: "oldpass",
 				Password:    "password",
-				UserID:      123,
 			},
 			mockFields: func(mf mockFields) {
-				mf.accountSvc.EXPECT().CheckPasswordCorrect(context.Background(), "email", "oldpass").Return(nil)
-				mf.accountSvc.EXPECT().UpdateUserPassword(context.Background(), int64(123), "password").Return(nil)
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "oldpass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{Email: "email", FirstName: "Ji Eun", ID: 123, LastName: "Lee"}, nil)
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Email: "email", FirstName: "Ji Eun", LastName: "Lee", Password: "password"}).Return(nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(ctx, int64(123), "password").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventPasswordChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(nil)
 			},
 		},
 	}
@@ -346,7 +908,7 @@ func TestUseCase_UpdatePassword(t *testing.T) {
 				account: mockFields.accountSvc,
 			}
 
-			err := uc.UpdatePassword(context.Background(), test.args)
+			err := uc.UpdatePassword(test.ctx, test.args)
 			assert.Equal(t, test.wantErr, err)
 		})
 	}
@@ -0,0 +1,170 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+const (
+	// actions written to the admin audit trail
+	adminActionDisableAccount = "disable_account"
+	adminActionEnableAccount  = "enable_account"
+	adminActionForceLogOut    = "force_logout"
+	adminActionResetMFA       = "reset_mfa"
+
+	defaultSearchAccountsLimit = 20
+	maxSearchAccountsLimit     = 100
+)
+
+var (
+	// ErrAdminActionRefused is returned when an admin acts on their own account
+	// or on an account whose role is the same as or higher than theirs.
+	ErrAdminActionRefused = errors.New("can't act on an account with the same or a higher role")
+
+	// ErrUserNotFound is returned when the account an admin acts on doesn't exist.
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// DisableAccount will disable the account of a user on behalf of the admin acting on ctx.
+// Every session of the user is revoked and they can't log in until the account is enabled again.
+func (uc *UseCase) DisableAccount(ctx context.Context, userID int64) error {
+	return uc.performAdminAction(ctx, adminActionDisableAccount, userID, uc.account.DisableAccount)
+}
+
+// EnableAccount will enable the account of a user on behalf of the admin acting on ctx.
+func (uc *UseCase) EnableAccount(ctx context.Context, userID int64) error {
+	return uc.performAdminAction(ctx, adminActionEnableAccount, userID, uc.account.EnableAccount)
+}
+
+// ForceLogOut will revoke every session of a user on behalf of the admin acting on ctx.
+func (uc *UseCase) ForceLogOut(ctx context.Context, userID int64) error {
+	return uc.performAdminAction(ctx, adminActionForceLogOut, userID, uc.account.InvalidateJWT)
+}
+
+// ResetMFA will turn off TOTP of a user on behalf of the admin acting on ctx,
+// so a user who lost their authenticator can log in with their password again.
+func (uc *UseCase) ResetMFA(ctx context.Context, userID int64) error {
+	return uc.performAdminAction(ctx, adminActionResetMFA, userID, uc.account.DisableTOTP)
+}
+
+// SearchAccounts will fetch accounts of users whose email, first name or last name
+// contains param.Query. Limit is capped to keep a page small.
+func (uc *UseCase) SearchAccounts(ctx context.Context, param SearchAccountsParam) ([]AccountSummary, error) {
+	limit := param.Limit
+	if limit <= 0 {
+		limit = defaultSearchAccountsLimit
+	}
+
+	if limit > maxSearchAccountsLimit {
+		limit = maxSearchAccountsLimit
+	}
+
+	offset := param.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	accounts, err := uc.account.SearchUserAccounts(ctx, account.SearchUserAccountsParam{
+		Limit:  limit,
+		Offset: offset,
+		Query:  strings.TrimSpace(param.Query),
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"param": param,
+		}
+
+		log.Printf("[SearchAccounts] uc.account.SearchUserAccounts() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]AccountSummary, 0, len(accounts))
+	for _, acc := range accounts {
+		result = append(result, convertAccountSummary(acc))
+	}
+
+	return result, nil
+}
+
+// performAdminAction will check that the account of a user exists, write the action
+// to the audit trail, then perform it. The action is written before it's performed,
+// so an action that fails halfway is still on the audit trail.
+// An action on an account whose role isn't lower than the role of the admin, including
+// the admin's own account, is written to the audit trail as refused and returns ErrAdminActionRefused.
+func (uc *UseCase) performAdminAction(ctx context.Context, action string, userID int64, perform func(ctx context.Context, userID int64) error) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[performAdminAction] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"action":   action,
+		"actor_id": principal.UserID,
+		"user_id":  userID,
+	}
+
+	target, err := uc.account.GetUserAccountByID(ctx, userID)
+	if err != nil {
+		log.Printf("[performAdminAction] uc.account.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return ErrUserNotFound
+		}
+
+		return err
+	}
+
+	refused := target.ID == principal.UserID || !entity.RoleOutranks(principal.Role, target.Role)
+	err = uc.account.RecordAdminAction(ctx, account.AdminAuditLog{
+		Action:       action,
+		ActorID:      principal.UserID,
+		Refused:      refused,
+		TargetUserID: userID,
+	})
+	if err != nil {
+		log.Printf("[performAdminAction] uc.account.RecordAdminAction() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	if refused {
+		log.Printf("[performAdminAction] action refused\nMeta:%+v\n", meta)
+		return ErrAdminActionRefused
+	}
+
+	err = perform(ctx, userID)
+	if err != nil {
+		log.Printf("[performAdminAction] perform() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// convertAccountSummary will convert user's account from account service
+// into the format shown to admins.
+func convertAccountSummary(acc account.Account) AccountSummary {
+	result := AccountSummary{
+		CreatedAt:     acc.CreatedAt,
+		Email:         acc.Email,
+		EmailVerified: !acc.EmailVerifiedAt.IsZero(),
+		FirstName:     acc.FirstName,
+		ID:            acc.ID,
+		LastName:      acc.LastName,
+		Role:          acc.Role,
+		TOTPEnabled:   !acc.TOTPEnabledAt.IsZero(),
+	}
+
+	if !acc.DisabledAt.IsZero() {
+		disabledAt := acc.DisabledAt
+		result.DisabledAt = &disabledAt
+	}
+
+	return result
+}
@@ -0,0 +1,441 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_DisableAccount(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "admin",
+		Role:   entity.RoleAdmin,
+		UserID: 1,
+	})
+	mockAction := account.AdminAuditLog{
+		Action:       adminActionDisableAccount,
+		ActorID:      1,
+		TargetUserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_user_not_found_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, account.ErrAccountNotFound)
+			},
+			wantErr: ErrUserNotFound,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RecordAdminAction_error_then_return_error_without_disabling",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_user_has_the_same_role_then_record_refusal_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				action := mockAction
+				action.Refused = true
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleAdmin}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, action).Return(nil)
+			},
+			wantErr: ErrAdminActionRefused,
+		},
+		{
+			name: "when_user_is_the_admin_then_record_refusal_then_return_error",
+			ctx: entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+				Email:  "admin",
+				Role:   entity.RoleAdmin,
+				UserID: 123,
+			}),
+			mockFields: func(mf mockFields) {
+				action := mockAction
+				action.ActorID = 123
+				action.Refused = true
+				mf.accountSvc.EXPECT().GetUserAccountByID(gomock.Any(), int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(gomock.Any(), action).Return(nil)
+			},
+			wantErr: ErrAdminActionRefused,
+		},
+		{
+			name: "when_DisableAccount_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().DisableAccount(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().DisableAccount(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.DisableAccount(test.ctx, 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_EnableAccount(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "admin",
+		Role:   entity.RoleAdmin,
+		UserID: 1,
+	})
+	mockAction := account.AdminAuditLog{
+		Action:       adminActionEnableAccount,
+		ActorID:      1,
+		TargetUserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_EnableAccount_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().EnableAccount(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().EnableAccount(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.EnableAccount(ctx, 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ForceLogOut(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "support",
+		Role:   entity.RoleSupport,
+		UserID: 2,
+	})
+	mockAction := account.AdminAuditLog{
+		Action:       adminActionForceLogOut,
+		ActorID:      2,
+		TargetUserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_user_has_a_higher_role_then_record_refusal_then_return_error",
+			mockFields: func(mf mockFields) {
+				action := mockAction
+				action.Refused = true
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleAdmin}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, action).Return(nil)
+			},
+			wantErr: ErrAdminActionRefused,
+		},
+		{
+			name: "when_InvalidateJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ForceLogOut(ctx, 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ResetMFA(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "support",
+		Role:   entity.RoleSupport,
+		UserID: 2,
+	})
+	mockAction := account.AdminAuditLog{
+		Action:       adminActionResetMFA,
+		ActorID:      2,
+		TargetUserID: 123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_user_has_the_same_role_then_record_refusal_then_return_error",
+			mockFields: func(mf mockFields) {
+				action := mockAction
+				action.Refused = true
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleSupport}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, action).Return(nil)
+			},
+			wantErr: ErrAdminActionRefused,
+		},
+		{
+			name: "when_DisableTOTP_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().DisableTOTP(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{ID: 123, Role: entity.RoleUser}, nil)
+				mf.accountSvc.EXPECT().RecordAdminAction(ctx, mockAction).Return(nil)
+				mf.accountSvc.EXPECT().DisableTOTP(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ResetMFA(ctx, 123)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_SearchAccounts(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		param      SearchAccountsParam
+		mockFields func(mockFields)
+		want       []AccountSummary
+		wantErr    error
+	}{
+		{
+			name:  "when_SearchUserAccounts_error_then_return_error",
+			param: SearchAccountsParam{Limit: 10, Query: "lee"},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().SearchUserAccounts(context.Background(), account.SearchUserAccountsParam{
+					Limit: 10,
+					Query: "lee",
+				}).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_limit_and_offset_not_set_then_use_default_page",
+			param: SearchAccountsParam{Offset: -5, Query: " lee "},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().SearchUserAccounts(context.Background(), account.SearchUserAccountsParam{
+					Limit: defaultSearchAccountsLimit,
+					Query: "lee",
+				}).Return(nil, nil)
+			},
+			want: []AccountSummary{},
+		},
+		{
+			name:  "when_limit_too_big_then_cap_it",
+			param: SearchAccountsParam{Limit: 1000, Offset: 40, Query: "lee"},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().SearchUserAccounts(context.Background(), account.SearchUserAccountsParam{
+					Limit:  maxSearchAccountsLimit,
+					Offset: 40,
+					Query:  "lee",
+				}).Return(nil, nil)
+			},
+			want: []AccountSummary{},
+		},
+		{
+			name:  "when_no_error_occured_then_return_accounts",
+			param: SearchAccountsParam{Limit: 10, Query: "lee"},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().SearchUserAccounts(context.Background(), account.SearchUserAccountsParam{
+					Limit: 10,
+					Query: "lee",
+				}).Return([]account.Account{
+					{
+						CreatedAt: mockTime,
+						Email:     "lee.jieun@iu.com",
+						ID:        123,
+						Role:      entity.RoleUser,
+					},
+					{
+						CreatedAt:       mockTime,
+						DisabledAt:      mockTime,
+						Email:           "lee.chaeyeon@wm.com",
+						EmailVerifiedAt: mockTime,
+						FirstName:       "Chaeyeon",
+						ID:              456,
+						LastName:        "Lee",
+						Password:        "hashed",
+						Role:            entity.RoleUser,
+						TOTPEnabledAt:   mockTime,
+					},
+				}, nil)
+			},
+			want: []AccountSummary{
+				{
+					CreatedAt: mockTime,
+					Email:     "lee.jieun@iu.com",
+					ID:        123,
+					Role:      entity.RoleUser,
+				},
+				{
+					CreatedAt:     mockTime,
+					DisabledAt:    &mockTime,
+					Email:         "lee.chaeyeon@wm.com",
+					EmailVerified: true,
+					FirstName:     "Chaeyeon",
+					ID:            456,
+					LastName:      "Lee",
+					Role:          entity.RoleUser,
+					TOTPEnabled:   true,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.SearchAccounts(context.Background(), test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
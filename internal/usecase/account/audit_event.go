@@ -0,0 +1,83 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+const (
+	defaultListActivityLimit = 20
+	maxListActivityLimit     = 100
+)
+
+// ListActivity will fetch security events of the user acting on ctx,
+// ordered from the most recent event. Limit is capped to keep a page small.
+func (uc *UseCase) ListActivity(ctx context.Context, param ListActivityParam) ([]AuditEvent, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListActivity] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	limit := param.Limit
+	if limit <= 0 {
+		limit = defaultListActivityLimit
+	}
+
+	if limit > maxListActivityLimit {
+		limit = maxListActivityLimit
+	}
+
+	offset := param.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	events, err := uc.account.ListAuditEvents(ctx, account.GetAccountAuditEventsParam{
+		Limit:  limit,
+		Offset: offset,
+		UserID: principal.UserID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"param":   param,
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[ListActivity] uc.account.ListAuditEvents() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]AuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, AuditEvent{
+			CreatedAt: event.CreatedAt,
+			Event:     event.Event,
+			IPAddress: event.IPAddress,
+			RequestID: event.RequestID,
+			UserAgent: event.UserAgent,
+		})
+	}
+
+	return result, nil
+}
+
+// recordAuditEvent will write a security event of a user to the account audit trail.
+// The audit trail shouldn't change the result of the action it records,
+// so the error is only logged.
+func (uc *UseCase) recordAuditEvent(ctx context.Context, userID int64, event string) {
+	err := uc.account.RecordAuditEvent(ctx, userID, event)
+	if err != nil {
+		meta := map[string]interface{}{
+			"event":   event,
+			"user_id": userID,
+		}
+
+		log.Printf("[recordAuditEvent] uc.account.RecordAuditEvent() got an error: %+v\nMeta:%+v\n", err, meta)
+	}
+}
@@ -0,0 +1,115 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ListActivity(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      ListActivityParam
+		mockFields func(mockFields)
+		want       []AuditEvent
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListAuditEvents_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListAuditEvents(ctx, account.GetAccountAuditEventsParam{
+					Limit:  defaultListActivityLimit,
+					UserID: 123,
+				}).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_limit_too_big_and_offset_negative_then_clamp_them",
+			ctx:   ctx,
+			param: ListActivityParam{Limit: 1000, Offset: -1},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListAuditEvents(ctx, account.GetAccountAuditEventsParam{
+					Limit:  maxListActivityLimit,
+					UserID: 123,
+				}).Return(nil, nil)
+			},
+			want: []AuditEvent{},
+		},
+		{
+			name:  "when_no_error_occured_then_return_events",
+			ctx:   ctx,
+			param: ListActivityParam{Limit: 10, Offset: 20},
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListAuditEvents(ctx, account.GetAccountAuditEventsParam{
+					Limit:  10,
+					Offset: 20,
+					UserID: 123,
+				}).Return([]account.AccountAuditEvent{
+					{
+						CreatedAt: mockTime,
+						Event:     account.AuditEventLoginSucceeded,
+						IPAddress: "127.0.0.1",
+						RequestID: "req-1",
+						UserAgent: "Mozilla/5.0",
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []AuditEvent{
+				{
+					CreatedAt: mockTime,
+					Event:     account.AuditEventLoginSucceeded,
+					IPAddress: "127.0.0.1",
+					RequestID: "req-1",
+					UserAgent: "Mozilla/5.0",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.ListActivity(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrEmailAlreadyUsed is returned when the requested email already belongs to an account.
+	ErrEmailAlreadyUsed = errors.New("email already used")
+
+	// ErrEmailChangeTokenInvalid is returned when an email change confirmation token is unknown, expired or already used.
+	ErrEmailChangeTokenInvalid = errors.New("email change token not valid")
+
+	// ErrEmailUnchanged is returned when the requested email is the current email of the user.
+	ErrEmailUnchanged = errors.New("new email is the same as current email")
+)
+
+// ConfirmEmailChange will replace email of the owner of an email change confirmation token
+// with the email the token was issued for, and revoke every session of the user.
+// The new email is marked as verified, and password reset and magic links sent to the previous email can't be used anymore.
+// Uniqueness of the new email is checked again, since it might be taken after the change was requested.
+func (uc *UseCase) ConfirmEmailChange(ctx context.Context, token string) error {
+	change, err := uc.account.ConsumeEmailChangeToken(ctx, token)
+	if err != nil {
+		log.Printf("[ConfirmEmailChange] uc.account.ConsumeEmailChangeToken() got an error: %+v\n", err)
+		if errors.Is(err, account.ErrEmailChangeTokenInvalid) {
+			return ErrEmailChangeTokenInvalid
+		}
+
+		return err
+	}
+
+	meta := map[string]interface{}{
+		"user_id":   change.UserID,
+		"new_email": change.NewEmail,
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, change.NewEmail)
+	if err != nil {
+		log.Printf("[ConfirmEmailChange] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	accountExist := acc.ID != 0
+	if accountExist {
+		log.Printf("[ConfirmEmailChange] Email already used!\nMeta:%+v\n", meta)
+		return ErrEmailAlreadyUsed
+	}
+
+	err = uc.account.UpdateUserEmail(ctx, change.UserID, change.NewEmail)
+	if err != nil {
+		log.Printf("[ConfirmEmailChange] uc.account.UpdateUserEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, change.UserID, account.AuditEventEmailChanged)
+
+	err = uc.account.InvalidateJWT(ctx, change.UserID)
+	if err != nil {
+		log.Printf("[ConfirmEmailChange] uc.account.InvalidateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// RequestEmailChange will start changing email of the user acting on ctx.
+// User's current password is needed to do so. A confirmation link is sent to the new email
+// and a notice is sent to the current email. The email is only changed once the page opened by the link confirms it.
+func (uc *UseCase) RequestEmailChange(ctx context.Context, param RequestEmailChangeParam) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[RequestEmailChange] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"user_id":   principal.UserID,
+		"new_email": param.Email,
+	}
+
+	if param.Email == principal.Email {
+		log.Printf("[RequestEmailChange] Email unchanged!\nMeta:%+v\n", meta)
+		return ErrEmailUnchanged
+	}
+
+	err := uc.account.CheckPasswordCorrect(ctx, principal.Email, param.Password)
+	if err != nil {
+		log.Printf("[RequestEmailChange] uc.account.CheckPasswordCorrect() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			return ErrIncorrectPassword
+		}
+
+		return err
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, param.Email)
+	if err != nil {
+		log.Printf("[RequestEmailChange] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	accountExist := acc.ID != 0
+	if accountExist {
+		log.Printf("[RequestEmailChange] Email already used!\nMeta:%+v\n", meta)
+		return ErrEmailAlreadyUsed
+	}
+
+	err = uc.account.SendEmailChangeToken(ctx, account.SendEmailChangeParam{
+		Email:    principal.Email,
+		NewEmail: param.Email,
+		UserID:   principal.UserID,
+	})
+	if err != nil {
+		log.Printf("[RequestEmailChange] uc.account.SendEmailChangeToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ConfirmEmailChange(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := context.Background()
+	mockChange := account.EmailChange{NewEmail: "new@mail.com", UserID: 123}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_token_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(account.EmailChange{}, account.ErrEmailChangeTokenInvalid)
+			},
+			wantErr: ErrEmailChangeTokenInvalid,
+		},
+		{
+			name: "when_ConsumeEmailChangeToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(account.EmailChange{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetUserAccountByEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(mockChange, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_email_already_used_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(mockChange, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{ID: 456}, nil)
+			},
+			wantErr: ErrEmailAlreadyUsed,
+		},
+		{
+			name: "when_UpdateUserEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(mockChange, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().UpdateUserEmail(ctx, int64(123), "new@mail.com").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InvalidateJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(mockChange, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().UpdateUserEmail(ctx, int64(123), "new@mail.com").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventEmailChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeEmailChangeToken(ctx, "token").Return(mockChange, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().UpdateUserEmail(ctx, int64(123), "new@mail.com").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventEmailChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ConfirmEmailChange(ctx, "token")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_RequestEmailChange(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "old@mail.com",
+		UserID: 123,
+	})
+	mockParam := RequestEmailChangeParam{
+		Email:    "new@mail.com",
+		Password: "pass",
+	}
+	mockSendParam := account.SendEmailChangeParam{
+		Email:    "old@mail.com",
+		NewEmail: "new@mail.com",
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      RequestEmailChangeParam
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_email_unchanged_then_return_error",
+			ctx:  ctx,
+			param: RequestEmailChangeParam{
+				Email:    "old@mail.com",
+				Password: "pass",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr:    ErrEmailUnchanged,
+		},
+		{
+			name:  "when_password_incorrect_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(account.ErrIncorrectPassword)
+			},
+			wantErr: ErrIncorrectPassword,
+		},
+		{
+			name:  "when_CheckPasswordCorrect_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_GetUserAccountByEmail_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_email_already_used_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{ID: 456}, nil)
+			},
+			wantErr: ErrEmailAlreadyUsed,
+		},
+		{
+			name:  "when_SendEmailChangeToken_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().SendEmailChangeToken(ctx, mockSendParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_nil",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "old@mail.com", "pass").Return(nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(ctx, "new@mail.com").Return(account.Account{}, nil)
+				mf.accountSvc.EXPECT().SendEmailChangeToken(ctx, mockSendParam).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.RequestEmailChange(test.ctx, test.param)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrEmailNotVerified is returned when logging in to an account whose email is not verified
+	// while verified email is required.
+	ErrEmailNotVerified = errors.New("email not verified")
+
+	// ErrEmailVerificationThrottled is returned when a verification email is requested too often.
+	ErrEmailVerificationThrottled = errors.New("email verification requested too often")
+
+	// ErrEmailVerificationTokenInvalid is returned when an email verification token is unknown, expired or already used.
+	ErrEmailVerificationTokenInvalid = errors.New("email verification token not valid")
+)
+
+// ResendEmailVerification will send a new verification link to the email of an account.
+// To avoid disclosing which emails are registered,
+// it won't return an error when the account doesn't exist or is already verified.
+func (uc *UseCase) ResendEmailVerification(ctx context.Context, email string) error {
+	meta := map[string]interface{}{
+		"email": email,
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, email)
+	if err != nil {
+		log.Printf("[ResendEmailVerification] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	accountNotExist := acc.ID == 0
+	if accountNotExist {
+		log.Printf("[ResendEmailVerification] User not exist!\nMeta:%+v\n", meta)
+		return nil
+	}
+
+	if !acc.EmailVerifiedAt.IsZero() {
+		log.Printf("[ResendEmailVerification] Email already verified!\nMeta:%+v\n", meta)
+		return nil
+	}
+
+	err = uc.account.SendEmailVerificationToken(ctx, acc.ID, acc.Email)
+	if err != nil {
+		log.Printf("[ResendEmailVerification] uc.account.SendEmailVerificationToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrEmailVerificationThrottled) {
+			return ErrEmailVerificationThrottled
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// VerifyEmail will mark email of the owner of an email verification token as verified.
+func (uc *UseCase) VerifyEmail(ctx context.Context, token string) error {
+	err := uc.account.VerifyEmail(ctx, token)
+	if err != nil {
+		log.Printf("[VerifyEmail] uc.account.VerifyEmail() got an error: %+v\n", err)
+		if errors.Is(err, account.ErrEmailVerificationTokenInvalid) {
+			return ErrEmailVerificationTokenInvalid
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// sendEmailVerification will send a verification link to the email of a newly created account.
+// Failure is only logged, the account is already created and the link can be resent.
+func (uc *UseCase) sendEmailVerification(ctx context.Context, acc account.Account) {
+	err := uc.account.SendEmailVerificationToken(ctx, acc.ID, acc.Email)
+	if err != nil {
+		meta := map[string]interface{}{
+			"email":   acc.Email,
+			"user_id": acc.ID,
+		}
+
+		log.Printf("[sendEmailVerification] uc.account.SendEmailVerificationToken() got an error: %+v\nMeta:%+v\n", err, meta)
+	}
+}
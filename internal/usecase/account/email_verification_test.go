@@ -0,0 +1,144 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ResendEmailVerification(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockAccount := account.Account{
+		Email: "email",
+		ID:    123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+			},
+		},
+		{
+			name: "when_email_already_verified_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email:           "email",
+					EmailVerifiedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					ID:              123,
+				}, nil)
+			},
+		},
+		{
+			name: "when_throttled_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().SendEmailVerificationToken(context.Background(), int64(123), "email").Return(account.ErrEmailVerificationThrottled)
+			},
+			wantErr: ErrEmailVerificationThrottled,
+		},
+		{
+			name: "when_SendEmailVerificationToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().SendEmailVerificationToken(context.Background(), int64(123), "email").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().SendEmailVerificationToken(context.Background(), int64(123), "email").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ResendEmailVerification(context.Background(), "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_VerifyEmail(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_token_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().VerifyEmail(context.Background(), "token").Return(account.ErrEmailVerificationTokenInvalid)
+			},
+			wantErr: ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "when_VerifyEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().VerifyEmail(context.Background(), "token").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().VerifyEmail(context.Background(), "token").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.VerifyEmail(context.Background(), "token")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrMagicLinkTokenInvalid is returned when a magic link login token is unknown, expired or already used.
+	ErrMagicLinkTokenInvalid = errors.New("magic link token not valid")
+)
+
+// LogInMagicLink will log in the owner of a magic link login token.
+// It finishes the same way as LogIn, so an account with TOTP enabled
+// only gets an MFA challenge token that has to be exchanged using LogInMFA.
+func (uc *UseCase) LogInMagicLink(ctx context.Context, param LogInMagicLinkParam) (JWT, error) {
+	meta := map[string]interface{}{
+		"device_name": param.DeviceName,
+		"ip_address":  param.IPAddress,
+	}
+
+	link, err := uc.account.ConsumeMagicLink(ctx, param.Token)
+	if err != nil {
+		log.Printf("[LogInMagicLink] uc.account.ConsumeMagicLink() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrMagicLinkTokenInvalid) {
+			return JWT{}, ErrMagicLinkTokenInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	meta["user_id"] = link.UserID
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, link.Email)
+	if err != nil {
+		log.Printf("[LogInMagicLink] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
+	}
+
+	if acc.ID != link.UserID {
+		log.Printf("[LogInMagicLink] account of the magic link not found\nMeta:%+v\n", meta)
+		return JWT{}, ErrMagicLinkTokenInvalid
+	}
+
+	return uc.completeLogIn(ctx, acc, account.CreateSessionParam{
+		DeviceName: param.DeviceName,
+		IPAddress:  param.IPAddress,
+		UserAgent:  param.UserAgent,
+	})
+}
+
+// RequestMagicLink will send a magic link to the email of an account.
+// To avoid disclosing which emails are registered,
+// it won't return an error when the account doesn't exist or the previous link was sent too recently.
+func (uc *UseCase) RequestMagicLink(ctx context.Context, email string) error {
+	meta := map[string]interface{}{
+		"email": email,
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, email)
+	if err != nil {
+		log.Printf("[RequestMagicLink] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	accountNotExist := acc.ID == 0
+	if accountNotExist {
+		log.Printf("[RequestMagicLink] User not exist!\nMeta:%+v\n", meta)
+		return nil
+	}
+
+	err = uc.account.SendMagicLink(ctx, acc.ID, acc.Email)
+	if err != nil {
+		log.Printf("[RequestMagicLink] uc.account.SendMagicLink() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrMagicLinkThrottled) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
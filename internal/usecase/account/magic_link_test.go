@@ -0,0 +1,228 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_LogInMagicLink(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockParam := LogInMagicLinkParam{
+		DeviceName: "device",
+		IPAddress:  "127.0.0.1",
+		Token:      "token",
+		UserAgent:  "agent",
+	}
+	mockLink := account.MagicLink{
+		Email:  "email",
+		UserID: 123,
+	}
+	mockAccount := account.Account{
+		Email: "email",
+		ID:    123,
+	}
+	mockSessionParam := account.CreateSessionParam{
+		DeviceName: "device",
+		IPAddress:  "127.0.0.1",
+		UserAgent:  "agent",
+		UserID:     123,
+	}
+	mockSession := account.Session{
+		SessionID: "session",
+		UserID:    123,
+	}
+	mockTOTPAccount := account.Account{
+		Email:         "email",
+		ID:            123,
+		TOTPEnabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       JWT
+		wantErr    error
+	}{
+		{
+			name: "when_ConsumeMagicLink_return_invalid_then_return_ErrMagicLinkTokenInvalid",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(account.MagicLink{}, account.ErrMagicLinkTokenInvalid)
+			},
+			wantErr: ErrMagicLinkTokenInvalid,
+		},
+		{
+			name: "when_ConsumeMagicLink_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(account.MagicLink{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetUserAccountByEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_match_then_return_ErrMagicLinkTokenInvalid",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+			},
+			wantErr: ErrMagicLinkTokenInvalid,
+		},
+		{
+			name: "when_email_not_verified_then_return_ErrEmailNotVerified",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockAccount).Return(account.ErrEmailNotVerified)
+			},
+			wantErr: ErrEmailNotVerified,
+		},
+		{
+			name: "when_totp_enabled_then_return_mfa_challenge_token_only",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockTOTPAccount, nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockTOTPAccount).Return(nil)
+				mf.accountSvc.EXPECT().CreateMFAChallenge(context.Background(), mockTOTPAccount).Return("challenge", nil)
+			},
+			want: JWT{
+				MFAChallengeToken: "challenge",
+			},
+		},
+		{
+			name: "when_NewSession_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockAccount).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(account.Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMagicLink(context.Background(), "token").Return(mockLink, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().CheckEmailVerified(mockAccount).Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.LogInMagicLink(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_RequestMagicLink(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+			},
+		},
+		{
+			name: "when_SendMagicLink_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendMagicLink(context.Background(), int64(123), "email").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendMagicLink_throttled_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendMagicLink(context.Background(), int64(123), "email").Return(account.ErrMagicLinkThrottled)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendMagicLink(context.Background(), int64(123), "email").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.RequestMagicLink(context.Background(), "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package account
+
+import (
+	// golang package
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+const (
+	fieldPassword = "password"
+)
+
+// validatePassword will check a new password against the password policy.
+// Policy violations are returned as a ValidationError of field password.
+func (uc *UseCase) validatePassword(param account.ValidatePasswordParam) error {
+	err := uc.account.ValidatePassword(param)
+	if err == nil {
+		return nil
+	}
+
+	var policyErr *account.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		log.Printf("[validatePassword] uc.account.ValidatePassword() got an error: %+v\n", err)
+		return err
+	}
+
+	fields := make([]FieldError, 0, len(policyErr.Violations))
+	for _, violation := range policyErr.Violations {
+		fields = append(fields, FieldError{
+			Code:    violation.Code,
+			Field:   fieldPassword,
+			Message: violation.Message,
+		})
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrPasswordResetTokenInvalid is returned when a password reset token is unknown, expired or already used.
+	ErrPasswordResetTokenInvalid = errors.New("password reset token not valid")
+)
+
+// ForgotPassword will send a password reset link to the email of an account.
+// To avoid disclosing which emails are registered,
+// it won't return an error when the account doesn't exist or the previous link was sent too recently.
+func (uc *UseCase) ForgotPassword(ctx context.Context, email string) error {
+	meta := map[string]interface{}{
+		"email": email,
+	}
+
+	acc, err := uc.account.GetUserAccountByEmail(ctx, email)
+	if err != nil {
+		log.Printf("[ForgotPassword] uc.account.GetUserAccountByEmail() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	accountNotExist := acc.ID == 0
+	if accountNotExist {
+		log.Printf("[ForgotPassword] User not exist!\nMeta:%+v\n", meta)
+		return nil
+	}
+
+	err = uc.account.SendPasswordResetToken(ctx, acc.ID, acc.Email)
+	if err != nil {
+		log.Printf("[ForgotPassword] uc.account.SendPasswordResetToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrPasswordResetThrottled) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ResetPassword will set a new password for the owner of a password reset token.
+// Every session of the user will be revoked afterward.
+// A password that violates the password policy is refused with ValidationError.
+func (uc *UseCase) ResetPassword(ctx context.Context, token, password string) error {
+	// the owner of the token is only known once the token is consumed,
+	// so the password is checked without personal information to keep the token
+	// usable when the password is refused.
+	err := uc.validatePassword(account.ValidatePasswordParam{
+		Password: password,
+	})
+	if err != nil {
+		log.Printf("[ResetPassword] uc.validatePassword() got an error: %+v\n", err)
+		return err
+	}
+
+	userID, err := uc.account.ConsumePasswordResetToken(ctx, token)
+	if err != nil {
+		log.Printf("[ResetPassword] uc.account.ConsumePasswordResetToken() got an error: %+v\n", err)
+		if errors.Is(err, account.ErrPasswordResetTokenInvalid) {
+			return ErrPasswordResetTokenInvalid
+		}
+
+		return err
+	}
+
+	meta := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	err = uc.account.UpdateUserPassword(ctx, userID, password)
+	if err != nil {
+		log.Printf("[ResetPassword] uc.account.UpdateUserPassword() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, userID, account.AuditEventPasswordChanged)
+
+	err = uc.account.InvalidateJWT(ctx, userID)
+	if err != nil {
+		log.Printf("[ResetPassword] uc.account.InvalidateJWT() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ForgotPassword(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_GetUserAccountByEmail_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{}, nil)
+			},
+		},
+		{
+			name: "when_SendPasswordResetToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendPasswordResetToken(context.Background(), int64(123), "email").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SendPasswordResetToken_throttled_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendPasswordResetToken(context.Background(), int64(123), "email").Return(account.ErrPasswordResetThrottled)
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByEmail(context.Background(), "email").Return(account.Account{
+					Email: "email",
+					ID:    123,
+				}, nil)
+				mf.accountSvc.EXPECT().SendPasswordResetToken(context.Background(), int64(123), "email").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ForgotPassword(context.Background(), "email")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ResetPassword(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name: "when_password_violates_policy_then_return_validation_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(&account.PasswordPolicyError{
+					Violations: []account.PasswordViolation{{Code: account.PasswordViolationTooShort, Message: "too short"}},
+				})
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{{Code: account.PasswordViolationTooShort, Field: "password", Message: "too short"}},
+			},
+		},
+		{
+			name: "when_ValidatePassword_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ConsumePasswordResetToken_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(nil)
+				mf.accountSvc.EXPECT().ConsumePasswordResetToken(context.Background(), "token").Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_token_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(nil)
+				mf.accountSvc.EXPECT().ConsumePasswordResetToken(context.Background(), "token").Return(int64(0), account.ErrPasswordResetTokenInvalid)
+			},
+			wantErr: ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "when_UpdateUserPassword_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(nil)
+				mf.accountSvc.EXPECT().ConsumePasswordResetToken(context.Background(), "token").Return(int64(123), nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(context.Background(), int64(123), "pass").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_InvalidateJWT_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(nil)
+				mf.accountSvc.EXPECT().ConsumePasswordResetToken(context.Background(), "token").Return(int64(123), nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(context.Background(), int64(123), "pass").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventPasswordChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ValidatePassword(account.ValidatePasswordParam{Password: "pass"}).Return(nil)
+				mf.accountSvc.EXPECT().ConsumePasswordResetToken(context.Background(), "token").Return(int64(123), nil)
+				mf.accountSvc.EXPECT().UpdateUserPassword(context.Background(), int64(123), "pass").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventPasswordChanged).Return(nil)
+				mf.accountSvc.EXPECT().InvalidateJWT(context.Background(), int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ResetPassword(context.Background(), "token", "pass")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrPersonalAccessTokenExpiryInvalid is returned when a personal access token is created with an expiry in the past.
+	ErrPersonalAccessTokenExpiryInvalid = errors.New("personal access token expiry must be in the future")
+
+	// ErrPersonalAccessTokenNotFound is returned when the personal access token to revoke doesn't exist.
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+
+	// ErrPersonalAccessTokenScopeInvalid is returned when a personal access token is created without scope or with an unknown scope.
+	ErrPersonalAccessTokenScopeInvalid = errors.New("personal access token scope not valid")
+)
+
+// CreatePersonalAccessToken will create a new personal access token for the user acting on ctx.
+// The token is only returned this once.
+func (uc *UseCase) CreatePersonalAccessToken(ctx context.Context, param CreatePersonalAccessTokenParam) (PersonalAccessTokenCreated, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[CreatePersonalAccessToken] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return PersonalAccessTokenCreated{}, errUnauthorized
+	}
+
+	token, pat, err := uc.account.CreatePersonalAccessToken(ctx, account.CreatePersonalAccessTokenParam{
+		ExpiresAt: param.ExpiresAt,
+		Name:      param.Name,
+		Scopes:    param.Scopes,
+		UserID:    principal.UserID,
+	})
+	if err != nil {
+		meta := map[string]interface{}{
+			"name":    param.Name,
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[CreatePersonalAccessToken] uc.account.CreatePersonalAccessToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		switch {
+		case errors.Is(err, account.ErrPersonalAccessTokenExpiryInvalid):
+			return PersonalAccessTokenCreated{}, ErrPersonalAccessTokenExpiryInvalid
+		case errors.Is(err, account.ErrPersonalAccessTokenScopeInvalid):
+			return PersonalAccessTokenCreated{}, ErrPersonalAccessTokenScopeInvalid
+		}
+
+		return PersonalAccessTokenCreated{}, err
+	}
+
+	return PersonalAccessTokenCreated{
+		PersonalAccessToken: convertPersonalAccessToken(pat),
+		Token:               token,
+	}, nil
+}
+
+// ListPersonalAccessTokens will fetch every personal access token of the user acting on ctx.
+func (uc *UseCase) ListPersonalAccessTokens(ctx context.Context) ([]PersonalAccessToken, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListPersonalAccessTokens] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	tokens, err := uc.account.ListPersonalAccessTokens(ctx, principal.UserID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[ListPersonalAccessTokens] uc.account.ListPersonalAccessTokens() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, convertPersonalAccessToken(token))
+	}
+
+	return result, nil
+}
+
+// RevokePersonalAccessToken will revoke a personal access token of the user acting on ctx.
+// A user can only revoke their own token.
+func (uc *UseCase) RevokePersonalAccessToken(ctx context.Context, tokenID int64) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[RevokePersonalAccessToken] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	err := uc.account.RevokePersonalAccessToken(ctx, principal.UserID, tokenID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"token_id": tokenID,
+			"user_id":  principal.UserID,
+		}
+
+		log.Printf("[RevokePersonalAccessToken] uc.account.RevokePersonalAccessToken() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrPersonalAccessTokenNotFound) {
+			return ErrPersonalAccessTokenNotFound
+		}
+
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventTokenRevoked)
+
+	return nil
+}
+
+// convertPersonalAccessToken will convert a personal access token from account service
+// into its response format.
+func convertPersonalAccessToken(pat account.PersonalAccessToken) PersonalAccessToken {
+	result := PersonalAccessToken{
+		CreatedAt: pat.CreatedAt,
+		ID:        pat.ID,
+		Name:      pat.Name,
+		Scopes:    pat.Scopes,
+	}
+
+	if !pat.ExpiresAt.IsZero() {
+		expiresAt := pat.ExpiresAt
+		result.ExpiresAt = &expiresAt
+	}
+
+	return result
+}
@@ -0,0 +1,273 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_CreatePersonalAccessToken(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockExpiresAt := mockTime.Add(time.Hour)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 123,
+	})
+	mockParam := account.CreatePersonalAccessTokenParam{
+		ExpiresAt: mockExpiresAt,
+		Name:      "ci",
+		Scopes:    []string{entity.ScopeRead},
+		UserID:    123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       PersonalAccessTokenCreated
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_expiry_invalid_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return("", account.PersonalAccessToken{}, account.ErrPersonalAccessTokenExpiryInvalid)
+			},
+			wantErr: ErrPersonalAccessTokenExpiryInvalid,
+		},
+		{
+			name: "when_scope_invalid_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return("", account.PersonalAccessToken{}, account.ErrPersonalAccessTokenScopeInvalid)
+			},
+			wantErr: ErrPersonalAccessTokenScopeInvalid,
+		},
+		{
+			name: "when_CreatePersonalAccessToken_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return("", account.PersonalAccessToken{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_token",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CreatePersonalAccessToken(ctx, mockParam).Return("bubi_pat_token", account.PersonalAccessToken{
+					CreatedAt: mockTime,
+					ExpiresAt: mockExpiresAt,
+					ID:        1,
+					Name:      "ci",
+					Scopes:    []string{entity.ScopeRead},
+					UserID:    123,
+				}, nil)
+			},
+			want: PersonalAccessTokenCreated{
+				PersonalAccessToken: PersonalAccessToken{
+					CreatedAt: mockTime,
+					ExpiresAt: &mockExpiresAt,
+					ID:        1,
+					Name:      "ci",
+					Scopes:    []string{entity.ScopeRead},
+				},
+				Token: "bubi_pat_token",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.CreatePersonalAccessToken(test.ctx, CreatePersonalAccessTokenParam{
+				ExpiresAt: mockExpiresAt,
+				Name:      "ci",
+				Scopes:    []string{entity.ScopeRead},
+			})
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ListPersonalAccessTokens(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       []PersonalAccessToken
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListPersonalAccessTokens_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListPersonalAccessTokens(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_tokens",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListPersonalAccessTokens(ctx, int64(123)).Return([]account.PersonalAccessToken{
+					{
+						CreatedAt: mockTime,
+						Email:     "email",
+						ID:        2,
+						Name:      "deploy",
+						Scopes:    []string{entity.ScopeRead, entity.ScopeWrite},
+						UserID:    123,
+					},
+					{
+						CreatedAt: mockTime,
+						Email:     "email",
+						ExpiresAt: mockTime,
+						ID:        1,
+						Name:      "ci",
+						Scopes:    []string{entity.ScopeRead},
+						UserID:    123,
+					},
+				}, nil)
+			},
+			want: []PersonalAccessToken{
+				{
+					CreatedAt: mockTime,
+					ID:        2,
+					Name:      "deploy",
+					Scopes:    []string{entity.ScopeRead, entity.ScopeWrite},
+				},
+				{
+					CreatedAt: mockTime,
+					ExpiresAt: &mockTime,
+					ID:        1,
+					Name:      "ci",
+					Scopes:    []string{entity.ScopeRead},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.ListPersonalAccessTokens(test.ctx)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_RevokePersonalAccessToken(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_token_not_found_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokePersonalAccessToken(ctx, int64(123), int64(1)).Return(account.ErrPersonalAccessTokenNotFound)
+			},
+			wantErr: ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name: "when_RevokePersonalAccessToken_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokePersonalAccessToken(ctx, int64(123), int64(1)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokePersonalAccessToken(ctx, int64(123), int64(1)).Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventTokenRevoked).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.RevokePersonalAccessToken(test.ctx, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
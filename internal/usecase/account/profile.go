@@ -0,0 +1,74 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrAccountNotFound is returned when the account acting on ctx no longer exists.
+	ErrAccountNotFound = errors.New("account not found")
+)
+
+// GetProfile will fetch account information of the user acting on ctx.
+// Secrets of the account, such as its password hash, are never returned.
+func (uc *UseCase) GetProfile(ctx context.Context) (Profile, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetProfile] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Profile{}, errUnauthorized
+	}
+
+	acc, err := uc.account.GetUserAccountByID(ctx, principal.UserID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[GetProfile] uc.account.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return Profile{}, ErrAccountNotFound
+		}
+
+		return Profile{}, err
+	}
+
+	return convertProfile(acc), nil
+}
+
+// convertProfile will convert user's account from account service
+// into its response format.
+func convertProfile(acc account.Account) Profile {
+	result := Profile{
+		CreatedAt:     acc.CreatedAt,
+		Email:         acc.Email,
+		EmailVerified: !acc.EmailVerifiedAt.IsZero(),
+		FirstName:     acc.FirstName,
+		ID:            acc.ID,
+		LastName:      acc.LastName,
+		Preferences: Preferences{
+			BudgetingMode:     acc.BudgetingMode,
+			RecordPeriodStart: acc.RecordPeriodStart,
+			Timezone:          acc.Timezone,
+		},
+		TOTPEnabled: !acc.TOTPEnabledAt.IsZero(),
+	}
+
+	if !acc.EmailVerifiedAt.IsZero() {
+		emailVerifiedAt := acc.EmailVerifiedAt
+		result.EmailVerifiedAt = &emailVerifiedAt
+	}
+
+	if !acc.UpdatedAt.IsZero() {
+		updatedAt := acc.UpdatedAt
+		result.UpdatedAt = &updatedAt
+	}
+
+	return result
+}
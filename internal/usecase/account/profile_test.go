@@ -0,0 +1,136 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_GetProfile(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       Profile
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_account_not_found_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, account.ErrAccountNotFound)
+			},
+			wantErr: ErrAccountNotFound,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_never_verified_nor_updated_then_return_profile_without_those_times",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{
+					BudgetingMode:     "envelope",
+					CreatedAt:         mockTime,
+					Email:             "email",
+					ID:                123,
+					Password:          "hashed",
+					RecordPeriodStart: 25,
+				}, nil)
+			},
+			want: Profile{
+				CreatedAt: mockTime,
+				Email:     "email",
+				ID:        123,
+				Preferences: Preferences{
+					BudgetingMode:     "envelope",
+					RecordPeriodStart: 25,
+				},
+			},
+		},
+		{
+			name: "when_no_error_occured_then_return_profile",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{
+					BudgetingMode:     "envelope",
+					CreatedAt:         mockTime,
+					Email:             "email",
+					EmailVerifiedAt:   mockTime,
+					FirstName:         "Ji Eun",
+					ID:                123,
+					LastName:          "Lee",
+					Password:          "hashed",
+					RecordPeriodStart: 25,
+					TOTPEnabledAt:     mockTime,
+					TOTPSecret:        "secret",
+					Timezone:          "Asia/Jakarta",
+					UpdatedAt:         mockTime,
+				}, nil)
+			},
+			want: Profile{
+				CreatedAt:       mockTime,
+				Email:           "email",
+				EmailVerified:   true,
+				EmailVerifiedAt: &mockTime,
+				FirstName:       "Ji Eun",
+				ID:              123,
+				LastName:        "Lee",
+				Preferences: Preferences{
+					BudgetingMode:     "envelope",
+					RecordPeriodStart: 25,
+					Timezone:          "Asia/Jakarta",
+				},
+				TOTPEnabled: true,
+				UpdatedAt:   &mockTime,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.GetProfile(test.ctx)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrSessionNotFound is returned when the session to revoke doesn't exist.
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// ListSessions will fetch every active session of the user acting on ctx.
+func (uc *UseCase) ListSessions(ctx context.Context) ([]Session, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListSessions] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	sessions, err := uc.account.ListSessions(ctx, principal.UserID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[ListSessions] uc.account.ListSessions() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, Session{
+			CreatedAt:  session.CreatedAt,
+			Current:    session.SessionID == principal.SessionID,
+			DeviceName: session.DeviceName,
+			IPAddress:  session.IPAddress,
+			LastSeenAt: session.LastSeenAt,
+			SessionID:  session.SessionID,
+			UserAgent:  session.UserAgent,
+		})
+	}
+
+	return result, nil
+}
+
+// RevokeOtherSessions will revoke every session of the user acting on ctx,
+// except the session used by the request.
+func (uc *UseCase) RevokeOtherSessions(ctx context.Context) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[RevokeOtherSessions] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	err := uc.account.RevokeOtherSessions(ctx, principal.UserID, principal.SessionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":    principal.UserID,
+			"session_id": principal.SessionID,
+		}
+
+		log.Printf("[RevokeOtherSessions] uc.account.RevokeOtherSessions() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventTokenRevoked)
+
+	return nil
+}
+
+// RevokeSession will revoke a session of the user acting on ctx.
+// A user can only revoke their own session.
+func (uc *UseCase) RevokeSession(ctx context.Context, sessionID string) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[RevokeSession] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	err := uc.account.RevokeSession(ctx, principal.UserID, sessionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":    principal.UserID,
+			"session_id": sessionID,
+		}
+
+		log.Printf("[RevokeSession] uc.account.RevokeSession() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	uc.recordAuditEvent(ctx, principal.UserID, account.AuditEventTokenRevoked)
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ListSessions(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "current",
+		UserID:    123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       []Session
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListSessions_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListSessions(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_sessions",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ListSessions(ctx, int64(123)).Return([]account.Session{
+					{
+						CreatedAt:  mockTime,
+						DeviceName: "phone",
+						IPAddress:  "127.0.0.1",
+						LastSeenAt: mockTime,
+						SessionID:  "current",
+						TokenID:    "jti",
+						UserAgent:  "agent",
+						UserID:     123,
+					},
+					{
+						CreatedAt:  mockTime,
+						DeviceName: "laptop",
+						LastSeenAt: mockTime,
+						SessionID:  "other",
+						TokenID:    "another_jti",
+						UserID:     123,
+					},
+				}, nil)
+			},
+			want: []Session{
+				{
+					CreatedAt:  mockTime,
+					Current:    true,
+					DeviceName: "phone",
+					IPAddress:  "127.0.0.1",
+					LastSeenAt: mockTime,
+					SessionID:  "current",
+					UserAgent:  "agent",
+				},
+				{
+					CreatedAt:  mockTime,
+					DeviceName: "laptop",
+					LastSeenAt: mockTime,
+					SessionID:  "other",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.ListSessions(test.ctx)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_RevokeOtherSessions(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "current",
+		UserID:    123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_RevokeOtherSessions_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeOtherSessions(ctx, int64(123), "current").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeOtherSessions(ctx, int64(123), "current").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventTokenRevoked).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.RevokeOtherSessions(test.ctx)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_RevokeSession(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		SessionID: "current",
+		UserID:    123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_session_not_found_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(123), "other").Return(account.ErrSessionNotFound)
+			},
+			wantErr: ErrSessionNotFound,
+		},
+		{
+			name: "when_RevokeSession_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(123), "other").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().RevokeSession(ctx, int64(123), "other").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(ctx, int64(123), account.AuditEventTokenRevoked).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.RevokeSession(test.ctx, "other")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+var (
+	// ErrIncorrectPassword is returned when the current password given by user doesn't match.
+	ErrIncorrectPassword = errors.New("incorrect password")
+
+	// ErrMFAChallengeInvalid is returned when an MFA challenge token is unknown, expired or already used.
+	ErrMFAChallengeInvalid = errors.New("mfa challenge not valid")
+
+	// ErrTOTPAlreadyEnabled is returned when enrolling TOTP while it's already enabled.
+	ErrTOTPAlreadyEnabled = errors.New("two-factor authentication already enabled")
+
+	// ErrTOTPCodeInvalid is returned when the given TOTP or recovery code doesn't match.
+	ErrTOTPCodeInvalid = errors.New("two-factor authentication code not valid")
+
+	// ErrTOTPNotEnrolled is returned when confirming TOTP before enrolling.
+	ErrTOTPNotEnrolled = errors.New("two-factor authentication not enrolled")
+)
+
+// ConfirmTOTP will enable TOTP of the user acting on ctx
+// once the first code generated by user's authenticator is verified.
+func (uc *UseCase) ConfirmTOTP(ctx context.Context, code string) error {
+	acc, err := uc.getPrincipalAccount(ctx)
+	if err != nil {
+		log.Printf("[ConfirmTOTP] uc.getPrincipalAccount() got an error: %+v\n", err)
+		return err
+	}
+
+	meta := map[string]interface{}{
+		"user_id": acc.ID,
+	}
+
+	err = uc.account.ConfirmTOTP(ctx, acc, code)
+	if err != nil {
+		log.Printf("[ConfirmTOTP] uc.account.ConfirmTOTP() got an error: %+v\nMeta:%+v\n", err, meta)
+		switch {
+		case errors.Is(err, account.ErrTOTPAlreadyEnabled):
+			return ErrTOTPAlreadyEnabled
+		case errors.Is(err, account.ErrTOTPNotEnrolled):
+			return ErrTOTPNotEnrolled
+		case errors.Is(err, account.ErrTOTPCodeInvalid):
+			return ErrTOTPCodeInvalid
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// DisableTOTP will turn off TOTP of the user acting on ctx.
+// User's current password is needed to do so.
+func (uc *UseCase) DisableTOTP(ctx context.Context, password string) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[DisableTOTP] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"user_id": principal.UserID,
+	}
+
+	err := uc.account.CheckPasswordCorrect(ctx, principal.Email, password)
+	if err != nil {
+		log.Printf("[DisableTOTP] uc.account.CheckPasswordCorrect() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrIncorrectPassword) {
+			return ErrIncorrectPassword
+		}
+
+		return err
+	}
+
+	err = uc.account.DisableTOTP(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[DisableTOTP] uc.account.DisableTOTP() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	return nil
+}
+
+// EnrollTOTP will start TOTP enrollment of the user acting on ctx.
+// TOTP is enabled once the enrollment is confirmed by ConfirmTOTP.
+func (uc *UseCase) EnrollTOTP(ctx context.Context) (TOTPEnrollment, error) {
+	acc, err := uc.getPrincipalAccount(ctx)
+	if err != nil {
+		log.Printf("[EnrollTOTP] uc.getPrincipalAccount() got an error: %+v\n", err)
+		return TOTPEnrollment{}, err
+	}
+
+	meta := map[string]interface{}{
+		"user_id": acc.ID,
+	}
+
+	enrollment, err := uc.account.EnrollTOTP(ctx, acc)
+	if err != nil {
+		log.Printf("[EnrollTOTP] uc.account.EnrollTOTP() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrTOTPAlreadyEnabled) {
+			return TOTPEnrollment{}, ErrTOTPAlreadyEnabled
+		}
+
+		return TOTPEnrollment{}, err
+	}
+
+	return TOTPEnrollment{
+		ProvisioningURI: enrollment.ProvisioningURI,
+		RecoveryCodes:   enrollment.RecoveryCodes,
+		Secret:          enrollment.Secret,
+	}, nil
+}
+
+// LogInMFA will finish a log in that is waiting for a TOTP code.
+// It exchanges the MFA challenge token returned by LogIn and a TOTP or recovery code
+// with a new session, a short-lived JWT and a refresh token.
+// An MFA challenge token can only be used once, so a wrong code means logging in again.
+func (uc *UseCase) LogInMFA(ctx context.Context, param LogInMFAParam) (JWT, error) {
+	meta := map[string]interface{}{
+		"device_name": param.DeviceName,
+		"ip_address":  param.IPAddress,
+	}
+
+	challenge, err := uc.account.ConsumeMFAChallenge(ctx, param.ChallengeToken)
+	if err != nil {
+		log.Printf("[LogInMFA] uc.account.ConsumeMFAChallenge() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrMFAChallengeInvalid) {
+			return JWT{}, ErrMFAChallengeInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	meta["user_id"] = challenge.UserID
+
+	acc, err := uc.account.GetUserAccountByID(ctx, challenge.UserID)
+	if err != nil {
+		log.Printf("[LogInMFA] uc.account.GetUserAccountByID() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return JWT{}, ErrMFAChallengeInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	// the account may be disabled while the challenge is waiting for a TOTP code.
+	if !acc.DisabledAt.IsZero() {
+		log.Printf("[LogInMFA] account disabled\nMeta:%+v\n", meta)
+		return JWT{}, ErrAccountDisabled
+	}
+
+	err = uc.account.VerifyTOTP(ctx, acc, param.Code)
+	if err != nil {
+		log.Printf("[LogInMFA] uc.account.VerifyTOTP() got an error: %+v\nMeta:%+v\n", err, meta)
+		switch {
+		case errors.Is(err, account.ErrTOTPCodeInvalid):
+			uc.recordLoginFailure(ctx, acc.Email, param.IPAddress)
+			uc.recordAuditEvent(ctx, acc.ID, account.AuditEventLoginFailed)
+			return JWT{}, ErrTOTPCodeInvalid
+		case errors.Is(err, account.ErrTOTPNotEnabled):
+			return JWT{}, ErrMFAChallengeInvalid
+		}
+
+		return JWT{}, err
+	}
+
+	uc.resetLoginFailures(ctx, acc.Email)
+
+	err = uc.cancelAccountDeletion(ctx, acc)
+	if err != nil {
+		log.Printf("[LogInMFA] uc.cancelAccountDeletion() got an error: %+v\nMeta:%+v\n", err, meta)
+		return JWT{}, err
+	}
+
+	return uc.issueJWT(ctx, account.CreateSessionParam{
+		DeviceName: param.DeviceName,
+		IPAddress:  param.IPAddress,
+		Role:       acc.Role,
+		UserAgent:  param.UserAgent,
+		UserID:     acc.ID,
+	}, acc.Email)
+}
+
+// getPrincipalAccount will fetch account of the user acting on ctx.
+func (uc *UseCase) getPrincipalAccount(ctx context.Context) (account.Account, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		return account.Account{}, errUnauthorized
+	}
+
+	acc, err := uc.account.GetUserAccountByID(ctx, principal.UserID)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return account.Account{}, errUserNotExist
+		}
+
+		return account.Account{}, err
+	}
+
+	return acc, nil
+}
@@ -0,0 +1,463 @@
+package account
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+)
+
+func TestUseCase_ConfirmTOTP(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockAccount := account.Account{Email: "email", ID: 123}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(account.Account{}, account.ErrAccountNotFound)
+			},
+			wantErr: errUserNotExist,
+		},
+		{
+			name: "when_totp_already_enabled_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().ConfirmTOTP(ctx, mockAccount, "123456").Return(account.ErrTOTPAlreadyEnabled)
+			},
+			wantErr: ErrTOTPAlreadyEnabled,
+		},
+		{
+			name: "when_totp_not_enrolled_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().ConfirmTOTP(ctx, mockAccount, "123456").Return(account.ErrTOTPNotEnrolled)
+			},
+			wantErr: ErrTOTPNotEnrolled,
+		},
+		{
+			name: "when_code_invalid_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().ConfirmTOTP(ctx, mockAccount, "123456").Return(account.ErrTOTPCodeInvalid)
+			},
+			wantErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name: "when_ConfirmTOTP_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().ConfirmTOTP(ctx, mockAccount, "123456").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().ConfirmTOTP(ctx, mockAccount, "123456").Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.ConfirmTOTP(test.ctx, "123456")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_DisableTOTP(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_password_incorrect_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(account.ErrIncorrectPassword)
+			},
+			wantErr: ErrIncorrectPassword,
+		},
+		{
+			name: "when_CheckPasswordCorrect_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_DisableTOTP_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().DisableTOTP(ctx, int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().CheckPasswordCorrect(ctx, "email", "pass").Return(nil)
+				mf.accountSvc.EXPECT().DisableTOTP(ctx, int64(123)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			err := uc.DisableTOTP(test.ctx, "pass")
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_EnrollTOTP(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockAccount := account.Account{Email: "email", ID: 123}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       TOTPEnrollment
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_totp_already_enabled_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().EnrollTOTP(ctx, mockAccount).Return(account.TOTPEnrollment{}, account.ErrTOTPAlreadyEnabled)
+			},
+			wantErr: ErrTOTPAlreadyEnabled,
+		},
+		{
+			name: "when_EnrollTOTP_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().EnrollTOTP(ctx, mockAccount).Return(account.TOTPEnrollment{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_enrollment",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(ctx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().EnrollTOTP(ctx, mockAccount).Return(account.TOTPEnrollment{
+					ProvisioningURI: "otpauth://totp/bubi:email",
+					RecoveryCodes:   []string{"ababa-babab"},
+					Secret:          "SECRET",
+				}, nil)
+			},
+			want: TOTPEnrollment{
+				ProvisioningURI: "otpauth://totp/bubi:email",
+				RecoveryCodes:   []string{"ababa-babab"},
+				Secret:          "SECRET",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.EnrollTOTP(test.ctx)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_LogInMFA(t *testing.T) {
+	type mockFields struct {
+		accountSvc *MockaccountServiceProvider
+	}
+
+	mockParam := LogInMFAParam{
+		ChallengeToken: "challenge",
+		Code:           "123456",
+		DeviceName:     "device",
+		IPAddress:      "127.0.0.1",
+		UserAgent:      "agent",
+	}
+	mockChallenge := account.MFAChallenge{
+		Email:  "old_email",
+		UserID: 123,
+	}
+	mockAccount := account.Account{
+		Email:         "email",
+		ID:            123,
+		TOTPEnabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mockDeletionAccount := account.Account{
+		DeletionRequestedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Email:               "email",
+		ID:                  123,
+		TOTPEnabledAt:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mockSessionParam := account.CreateSessionParam{
+		DeviceName: "device",
+		IPAddress:  "127.0.0.1",
+		UserAgent:  "agent",
+		UserID:     123,
+	}
+	mockSession := account.Session{
+		SessionID: "session",
+		UserID:    123,
+	}
+
+	tests := []struct {
+		name       string
+		mockFields func(mockFields)
+		want       JWT
+		wantErr    error
+	}{
+		{
+			name: "when_challenge_invalid_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(account.MFAChallenge{}, account.ErrMFAChallengeInvalid)
+			},
+			wantErr: ErrMFAChallengeInvalid,
+		},
+		{
+			name: "when_ConsumeMFAChallenge_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(account.MFAChallenge{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_not_exist_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{}, account.ErrAccountNotFound)
+			},
+			wantErr: ErrMFAChallengeInvalid,
+		},
+		{
+			name: "when_account_disabled_meanwhile_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(account.Account{
+					DisabledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Email:      "email",
+					ID:         123,
+				}, nil)
+			},
+			wantErr: ErrAccountDisabled,
+		},
+		{
+			name: "when_code_invalid_then_record_failure_and_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockAccount, "123456").Return(account.ErrTOTPCodeInvalid)
+				mf.accountSvc.EXPECT().RecordLoginFailure(context.Background(), "email", "127.0.0.1").Return(nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginFailed).Return(nil)
+			},
+			wantErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name: "when_totp_disabled_meanwhile_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockAccount, "123456").Return(account.ErrTOTPNotEnabled)
+			},
+			wantErr: ErrMFAChallengeInvalid,
+		},
+		{
+			name: "when_VerifyTOTP_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockAccount, "123456").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_CancelAccountDeletion_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockDeletionAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockDeletionAccount, "123456").Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().CancelAccountDeletion(context.Background(), int64(123)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_account_pending_deletion_then_cancel_deletion_and_return_jwt",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockDeletionAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockDeletionAccount, "123456").Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().CancelAccountDeletion(context.Background(), int64(123)).Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
+			},
+		},
+		{
+			name: "when_NewSession_error_then_return_error",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockAccount, "123456").Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(nil)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(account.Session{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_jwt",
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().ConsumeMFAChallenge(context.Background(), "challenge").Return(mockChallenge, nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(context.Background(), int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().VerifyTOTP(context.Background(), mockAccount, "123456").Return(nil)
+				mf.accountSvc.EXPECT().ResetLoginFailures(context.Background(), "email").Return(assert.AnError)
+				mf.accountSvc.EXPECT().NewSession(mockSessionParam).Return(mockSession, nil)
+				mf.accountSvc.EXPECT().GenerateJWT(context.Background(), mockSession, "email").Return("abc", nil)
+				mf.accountSvc.EXPECT().GenerateRefreshToken(context.Background(), mockSession, "email").Return("def", nil)
+				mf.accountSvc.EXPECT().RecordAuditEvent(context.Background(), int64(123), account.AuditEventLoginSucceeded).Return(nil)
+			},
+			want: JWT{
+				RefreshToken: "def",
+				Token:        "abc",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc: NewMockaccountServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account: mockFields.accountSvc,
+			}
+
+			got, err := uc.LogInMFA(context.Background(), mockParam)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -1,30 +1,220 @@
 package account
 
+import (
+	// golang package
+	"time"
+)
+
+// ----------------
+// | Error Struct |
+// ----------------
+
+// LoginBlockedError is returned when log in is refused because of too many failed attempts.
+// Err is either ErrAccountLocked or ErrLoginThrottled, and RetryAfter tells
+// how long until the next attempt is allowed.
+type LoginBlockedError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// Error returns the message of the underlying error.
+func (e *LoginBlockedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error so it can be checked with errors.Is.
+func (e *LoginBlockedError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is returned when fields of a request aren't valid.
+// Fields holds every problem found, so all of them can be shown at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns a summary of the invalid fields.
+func (e *ValidationError) Error() string {
+	return "request not valid"
+}
+
+// FieldError describes why a field of a request isn't valid.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 // -------------------
 // | Response Struct |
 // -------------------
 
-// JWT holds token needed for authorization.
+// AccountSummary holds account information of a user that is shown to admins.
+// DisabledAt is empty unless the account is disabled.
+type AccountSummary struct {
+	CreatedAt     time.Time  `json:"created_at"`
+	DisabledAt    *time.Time `json:"disabled_at"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"email_verified"`
+	FirstName     string     `json:"first_name"`
+	ID            int64      `json:"id"`
+	LastName      string     `json:"last_name"`
+	Role          string     `json:"role"`
+	TOTPEnabled   bool       `json:"totp_enabled"`
+}
+
+// AuditEvent holds information about a security event that happened on a user's account.
+// IPAddress, RequestID and UserAgent describe the request that caused the event.
+type AuditEvent struct {
+	CreatedAt time.Time `json:"created_at"`
+	Event     string    `json:"event"`
+	IPAddress string    `json:"ip_address"`
+	RequestID string    `json:"request_id"`
+	UserAgent string    `json:"user_agent"`
+}
+
+// JWT holds token needed for authorization
+// alongside refresh token needed to get a new one.
+// When a TOTP code is still needed, only MFAChallengeToken is filled.
 type JWT struct {
+	MFAChallengeToken string `json:"mfa_challenge_token,omitempty"`
+	RefreshToken      string `json:"refresh_token"`
+	Token             string `json:"token"`
+}
+
+// PersonalAccessToken holds information about a personal access token of a user.
+// ExpiresAt is empty when the token never expires.
+type PersonalAccessToken struct {
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+}
+
+// PersonalAccessTokenCreated holds a newly created personal access token.
+// Token is only shown once.
+type PersonalAccessTokenCreated struct {
+	PersonalAccessToken
 	Token string `json:"token"`
 }
 
+// Preferences holds settings chosen by user.
+type Preferences struct {
+	BudgetingMode     string `json:"budgeting_mode"`
+	RecordPeriodStart int    `json:"record_period_start"`
+	Timezone          string `json:"timezone"`
+}
+
+// Profile holds user's own account information.
+// EmailVerifiedAt and UpdatedAt are empty until the email is verified and the account is updated.
+type Profile struct {
+	CreatedAt       time.Time   `json:"created_at"`
+	Email           string      `json:"email"`
+	EmailVerified   bool        `json:"email_verified"`
+	EmailVerifiedAt *time.Time  `json:"email_verified_at"`
+	FirstName       string      `json:"first_name"`
+	ID              int64       `json:"id"`
+	LastName        string      `json:"last_name"`
+	Preferences     Preferences `json:"preferences"`
+	TOTPEnabled     bool        `json:"totp_enabled"`
+	UpdatedAt       *time.Time  `json:"updated_at"`
+}
+
+// Session holds information about a device that is logged in to user's account.
+// Current marks the session that is used by the request.
+type Session struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Current    bool      `json:"current"`
+	DeviceName string    `json:"device_name"`
+	IPAddress  string    `json:"ip_address"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	SessionID  string    `json:"session_id"`
+	UserAgent  string    `json:"user_agent"`
+}
+
+// TOTPEnrollment holds what user needs to set up an authenticator app.
+// RecoveryCodes are only shown once.
+type TOTPEnrollment struct {
+	ProvisioningURI string   `json:"provisioning_uri"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+	Secret          string   `json:"secret"`
+}
+
 // --------------------
 // | Parameter Struct |
 // --------------------
 
+// CreatePersonalAccessTokenParam represents parameter needed to create a personal access token.
+// A zero ExpiresAt means the token never expires.
+type CreatePersonalAccessTokenParam struct {
+	ExpiresAt time.Time
+	Name      string
+	Scopes    []string
+}
+
+// ListActivityParam represents parameter needed to fetch security events of a user.
+// A zero Limit uses the default page size.
+type ListActivityParam struct {
+	Limit  int
+	Offset int
+}
+
+// LogInParam represents parameter needed to log in a user.
+// DeviceName, IPAddress and UserAgent describe the device that logs in.
+type LogInParam struct {
+	DeviceName string
+	Email      string
+	IPAddress  string
+	Password   string
+	UserAgent  string
+}
+
+// LogInMFAParam represents parameter needed to finish log in using a TOTP code.
+// DeviceName, IPAddress and UserAgent describe the device that logs in.
+type LogInMFAParam struct {
+	ChallengeToken string
+	Code           string
+	DeviceName     string
+	IPAddress      string
+	UserAgent      string
+}
+
+// LogInMagicLinkParam represents parameter needed to log in using a magic link.
+// DeviceName, IPAddress and UserAgent describe the device that logs in.
+type LogInMagicLinkParam struct {
+	DeviceName string
+	IPAddress  string
+	Token      string
+	UserAgent  string
+}
+
+// RequestEmailChangeParam represents parameter needed to request a change of user's email.
+type RequestEmailChangeParam struct {
+	Email    string
+	Password string
+}
+
+// SearchAccountsParam represents parameter needed to search accounts of users.
+// A zero Limit uses the default page size.
+type SearchAccountsParam struct {
+	Limit  int
+	Offset int
+	Query  string
+}
+
 // UpdateUserAccountParam represents parameter needed to update an account.
+// An empty BudgetingMode or Timezone keeps the current one.
 type UpdateUserAccountParam struct {
-	FirstName    string
-	LastName     string
-	RecordPeriod int
-	UserID       int64
+	BudgetingMode string
+	FirstName     string
+	LastName      string
+	RecordPeriod  int
+	Timezone      string
 }
 
 // UpdatePasswordParam represents parameter needed to update user's password.
 type UpdatePasswordParam struct {
-	Email       string
 	OldPassword string
 	Password    string
-	UserID      int64
 }
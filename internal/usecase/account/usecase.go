@@ -3,6 +3,7 @@ package account
 import (
 	// golang package
 	"context"
+	"time"
 
 	// internal package
 	"github.com/arifinhermawan/bubi/internal/service/account"
@@ -12,43 +13,216 @@ import (
 
 // accountServiceProvider holds all methods from account service that wil be used in account's usecase.
 type accountServiceProvider interface {
+	// CancelAccountDeletion will cancel a pending deletion of a user's account.
+	CancelAccountDeletion(ctx context.Context, userID int64) error
+
+	// CheckLoginAttempt will check whether a log in attempt for email from ipAddress may proceed.
+	// If it's blocked, it returns how long until the next attempt is allowed
+	// alongside ErrAccountLocked or ErrLoginThrottled.
+	CheckLoginAttempt(ctx context.Context, email, ipAddress string) (time.Duration, error)
+
+	// CheckEmailVerified will check whether an account may be used with its current verification state.
+	// It returns ErrEmailNotVerified only when verified email is required by config.
+	CheckEmailVerified(account account.Account) error
+
 	// CheckPasswordCorrect will check whether user's password match with current password or not.
 	CheckPasswordCorrect(ctx context.Context, email, password string) error
 
-	// GenerateJWT will generate a new JWT for user if not exist.
-	// If it already exist, it will get the value from cache.
-	// If it's not exist, it will generate new token and save it to cache.
-	GenerateJWT(ctx context.Context, userID int64, email string) (string, error)
+	// ConfirmTOTP will enable TOTP of an account once the first code
+	// generated by user's authenticator is verified.
+	ConfirmTOTP(ctx context.Context, acc account.Account, code string) error
+
+	// ConsumeEmailChangeToken will redeem an email change confirmation token
+	// and return its owner alongside the email it will be changed to.
+	// An email change confirmation token can only be redeemed once.
+	ConsumeEmailChangeToken(ctx context.Context, token string) (account.EmailChange, error)
+
+	// ConsumeMagicLink will redeem a magic link login token and return its owner.
+	// A magic link login token can only be redeemed once.
+	ConsumeMagicLink(ctx context.Context, token string) (account.MagicLink, error)
+
+	// ConsumeMFAChallenge will redeem an MFA challenge token and return its owner.
+	// An MFA challenge token can only be redeemed once.
+	ConsumeMFAChallenge(ctx context.Context, token string) (account.MFAChallenge, error)
+
+	// ConsumePasswordResetToken will redeem a password reset token and return id of its owner.
+	// A password reset token can only be redeemed once.
+	ConsumePasswordResetToken(ctx context.Context, token string) (int64, error)
+
+	// CreatePersonalAccessToken will create a new named personal access token for a user.
+	// It returns the token alongside its information. The token is only returned this once.
+	CreatePersonalAccessToken(ctx context.Context, param account.CreatePersonalAccessTokenParam) (string, account.PersonalAccessToken, error)
+
+	// CreateMFAChallenge will generate a short-lived challenge token for an account
+	// whose password had been verified but still needs a TOTP code to log in.
+	CreateMFAChallenge(ctx context.Context, acc account.Account) (string, error)
+
+	// DisableAccount will disable a user's account and revoke every session of the user.
+	DisableAccount(ctx context.Context, userID int64) error
+
+	// DisableTOTP will turn off TOTP of a user and remove its secret and recovery codes.
+	DisableTOTP(ctx context.Context, userID int64) error
+
+	// EnableAccount will enable a user's account that had been disabled.
+	EnableAccount(ctx context.Context, userID int64) error
+
+	// EnrollTOTP will generate a new TOTP secret and recovery codes for an account.
+	// The secret stays inactive until it's confirmed by ConfirmTOTP.
+	EnrollTOTP(ctx context.Context, acc account.Account) (account.TOTPEnrollment, error)
+
+	// GenerateJWT will generate a new short-lived JWT for a session of user
+	// and save it to cache as the active JWT of the session.
+	// The new JWT replaces the previous JWT of the session.
+	GenerateJWT(ctx context.Context, session account.Session, email string) (string, error)
+
+	// GenerateRefreshToken will generate a new refresh token for a session of user
+	// and start a new token family for that session.
+	// The new family replaces the previous family of the session.
+	GenerateRefreshToken(ctx context.Context, session account.Session, email string) (string, error)
+
+	// GetSession will fetch an active session of a user.
+	// If the session doesn't exist, it will return ErrSessionNotFound.
+	GetSession(ctx context.Context, userID int64, sessionID string) (account.Session, error)
 
 	// GetUserAccountByEmail will check whether an account is already exist by using email.
 	GetUserAccountByEmail(ctx context.Context, email string) (account.Account, error)
 
+	// GetUserAccountByID will fetch user's account based on its id.
+	// If the account doesn't exist, it will return ErrAccountNotFound.
+	GetUserAccountByID(ctx context.Context, userID int64) (account.Account, error)
+
 	// InsertUserAccount will create a new user account.
 	InsertUserAccount(ctx context.Context, email, password string) (err error)
 
-	// InvalidateJWT will delete user's cached JWT.
+	// InvalidateJWT will revoke every session of a user.
 	InvalidateJWT(ctx context.Context, userID int64) error
 
+	// ListAuditEvents will fetch security events of a user, ordered from the most recent event.
+	ListAuditEvents(ctx context.Context, param account.GetAccountAuditEventsParam) ([]account.AccountAuditEvent, error)
+
+	// ListSessions will fetch every active session of a user,
+	// ordered from the most recently seen session.
+	// Expired sessions found along the way will be removed.
+	ListSessions(ctx context.Context, userID int64) ([]account.Session, error)
+
+	// ListPersonalAccessTokens will fetch every personal access token of a user,
+	// ordered from the most recently created token.
+	ListPersonalAccessTokens(ctx context.Context, userID int64) ([]account.PersonalAccessToken, error)
+
+	// NewSession will build a new session for a device that logs in to user's account.
+	// The session will be saved once a JWT is generated for it.
+	NewSession(param account.CreateSessionParam) (account.Session, error)
+
+	// PurgeAccountsPendingDeletion will permanently delete every account whose grace period
+	// has passed, alongside every row owned by those accounts.
+	// It returns id of the deleted accounts.
+	PurgeAccountsPendingDeletion(ctx context.Context) ([]int64, error)
+
+	// RecordAdminAction will write an action an admin took on an account to the audit trail.
+	RecordAdminAction(ctx context.Context, action account.AdminAuditLog) error
+
+	// RecordAuditEvent will write a security event of a user to the account audit trail.
+	// IP address, user agent and request id are taken from the request metadata on ctx.
+	RecordAuditEvent(ctx context.Context, userID int64, event string) error
+
+	// RecordLoginFailure will count a failed log in attempt for email and ipAddress
+	// and block further attempts once they pass the configured threshold.
+	RecordLoginFailure(ctx context.Context, email, ipAddress string) error
+
+	// RequestAccountDeletion will mark a user's account as pending deletion
+	// and revoke every session of the user.
+	// It returns the time the account will be purged at.
+	RequestAccountDeletion(ctx context.Context, userID int64) (time.Time, error)
+
+	// ResetLoginFailures will clear failed log in attempts of an email.
+	ResetLoginFailures(ctx context.Context, email string) error
+
+	// RevokeOtherSessions will revoke every session of a user except the given session.
+	RevokeOtherSessions(ctx context.Context, userID int64, sessionID string) error
+
+	// RevokePersonalAccessToken will delete a personal access token of a user.
+	// If the user doesn't have the token, it will return ErrPersonalAccessTokenNotFound.
+	RevokePersonalAccessToken(ctx context.Context, userID, tokenID int64) error
+
+	// RevokeSession will revoke a session of a user.
+	// If the session doesn't exist, it will return ErrSessionNotFound.
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+
+	// RotateRefreshToken will exchange a refresh token with a new one from the same family.
+	// A refresh token can only be used once. If a refresh token that had been
+	// rotated is used again, the whole family and its session will be revoked.
+	// It returns the owner of the refresh token alongside the new refresh token.
+	RotateRefreshToken(ctx context.Context, refreshToken string) (account.RefreshToken, string, error)
+
+	// SearchUserAccounts will fetch accounts whose email, first name or last name contains param.Query.
+	SearchUserAccounts(ctx context.Context, param account.SearchUserAccountsParam) ([]account.Account, error)
+
+	// SendEmailChangeToken will generate a one-time email change confirmation token for user
+	// and send it to the new email as a link. A notice is sent to the current email as well.
+	SendEmailChangeToken(ctx context.Context, param account.SendEmailChangeParam) error
+
+	// SendEmailVerificationToken will generate a one-time email verification token for user
+	// and send it to user's email as a link.
+	// It returns ErrEmailVerificationThrottled if the previous email was sent too recently.
+	SendEmailVerificationToken(ctx context.Context, userID int64, email string) error
+
+	// SendMagicLink will generate a one-time magic link login token for user
+	// and send it to user's email as a link.
+	// It returns ErrMagicLinkThrottled if the previous email was sent too recently.
+	SendMagicLink(ctx context.Context, userID int64, email string) error
+
+	// SendPasswordResetToken will generate a one-time password reset token for user
+	// and send it to user's email as a link.
+	// It returns ErrPasswordResetThrottled if the previous email was sent too recently.
+	SendPasswordResetToken(ctx context.Context, userID int64, email string) error
+
 	// UpdateUserAccount will update the information of an existing user account.
 	UpdateUserAccount(ctx context.Context, param account.UpdateUserAccountParam) error
 
+	// UpdateUserEmail will replace email of an existing account with a confirmed new email,
+	// and mark the new email as verified.
+	// Every password reset and magic link sent before is invalidated, since they were sent to the previous email.
+	UpdateUserEmail(ctx context.Context, userID int64, email string) error
+
 	// UpdateUserPassword will update password of an existing account.
 	UpdateUserPassword(ctx context.Context, userID int64, password string) error
+
+	// ValidatePassword will check a new password against the configured password policy.
+	// If the password violates any rule, it returns a PasswordPolicyError holding every violation.
+	ValidatePassword(param account.ValidatePasswordParam) error
+
+	// VerifyEmail will redeem an email verification token and mark email of its owner as verified.
+	// An email verification token can only be redeemed once.
+	VerifyEmail(ctx context.Context, token string) error
+
+	// VerifyTOTP will check a code given by user whose TOTP is enabled.
+	// The code is either generated by user's authenticator or one of user's recovery codes.
+	VerifyTOTP(ctx context.Context, acc account.Account, code string) error
+}
+
+// categoryServiceProvider holds all methods from category service that wil be used in account's usecase.
+type categoryServiceProvider interface {
+	// CreateDefaultCategories will create the default category set for a user, named in language.
+	// Indonesian is used when language isn't supported.
+	CreateDefaultCategories(ctx context.Context, userID int64, language string) error
 }
 
 // AccountUsecaseParam holds all parameters needed to instantiate
 // a new instance of Usecase.
 type AccountUsecaseParam struct {
-	Account accountServiceProvider
+	Account  accountServiceProvider
+	Category categoryServiceProvider
 }
 
 type UseCase struct {
-	account accountServiceProvider
+	account  accountServiceProvider
+	category categoryServiceProvider
 }
 
 // NewUseCase will instantiate a new instance of UseCase.
 func NewUseCase(param AccountUsecaseParam) *UseCase {
 	return &UseCase{
-		account: param.Account,
+		account:  param.Account,
+		category: param.Category,
 	}
 }
@@ -7,6 +7,7 @@ package account
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	account "github.com/arifinhermawan/bubi/internal/service/account"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,49 @@ func (m *MockaccountServiceProvider) EXPECT() *MockaccountServiceProviderMockRec
 	return m.recorder
 }
 
+// CancelAccountDeletion mocks base method.
+func (m *MockaccountServiceProvider) CancelAccountDeletion(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAccountDeletion", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAccountDeletion indicates an expected call of CancelAccountDeletion.
+func (mr *MockaccountServiceProviderMockRecorder) CancelAccountDeletion(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountDeletion", reflect.TypeOf((*MockaccountServiceProvider)(nil).CancelAccountDeletion), ctx, userID)
+}
+
+// CheckEmailVerified mocks base method.
+func (m *MockaccountServiceProvider) CheckEmailVerified(account account.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailVerified", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckEmailVerified indicates an expected call of CheckEmailVerified.
+func (mr *MockaccountServiceProviderMockRecorder) CheckEmailVerified(account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailVerified", reflect.TypeOf((*MockaccountServiceProvider)(nil).CheckEmailVerified), account)
+}
+
+// CheckLoginAttempt mocks base method.
+func (m *MockaccountServiceProvider) CheckLoginAttempt(ctx context.Context, email, ipAddress string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLoginAttempt", ctx, email, ipAddress)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLoginAttempt indicates an expected call of CheckLoginAttempt.
+func (mr *MockaccountServiceProviderMockRecorder) CheckLoginAttempt(ctx, email, ipAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLoginAttempt", reflect.TypeOf((*MockaccountServiceProvider)(nil).CheckLoginAttempt), ctx, email, ipAddress)
+}
+
 // CheckPasswordCorrect mocks base method.
 func (m *MockaccountServiceProvider) CheckPasswordCorrect(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
@@ -49,19 +93,211 @@ func (mr *MockaccountServiceProviderMockRecorder) CheckPasswordCorrect(ctx, emai
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPasswordCorrect", reflect.TypeOf((*MockaccountServiceProvider)(nil).CheckPasswordCorrect), ctx, email, password)
 }
 
+// ConfirmTOTP mocks base method.
+func (m *MockaccountServiceProvider) ConfirmTOTP(ctx context.Context, acc account.Account, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, acc, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockaccountServiceProviderMockRecorder) ConfirmTOTP(ctx, acc, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockaccountServiceProvider)(nil).ConfirmTOTP), ctx, acc, code)
+}
+
+// ConsumeEmailChangeToken mocks base method.
+func (m *MockaccountServiceProvider) ConsumeEmailChangeToken(ctx context.Context, token string) (account.EmailChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeEmailChangeToken", ctx, token)
+	ret0, _ := ret[0].(account.EmailChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeEmailChangeToken indicates an expected call of ConsumeEmailChangeToken.
+func (mr *MockaccountServiceProviderMockRecorder) ConsumeEmailChangeToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeEmailChangeToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).ConsumeEmailChangeToken), ctx, token)
+}
+
+// ConsumeMFAChallenge mocks base method.
+func (m *MockaccountServiceProvider) ConsumeMFAChallenge(ctx context.Context, token string) (account.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMFAChallenge", ctx, token)
+	ret0, _ := ret[0].(account.MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeMFAChallenge indicates an expected call of ConsumeMFAChallenge.
+func (mr *MockaccountServiceProviderMockRecorder) ConsumeMFAChallenge(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMFAChallenge", reflect.TypeOf((*MockaccountServiceProvider)(nil).ConsumeMFAChallenge), ctx, token)
+}
+
+// ConsumeMagicLink mocks base method.
+func (m *MockaccountServiceProvider) ConsumeMagicLink(ctx context.Context, token string) (account.MagicLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMagicLink", ctx, token)
+	ret0, _ := ret[0].(account.MagicLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeMagicLink indicates an expected call of ConsumeMagicLink.
+func (mr *MockaccountServiceProviderMockRecorder) ConsumeMagicLink(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMagicLink", reflect.TypeOf((*MockaccountServiceProvider)(nil).ConsumeMagicLink), ctx, token)
+}
+
+// ConsumePasswordResetToken mocks base method.
+func (m *MockaccountServiceProvider) ConsumePasswordResetToken(ctx context.Context, token string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasswordResetToken", ctx, token)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePasswordResetToken indicates an expected call of ConsumePasswordResetToken.
+func (mr *MockaccountServiceProviderMockRecorder) ConsumePasswordResetToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasswordResetToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).ConsumePasswordResetToken), ctx, token)
+}
+
+// CreateMFAChallenge mocks base method.
+func (m *MockaccountServiceProvider) CreateMFAChallenge(ctx context.Context, acc account.Account) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMFAChallenge", ctx, acc)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMFAChallenge indicates an expected call of CreateMFAChallenge.
+func (mr *MockaccountServiceProviderMockRecorder) CreateMFAChallenge(ctx, acc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMFAChallenge", reflect.TypeOf((*MockaccountServiceProvider)(nil).CreateMFAChallenge), ctx, acc)
+}
+
+// CreatePersonalAccessToken mocks base method.
+func (m *MockaccountServiceProvider) CreatePersonalAccessToken(ctx context.Context, param account.CreatePersonalAccessTokenParam) (string, account.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", ctx, param)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(account.PersonalAccessToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockaccountServiceProviderMockRecorder) CreatePersonalAccessToken(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).CreatePersonalAccessToken), ctx, param)
+}
+
+// DisableAccount mocks base method.
+func (m *MockaccountServiceProvider) DisableAccount(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableAccount indicates an expected call of DisableAccount.
+func (mr *MockaccountServiceProviderMockRecorder) DisableAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockaccountServiceProvider)(nil).DisableAccount), ctx, userID)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockaccountServiceProvider) DisableTOTP(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockaccountServiceProviderMockRecorder) DisableTOTP(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockaccountServiceProvider)(nil).DisableTOTP), ctx, userID)
+}
+
+// EnableAccount mocks base method.
+func (m *MockaccountServiceProvider) EnableAccount(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableAccount indicates an expected call of EnableAccount.
+func (mr *MockaccountServiceProviderMockRecorder) EnableAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccount", reflect.TypeOf((*MockaccountServiceProvider)(nil).EnableAccount), ctx, userID)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockaccountServiceProvider) EnrollTOTP(ctx context.Context, acc account.Account) (account.TOTPEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, acc)
+	ret0, _ := ret[0].(account.TOTPEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockaccountServiceProviderMockRecorder) EnrollTOTP(ctx, acc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockaccountServiceProvider)(nil).EnrollTOTP), ctx, acc)
+}
+
 // GenerateJWT mocks base method.
-func (m *MockaccountServiceProvider) GenerateJWT(ctx context.Context, userID int64, email string) (string, error) {
+func (m *MockaccountServiceProvider) GenerateJWT(ctx context.Context, session account.Session, email string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateJWT", ctx, userID, email)
+	ret := m.ctrl.Call(m, "GenerateJWT", ctx, session, email)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateJWT indicates an expected call of GenerateJWT.
-func (mr *MockaccountServiceProviderMockRecorder) GenerateJWT(ctx, userID, email interface{}) *gomock.Call {
+func (mr *MockaccountServiceProviderMockRecorder) GenerateJWT(ctx, session, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockaccountServiceProvider)(nil).GenerateJWT), ctx, userID, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockaccountServiceProvider)(nil).GenerateJWT), ctx, session, email)
+}
+
+// GenerateRefreshToken mocks base method.
+func (m *MockaccountServiceProvider) GenerateRefreshToken(ctx context.Context, session account.Session, email string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRefreshToken", ctx, session, email)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockaccountServiceProviderMockRecorder) GenerateRefreshToken(ctx, session, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).GenerateRefreshToken), ctx, session, email)
+}
+
+// GetSession mocks base method.
+func (m *MockaccountServiceProvider) GetSession(ctx context.Context, userID int64, sessionID string) (account.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(account.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockaccountServiceProviderMockRecorder) GetSession(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetSession), ctx, userID, sessionID)
 }
 
 // GetUserAccountByEmail mocks base method.
@@ -79,6 +315,21 @@ func (mr *MockaccountServiceProviderMockRecorder) GetUserAccountByEmail(ctx, ema
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByEmail", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetUserAccountByEmail), ctx, email)
 }
 
+// GetUserAccountByID mocks base method.
+func (m *MockaccountServiceProvider) GetUserAccountByID(ctx context.Context, userID int64) (account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccountByID", ctx, userID)
+	ret0, _ := ret[0].(account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccountByID indicates an expected call of GetUserAccountByID.
+func (mr *MockaccountServiceProviderMockRecorder) GetUserAccountByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByID", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetUserAccountByID), ctx, userID)
+}
+
 // InsertUserAccount mocks base method.
 func (m *MockaccountServiceProvider) InsertUserAccount(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
@@ -107,6 +358,281 @@ func (mr *MockaccountServiceProviderMockRecorder) InvalidateJWT(ctx, userID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateJWT", reflect.TypeOf((*MockaccountServiceProvider)(nil).InvalidateJWT), ctx, userID)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockaccountServiceProvider) ListAuditEvents(ctx context.Context, param account.GetAccountAuditEventsParam) ([]account.AccountAuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, param)
+	ret0, _ := ret[0].([]account.AccountAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockaccountServiceProviderMockRecorder) ListAuditEvents(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockaccountServiceProvider)(nil).ListAuditEvents), ctx, param)
+}
+
+// ListPersonalAccessTokens mocks base method.
+func (m *MockaccountServiceProvider) ListPersonalAccessTokens(ctx context.Context, userID int64) ([]account.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonalAccessTokens", ctx, userID)
+	ret0, _ := ret[0].([]account.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalAccessTokens indicates an expected call of ListPersonalAccessTokens.
+func (mr *MockaccountServiceProviderMockRecorder) ListPersonalAccessTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalAccessTokens", reflect.TypeOf((*MockaccountServiceProvider)(nil).ListPersonalAccessTokens), ctx, userID)
+}
+
+// ListSessions mocks base method.
+func (m *MockaccountServiceProvider) ListSessions(ctx context.Context, userID int64) ([]account.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]account.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockaccountServiceProviderMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockaccountServiceProvider)(nil).ListSessions), ctx, userID)
+}
+
+// NewSession mocks base method.
+func (m *MockaccountServiceProvider) NewSession(param account.CreateSessionParam) (account.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSession", param)
+	ret0, _ := ret[0].(account.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSession indicates an expected call of NewSession.
+func (mr *MockaccountServiceProviderMockRecorder) NewSession(param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockaccountServiceProvider)(nil).NewSession), param)
+}
+
+// PurgeAccountsPendingDeletion mocks base method.
+func (m *MockaccountServiceProvider) PurgeAccountsPendingDeletion(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAccountsPendingDeletion", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAccountsPendingDeletion indicates an expected call of PurgeAccountsPendingDeletion.
+func (mr *MockaccountServiceProviderMockRecorder) PurgeAccountsPendingDeletion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAccountsPendingDeletion", reflect.TypeOf((*MockaccountServiceProvider)(nil).PurgeAccountsPendingDeletion), ctx)
+}
+
+// RecordAdminAction mocks base method.
+func (m *MockaccountServiceProvider) RecordAdminAction(ctx context.Context, action account.AdminAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAdminAction", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAdminAction indicates an expected call of RecordAdminAction.
+func (mr *MockaccountServiceProviderMockRecorder) RecordAdminAction(ctx, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAdminAction", reflect.TypeOf((*MockaccountServiceProvider)(nil).RecordAdminAction), ctx, action)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockaccountServiceProvider) RecordAuditEvent(ctx context.Context, userID int64, event string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockaccountServiceProviderMockRecorder) RecordAuditEvent(ctx, userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockaccountServiceProvider)(nil).RecordAuditEvent), ctx, userID, event)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockaccountServiceProvider) RecordLoginFailure(ctx context.Context, email, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, email, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockaccountServiceProviderMockRecorder) RecordLoginFailure(ctx, email, ipAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockaccountServiceProvider)(nil).RecordLoginFailure), ctx, email, ipAddress)
+}
+
+// RequestAccountDeletion mocks base method.
+func (m *MockaccountServiceProvider) RequestAccountDeletion(ctx context.Context, userID int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAccountDeletion", ctx, userID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAccountDeletion indicates an expected call of RequestAccountDeletion.
+func (mr *MockaccountServiceProviderMockRecorder) RequestAccountDeletion(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAccountDeletion", reflect.TypeOf((*MockaccountServiceProvider)(nil).RequestAccountDeletion), ctx, userID)
+}
+
+// ResetLoginFailures mocks base method.
+func (m *MockaccountServiceProvider) ResetLoginFailures(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginFailures", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginFailures indicates an expected call of ResetLoginFailures.
+func (mr *MockaccountServiceProviderMockRecorder) ResetLoginFailures(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginFailures", reflect.TypeOf((*MockaccountServiceProvider)(nil).ResetLoginFailures), ctx, email)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockaccountServiceProvider) RevokeOtherSessions(ctx context.Context, userID int64, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockaccountServiceProviderMockRecorder) RevokeOtherSessions(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockaccountServiceProvider)(nil).RevokeOtherSessions), ctx, userID, sessionID)
+}
+
+// RevokePersonalAccessToken mocks base method.
+func (m *MockaccountServiceProvider) RevokePersonalAccessToken(ctx context.Context, userID, tokenID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockaccountServiceProviderMockRecorder) RevokePersonalAccessToken(ctx, userID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).RevokePersonalAccessToken), ctx, userID, tokenID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockaccountServiceProvider) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockaccountServiceProviderMockRecorder) RevokeSession(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockaccountServiceProvider)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockaccountServiceProvider) RotateRefreshToken(ctx context.Context, refreshToken string) (account.RefreshToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(account.RefreshToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockaccountServiceProviderMockRecorder) RotateRefreshToken(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).RotateRefreshToken), ctx, refreshToken)
+}
+
+// SearchUserAccounts mocks base method.
+func (m *MockaccountServiceProvider) SearchUserAccounts(ctx context.Context, param account.SearchUserAccountsParam) ([]account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserAccounts", ctx, param)
+	ret0, _ := ret[0].([]account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserAccounts indicates an expected call of SearchUserAccounts.
+func (mr *MockaccountServiceProviderMockRecorder) SearchUserAccounts(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserAccounts", reflect.TypeOf((*MockaccountServiceProvider)(nil).SearchUserAccounts), ctx, param)
+}
+
+// SendEmailChangeToken mocks base method.
+func (m *MockaccountServiceProvider) SendEmailChangeToken(ctx context.Context, param account.SendEmailChangeParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailChangeToken", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailChangeToken indicates an expected call of SendEmailChangeToken.
+func (mr *MockaccountServiceProviderMockRecorder) SendEmailChangeToken(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailChangeToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).SendEmailChangeToken), ctx, param)
+}
+
+// SendEmailVerificationToken mocks base method.
+func (m *MockaccountServiceProvider) SendEmailVerificationToken(ctx context.Context, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerificationToken", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerificationToken indicates an expected call of SendEmailVerificationToken.
+func (mr *MockaccountServiceProviderMockRecorder) SendEmailVerificationToken(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerificationToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).SendEmailVerificationToken), ctx, userID, email)
+}
+
+// SendMagicLink mocks base method.
+func (m *MockaccountServiceProvider) SendMagicLink(ctx context.Context, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMagicLink", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMagicLink indicates an expected call of SendMagicLink.
+func (mr *MockaccountServiceProviderMockRecorder) SendMagicLink(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMagicLink", reflect.TypeOf((*MockaccountServiceProvider)(nil).SendMagicLink), ctx, userID, email)
+}
+
+// SendPasswordResetToken mocks base method.
+func (m *MockaccountServiceProvider) SendPasswordResetToken(ctx context.Context, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetToken", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetToken indicates an expected call of SendPasswordResetToken.
+func (mr *MockaccountServiceProviderMockRecorder) SendPasswordResetToken(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetToken", reflect.TypeOf((*MockaccountServiceProvider)(nil).SendPasswordResetToken), ctx, userID, email)
+}
+
 // UpdateUserAccount mocks base method.
 func (m *MockaccountServiceProvider) UpdateUserAccount(ctx context.Context, param account.UpdateUserAccountParam) error {
 	m.ctrl.T.Helper()
@@ -121,6 +647,20 @@ func (mr *MockaccountServiceProviderMockRecorder) UpdateUserAccount(ctx, param i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAccount", reflect.TypeOf((*MockaccountServiceProvider)(nil).UpdateUserAccount), ctx, param)
 }
 
+// UpdateUserEmail mocks base method.
+func (m *MockaccountServiceProvider) UpdateUserEmail(ctx context.Context, userID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockaccountServiceProviderMockRecorder) UpdateUserEmail(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockaccountServiceProvider)(nil).UpdateUserEmail), ctx, userID, email)
+}
+
 // UpdateUserPassword mocks base method.
 func (m *MockaccountServiceProvider) UpdateUserPassword(ctx context.Context, userID int64, password string) error {
 	m.ctrl.T.Helper()
@@ -134,3 +674,82 @@ func (mr *MockaccountServiceProviderMockRecorder) UpdateUserPassword(ctx, userID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockaccountServiceProvider)(nil).UpdateUserPassword), ctx, userID, password)
 }
+
+// ValidatePassword mocks base method.
+func (m *MockaccountServiceProvider) ValidatePassword(param account.ValidatePasswordParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePassword", param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePassword indicates an expected call of ValidatePassword.
+func (mr *MockaccountServiceProviderMockRecorder) ValidatePassword(param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePassword", reflect.TypeOf((*MockaccountServiceProvider)(nil).ValidatePassword), param)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockaccountServiceProvider) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockaccountServiceProviderMockRecorder) VerifyEmail(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockaccountServiceProvider)(nil).VerifyEmail), ctx, token)
+}
+
+// VerifyTOTP mocks base method.
+func (m *MockaccountServiceProvider) VerifyTOTP(ctx context.Context, acc account.Account, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTOTP", ctx, acc, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyTOTP indicates an expected call of VerifyTOTP.
+func (mr *MockaccountServiceProviderMockRecorder) VerifyTOTP(ctx, acc, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTOTP", reflect.TypeOf((*MockaccountServiceProvider)(nil).VerifyTOTP), ctx, acc, code)
+}
+
+// MockcategoryServiceProvider is a mock of categoryServiceProvider interface.
+type MockcategoryServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockcategoryServiceProviderMockRecorder
+}
+
+// MockcategoryServiceProviderMockRecorder is the mock recorder for MockcategoryServiceProvider.
+type MockcategoryServiceProviderMockRecorder struct {
+	mock *MockcategoryServiceProvider
+}
+
+// NewMockcategoryServiceProvider creates a new mock instance.
+func NewMockcategoryServiceProvider(ctrl *gomock.Controller) *MockcategoryServiceProvider {
+	mock := &MockcategoryServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockcategoryServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcategoryServiceProvider) EXPECT() *MockcategoryServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateDefaultCategories mocks base method.
+func (m *MockcategoryServiceProvider) CreateDefaultCategories(ctx context.Context, userID int64, language string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDefaultCategories", ctx, userID, language)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDefaultCategories indicates an expected call of CreateDefaultCategories.
+func (mr *MockcategoryServiceProviderMockRecorder) CreateDefaultCategories(ctx, userID, language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDefaultCategories", reflect.TypeOf((*MockcategoryServiceProvider)(nil).CreateDefaultCategories), ctx, userID, language)
+}
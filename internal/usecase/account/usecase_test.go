@@ -12,9 +12,14 @@ import (
 func TestNewUseCase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockAccountSvc := NewMockaccountServiceProvider(ctrl)
+	mockCategorySvc := NewMockcategoryServiceProvider(ctrl)
 
 	want := &UseCase{
-		account: mockAccountSvc,
+		account:  mockAccountSvc,
+		category: mockCategorySvc,
 	}
-	assert.Equal(t, want, NewUseCase(AccountUsecaseParam{Account: mockAccountSvc}))
+	assert.Equal(t, want, NewUseCase(AccountUsecaseParam{
+		Account:  mockAccountSvc,
+		Category: mockCategorySvc,
+	}))
 }
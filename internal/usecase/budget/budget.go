@@ -0,0 +1,689 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+const (
+	fieldAmount         = "amount"
+	fieldCategoryID     = "category_id"
+	fieldCurrency       = "currency"
+	fieldFromCategoryID = "from_category_id"
+	fieldRolloverMode   = "rollover_mode"
+	fieldToCategoryID   = "to_category_id"
+
+	violationInvalid  = "invalid"
+	violationRequired = "required"
+)
+
+var (
+	// ErrBudgetNotFound is returned when the category isn't budgeted in the requested period.
+	ErrBudgetNotFound = errors.New("budget not found")
+
+	// ErrEnvelopeModeRequired is returned when a user that doesn't budget in envelope mode moves money between budgets.
+	ErrEnvelopeModeRequired = errors.New("moving money between budgets requires envelope budgeting mode")
+
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	errUnauthorized = errors.New("unauthorized!")
+)
+
+// CopyBudgets will copy every budget of the user acting on ctx from the period before
+// the one that is offset periods away from the current one into it.
+// Categories that are already budgeted in the period keep their budget.
+// It returns every budget of the period after copying.
+func (uc *UseCase) CopyBudgets(ctx context.Context, offset int) (BudgetSummary, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[CopyBudgets] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return BudgetSummary{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"offset":  offset,
+		"user_id": principal.UserID,
+	}
+
+	budgetingMode, err := uc.getBudgetingMode(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.getBudgetingMode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	err = uc.rollOverBudgets(ctx, principal.UserID, budgetingMode)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.rollOverBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	from, err := uc.account.GetPeriod(ctx, principal.UserID, offset-1)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	to, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	_, err = uc.budget.CopyBudgets(ctx, principal.UserID, budgetingMode, from, to)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.budget.CopyBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	result, err := uc.getBudgetSummary(ctx, principal.UserID, budgetingMode, to, offset)
+	if err != nil {
+		log.Printf("[CopyBudgets] uc.getBudgetSummary() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	return result, nil
+}
+
+// DeleteBudget will delete the budget of a category of the user acting on ctx
+// in the period that is offset periods away from the current one.
+func (uc *UseCase) DeleteBudget(ctx context.Context, offset int, categoryID int64) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[DeleteBudget] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"category_id": categoryID,
+		"offset":      offset,
+		"user_id":     principal.UserID,
+	}
+
+	budgetingMode, err := uc.getBudgetingMode(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[DeleteBudget] uc.getBudgetingMode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = uc.rollOverBudgets(ctx, principal.UserID, budgetingMode)
+	if err != nil {
+		log.Printf("[DeleteBudget] uc.rollOverBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	period, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		log.Printf("[DeleteBudget] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return err
+	}
+
+	err = uc.budget.DeleteBudget(ctx, principal.UserID, categoryID, period)
+	if err != nil {
+		log.Printf("[DeleteBudget] uc.budget.DeleteBudget() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, budget.ErrBudgetNotFound) {
+			return ErrBudgetNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetBudgets will fetch every budget of the user acting on ctx in the period
+// that is offset periods away from the current one, alongside how much is spent on them.
+func (uc *UseCase) GetBudgets(ctx context.Context, offset int) (BudgetSummary, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetBudgets] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return BudgetSummary{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"offset":  offset,
+		"user_id": principal.UserID,
+	}
+
+	budgetingMode, err := uc.getBudgetingMode(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[GetBudgets] uc.getBudgetingMode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	err = uc.rollOverBudgets(ctx, principal.UserID, budgetingMode)
+	if err != nil {
+		log.Printf("[GetBudgets] uc.rollOverBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	period, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		log.Printf("[GetBudgets] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	result, err := uc.getBudgetSummary(ctx, principal.UserID, budgetingMode, period, offset)
+	if err != nil {
+		log.Printf("[GetBudgets] uc.getBudgetSummary() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	return result, nil
+}
+
+// MoveBudget will move money from the budget of a category of the user acting on ctx to the budget of
+// another expense category in the period that is offset periods away from the current one.
+// Only a user that budgets in envelope mode can move money, otherwise it returns ErrEnvelopeModeRequired.
+// Fields that aren't valid, including money the source can't spare, are refused with ValidationError.
+// It returns every budget of the period after moving.
+func (uc *UseCase) MoveBudget(ctx context.Context, offset int, param MoveBudgetParam) (BudgetSummary, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[MoveBudget] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return BudgetSummary{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"amount":           param.Amount,
+		"from_category_id": param.FromCategoryID,
+		"offset":           offset,
+		"to_category_id":   param.ToCategoryID,
+		"user_id":          principal.UserID,
+	}
+
+	err := validateMoveBudget(param)
+	if err != nil {
+		log.Printf("[MoveBudget] validateMoveBudget() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	budgetingMode, err := uc.getBudgetingMode(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.getBudgetingMode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	if budgetingMode != entity.BudgetingModeEnvelope {
+		log.Printf("[MoveBudget] user doesn't budget in envelope mode\nMeta:%+v\n", meta)
+		return BudgetSummary{}, ErrEnvelopeModeRequired
+	}
+
+	err = uc.rollOverBudgets(ctx, principal.UserID, budgetingMode)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.rollOverBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	_, err = uc.getBudgetCategory(ctx, principal.UserID, param.ToCategoryID, fieldToCategoryID)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.getBudgetCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	period, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	previous, err := uc.account.GetPeriod(ctx, principal.UserID, offset-1)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	err = uc.budget.MoveBudget(ctx, budget.MoveBudgetParam{
+		Amount:         param.Amount,
+		FromCategoryID: param.FromCategoryID,
+		Period:         period,
+		PreviousPeriod: previous,
+		ToCategoryID:   param.ToCategoryID,
+		UserID:         principal.UserID,
+	})
+	if err != nil {
+		log.Printf("[MoveBudget] uc.budget.MoveBudget() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, convertMoveBudgetError(err)
+	}
+
+	result, err := uc.getBudgetSummary(ctx, principal.UserID, budgetingMode, period, offset)
+	if err != nil {
+		log.Printf("[MoveBudget] uc.getBudgetSummary() got an error: %+v\nMeta:%+v\n", err, meta)
+		return BudgetSummary{}, err
+	}
+
+	return result, nil
+}
+
+// SetBudget will set the budget of an expense category of the user acting on ctx
+// in the period that is offset periods away from the current one.
+// Fields that aren't valid, including a category the user doesn't have, are refused with ValidationError.
+func (uc *UseCase) SetBudget(ctx context.Context, offset int, param SetBudgetParam) (Budget, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[SetBudget] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Budget{}, errUnauthorized
+	}
+
+	param.Currency = strings.ToUpper(strings.TrimSpace(param.Currency))
+	param.RolloverMode = strings.ToLower(strings.TrimSpace(param.RolloverMode))
+	if param.RolloverMode == "" {
+		param.RolloverMode = entity.RolloverModeNone
+	}
+
+	meta := map[string]interface{}{
+		"category_id": param.CategoryID,
+		"offset":      offset,
+		"user_id":     principal.UserID,
+	}
+
+	err := validateBudget(param)
+	if err != nil {
+		log.Printf("[SetBudget] validateBudget() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	budgetCategory, err := uc.getBudgetCategory(ctx, principal.UserID, param.CategoryID, fieldCategoryID)
+	if err != nil {
+		log.Printf("[SetBudget] uc.getBudgetCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	budgetingMode, err := uc.getBudgetingMode(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[SetBudget] uc.getBudgetingMode() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	err = uc.rollOverBudgets(ctx, principal.UserID, budgetingMode)
+	if err != nil {
+		log.Printf("[SetBudget] uc.rollOverBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	period, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		log.Printf("[SetBudget] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	previous, err := uc.account.GetPeriod(ctx, principal.UserID, offset-1)
+	if err != nil {
+		log.Printf("[SetBudget] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	err = uc.budget.SetBudget(ctx, budget.SetBudgetParam{
+		Amount:         param.Amount,
+		BudgetingMode:  budgetingMode,
+		CategoryID:     param.CategoryID,
+		Currency:       param.Currency,
+		Period:         period,
+		PreviousPeriod: previous,
+		RolloverMode:   param.RolloverMode,
+		UserID:         principal.UserID,
+	})
+	if err != nil {
+		log.Printf("[SetBudget] uc.budget.SetBudget() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	budgets, err := uc.budget.ListBudgets(ctx, principal.UserID, period)
+	if err != nil {
+		log.Printf("[SetBudget] uc.budget.ListBudgets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Budget{}, err
+	}
+
+	for _, b := range budgets {
+		if b.CategoryID == param.CategoryID {
+			return convertBudget(b, budgetCategory.Name), nil
+		}
+	}
+
+	log.Printf("[SetBudget] budget isn't found after being set\nMeta:%+v\n", meta)
+	return Budget{}, ErrBudgetNotFound
+}
+
+// getBudgetCategory will fetch a category of the user that can be budgeted.
+// Only an expense category that isn't archived can be budgeted, field names the request field holding categoryID.
+func (uc *UseCase) getBudgetCategory(ctx context.Context, userID, categoryID int64, field string) (category.Category, error) {
+	result, err := uc.category.GetCategory(ctx, userID, categoryID)
+	if err != nil {
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			return category.Category{}, invalidFieldError(field, "category doesn't exist")
+		}
+
+		return category.Category{}, err
+	}
+
+	if result.Type != entity.TransactionTypeExpense {
+		return category.Category{}, invalidFieldError(field, "only an expense category can be budgeted")
+	}
+
+	if !result.ArchivedAt.IsZero() {
+		return category.Category{}, invalidFieldError(field, "an archived category can't be budgeted")
+	}
+
+	return result, nil
+}
+
+// getBudgetSummary will fetch every budget of the user in a period alongside the name of their category.
+// In envelope mode, it also counts what the user has left to assign up to the period.
+func (uc *UseCase) getBudgetSummary(ctx context.Context, userID int64, budgetingMode string, period entity.Period, offset int) (BudgetSummary, error) {
+	budgets, err := uc.budget.ListBudgets(ctx, userID, period)
+	if err != nil {
+		return BudgetSummary{}, err
+	}
+
+	categories, err := uc.category.ListCategories(ctx, userID)
+	if err != nil {
+		return BudgetSummary{}, err
+	}
+
+	names := make(map[int64]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+
+	result := BudgetSummary{
+		Budgets:       make([]Budget, 0, len(budgets)),
+		BudgetingMode: budgetingMode,
+		Period: Period{
+			End:    period.End,
+			Offset: offset,
+			Start:  period.Start,
+		},
+	}
+
+	for _, b := range budgets {
+		result.Budgets = append(result.Budgets, convertBudget(b, names[b.CategoryID]))
+	}
+
+	if budgetingMode != entity.BudgetingModeEnvelope {
+		return result, nil
+	}
+
+	available, err := uc.budget.GetAvailableToAssign(ctx, userID, period)
+	if err != nil {
+		return BudgetSummary{}, err
+	}
+
+	result.AvailableToAssign = make([]AvailableToAssign, 0, len(available))
+	for _, a := range available {
+		result.AvailableToAssign = append(result.AvailableToAssign, AvailableToAssign{
+			Amount:   a.Amount,
+			Currency: a.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+// getBudgetingMode will fetch the budgeting mode of the user, an account without one budgets in classic mode.
+func (uc *UseCase) getBudgetingMode(ctx context.Context, userID int64) (string, error) {
+	account, err := uc.account.GetUserAccountByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if account.BudgetingMode == "" {
+		return entity.BudgetingModeClassic, nil
+	}
+
+	return account.BudgetingMode, nil
+}
+
+// rollOverBudgets will continue budgets of the user that roll over into every period after the one
+// they were last rolled over into, up to the current period. Periods are walked through one by one,
+// so what is carried over doesn't depend on when the user happens to open their budgets.
+// Budgets of a user that has never rolled over start being rolled over from the current period.
+// Budgets are rolled over while holding the rollover lock of the user, so concurrent requests
+// don't roll over the same periods twice.
+func (uc *UseCase) rollOverBudgets(ctx context.Context, userID int64, budgetingMode string) error {
+	current, err := uc.account.GetPeriod(ctx, userID, 0)
+	if err != nil {
+		return err
+	}
+
+	rolledOver, err := uc.budget.GetRolledOverPeriodStart(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if rolledOver.IsZero() {
+		return uc.budget.SaveRolledOverPeriod(ctx, userID, current)
+	}
+
+	if periodOffset(current, rolledOver) >= 0 {
+		return nil
+	}
+
+	unlock, err := uc.budget.LockRollOver(ctx, userID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// another request might have rolled them over while waiting for the lock.
+	rolledOver, err = uc.budget.GetRolledOverPeriodStart(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	offset := periodOffset(current, rolledOver)
+	if offset >= 0 {
+		return nil
+	}
+
+	from, err := uc.account.GetPeriod(ctx, userID, offset)
+	if err != nil {
+		return err
+	}
+
+	for offset++; offset <= 0; offset++ {
+		to := current
+		if offset < 0 {
+			to, err = uc.account.GetPeriod(ctx, userID, offset)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = uc.rollOverPeriod(ctx, userID, budgetingMode, from, to)
+		if err != nil {
+			return err
+		}
+
+		from = to
+	}
+
+	return uc.budget.SaveRolledOverPeriod(ctx, userID, current)
+}
+
+// rollOverPeriod will settle what budgets of the user in to period carry over from from period
+// and give back what is left unspent in from period that doesn't carry over, then continue budgets of the user that roll over from from period into to period.
+// In envelope mode they are continued as envelopes that start without any money assigned to them.
+func (uc *UseCase) rollOverPeriod(ctx context.Context, userID int64, budgetingMode string, from, to entity.Period) error {
+	_, err := uc.budget.SettleCarriedOver(ctx, userID, from, to)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.budget.ReleaseUnspent(ctx, userID, from)
+	if err != nil {
+		return err
+	}
+
+	if budgetingMode == entity.BudgetingModeEnvelope {
+		_, err = uc.budget.RollOverEnvelopes(ctx, userID, from, to)
+		return err
+	}
+
+	_, err = uc.budget.RollOverBudgets(ctx, userID, from, to)
+	return err
+}
+
+// periodOffset will count how many periods away the period starting on periodStart is from current.
+// A period is a month long, so it's counted from the month each of them starts in.
+func periodOffset(current entity.Period, periodStart time.Time) int {
+	years := periodStart.Year() - current.Start.Year()
+	months := int(periodStart.Month()) - int(current.Start.Month())
+
+	return years*12 + months
+}
+
+// validateBudget will check fields of a budget that is about to be set.
+// Every problem found is returned at once as a ValidationError.
+func validateBudget(param SetBudgetParam) error {
+	var fields []FieldError
+	if param.Amount <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldAmount,
+			Message: "amount must be greater than zero",
+		})
+	}
+
+	if param.CategoryID <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldCategoryID,
+			Message: "category_id is required",
+		})
+	}
+
+	if param.Currency == "" {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldCurrency,
+			Message: "currency is required",
+		})
+	} else if !currencyPattern.MatchString(param.Currency) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldCurrency,
+			Message: "currency must be a 3 letter ISO 4217 code",
+		})
+	}
+
+	if !entity.IsRolloverMode(param.RolloverMode) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldRolloverMode,
+			Message: "rollover_mode must be one of both, none or positive",
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// validateMoveBudget will check fields of money that is about to be moved between budgets.
+// Every problem found is returned at once as a ValidationError.
+func validateMoveBudget(param MoveBudgetParam) error {
+	var fields []FieldError
+	if param.Amount <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldAmount,
+			Message: "amount must be greater than zero",
+		})
+	}
+
+	if param.FromCategoryID <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldFromCategoryID,
+			Message: "from_category_id is required",
+		})
+	}
+
+	if param.ToCategoryID <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldToCategoryID,
+			Message: "to_category_id is required",
+		})
+	} else if param.ToCategoryID == param.FromCategoryID {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldToCategoryID,
+			Message: "to_category_id must be different from from_category_id",
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// invalidFieldError will describe a field that isn't valid for the given reason.
+func invalidFieldError(field, message string) error {
+	return &ValidationError{
+		Fields: []FieldError{
+			{
+				Code:    violationInvalid,
+				Field:   field,
+				Message: message,
+			},
+		},
+	}
+}
+
+// convertMoveBudgetError will describe a move refused by budget service as a ValidationError.
+// Any other error is returned as is.
+func convertMoveBudgetError(err error) error {
+	switch {
+	case errors.Is(err, budget.ErrBudgetNotFound):
+		return invalidFieldError(fieldFromCategoryID, "from_category_id isn't budgeted in the period")
+	case errors.Is(err, budget.ErrBudgetInsufficient):
+		return invalidFieldError(fieldAmount, "amount must not be more than what is left unspent in the budget of from_category_id")
+	case errors.Is(err, budget.ErrBudgetCurrencyMismatch):
+		return invalidFieldError(fieldToCategoryID, "budget of to_category_id has a different currency")
+	}
+
+	return err
+}
+
+// convertBudget will convert a budget from budget service into its response format.
+// PercentUsed is rounded to 2 decimal places, it's 100 when nothing is left to spend
+// because an overspent previous period took up the whole budget.
+func convertBudget(b budget.Budget, categoryName string) Budget {
+	limit := b.Amount + b.CarriedOver
+	result := Budget{
+		Budgeted:     b.Amount,
+		CarriedOver:  b.CarriedOver,
+		CategoryID:   b.CategoryID,
+		CategoryName: categoryName,
+		Currency:     b.Currency,
+		ID:           b.ID,
+		PercentUsed:  100,
+		Remaining:    limit - b.Spent,
+		RolloverMode: b.RolloverMode,
+		Spent:        b.Spent,
+	}
+
+	if limit > 0 {
+		result.PercentUsed = math.Round(float64(b.Spent)*10000/float64(limit)) / 100
+	}
+
+	return result
+}
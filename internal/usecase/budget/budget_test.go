@@ -0,0 +1,1345 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+type mockFields struct {
+	accountSvc  *MockaccountServiceProvider
+	budgetSvc   *MockbudgetServiceProvider
+	categorySvc *MockcategoryServiceProvider
+}
+
+var (
+	mockCtx = entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockPeriod = entity.Period{
+		End:   time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+	}
+	mockPreviousPeriod = entity.Period{
+		End:   time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC),
+	}
+	mockEarlierPeriod = entity.Period{
+		End:   time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC),
+		Start: time.Date(2022, 11, 25, 0, 0, 0, 0, time.UTC),
+	}
+	mockAccount = account.Account{
+		BudgetingMode: entity.BudgetingModeClassic,
+		ID:            123,
+	}
+	mockEnvelopeAccount = account.Account{
+		BudgetingMode: entity.BudgetingModeEnvelope,
+		ID:            123,
+	}
+	mockBudgets = []budget.Budget{
+		{
+			Amount:       1000000,
+			CategoryID:   7,
+			Currency:     "IDR",
+			ID:           1,
+			RolloverMode: entity.RolloverModeNone,
+			Spent:        333333,
+			UserID:       123,
+		},
+		{
+			Amount:       500000,
+			CarriedOver:  -100000,
+			CategoryID:   9,
+			Currency:     "IDR",
+			ID:           2,
+			RolloverMode: entity.RolloverModeBoth,
+			Spent:        750000,
+			UserID:       123,
+		},
+		{
+			Amount:       200000,
+			CarriedOver:  -250000,
+			CategoryID:   11,
+			Currency:     "IDR",
+			ID:           3,
+			RolloverMode: entity.RolloverModeBoth,
+			UserID:       123,
+		},
+	}
+	mockCategories = []category.Category{
+		{ID: 7, Name: "Food", Type: entity.TransactionTypeExpense},
+		{ID: 9, Name: "Transport", Type: entity.TransactionTypeExpense},
+		{ID: 11, Name: "Fun", Type: entity.TransactionTypeExpense},
+	}
+	mockSummary = BudgetSummary{
+		Budgets: []Budget{
+			{
+				Budgeted:     1000000,
+				CategoryID:   7,
+				CategoryName: "Food",
+				Currency:     "IDR",
+				ID:           1,
+				PercentUsed:  33.33,
+				Remaining:    666667,
+				RolloverMode: entity.RolloverModeNone,
+				Spent:        333333,
+			},
+			{
+				Budgeted:     500000,
+				CarriedOver:  -100000,
+				CategoryID:   9,
+				CategoryName: "Transport",
+				Currency:     "IDR",
+				ID:           2,
+				PercentUsed:  187.5,
+				Remaining:    -350000,
+				RolloverMode: entity.RolloverModeBoth,
+				Spent:        750000,
+			},
+			{
+				Budgeted:     200000,
+				CarriedOver:  -250000,
+				CategoryID:   11,
+				CategoryName: "Fun",
+				Currency:     "IDR",
+				ID:           3,
+				PercentUsed:  100,
+				Remaining:    -50000,
+				RolloverMode: entity.RolloverModeBoth,
+			},
+		},
+		BudgetingMode: entity.BudgetingModeClassic,
+		Period: Period{
+			End:   mockPeriod.End,
+			Start: mockPeriod.Start,
+		},
+	}
+)
+
+func TestUseCase_CopyBudgets(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       BudgetSummary
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetRolledOverPeriodStart_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_of_previous_period_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_CopyBudgets_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().CopyBudgets(mockCtx, int64(123), entity.BudgetingModeClassic, mockPreviousPeriod, mockPeriod).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ListBudgets_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().CopyBudgets(mockCtx, int64(123), entity.BudgetingModeClassic, mockPreviousPeriod, mockPeriod).Return(int64(2), nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_budget_summary",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().CopyBudgets(mockCtx, int64(123), entity.BudgetingModeClassic, mockPreviousPeriod, mockPeriod).Return(int64(2), nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: mockSummary,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				budgetSvc:   NewMockbudgetServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account:  mockFields.accountSvc,
+				budget:   mockFields.budgetSvc,
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.CopyBudgets(test.ctx, 0)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_DeleteBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetRolledOverPeriodStart_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 1).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_budget_not_found_then_return_ErrBudgetNotFound",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 1).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().DeleteBudget(mockCtx, int64(123), int64(7), mockPeriod).Return(budget.ErrBudgetNotFound)
+			},
+			wantErr: ErrBudgetNotFound,
+		},
+		{
+			name: "when_DeleteBudget_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 1).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().DeleteBudget(mockCtx, int64(123), int64(7), mockPeriod).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 1).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().DeleteBudget(mockCtx, int64(123), int64(7), mockPeriod).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				budgetSvc:   NewMockbudgetServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account:  mockFields.accountSvc,
+				budget:   mockFields.budgetSvc,
+				category: mockFields.categorySvc,
+			}
+
+			err := uc.DeleteBudget(test.ctx, 1, 7)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_GetBudgets(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		offset     int
+		mockFields func(mockFields)
+		want       BudgetSummary
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_GetUserAccountByID_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_of_current_period_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetRolledOverPeriodStart_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SaveRolledOverPeriod_of_first_roll_over_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, nil)
+				mf.budgetSvc.EXPECT().SaveRolledOverPeriod(mockCtx, int64(123), mockPeriod).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_LockRollOver_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetRolledOverPeriodStart_after_lock_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_of_last_rolled_over_period_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -2).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_of_missing_period_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -2).Return(mockEarlierPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SettleCarriedOver_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ReleaseUnspent_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RollOverBudgets_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverBudgets(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_RollOverEnvelopes_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverEnvelopes(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_SaveRolledOverPeriod_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverBudgets(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(1), nil)
+				mf.budgetSvc.EXPECT().SaveRolledOverPeriod(mockCtx, int64(123), mockPeriod).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_GetPeriod_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ListBudgets_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_ListCategories_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_budget_set_then_return_empty_budgets",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(nil, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: BudgetSummary{
+				Budgets:       []Budget{},
+				BudgetingMode: entity.BudgetingModeClassic,
+				Period:        mockSummary.Period,
+			},
+		},
+		{
+			name: "when_never_rolled_over_then_start_rolling_over_from_current_period",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, nil)
+				mf.budgetSvc.EXPECT().SaveRolledOverPeriod(mockCtx, int64(123), mockPeriod).Return(nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: mockSummary,
+		},
+		{
+			name: "when_periods_missing_then_roll_over_through_every_one_of_them",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockEarlierPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -2).Return(mockEarlierPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockEarlierPeriod, mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockEarlierPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverBudgets(mockCtx, int64(123), mockEarlierPeriod, mockPreviousPeriod).Return(int64(1), nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverBudgets(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(1), nil)
+				mf.budgetSvc.EXPECT().SaveRolledOverPeriod(mockCtx, int64(123), mockPeriod).Return(nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: mockSummary,
+		},
+		{
+			name: "when_rolled_over_while_waiting_for_lock_then_skip_roll_over",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: mockSummary,
+		},
+		{
+			name: "when_no_error_occured_then_return_budget_summary",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: mockSummary,
+		},
+		{
+			name: "when_GetAvailableToAssign_error_then_return_error",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+				mf.budgetSvc.EXPECT().GetAvailableToAssign(mockCtx, int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_user_budgets_in_envelope_mode_then_return_budget_summary_with_available_to_assign",
+			ctx:  mockCtx,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.budgetSvc.EXPECT().LockRollOver(mockCtx, int64(123)).Return(func() {}, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPreviousPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SettleCarriedOver(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().ReleaseUnspent(mockCtx, int64(123), mockPreviousPeriod).Return(int64(0), nil)
+				mf.budgetSvc.EXPECT().RollOverEnvelopes(mockCtx, int64(123), mockPreviousPeriod, mockPeriod).Return(int64(1), nil)
+				mf.budgetSvc.EXPECT().SaveRolledOverPeriod(mockCtx, int64(123), mockPeriod).Return(nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+				mf.budgetSvc.EXPECT().GetAvailableToAssign(mockCtx, int64(123), mockPeriod).Return([]budget.AvailableToAssign{
+					{
+						Amount:   2500000,
+						Currency: "IDR",
+					},
+				}, nil)
+			},
+			want: BudgetSummary{
+				AvailableToAssign: []AvailableToAssign{
+					{
+						Amount:   2500000,
+						Currency: "IDR",
+					},
+				},
+				Budgets:       mockSummary.Budgets,
+				BudgetingMode: entity.BudgetingModeEnvelope,
+				Period:        mockSummary.Period,
+			},
+		},
+		{
+			name:   "when_period_is_not_current_then_return_budget_summary_of_the_period",
+			ctx:    mockCtx,
+			offset: -1,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPreviousPeriod).Return(nil, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+			},
+			want: BudgetSummary{
+				Budgets:       []Budget{},
+				BudgetingMode: entity.BudgetingModeClassic,
+				Period: Period{
+					End:    mockPreviousPeriod.End,
+					Offset: -1,
+					Start:  mockPreviousPeriod.Start,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				budgetSvc:   NewMockbudgetServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account:  mockFields.accountSvc,
+				budget:   mockFields.budgetSvc,
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.GetBudgets(test.ctx, test.offset)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_rollOverBudgets(t *testing.T) {
+	t.Run("when_requested_concurrently_then_roll_over_every_period_once", func(t *testing.T) {
+		const requests = 8
+
+		ctrl := gomock.NewController(t)
+		mf := mockFields{
+			accountSvc: NewMockaccountServiceProvider(ctrl),
+			budgetSvc:  NewMockbudgetServiceProvider(ctrl),
+		}
+
+		// the lock and the rolled over period stand in for the ones kept in database.
+		var (
+			lock       sync.Mutex
+			mu         sync.Mutex
+			reads      int
+			rolledOver = mockPreviousPeriod.Start
+		)
+
+		// every request reads the rolled over period before any of them rolls over.
+		allRead := make(chan struct{})
+
+		mf.accountSvc.EXPECT().GetPeriod(context.Background(), int64(123), 0).Return(mockPeriod, nil).Times(requests)
+		mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(context.Background(), int64(123)).DoAndReturn(func(context.Context, int64) (time.Time, error) {
+			mu.Lock()
+			reads++
+			read, periodStart := reads, rolledOver
+			if read == requests {
+				close(allRead)
+			}
+			mu.Unlock()
+
+			if read <= requests {
+				<-allRead
+			}
+
+			return periodStart, nil
+		}).AnyTimes()
+		mf.budgetSvc.EXPECT().LockRollOver(context.Background(), int64(123)).DoAndReturn(func(context.Context, int64) (func(), error) {
+			lock.Lock()
+			return lock.Unlock, nil
+		}).AnyTimes()
+
+		// every step of rolling over the missing period is expected exactly once.
+		mf.accountSvc.EXPECT().GetPeriod(context.Background(), int64(123), -1).Return(mockPreviousPeriod, nil)
+		mf.budgetSvc.EXPECT().SettleCarriedOver(context.Background(), int64(123), mockPreviousPeriod, mockPeriod).Return(int64(0), nil)
+		mf.budgetSvc.EXPECT().ReleaseUnspent(context.Background(), int64(123), mockPreviousPeriod).Return(int64(0), nil)
+		mf.budgetSvc.EXPECT().RollOverBudgets(context.Background(), int64(123), mockPreviousPeriod, mockPeriod).Return(int64(1), nil)
+		mf.budgetSvc.EXPECT().SaveRolledOverPeriod(context.Background(), int64(123), mockPeriod).DoAndReturn(func(context.Context, int64, entity.Period) error {
+			mu.Lock()
+			defer mu.Unlock()
+			rolledOver = mockPeriod.Start
+			return nil
+		})
+
+		uc := &UseCase{
+			account: mf.accountSvc,
+			budget:  mf.budgetSvc,
+		}
+
+		start := make(chan struct{})
+		errs := make(chan error, requests)
+
+		var wg sync.WaitGroup
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				errs <- uc.rollOverBudgets(context.Background(), 123, entity.BudgetingModeClassic)
+			}()
+		}
+
+		close(start)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.Nil(t, err)
+		}
+	})
+}
+
+func TestUseCase_MoveBudget(t *testing.T) {
+	mockParam := MoveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 7,
+		ToCategoryID:   9,
+	}
+	mockSvcParam := budget.MoveBudgetParam{
+		Amount:         250000,
+		FromCategoryID: 7,
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		ToCategoryID:   9,
+		UserID:         123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      MoveBudgetParam
+		mockFields func(mockFields)
+		want       BudgetSummary
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name:       "when_fields_empty_then_return_every_problem",
+			ctx:        mockCtx,
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldAmount, Message: "amount must be greater than zero"},
+					{Code: violationRequired, Field: fieldFromCategoryID, Message: "from_category_id is required"},
+					{Code: violationRequired, Field: fieldToCategoryID, Message: "to_category_id is required"},
+				},
+			},
+		},
+		{
+			name: "when_moving_to_the_same_category_then_return_validation_error",
+			ctx:  mockCtx,
+			param: MoveBudgetParam{
+				Amount:         250000,
+				FromCategoryID: 7,
+				ToCategoryID:   7,
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldToCategoryID, Message: "to_category_id must be different from from_category_id"},
+				},
+			},
+		},
+		{
+			name:  "when_GetUserAccountByID_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_user_budgets_in_classic_mode_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+			},
+			wantErr: ErrEnvelopeModeRequired,
+		},
+		{
+			name:  "when_GetRolledOverPeriodStart_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_destination_category_not_exist_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldToCategoryID, Message: "category doesn't exist"},
+				},
+			},
+		},
+		{
+			name:  "when_GetPeriod_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_GetPeriod_of_previous_period_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_source_not_budgeted_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(budget.ErrBudgetNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldFromCategoryID, Message: "from_category_id isn't budgeted in the period"},
+				},
+			},
+		},
+		{
+			name:  "when_source_insufficient_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(budget.ErrBudgetInsufficient)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldAmount, Message: "amount must not be more than what is left unspent in the budget of from_category_id"},
+				},
+			},
+		},
+		{
+			name:  "when_currency_differs_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(budget.ErrBudgetCurrencyMismatch)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldToCategoryID, Message: "budget of to_category_id has a different currency"},
+				},
+			},
+		},
+		{
+			name:  "when_MoveBudget_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_ListBudgets_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_budget_summary",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockEnvelopeAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(9)).Return(mockCategories[1], nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().MoveBudget(mockCtx, mockSvcParam).Return(nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+				mf.categorySvc.EXPECT().ListCategories(mockCtx, int64(123)).Return(mockCategories, nil)
+				mf.budgetSvc.EXPECT().GetAvailableToAssign(mockCtx, int64(123), mockPeriod).Return(nil, nil)
+			},
+			want: BudgetSummary{
+				AvailableToAssign: []AvailableToAssign{},
+				Budgets:           mockSummary.Budgets,
+				BudgetingMode:     entity.BudgetingModeEnvelope,
+				Period:            mockSummary.Period,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				budgetSvc:   NewMockbudgetServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account:  mockFields.accountSvc,
+				budget:   mockFields.budgetSvc,
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.MoveBudget(test.ctx, 0, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_SetBudget(t *testing.T) {
+	mockParam := SetBudgetParam{
+		Amount:     1000000,
+		CategoryID: 7,
+		Currency:   " idr ",
+	}
+	mockSvcParam := budget.SetBudgetParam{
+		Amount:         1000000,
+		BudgetingMode:  entity.BudgetingModeClassic,
+		CategoryID:     7,
+		Currency:       "IDR",
+		Period:         mockPeriod,
+		PreviousPeriod: mockPreviousPeriod,
+		RolloverMode:   entity.RolloverModeNone,
+		UserID:         123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      SetBudgetParam
+		mockFields func(mockFields)
+		want       Budget
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name:       "when_fields_empty_then_return_every_problem",
+			ctx:        mockCtx,
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldAmount, Message: "amount must be greater than zero"},
+					{Code: violationRequired, Field: fieldCategoryID, Message: "category_id is required"},
+					{Code: violationRequired, Field: fieldCurrency, Message: "currency is required"},
+				},
+			},
+		},
+		{
+			name: "when_currency_and_rollover_mode_invalid_then_return_validation_error",
+			ctx:  mockCtx,
+			param: SetBudgetParam{
+				Amount:       1000000,
+				CategoryID:   7,
+				Currency:     "rupiah",
+				RolloverMode: "negative",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCurrency, Message: "currency must be a 3 letter ISO 4217 code"},
+					{Code: violationInvalid, Field: fieldRolloverMode, Message: "rollover_mode must be one of both, none or positive"},
+				},
+			},
+		},
+		{
+			name:  "when_category_not_exist_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "category doesn't exist"},
+				},
+			},
+		},
+		{
+			name:  "when_GetCategory_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(category.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_category_is_income_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(category.Category{
+					ID:   7,
+					Type: entity.TransactionTypeIncome,
+				}, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "only an expense category can be budgeted"},
+				},
+			},
+		},
+		{
+			name:  "when_category_archived_then_return_validation_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(category.Category{
+					ArchivedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					ID:         7,
+					Type:       entity.TransactionTypeExpense,
+				}, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "an archived category can't be budgeted"},
+				},
+			},
+		},
+		{
+			name:  "when_GetUserAccountByID_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(account.Account{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_GetRolledOverPeriodStart_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_GetPeriod_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_GetPeriod_of_previous_period_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(entity.Period{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_SetBudget_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SetBudget(mockCtx, mockSvcParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_ListBudgets_error_then_return_error",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SetBudget(mockCtx, mockSvcParam).Return(nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_budget_not_listed_then_return_ErrBudgetNotFound",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SetBudget(mockCtx, mockSvcParam).Return(nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets[1:], nil)
+			},
+			wantErr: ErrBudgetNotFound,
+		},
+		{
+			name:  "when_no_error_occured_then_return_budget",
+			ctx:   mockCtx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(mockCtx, int64(123), int64(7)).Return(mockCategories[0], nil)
+				mf.accountSvc.EXPECT().GetUserAccountByID(mockCtx, int64(123)).Return(mockAccount, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.budgetSvc.EXPECT().GetRolledOverPeriodStart(mockCtx, int64(123)).Return(mockPeriod.Start, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), 0).Return(mockPeriod, nil)
+				mf.accountSvc.EXPECT().GetPeriod(mockCtx, int64(123), -1).Return(mockPreviousPeriod, nil)
+				mf.budgetSvc.EXPECT().SetBudget(mockCtx, mockSvcParam).Return(nil)
+				mf.budgetSvc.EXPECT().ListBudgets(mockCtx, int64(123), mockPeriod).Return(mockBudgets, nil)
+			},
+			want: mockSummary.Budgets[0],
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				accountSvc:  NewMockaccountServiceProvider(ctrl),
+				budgetSvc:   NewMockbudgetServiceProvider(ctrl),
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				account:  mockFields.accountSvc,
+				budget:   mockFields.budgetSvc,
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.SetBudget(test.ctx, 0, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package budget
+
+import (
+	// golang package
+	"time"
+)
+
+// ----------------
+// | Error Struct |
+// ----------------
+
+// ValidationError is returned when fields of a request aren't valid.
+// Fields holds every problem found, so all of them can be shown at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns a summary of the invalid fields.
+func (e *ValidationError) Error() string {
+	return "request not valid"
+}
+
+// FieldError describes why a field of a request isn't valid.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// -------------------
+// | Response Struct |
+// -------------------
+
+// AvailableToAssign holds how much income in a currency isn't assigned to any budget yet,
+// counted from every period up to the requested one. Amount is negative when more is assigned than earned.
+type AvailableToAssign struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Budget holds how much user spent on a category against its limit in a period.
+// Amounts are in minor unit of Currency. The limit is Budgeted plus CarriedOver from the previous period,
+// Remaining and PercentUsed are counted against it and Remaining is negative when the category is overspent.
+type Budget struct {
+	Budgeted     int64   `json:"budgeted"`
+	CarriedOver  int64   `json:"carried_over"`
+	CategoryID   int64   `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Currency     string  `json:"currency"`
+	ID           int64   `json:"id"`
+	PercentUsed  float64 `json:"percent_used"`
+	Remaining    int64   `json:"remaining"`
+	RolloverMode string  `json:"rollover_mode"`
+	Spent        int64   `json:"spent"`
+}
+
+// BudgetSummary holds every budget of user in a period.
+// AvailableToAssign is only filled when user budgets in envelope mode.
+type BudgetSummary struct {
+	AvailableToAssign []AvailableToAssign `json:"available_to_assign,omitempty"`
+	Budgets           []Budget            `json:"budgets"`
+	BudgetingMode     string              `json:"budgeting_mode"`
+	Period            Period              `json:"period"`
+}
+
+// Period holds a payday-to-payday cycle of user.
+// Start is inclusive and End is exclusive, Offset tells how many periods away it is from the current one.
+type Period struct {
+	End    time.Time `json:"end"`
+	Offset int       `json:"offset"`
+	Start  time.Time `json:"start"`
+}
+
+// --------------------
+// | Parameter Struct |
+// --------------------
+
+// MoveBudgetParam represents parameter needed to move money from the budget of a category
+// to the budget of another category in a period. Amount is in minor unit of the currency of the budgets.
+type MoveBudgetParam struct {
+	Amount         int64
+	FromCategoryID int64
+	ToCategoryID   int64
+}
+
+// SetBudgetParam represents parameter needed to set the budget of a category in a period.
+// Amount is in minor unit of Currency. RolloverMode is none when left out.
+type SetBudgetParam struct {
+	Amount       int64
+	CategoryID   int64
+	Currency     string
+	RolloverMode string
+}
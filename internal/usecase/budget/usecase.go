@@ -0,0 +1,130 @@
+package budget
+
+import (
+	// golang package
+	"context"
+	"time"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/account"
+	"github.com/arifinhermawan/bubi/internal/service/budget"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+//go:generate mockgen -source=usecase.go -destination=usecase_mock.go -package=budget
+
+// accountServiceProvider holds all methods from account service that wil be used in budget's usecase.
+type accountServiceProvider interface {
+	// GetPeriod will return the period of a user that is offset periods away from the current one.
+	// The period starts on user's record period start day and is counted in user's timezone.
+	GetPeriod(ctx context.Context, userID int64, offset int) (entity.Period, error)
+
+	// GetUserAccountByID will fetch user's account based on its id.
+	// If the account doesn't exist, it will return ErrAccountNotFound.
+	GetUserAccountByID(ctx context.Context, userID int64) (account.Account, error)
+}
+
+// budgetServiceProvider holds all methods from budget service that wil be used in budget's usecase.
+type budgetServiceProvider interface {
+	// CopyBudgets will copy every budget of a user in from period into to period,
+	// carrying over what is left of them according to their rollover mode once from period has ended.
+	// The copies are set in budgetingMode, the budgeting mode of the user.
+	// Budgets of archived categories and of categories that are already budgeted in to period are skipped.
+	// It returns how many budgets are copied.
+	CopyBudgets(ctx context.Context, userID int64, budgetingMode string, from, to entity.Period) (int64, error)
+
+	// DeleteBudget will delete the budget of a category of a user in a period.
+	// If the category isn't budgeted in the period, it will return ErrBudgetNotFound.
+	DeleteBudget(ctx context.Context, userID, categoryID int64, period entity.Period) error
+
+	// GetAvailableToAssign will count, per currency, the income of a user until the end of a period
+	// that isn't assigned to any envelope budget up to that period, ordered by the currency.
+	// What envelope budgets of the periods before don't carry over is given back to it.
+	GetAvailableToAssign(ctx context.Context, userID int64, period entity.Period) ([]budget.AvailableToAssign, error)
+
+	// GetRolledOverPeriodStart will fetch start of the latest period budgets of a user are rolled over into.
+	// It returns zero time if they have never been rolled over.
+	GetRolledOverPeriodStart(ctx context.Context, userID int64) (time.Time, error)
+
+	// ListBudgets will fetch every budget of a user in a period alongside how much is spent on them,
+	// ordered by their category.
+	ListBudgets(ctx context.Context, userID int64, period entity.Period) ([]budget.Budget, error)
+
+	// LockRollOver will take the lock of rolling over budgets of a user, waiting while another request holds it,
+	// so budgets of the user are only rolled over by one request at a time. The lock is held until the returned function is called.
+	LockRollOver(ctx context.Context, userID int64) (func(), error)
+
+	// MoveBudget will move money from the budget of a category to the budget of another category in a period.
+	// Only what is left unspent of the source can be moved, including what it carried over,
+	// otherwise it returns ErrBudgetInsufficient.
+	// If the source category isn't budgeted in the period, it will return ErrBudgetNotFound.
+	// Budgets of different currencies are refused with ErrBudgetCurrencyMismatch.
+	MoveBudget(ctx context.Context, param budget.MoveBudgetParam) error
+
+	// RollOverBudgets will continue every budget of a user in from period that has a rollover mode
+	// other than none into to period, carrying over what is left of them.
+	// Categories that are already budgeted in to period keep their budget.
+	// It returns how many budgets are rolled over.
+	RollOverBudgets(ctx context.Context, userID int64, from, to entity.Period) (int64, error)
+
+	// ReleaseUnspent will give back to what a user has left to assign what budgets in period don't carry over
+	// into the next period once period has ended. Budgets that are already released are skipped.
+	// It returns how many budgets are released.
+	ReleaseUnspent(ctx context.Context, userID int64, period entity.Period) (int64, error)
+
+	// RollOverEnvelopes will continue every budget of a user in from period that has a rollover mode
+	// other than none into to period as an envelope, which carries over what is left of its source
+	// but starts without any money assigned to it.
+	// Categories that are already budgeted in to period keep their budget.
+	// It returns how many budgets are rolled over.
+	RollOverEnvelopes(ctx context.Context, userID int64, from, to entity.Period) (int64, error)
+
+	// SaveRolledOverPeriod will save period as the latest period budgets of a user are rolled over into.
+	// A period before the one that is already saved is ignored.
+	SaveRolledOverPeriod(ctx context.Context, userID int64, period entity.Period) error
+
+	// SettleCarriedOver will fix what budgets of a user in to period carry over from their budget in from period,
+	// for budgets whose carry was left pending because from period hasn't ended when they were created.
+	// Nothing is settled while from period hasn't ended. It returns how many budgets are settled.
+	SettleCarriedOver(ctx context.Context, userID int64, from, to entity.Period) (int64, error)
+
+	// SetBudget will set the budget of a category of a user in a period,
+	// replacing the budget that is already set for the category in that period.
+	// A new budget carries over from the budget of the category in the previous period,
+	// or leaves it pending until SettleCarriedOver when that period hasn't ended yet.
+	SetBudget(ctx context.Context, param budget.SetBudgetParam) error
+}
+
+// categoryServiceProvider holds all methods from category service that wil be used in budget's usecase.
+type categoryServiceProvider interface {
+	// GetCategory will fetch a category of a user.
+	// If the user doesn't have the category, it will return ErrCategoryNotFound.
+	GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error)
+
+	// ListCategories will fetch every category of a user, ordered by their type and name.
+	ListCategories(ctx context.Context, userID int64) ([]category.Category, error)
+}
+
+// BudgetUsecaseParam holds all parameters needed to instantiate
+// a new instance of Usecase.
+type BudgetUsecaseParam struct {
+	Account  accountServiceProvider
+	Budget   budgetServiceProvider
+	Category categoryServiceProvider
+}
+
+type UseCase struct {
+	account  accountServiceProvider
+	budget   budgetServiceProvider
+	category categoryServiceProvider
+}
+
+// NewUseCase will instantiate a new instance of UseCase.
+func NewUseCase(param BudgetUsecaseParam) *UseCase {
+	return &UseCase{
+		account:  param.Account,
+		budget:   param.Budget,
+		category: param.Category,
+	}
+}
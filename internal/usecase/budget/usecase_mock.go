@@ -0,0 +1,337 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package budget is a generated GoMock package.
+package budget
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	account "github.com/arifinhermawan/bubi/internal/service/account"
+	budget "github.com/arifinhermawan/bubi/internal/service/budget"
+	category "github.com/arifinhermawan/bubi/internal/service/category"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockaccountServiceProvider is a mock of accountServiceProvider interface.
+type MockaccountServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockaccountServiceProviderMockRecorder
+}
+
+// MockaccountServiceProviderMockRecorder is the mock recorder for MockaccountServiceProvider.
+type MockaccountServiceProviderMockRecorder struct {
+	mock *MockaccountServiceProvider
+}
+
+// NewMockaccountServiceProvider creates a new mock instance.
+func NewMockaccountServiceProvider(ctrl *gomock.Controller) *MockaccountServiceProvider {
+	mock := &MockaccountServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockaccountServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaccountServiceProvider) EXPECT() *MockaccountServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetPeriod mocks base method.
+func (m *MockaccountServiceProvider) GetPeriod(ctx context.Context, userID int64, offset int) (entity.Period, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeriod", ctx, userID, offset)
+	ret0, _ := ret[0].(entity.Period)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeriod indicates an expected call of GetPeriod.
+func (mr *MockaccountServiceProviderMockRecorder) GetPeriod(ctx, userID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeriod", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetPeriod), ctx, userID, offset)
+}
+
+// GetUserAccountByID mocks base method.
+func (m *MockaccountServiceProvider) GetUserAccountByID(ctx context.Context, userID int64) (account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccountByID", ctx, userID)
+	ret0, _ := ret[0].(account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccountByID indicates an expected call of GetUserAccountByID.
+func (mr *MockaccountServiceProviderMockRecorder) GetUserAccountByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountByID", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetUserAccountByID), ctx, userID)
+}
+
+// MockbudgetServiceProvider is a mock of budgetServiceProvider interface.
+type MockbudgetServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockbudgetServiceProviderMockRecorder
+}
+
+// MockbudgetServiceProviderMockRecorder is the mock recorder for MockbudgetServiceProvider.
+type MockbudgetServiceProviderMockRecorder struct {
+	mock *MockbudgetServiceProvider
+}
+
+// NewMockbudgetServiceProvider creates a new mock instance.
+func NewMockbudgetServiceProvider(ctrl *gomock.Controller) *MockbudgetServiceProvider {
+	mock := &MockbudgetServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockbudgetServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbudgetServiceProvider) EXPECT() *MockbudgetServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// CopyBudgets mocks base method.
+func (m *MockbudgetServiceProvider) CopyBudgets(ctx context.Context, userID int64, budgetingMode string, from, to entity.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyBudgets", ctx, userID, budgetingMode, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyBudgets indicates an expected call of CopyBudgets.
+func (mr *MockbudgetServiceProviderMockRecorder) CopyBudgets(ctx, userID, budgetingMode, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyBudgets", reflect.TypeOf((*MockbudgetServiceProvider)(nil).CopyBudgets), ctx, userID, budgetingMode, from, to)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockbudgetServiceProvider) DeleteBudget(ctx context.Context, userID, categoryID int64, period entity.Period) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, userID, categoryID, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockbudgetServiceProviderMockRecorder) DeleteBudget(ctx, userID, categoryID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockbudgetServiceProvider)(nil).DeleteBudget), ctx, userID, categoryID, period)
+}
+
+// GetAvailableToAssign mocks base method.
+func (m *MockbudgetServiceProvider) GetAvailableToAssign(ctx context.Context, userID int64, period entity.Period) ([]budget.AvailableToAssign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableToAssign", ctx, userID, period)
+	ret0, _ := ret[0].([]budget.AvailableToAssign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableToAssign indicates an expected call of GetAvailableToAssign.
+func (mr *MockbudgetServiceProviderMockRecorder) GetAvailableToAssign(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableToAssign", reflect.TypeOf((*MockbudgetServiceProvider)(nil).GetAvailableToAssign), ctx, userID, period)
+}
+
+// GetRolledOverPeriodStart mocks base method.
+func (m *MockbudgetServiceProvider) GetRolledOverPeriodStart(ctx context.Context, userID int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolledOverPeriodStart", ctx, userID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolledOverPeriodStart indicates an expected call of GetRolledOverPeriodStart.
+func (mr *MockbudgetServiceProviderMockRecorder) GetRolledOverPeriodStart(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolledOverPeriodStart", reflect.TypeOf((*MockbudgetServiceProvider)(nil).GetRolledOverPeriodStart), ctx, userID)
+}
+
+// ListBudgets mocks base method.
+func (m *MockbudgetServiceProvider) ListBudgets(ctx context.Context, userID int64, period entity.Period) ([]budget.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBudgets", ctx, userID, period)
+	ret0, _ := ret[0].([]budget.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBudgets indicates an expected call of ListBudgets.
+func (mr *MockbudgetServiceProviderMockRecorder) ListBudgets(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBudgets", reflect.TypeOf((*MockbudgetServiceProvider)(nil).ListBudgets), ctx, userID, period)
+}
+
+// LockRollOver mocks base method.
+func (m *MockbudgetServiceProvider) LockRollOver(ctx context.Context, userID int64) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockRollOver", ctx, userID)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockRollOver indicates an expected call of LockRollOver.
+func (mr *MockbudgetServiceProviderMockRecorder) LockRollOver(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRollOver", reflect.TypeOf((*MockbudgetServiceProvider)(nil).LockRollOver), ctx, userID)
+}
+
+// MoveBudget mocks base method.
+func (m *MockbudgetServiceProvider) MoveBudget(ctx context.Context, param budget.MoveBudgetParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBudget", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveBudget indicates an expected call of MoveBudget.
+func (mr *MockbudgetServiceProviderMockRecorder) MoveBudget(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBudget", reflect.TypeOf((*MockbudgetServiceProvider)(nil).MoveBudget), ctx, param)
+}
+
+// ReleaseUnspent mocks base method.
+func (m *MockbudgetServiceProvider) ReleaseUnspent(ctx context.Context, userID int64, period entity.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUnspent", ctx, userID, period)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseUnspent indicates an expected call of ReleaseUnspent.
+func (mr *MockbudgetServiceProviderMockRecorder) ReleaseUnspent(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUnspent", reflect.TypeOf((*MockbudgetServiceProvider)(nil).ReleaseUnspent), ctx, userID, period)
+}
+
+// RollOverBudgets mocks base method.
+func (m *MockbudgetServiceProvider) RollOverBudgets(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollOverBudgets", ctx, userID, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollOverBudgets indicates an expected call of RollOverBudgets.
+func (mr *MockbudgetServiceProviderMockRecorder) RollOverBudgets(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollOverBudgets", reflect.TypeOf((*MockbudgetServiceProvider)(nil).RollOverBudgets), ctx, userID, from, to)
+}
+
+// RollOverEnvelopes mocks base method.
+func (m *MockbudgetServiceProvider) RollOverEnvelopes(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollOverEnvelopes", ctx, userID, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollOverEnvelopes indicates an expected call of RollOverEnvelopes.
+func (mr *MockbudgetServiceProviderMockRecorder) RollOverEnvelopes(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollOverEnvelopes", reflect.TypeOf((*MockbudgetServiceProvider)(nil).RollOverEnvelopes), ctx, userID, from, to)
+}
+
+// SaveRolledOverPeriod mocks base method.
+func (m *MockbudgetServiceProvider) SaveRolledOverPeriod(ctx context.Context, userID int64, period entity.Period) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRolledOverPeriod", ctx, userID, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRolledOverPeriod indicates an expected call of SaveRolledOverPeriod.
+func (mr *MockbudgetServiceProviderMockRecorder) SaveRolledOverPeriod(ctx, userID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRolledOverPeriod", reflect.TypeOf((*MockbudgetServiceProvider)(nil).SaveRolledOverPeriod), ctx, userID, period)
+}
+
+// SetBudget mocks base method.
+func (m *MockbudgetServiceProvider) SetBudget(ctx context.Context, param budget.SetBudgetParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudget", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBudget indicates an expected call of SetBudget.
+func (mr *MockbudgetServiceProviderMockRecorder) SetBudget(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudget", reflect.TypeOf((*MockbudgetServiceProvider)(nil).SetBudget), ctx, param)
+}
+
+// SettleCarriedOver mocks base method.
+func (m *MockbudgetServiceProvider) SettleCarriedOver(ctx context.Context, userID int64, from, to entity.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleCarriedOver", ctx, userID, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleCarriedOver indicates an expected call of SettleCarriedOver.
+func (mr *MockbudgetServiceProviderMockRecorder) SettleCarriedOver(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleCarriedOver", reflect.TypeOf((*MockbudgetServiceProvider)(nil).SettleCarriedOver), ctx, userID, from, to)
+}
+
+// MockcategoryServiceProvider is a mock of categoryServiceProvider interface.
+type MockcategoryServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockcategoryServiceProviderMockRecorder
+}
+
+// MockcategoryServiceProviderMockRecorder is the mock recorder for MockcategoryServiceProvider.
+type MockcategoryServiceProviderMockRecorder struct {
+	mock *MockcategoryServiceProvider
+}
+
+// NewMockcategoryServiceProvider creates a new mock instance.
+func NewMockcategoryServiceProvider(ctrl *gomock.Controller) *MockcategoryServiceProvider {
+	mock := &MockcategoryServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockcategoryServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcategoryServiceProvider) EXPECT() *MockcategoryServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetCategory mocks base method.
+func (m *MockcategoryServiceProvider) GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, userID, categoryID)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockcategoryServiceProviderMockRecorder) GetCategory(ctx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockcategoryServiceProvider)(nil).GetCategory), ctx, userID, categoryID)
+}
+
+// ListCategories mocks base method.
+func (m *MockcategoryServiceProvider) ListCategories(ctx context.Context, userID int64) ([]category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, userID)
+	ret0, _ := ret[0].([]category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockcategoryServiceProviderMockRecorder) ListCategories(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockcategoryServiceProvider)(nil).ListCategories), ctx, userID)
+}
@@ -0,0 +1,28 @@
+package budget
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAccountSvc := NewMockaccountServiceProvider(ctrl)
+	mockBudgetSvc := NewMockbudgetServiceProvider(ctrl)
+	mockCategorySvc := NewMockcategoryServiceProvider(ctrl)
+
+	want := &UseCase{
+		account:  mockAccountSvc,
+		budget:   mockBudgetSvc,
+		category: mockCategorySvc,
+	}
+	assert.Equal(t, want, NewUseCase(BudgetUsecaseParam{
+		Account:  mockAccountSvc,
+		Budget:   mockBudgetSvc,
+		Category: mockCategorySvc,
+	}))
+}
@@ -0,0 +1,466 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+const (
+	fieldColor    = "color"
+	fieldIcon     = "icon"
+	fieldName     = "name"
+	fieldParentID = "parent_id"
+	fieldTargetID = "target_id"
+	fieldType     = "type"
+
+	violationInvalid  = "invalid"
+	violationRequired = "required"
+	violationTooLong  = "too_long"
+
+	maxCategoryNameLength = 50
+	maxIconLength         = 50
+)
+
+var (
+	// ErrCategoryNotFound is returned when the user doesn't have the requested category.
+	ErrCategoryNotFound = errors.New("category not found")
+
+	errUnauthorized = errors.New("unauthorized!")
+
+	// colorPattern matches a hex color such as #FF9800.
+	colorPattern = regexp.MustCompile(`^#[0-9A-F]{6}$`)
+)
+
+// CreateCategory will create a new category for the user acting on ctx.
+// A subcategory must be placed under a top level category of the same type.
+// Fields that aren't valid are refused with ValidationError.
+func (uc *UseCase) CreateCategory(ctx context.Context, param CreateCategoryParam) (Category, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[CreateCategory] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Category{}, errUnauthorized
+	}
+
+	svcParam := category.CreateCategoryParam{
+		Color:    strings.ToUpper(strings.TrimSpace(param.Color)),
+		Icon:     strings.TrimSpace(param.Icon),
+		Name:     strings.TrimSpace(param.Name),
+		ParentID: param.ParentID,
+		Type:     strings.TrimSpace(param.Type),
+		UserID:   principal.UserID,
+	}
+
+	meta := map[string]interface{}{
+		"param":   svcParam,
+		"user_id": principal.UserID,
+	}
+
+	// categories are only needed to check the parent, so they aren't fetched for a top level category.
+	var categories []category.Category
+	if svcParam.ParentID > 0 {
+		var err error
+		categories, err = uc.category.ListCategories(ctx, principal.UserID)
+		if err != nil {
+			log.Printf("[CreateCategory] uc.category.ListCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+			return Category{}, err
+		}
+	}
+
+	err := validateCategory(svcParam, 0, false, categories)
+	if err != nil {
+		log.Printf("[CreateCategory] validateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	created, err := uc.category.CreateCategory(ctx, svcParam)
+	if err != nil {
+		log.Printf("[CreateCategory] uc.category.CreateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	return convertCategory(created), nil
+}
+
+// GetCategory will fetch a category of the user acting on ctx.
+func (uc *UseCase) GetCategory(ctx context.Context, categoryID int64) (Category, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetCategory] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Category{}, errUnauthorized
+	}
+
+	result, err := uc.category.GetCategory(ctx, principal.UserID, categoryID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"category_id": categoryID,
+			"user_id":     principal.UserID,
+		}
+
+		log.Printf("[GetCategory] uc.category.GetCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			return Category{}, ErrCategoryNotFound
+		}
+
+		return Category{}, err
+	}
+
+	return convertCategory(result), nil
+}
+
+// ListCategories will fetch categories of the user acting on ctx, ordered by their type and name.
+// Archived categories are left out unless param.IncludeArchived is true.
+func (uc *UseCase) ListCategories(ctx context.Context, param ListCategoriesParam) ([]Category, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListCategories] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	categories, err := uc.category.ListCategories(ctx, principal.UserID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[ListCategories] uc.category.ListCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Category, 0, len(categories))
+	for _, c := range categories {
+		if !param.IncludeArchived && !c.ArchivedAt.IsZero() {
+			continue
+		}
+
+		result = append(result, convertCategory(c))
+	}
+
+	return result, nil
+}
+
+// MergeCategories will merge a category of the user acting on ctx into another category
+// of the same type and return the target category. Every transaction, subcategory and budget of
+// the merged category is moved to the target category before the merged category is deleted.
+// A target that can't take the merged category, including one budgeted in another currency
+// in the same period, is refused with ValidationError.
+func (uc *UseCase) MergeCategories(ctx context.Context, categoryID, targetID int64) (Category, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[MergeCategories] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Category{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"category_id": categoryID,
+		"target_id":   targetID,
+		"user_id":     principal.UserID,
+	}
+
+	categories, err := uc.category.ListCategories(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[MergeCategories] uc.category.ListCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	source, found := findCategory(categories, categoryID)
+	if !found {
+		log.Printf("[MergeCategories] category not found\nMeta:%+v\n", meta)
+		return Category{}, ErrCategoryNotFound
+	}
+
+	err = validateMerge(source, targetID, categories)
+	if err != nil {
+		log.Printf("[MergeCategories] validateMerge() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	err = uc.category.MergeCategories(ctx, category.MergeCategoriesParam{
+		SourceID: categoryID,
+		TargetID: targetID,
+		UserID:   principal.UserID,
+	})
+	if err != nil {
+		log.Printf("[MergeCategories] uc.category.MergeCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			return Category{}, ErrCategoryNotFound
+		}
+
+		if errors.Is(err, category.ErrBudgetCurrencyMismatch) {
+			return Category{}, &ValidationError{
+				Fields: []FieldError{
+					{
+						Code:    violationInvalid,
+						Field:   fieldTargetID,
+						Message: "target category must be budgeted in the same currency",
+					},
+				},
+			}
+		}
+
+		return Category{}, err
+	}
+
+	return uc.GetCategory(ctx, targetID)
+}
+
+// UpdateCategory will update a category of the user acting on ctx and return the updated category.
+// Only fields given in param are changed, and archiving a category also archives its subcategories.
+// Fields that aren't valid are refused with ValidationError.
+func (uc *UseCase) UpdateCategory(ctx context.Context, categoryID int64, param UpdateCategoryParam) (Category, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[UpdateCategory] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Category{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"category_id": categoryID,
+		"user_id":     principal.UserID,
+	}
+
+	categories, err := uc.category.ListCategories(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[UpdateCategory] uc.category.ListCategories() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	current, found := findCategory(categories, categoryID)
+	if !found {
+		log.Printf("[UpdateCategory] category not found\nMeta:%+v\n", meta)
+		return Category{}, ErrCategoryNotFound
+	}
+
+	svcParam := category.UpdateCategoryParam{
+		Archived: !current.ArchivedAt.IsZero(),
+		Color:    current.Color,
+		Icon:     current.Icon,
+		ID:       current.ID,
+		Name:     current.Name,
+		ParentID: current.ParentID,
+		UserID:   principal.UserID,
+	}
+
+	if param.Archived != nil {
+		svcParam.Archived = *param.Archived
+	}
+
+	if param.Color != nil {
+		svcParam.Color = strings.ToUpper(strings.TrimSpace(*param.Color))
+	}
+
+	if param.Icon != nil {
+		svcParam.Icon = strings.TrimSpace(*param.Icon)
+	}
+
+	if param.Name != nil {
+		svcParam.Name = strings.TrimSpace(*param.Name)
+	}
+
+	if param.ParentID != nil {
+		svcParam.ParentID = *param.ParentID
+	}
+
+	err = validateCategory(category.CreateCategoryParam{
+		Color:    svcParam.Color,
+		Icon:     svcParam.Icon,
+		Name:     svcParam.Name,
+		ParentID: svcParam.ParentID,
+		Type:     current.Type,
+	}, current.ID, svcParam.Archived, categories)
+	if err != nil {
+		log.Printf("[UpdateCategory] validateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Category{}, err
+	}
+
+	err = uc.category.UpdateCategory(ctx, svcParam)
+	if err != nil {
+		log.Printf("[UpdateCategory] uc.category.UpdateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			return Category{}, ErrCategoryNotFound
+		}
+
+		return Category{}, err
+	}
+
+	return uc.GetCategory(ctx, categoryID)
+}
+
+// findCategory will look for the category with the given id in categories.
+func findCategory(categories []category.Category, id int64) (category.Category, bool) {
+	for _, c := range categories {
+		if c.ID == id {
+			return c, true
+		}
+	}
+
+	return category.Category{}, false
+}
+
+// hasSubcategories will report whether any of categories is placed under the category with the given id.
+func hasSubcategories(categories []category.Category, id int64) bool {
+	for _, c := range categories {
+		if c.ParentID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateCategory will check fields of a category that is about to be saved.
+// categoryID is zero for a new category, and categories must hold every category
+// of the user whenever param.ParentID isn't zero.
+// Every problem found is returned at once as a ValidationError.
+func validateCategory(param category.CreateCategoryParam, categoryID int64, archived bool, categories []category.Category) error {
+	var fields []FieldError
+	switch {
+	case param.Name == "":
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldName,
+			Message: "name is required",
+		})
+	case utf8.RuneCountInString(param.Name) > maxCategoryNameLength:
+		fields = append(fields, FieldError{
+			Code:    violationTooLong,
+			Field:   fieldName,
+			Message: fmt.Sprintf("name must be at most %d characters", maxCategoryNameLength),
+		})
+	}
+
+	if utf8.RuneCountInString(param.Icon) > maxIconLength {
+		fields = append(fields, FieldError{
+			Code:    violationTooLong,
+			Field:   fieldIcon,
+			Message: fmt.Sprintf("icon must be at most %d characters", maxIconLength),
+		})
+	}
+
+	if param.Color != "" && !colorPattern.MatchString(param.Color) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldColor,
+			Message: "color must be a hex color such as #FF9800",
+		})
+	}
+
+	if !entity.IsTransactionType(param.Type) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldType,
+			Message: "type must be either expense or income",
+		})
+	}
+
+	if message := validateParent(param, categoryID, archived, categories); message != "" {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldParentID,
+			Message: message,
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// validateParent will check whether a category can be placed under param.ParentID.
+// Categories are nested only one level deep, so the parent must be a top level
+// category and the category itself can't have subcategories.
+// It returns the reason the parent is refused, or an empty string when it's accepted.
+func validateParent(param category.CreateCategoryParam, categoryID int64, archived bool, categories []category.Category) string {
+	if param.ParentID == 0 {
+		return ""
+	}
+
+	if param.ParentID < 0 {
+		return "parent_id not valid"
+	}
+
+	if param.ParentID == categoryID {
+		return "category can't be its own parent"
+	}
+
+	parent, found := findCategory(categories, param.ParentID)
+	switch {
+	case !found:
+		return "parent category doesn't exist"
+	case parent.ParentID != 0:
+		return "parent category must be a top level category"
+	case parent.Type != param.Type:
+		return "parent category must have the same type"
+	case !archived && !parent.ArchivedAt.IsZero():
+		return "parent category is archived"
+	case categoryID != 0 && hasSubcategories(categories, categoryID):
+		return "category with subcategories can't be placed under another category"
+	}
+
+	return ""
+}
+
+// validateMerge will check whether source can be merged into the category with targetID.
+func validateMerge(source category.Category, targetID int64, categories []category.Category) error {
+	var message string
+	target, found := findCategory(categories, targetID)
+	switch {
+	case targetID == source.ID:
+		message = "target category must be a different category"
+	case !found:
+		message = "target category doesn't exist"
+	case target.Type != source.Type:
+		message = "target category must have the same type"
+	case target.ParentID != 0 && hasSubcategories(categories, source.ID):
+		message = "category with subcategories can only be merged into a top level category"
+	default:
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: []FieldError{
+			{
+				Code:    violationInvalid,
+				Field:   fieldTargetID,
+				Message: message,
+			},
+		},
+	}
+}
+
+// convertCategory will convert a category from category service into its response format.
+func convertCategory(c category.Category) Category {
+	result := Category{
+		Color:     c.Color,
+		CreatedAt: c.CreatedAt,
+		Icon:      c.Icon,
+		ID:        c.ID,
+		Name:      c.Name,
+		Type:      c.Type,
+	}
+
+	if c.ParentID != 0 {
+		parentID := c.ParentID
+		result.ParentID = &parentID
+	}
+
+	if !c.ArchivedAt.IsZero() {
+		archivedAt := c.ArchivedAt
+		result.ArchivedAt = &archivedAt
+	}
+
+	return result
+}
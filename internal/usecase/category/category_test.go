@@ -0,0 +1,783 @@
+package category
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+var (
+	mockTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// mockCategories holds two expense top level categories, where Food has Coffee as its subcategory,
+	// one income top level category and one archived expense top level category.
+	mockCategories = []category.Category{
+		{Color: "#FF9800", ID: 1, Name: "Food", Type: entity.TransactionTypeExpense, UserID: 123},
+		{Color: "#FF9800", ID: 2, Name: "Coffee", ParentID: 1, Type: entity.TransactionTypeExpense, UserID: 123},
+		{Color: "#4CAF50", ID: 3, Name: "Salary", Type: entity.TransactionTypeIncome, UserID: 123},
+		{Color: "#2196F3", ID: 4, Name: "Transport", Type: entity.TransactionTypeExpense, UserID: 123},
+		{ArchivedAt: mockTime, ID: 5, Name: "Old", Type: entity.TransactionTypeExpense, UserID: 123},
+	}
+)
+
+func TestUseCase_CreateCategory(t *testing.T) {
+	type mockFields struct {
+		categorySvc *MockcategoryServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockParam := CreateCategoryParam{
+		Color:    " #ff9800 ",
+		Icon:     " local_cafe ",
+		Name:     " Snacks ",
+		ParentID: 1,
+		Type:     entity.TransactionTypeExpense,
+	}
+	mockSvcParam := category.CreateCategoryParam{
+		Color:    "#FF9800",
+		Icon:     "local_cafe",
+		Name:     "Snacks",
+		ParentID: 1,
+		Type:     entity.TransactionTypeExpense,
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      CreateCategoryParam
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name:  "when_ListCategories_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_fields_invalid_then_return_every_problem",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Color: "orange",
+				Name:  " ",
+				Type:  "transfer",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationRequired, Field: fieldName, Message: "name is required"},
+					{Code: violationInvalid, Field: fieldColor, Message: "color must be a hex color such as #FF9800"},
+					{Code: violationInvalid, Field: fieldType, Message: "type must be either expense or income"},
+				},
+			},
+		},
+		{
+			name: "when_parent_is_a_subcategory_then_return_error",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Name:     "Latte",
+				ParentID: 2,
+				Type:     entity.TransactionTypeExpense,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category must be a top level category"},
+				},
+			},
+		},
+		{
+			name: "when_parent_has_different_type_then_return_error",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Name:     "Snacks",
+				ParentID: 3,
+				Type:     entity.TransactionTypeExpense,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category must have the same type"},
+				},
+			},
+		},
+		{
+			name: "when_parent_is_archived_then_return_error",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Name:     "Snacks",
+				ParentID: 5,
+				Type:     entity.TransactionTypeExpense,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category is archived"},
+				},
+			},
+		},
+		{
+			name: "when_parent_not_exist_then_return_error",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Name:     "Snacks",
+				ParentID: 99,
+				Type:     entity.TransactionTypeExpense,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category doesn't exist"},
+				},
+			},
+		},
+		{
+			name:  "when_CreateCategory_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().CreateCategory(ctx, mockSvcParam).Return(category.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_top_level_category_created_then_skip_fetching_categories",
+			ctx:  ctx,
+			param: CreateCategoryParam{
+				Name: "Gift",
+				Type: entity.TransactionTypeIncome,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().CreateCategory(ctx, category.CreateCategoryParam{
+					Name:   "Gift",
+					Type:   entity.TransactionTypeIncome,
+					UserID: 123,
+				}).Return(category.Category{
+					CreatedAt: mockTime,
+					ID:        6,
+					Name:      "Gift",
+					Type:      entity.TransactionTypeIncome,
+					UserID:    123,
+				}, nil)
+			},
+			want: Category{
+				CreatedAt: mockTime,
+				ID:        6,
+				Name:      "Gift",
+				Type:      entity.TransactionTypeIncome,
+			},
+		},
+		{
+			name:  "when_no_error_occured_then_return_category",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().CreateCategory(ctx, mockSvcParam).Return(category.Category{
+					Color:     "#FF9800",
+					CreatedAt: mockTime,
+					Icon:      "local_cafe",
+					ID:        6,
+					Name:      "Snacks",
+					ParentID:  1,
+					Type:      entity.TransactionTypeExpense,
+					UserID:    123,
+				}, nil)
+			},
+			want: Category{
+				Color:     "#FF9800",
+				CreatedAt: mockTime,
+				Icon:      "local_cafe",
+				ID:        6,
+				Name:      "Snacks",
+				ParentID:  func() *int64 { id := int64(1); return &id }(),
+				Type:      entity.TransactionTypeExpense,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.CreateCategory(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_GetCategory(t *testing.T) {
+	type mockFields struct {
+		categorySvc *MockcategoryServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_category_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(5)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name: "when_GetCategory_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(5)).Return(category.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_category",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(5)).Return(mockCategories[4], nil)
+			},
+			want: Category{
+				ArchivedAt: &mockTime,
+				ID:         5,
+				Name:       "Old",
+				Type:       entity.TransactionTypeExpense,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.GetCategory(test.ctx, 5)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ListCategories(t *testing.T) {
+	type mockFields struct {
+		categorySvc *MockcategoryServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	parentID := int64(1)
+	active := []Category{
+		{Color: "#FF9800", ID: 1, Name: "Food", Type: entity.TransactionTypeExpense},
+		{Color: "#FF9800", ID: 2, Name: "Coffee", ParentID: &parentID, Type: entity.TransactionTypeExpense},
+		{Color: "#4CAF50", ID: 3, Name: "Salary", Type: entity.TransactionTypeIncome},
+		{Color: "#2196F3", ID: 4, Name: "Transport", Type: entity.TransactionTypeExpense},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      ListCategoriesParam
+		mockFields func(mockFields)
+		want       []Category
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListCategories_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_archived_not_included_then_leave_out_archived_categories",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			want: active,
+		},
+		{
+			name:  "when_archived_included_then_return_every_category",
+			ctx:   ctx,
+			param: ListCategoriesParam{IncludeArchived: true},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			want: append(append([]Category{}, active...), Category{
+				ArchivedAt: &mockTime,
+				ID:         5,
+				Name:       "Old",
+				Type:       entity.TransactionTypeExpense,
+			}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.ListCategories(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_MergeCategories(t *testing.T) {
+	type mockFields struct {
+		categorySvc *MockcategoryServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockSvcParam := category.MergeCategoriesParam{
+		SourceID: 1,
+		TargetID: 4,
+		UserID:   123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID int64
+		targetID   int64
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			categoryID: 1,
+			targetID:   4,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name:       "when_ListCategories_error_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_category_not_exist_then_return_error",
+			ctx:        ctx,
+			categoryID: 99,
+			targetID:   4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:       "when_target_is_the_same_category_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   1,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldTargetID, Message: "target category must be a different category"},
+				},
+			},
+		},
+		{
+			name:       "when_target_not_exist_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   99,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldTargetID, Message: "target category doesn't exist"},
+				},
+			},
+		},
+		{
+			name:       "when_target_has_different_type_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   3,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldTargetID, Message: "target category must have the same type"},
+				},
+			},
+		},
+		{
+			name:       "when_category_with_subcategories_merged_into_subcategory_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   2,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldTargetID, Message: "category with subcategories can only be merged into a top level category"},
+				},
+			},
+		},
+		{
+			name:       "when_MergeCategories_error_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().MergeCategories(ctx, mockSvcParam).Return(category.ErrCategoryNotFound)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:       "when_budgets_in_different_currencies_then_return_validation_error",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().MergeCategories(ctx, mockSvcParam).Return(category.ErrBudgetCurrencyMismatch)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldTargetID, Message: "target category must be budgeted in the same currency"},
+				},
+			},
+		},
+		{
+			name:       "when_no_error_occured_then_return_target_category",
+			ctx:        ctx,
+			categoryID: 1,
+			targetID:   4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().MergeCategories(ctx, mockSvcParam).Return(nil)
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(4)).Return(mockCategories[3], nil)
+			},
+			want: Category{
+				Color: "#2196F3",
+				ID:    4,
+				Name:  "Transport",
+				Type:  entity.TransactionTypeExpense,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.MergeCategories(test.ctx, test.categoryID, test.targetID)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_UpdateCategory(t *testing.T) {
+	type mockFields struct {
+		categorySvc *MockcategoryServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	archived := true
+	unarchived := false
+	name := " Transportation "
+	topLevel := int64(0)
+	underFood := int64(1)
+	underCoffee := int64(2)
+	underTransport := int64(4)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		categoryID int64
+		param      UpdateCategoryParam
+		mockFields func(mockFields)
+		want       Category
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			categoryID: 4,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name:       "when_ListCategories_error_then_return_error",
+			ctx:        ctx,
+			categoryID: 4,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:       "when_category_not_exist_then_return_error",
+			ctx:        ctx,
+			categoryID: 99,
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:       "when_moved_under_itself_then_return_error",
+			ctx:        ctx,
+			categoryID: 4,
+			param:      UpdateCategoryParam{ParentID: &underTransport},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "category can't be its own parent"},
+				},
+			},
+		},
+		{
+			name:       "when_moved_under_subcategory_then_return_error",
+			ctx:        ctx,
+			categoryID: 4,
+			param:      UpdateCategoryParam{ParentID: &underCoffee},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category must be a top level category"},
+				},
+			},
+		},
+		{
+			name:       "when_category_with_subcategories_moved_then_return_error",
+			ctx:        ctx,
+			categoryID: 1,
+			param:      UpdateCategoryParam{ParentID: &underTransport},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "category with subcategories can't be placed under another category"},
+				},
+			},
+		},
+		{
+			name:       "when_unarchived_under_archived_parent_then_return_error",
+			ctx:        ctx,
+			categoryID: 2,
+			param:      UpdateCategoryParam{Archived: &unarchived},
+			mockFields: func(mf mockFields) {
+				archivedFood := append([]category.Category{}, mockCategories...)
+				archivedFood[0].ArchivedAt = mockTime
+				archivedFood[1].ArchivedAt = mockTime
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(archivedFood, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldParentID, Message: "parent category is archived"},
+				},
+			},
+		},
+		{
+			name:       "when_unarchived_and_moved_to_top_level_then_return_updated_category",
+			ctx:        ctx,
+			categoryID: 2,
+			param:      UpdateCategoryParam{Archived: &unarchived, ParentID: &topLevel},
+			mockFields: func(mf mockFields) {
+				archivedFood := append([]category.Category{}, mockCategories...)
+				archivedFood[0].ArchivedAt = mockTime
+				archivedFood[1].ArchivedAt = mockTime
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(archivedFood, nil)
+				mf.categorySvc.EXPECT().UpdateCategory(ctx, category.UpdateCategoryParam{
+					Color:  "#FF9800",
+					ID:     2,
+					Name:   "Coffee",
+					UserID: 123,
+				}).Return(nil)
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(2)).Return(category.Category{
+					Color:  "#FF9800",
+					ID:     2,
+					Name:   "Coffee",
+					Type:   entity.TransactionTypeExpense,
+					UserID: 123,
+				}, nil)
+			},
+			want: Category{
+				Color: "#FF9800",
+				ID:    2,
+				Name:  "Coffee",
+				Type:  entity.TransactionTypeExpense,
+			},
+		},
+		{
+			name:       "when_UpdateCategory_error_then_return_error",
+			ctx:        ctx,
+			categoryID: 4,
+			param:      UpdateCategoryParam{Name: &name},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().UpdateCategory(ctx, category.UpdateCategoryParam{
+					Color:  "#2196F3",
+					ID:     4,
+					Name:   "Transportation",
+					UserID: 123,
+				}).Return(category.ErrCategoryNotFound)
+			},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:       "when_no_error_occured_then_return_updated_category",
+			ctx:        ctx,
+			categoryID: 4,
+			param:      UpdateCategoryParam{Archived: &archived, Name: &name, ParentID: &underFood},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().ListCategories(ctx, int64(123)).Return(mockCategories, nil)
+				mf.categorySvc.EXPECT().UpdateCategory(ctx, category.UpdateCategoryParam{
+					Archived: true,
+					Color:    "#2196F3",
+					ID:       4,
+					Name:     "Transportation",
+					ParentID: 1,
+					UserID:   123,
+				}).Return(nil)
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(4)).Return(category.Category{
+					ArchivedAt: mockTime,
+					Color:      "#2196F3",
+					ID:         4,
+					Name:       "Transportation",
+					ParentID:   1,
+					Type:       entity.TransactionTypeExpense,
+					UserID:     123,
+				}, nil)
+			},
+			want: Category{
+				ArchivedAt: &mockTime,
+				Color:      "#2196F3",
+				ID:         4,
+				Name:       "Transportation",
+				ParentID:   &underFood,
+				Type:       entity.TransactionTypeExpense,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc: NewMockcategoryServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category: mockFields.categorySvc,
+			}
+
+			got, err := uc.UpdateCategory(test.ctx, test.categoryID, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
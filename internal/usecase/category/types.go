@@ -0,0 +1,75 @@
+package category
+
+import (
+	// golang package
+	"time"
+)
+
+// ----------------
+// | Error Struct |
+// ----------------
+
+// ValidationError is returned when fields of a request aren't valid.
+// Fields holds every problem found, so all of them can be shown at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns a summary of the invalid fields.
+func (e *ValidationError) Error() string {
+	return "request not valid"
+}
+
+// FieldError describes why a field of a request isn't valid.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// -------------------
+// | Response Struct |
+// -------------------
+
+// Category holds information about a group of transactions of the same type.
+// ParentID is empty for a top level category. ArchivedAt is empty unless the category is archived.
+type Category struct {
+	ArchivedAt *time.Time `json:"archived_at"`
+	Color      string     `json:"color"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Icon       string     `json:"icon"`
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	ParentID   *int64     `json:"parent_id"`
+	Type       string     `json:"type"`
+}
+
+// --------------------
+// | Parameter Struct |
+// --------------------
+
+// CreateCategoryParam represents parameter needed to create a category.
+// A zero ParentID means the category is a top level category.
+type CreateCategoryParam struct {
+	Color    string
+	Icon     string
+	Name     string
+	ParentID int64
+	Type     string
+}
+
+// ListCategoriesParam represents parameter needed to list categories of a user.
+// Archived categories are left out unless IncludeArchived is true.
+type ListCategoriesParam struct {
+	IncludeArchived bool
+}
+
+// UpdateCategoryParam represents parameter needed to update a category.
+// A nil field keeps its current value, and a zero ParentID moves the category to the top level.
+type UpdateCategoryParam struct {
+	Archived *bool
+	Color    *string
+	Icon     *string
+	Name     *string
+	ParentID *int64
+}
@@ -0,0 +1,51 @@
+package category
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/category"
+)
+
+//go:generate mockgen -source=usecase.go -destination=usecase_mock.go -package=category
+
+// categoryServiceProvider holds all methods from category service that wil be used in category's usecase.
+type categoryServiceProvider interface {
+	// CreateCategory will create a new category for a user and return it.
+	CreateCategory(ctx context.Context, param category.CreateCategoryParam) (category.Category, error)
+
+	// GetCategory will fetch a category of a user.
+	// If the user doesn't have the category, it will return ErrCategoryNotFound.
+	GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error)
+
+	// ListCategories will fetch every category of a user, ordered by their type and name.
+	ListCategories(ctx context.Context, userID int64) ([]category.Category, error)
+
+	// MergeCategories will move every transaction, subcategory and budget of the source category
+	// into the target category, then delete the source category.
+	// If the user doesn't have the source category, it will return ErrCategoryNotFound.
+	// If both categories are budgeted in a period in different currencies, it will return ErrBudgetCurrencyMismatch.
+	MergeCategories(ctx context.Context, param category.MergeCategoriesParam) error
+
+	// UpdateCategory will update a category of a user.
+	// If the user doesn't have the category, it will return ErrCategoryNotFound.
+	UpdateCategory(ctx context.Context, param category.UpdateCategoryParam) error
+}
+
+// CategoryUsecaseParam holds all parameters needed to instantiate
+// a new instance of Usecase.
+type CategoryUsecaseParam struct {
+	Category categoryServiceProvider
+}
+
+type UseCase struct {
+	category categoryServiceProvider
+}
+
+// NewUseCase will instantiate a new instance of UseCase.
+func NewUseCase(param CategoryUsecaseParam) *UseCase {
+	return &UseCase{
+		category: param.Category,
+	}
+}
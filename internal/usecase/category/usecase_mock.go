@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package category is a generated GoMock package.
+package category
+
+import (
+	context "context"
+	reflect "reflect"
+
+	category "github.com/arifinhermawan/bubi/internal/service/category"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcategoryServiceProvider is a mock of categoryServiceProvider interface.
+type MockcategoryServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockcategoryServiceProviderMockRecorder
+}
+
+// MockcategoryServiceProviderMockRecorder is the mock recorder for MockcategoryServiceProvider.
+type MockcategoryServiceProviderMockRecorder struct {
+	mock *MockcategoryServiceProvider
+}
+
+// NewMockcategoryServiceProvider creates a new mock instance.
+func NewMockcategoryServiceProvider(ctrl *gomock.Controller) *MockcategoryServiceProvider {
+	mock := &MockcategoryServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockcategoryServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcategoryServiceProvider) EXPECT() *MockcategoryServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockcategoryServiceProvider) CreateCategory(ctx context.Context, param category.CreateCategoryParam) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, param)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockcategoryServiceProviderMockRecorder) CreateCategory(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockcategoryServiceProvider)(nil).CreateCategory), ctx, param)
+}
+
+// GetCategory mocks base method.
+func (m *MockcategoryServiceProvider) GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, userID, categoryID)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockcategoryServiceProviderMockRecorder) GetCategory(ctx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockcategoryServiceProvider)(nil).GetCategory), ctx, userID, categoryID)
+}
+
+// ListCategories mocks base method.
+func (m *MockcategoryServiceProvider) ListCategories(ctx context.Context, userID int64) ([]category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, userID)
+	ret0, _ := ret[0].([]category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockcategoryServiceProviderMockRecorder) ListCategories(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockcategoryServiceProvider)(nil).ListCategories), ctx, userID)
+}
+
+// MergeCategories mocks base method.
+func (m *MockcategoryServiceProvider) MergeCategories(ctx context.Context, param category.MergeCategoriesParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategories", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockcategoryServiceProviderMockRecorder) MergeCategories(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockcategoryServiceProvider)(nil).MergeCategories), ctx, param)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockcategoryServiceProvider) UpdateCategory(ctx context.Context, param category.UpdateCategoryParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockcategoryServiceProviderMockRecorder) UpdateCategory(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockcategoryServiceProvider)(nil).UpdateCategory), ctx, param)
+}
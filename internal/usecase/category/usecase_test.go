@@ -0,0 +1,20 @@
+package category
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCategorySvc := NewMockcategoryServiceProvider(ctrl)
+
+	want := &UseCase{
+		category: mockCategorySvc,
+	}
+	assert.Equal(t, want, NewUseCase(CategoryUsecaseParam{Category: mockCategorySvc}))
+}
@@ -0,0 +1,42 @@
+package period
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"log"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+var (
+	errUnauthorized = errors.New("unauthorized!")
+)
+
+// GetPeriod will return the period of the user acting on ctx that is offset periods away from the current one.
+// Offset 0 is the current period, -1 is the previous one and so on.
+func (uc *UseCase) GetPeriod(ctx context.Context, offset int) (Period, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetPeriod] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Period{}, errUnauthorized
+	}
+
+	result, err := uc.account.GetPeriod(ctx, principal.UserID, offset)
+	if err != nil {
+		meta := map[string]interface{}{
+			"offset":  offset,
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[GetPeriod] uc.account.GetPeriod() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Period{}, err
+	}
+
+	return Period{
+		End:    result.End,
+		Offset: offset,
+		Start:  result.Start,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package period
+
+import (
+	// golang package
+	"time"
+)
+
+// -------------------
+// | Response Struct |
+// -------------------
+
+// Period holds a payday-to-payday cycle of user.
+// Start is inclusive and End is exclusive, Offset tells how many periods away it is from the current one.
+type Period struct {
+	End    time.Time `json:"end"`
+	Offset int       `json:"offset"`
+	Start  time.Time `json:"start"`
+}
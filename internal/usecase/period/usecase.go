@@ -0,0 +1,35 @@
+package period
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+)
+
+//go:generate mockgen -source=usecase.go -destination=usecase_mock.go -package=period
+
+// accountServiceProvider holds all methods from account service that wil be used in period's usecase.
+type accountServiceProvider interface {
+	// GetPeriod will return the period of a user that is offset periods away from the current one.
+	// The period starts on user's record period start day and is counted in user's timezone.
+	GetPeriod(ctx context.Context, userID int64, offset int) (entity.Period, error)
+}
+
+// PeriodUsecaseParam holds all parameters needed to instantiate
+// a new instance of Usecase.
+type PeriodUsecaseParam struct {
+	Account accountServiceProvider
+}
+
+type UseCase struct {
+	account accountServiceProvider
+}
+
+// NewUseCase will instantiate a new instance of UseCase.
+func NewUseCase(param PeriodUsecaseParam) *UseCase {
+	return &UseCase{
+		account: param.Account,
+	}
+}
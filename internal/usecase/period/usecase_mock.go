@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package period is a generated GoMock package.
+package period
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/arifinhermawan/bubi/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockaccountServiceProvider is a mock of accountServiceProvider interface.
+type MockaccountServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockaccountServiceProviderMockRecorder
+}
+
+// MockaccountServiceProviderMockRecorder is the mock recorder for MockaccountServiceProvider.
+type MockaccountServiceProviderMockRecorder struct {
+	mock *MockaccountServiceProvider
+}
+
+// NewMockaccountServiceProvider creates a new mock instance.
+func NewMockaccountServiceProvider(ctrl *gomock.Controller) *MockaccountServiceProvider {
+	mock := &MockaccountServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockaccountServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaccountServiceProvider) EXPECT() *MockaccountServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetPeriod mocks base method.
+func (m *MockaccountServiceProvider) GetPeriod(ctx context.Context, userID int64, offset int) (entity.Period, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeriod", ctx, userID, offset)
+	ret0, _ := ret[0].(entity.Period)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeriod indicates an expected call of GetPeriod.
+func (mr *MockaccountServiceProviderMockRecorder) GetPeriod(ctx, userID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeriod", reflect.TypeOf((*MockaccountServiceProvider)(nil).GetPeriod), ctx, userID, offset)
+}
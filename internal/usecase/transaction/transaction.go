@@ -0,0 +1,482 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+)
+
+const (
+	fieldAmount       = "amount"
+	fieldCategoryID   = "category_id"
+	fieldNote         = "note"
+	fieldPayee        = "payee"
+	fieldTags         = "tags"
+	fieldTransactedAt = "transacted_at"
+	fieldType         = "type"
+	fieldWalletID     = "wallet_id"
+
+	violationInvalid  = "invalid"
+	violationRequired = "required"
+	violationTooLong  = "too_long"
+	violationTooMany  = "too_many"
+
+	defaultListTransactionsLimit = 20
+	maxListTransactionsLimit     = 100
+
+	maxNoteLength   = 500
+	maxPayeeLength  = 100
+	maxTagLength    = 30
+	maxTagsPerEntry = 10
+)
+
+var (
+	// ErrTransactionNotFound is returned when the user doesn't have the requested transaction.
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	errUnauthorized = errors.New("unauthorized!")
+)
+
+// CreateTransaction will record a new transaction for the user acting on ctx
+// and adjust the balance of its wallet. Fields that aren't valid, including
+// a wallet the user doesn't have, are refused with ValidationError.
+func (uc *UseCase) CreateTransaction(ctx context.Context, param CreateTransactionParam) (Transaction, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[CreateTransaction] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Transaction{}, errUnauthorized
+	}
+
+	svcParam := transaction.CreateTransactionParam{
+		Amount:       param.Amount,
+		CategoryID:   param.CategoryID,
+		Note:         strings.TrimSpace(param.Note),
+		Payee:        strings.TrimSpace(param.Payee),
+		Tags:         normalizeTags(param.Tags),
+		TransactedAt: param.TransactedAt,
+		Type:         strings.TrimSpace(param.Type),
+		UserID:       principal.UserID,
+		WalletID:     param.WalletID,
+	}
+
+	meta := map[string]interface{}{
+		"user_id":   principal.UserID,
+		"wallet_id": param.WalletID,
+	}
+
+	err := validateTransaction(transaction.UpdateTransactionParam{
+		Amount:       svcParam.Amount,
+		CategoryID:   svcParam.CategoryID,
+		Note:         svcParam.Note,
+		Payee:        svcParam.Payee,
+		Tags:         svcParam.Tags,
+		TransactedAt: svcParam.TransactedAt,
+		Type:         svcParam.Type,
+		WalletID:     svcParam.WalletID,
+	})
+	if err != nil {
+		log.Printf("[CreateTransaction] validateTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	err = uc.validateCategory(ctx, principal.UserID, svcParam.CategoryID, svcParam.Type)
+	if err != nil {
+		log.Printf("[CreateTransaction] uc.validateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	created, err := uc.transaction.CreateTransaction(ctx, svcParam)
+	if err != nil {
+		log.Printf("[CreateTransaction] uc.transaction.CreateTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, transaction.ErrWalletNotFound) {
+			return Transaction{}, walletNotFoundError()
+		}
+
+		return Transaction{}, err
+	}
+
+	return convertTransaction(created), nil
+}
+
+// DeleteTransaction will delete a transaction of the user acting on ctx
+// and take it out of the balance of its wallet.
+// A user can only delete their own transaction.
+func (uc *UseCase) DeleteTransaction(ctx context.Context, transactionID int64) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[DeleteTransaction] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	err := uc.transaction.DeleteTransaction(ctx, principal.UserID, transactionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"transaction_id": transactionID,
+			"user_id":        principal.UserID,
+		}
+
+		log.Printf("[DeleteTransaction] uc.transaction.DeleteTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			return ErrTransactionNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetTransaction will fetch a transaction of the user acting on ctx.
+func (uc *UseCase) GetTransaction(ctx context.Context, transactionID int64) (Transaction, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetTransaction] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Transaction{}, errUnauthorized
+	}
+
+	result, err := uc.transaction.GetTransaction(ctx, principal.UserID, transactionID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"transaction_id": transactionID,
+			"user_id":        principal.UserID,
+		}
+
+		log.Printf("[GetTransaction] uc.transaction.GetTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			return Transaction{}, ErrTransactionNotFound
+		}
+
+		return Transaction{}, err
+	}
+
+	return convertTransaction(result), nil
+}
+
+// ListTransactions will fetch transactions of the user acting on ctx, ordered from the most recent transaction.
+// Only transactions of param.WalletID are listed when it's given. Limit is capped to keep a page small.
+func (uc *UseCase) ListTransactions(ctx context.Context, param ListTransactionsParam) ([]Transaction, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListTransactions] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	limit := param.Limit
+	if limit <= 0 {
+		limit = defaultListTransactionsLimit
+	}
+
+	if limit > maxListTransactionsLimit {
+		limit = maxListTransactionsLimit
+	}
+
+	svcParam := transaction.ListTransactionsParam{
+		Limit:    limit,
+		Offset:   param.Offset,
+		UserID:   principal.UserID,
+		WalletID: param.WalletID,
+	}
+
+	transactions, err := uc.transaction.ListTransactions(ctx, svcParam)
+	if err != nil {
+		log.Printf("[ListTransactions] uc.transaction.ListTransactions() got an error: %+v\nMeta:%+v\n", err, svcParam)
+		return nil, err
+	}
+
+	result := make([]Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		result = append(result, convertTransaction(t))
+	}
+
+	return result, nil
+}
+
+// UpdateTransaction will update a transaction of the user acting on ctx and return the updated transaction.
+// The balance of its wallet is adjusted by the difference, or moved when the wallet is changed.
+// Only fields given in param are changed. Fields that aren't valid are refused with ValidationError.
+func (uc *UseCase) UpdateTransaction(ctx context.Context, transactionID int64, param UpdateTransactionParam) (Transaction, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[UpdateTransaction] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Transaction{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"transaction_id": transactionID,
+		"user_id":        principal.UserID,
+	}
+
+	current, err := uc.transaction.GetTransaction(ctx, principal.UserID, transactionID)
+	if err != nil {
+		log.Printf("[UpdateTransaction] uc.transaction.GetTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			return Transaction{}, ErrTransactionNotFound
+		}
+
+		return Transaction{}, err
+	}
+
+	svcParam := transaction.UpdateTransactionParam{
+		Amount:       current.Amount,
+		CategoryID:   current.CategoryID,
+		ID:           current.ID,
+		Note:         current.Note,
+		Payee:        current.Payee,
+		Tags:         current.Tags,
+		TransactedAt: current.TransactedAt,
+		Type:         current.Type,
+		UserID:       principal.UserID,
+		WalletID:     current.WalletID,
+	}
+
+	if param.Amount != nil {
+		svcParam.Amount = *param.Amount
+	}
+
+	if param.CategoryID != nil {
+		svcParam.CategoryID = *param.CategoryID
+	}
+
+	if param.Note != nil {
+		svcParam.Note = strings.TrimSpace(*param.Note)
+	}
+
+	if param.Payee != nil {
+		svcParam.Payee = strings.TrimSpace(*param.Payee)
+	}
+
+	if param.Tags != nil {
+		svcParam.Tags = normalizeTags(*param.Tags)
+	}
+
+	if param.TransactedAt != nil {
+		svcParam.TransactedAt = *param.TransactedAt
+	}
+
+	if param.Type != nil {
+		svcParam.Type = strings.TrimSpace(*param.Type)
+	}
+
+	if param.WalletID != nil {
+		svcParam.WalletID = *param.WalletID
+	}
+
+	err = validateTransaction(svcParam)
+	if err != nil {
+		log.Printf("[UpdateTransaction] validateTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	err = uc.validateCategory(ctx, principal.UserID, svcParam.CategoryID, svcParam.Type)
+	if err != nil {
+		log.Printf("[UpdateTransaction] uc.validateCategory() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Transaction{}, err
+	}
+
+	err = uc.transaction.UpdateTransaction(ctx, svcParam)
+	if err != nil {
+		log.Printf("[UpdateTransaction] uc.transaction.UpdateTransaction() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, transaction.ErrTransactionNotFound) {
+			return Transaction{}, ErrTransactionNotFound
+		}
+
+		if errors.Is(err, transaction.ErrWalletNotFound) {
+			return Transaction{}, walletNotFoundError()
+		}
+
+		return Transaction{}, err
+	}
+
+	return uc.GetTransaction(ctx, transactionID)
+}
+
+// normalizeTags will trim every tag and leave out empty and repeated tags.
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// validateTransaction will check fields of a transaction that is about to be saved.
+// Every problem found is returned at once as a ValidationError.
+func validateTransaction(param transaction.UpdateTransactionParam) error {
+	var fields []FieldError
+	if param.Amount <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldAmount,
+			Message: "amount must be greater than zero",
+		})
+	}
+
+	if param.CategoryID < 0 {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldCategoryID,
+			Message: "category_id not valid",
+		})
+	}
+
+	if utf8.RuneCountInString(param.Note) > maxNoteLength {
+		fields = append(fields, FieldError{
+			Code:    violationTooLong,
+			Field:   fieldNote,
+			Message: fmt.Sprintf("note must be at most %d characters", maxNoteLength),
+		})
+	}
+
+	if utf8.RuneCountInString(param.Payee) > maxPayeeLength {
+		fields = append(fields, FieldError{
+			Code:    violationTooLong,
+			Field:   fieldPayee,
+			Message: fmt.Sprintf("payee must be at most %d characters", maxPayeeLength),
+		})
+	}
+
+	if len(param.Tags) > maxTagsPerEntry {
+		fields = append(fields, FieldError{
+			Code:    violationTooMany,
+			Field:   fieldTags,
+			Message: fmt.Sprintf("a transaction can have at most %d tags", maxTagsPerEntry),
+		})
+	}
+
+	for _, tag := range param.Tags {
+		// tags are saved as a comma separated list, so a tag can't hold a comma.
+		if strings.Contains(tag, ",") || utf8.RuneCountInString(tag) > maxTagLength {
+			fields = append(fields, FieldError{
+				Code:    violationInvalid,
+				Field:   fieldTags,
+				Message: fmt.Sprintf("every tag must be at most %d characters without a comma", maxTagLength),
+			})
+			break
+		}
+	}
+
+	if param.TransactedAt.IsZero() {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldTransactedAt,
+			Message: "transacted_at is required",
+		})
+	}
+
+	if !entity.IsTransactionType(param.Type) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldType,
+			Message: "type must be either expense or income",
+		})
+	}
+
+	if param.WalletID <= 0 {
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldWalletID,
+			Message: "wallet_id is required",
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// validateCategory will check whether a transaction of transactionType can be placed in a category of the user.
+// A transaction without category is always accepted, otherwise the category must exist
+// and have the same type as the transaction.
+func (uc *UseCase) validateCategory(ctx context.Context, userID, categoryID int64, transactionType string) error {
+	if categoryID == 0 {
+		return nil
+	}
+
+	result, err := uc.category.GetCategory(ctx, userID, categoryID)
+	if err != nil {
+		if errors.Is(err, category.ErrCategoryNotFound) {
+			return invalidCategoryError("category doesn't exist")
+		}
+
+		return err
+	}
+
+	if result.Type != transactionType {
+		return invalidCategoryError("category type must match the transaction type")
+	}
+
+	return nil
+}
+
+// invalidCategoryError will describe a transaction placed in a category it can't be placed in.
+func invalidCategoryError(message string) error {
+	return &ValidationError{
+		Fields: []FieldError{
+			{
+				Code:    violationInvalid,
+				Field:   fieldCategoryID,
+				Message: message,
+			},
+		},
+	}
+}
+
+// walletNotFoundError will describe a transaction recorded on a wallet the user doesn't have.
+func walletNotFoundError() error {
+	return &ValidationError{
+		Fields: []FieldError{
+			{
+				Code:    violationInvalid,
+				Field:   fieldWalletID,
+				Message: "wallet doesn't exist",
+			},
+		},
+	}
+}
+
+// convertTransaction will convert a transaction from transaction service into its response format.
+func convertTransaction(t transaction.Transaction) Transaction {
+	result := Transaction{
+		Amount:       t.Amount,
+		CreatedAt:    t.CreatedAt,
+		ID:           t.ID,
+		Note:         t.Note,
+		Payee:        t.Payee,
+		Tags:         t.Tags,
+		TransactedAt: t.TransactedAt,
+		Type:         t.Type,
+		WalletID:     t.WalletID,
+	}
+
+	if t.CategoryID != 0 {
+		categoryID := t.CategoryID
+		result.CategoryID = &categoryID
+	}
+
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+
+	return result
+}
@@ -0,0 +1,644 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+)
+
+func TestUseCase_CreateTransaction(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		categorySvc    *MockcategoryServiceProvider
+		transactionSvc *MocktransactionServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockParam := CreateTransactionParam{
+		Amount:       25000,
+		Note:         " lunch ",
+		Payee:        " Warung ",
+		Tags:         []string{" food ", "", "food", "work"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		WalletID:     1,
+	}
+	mockSvcParam := transaction.CreateTransactionParam{
+		Amount:       25000,
+		Note:         "lunch",
+		Payee:        "Warung",
+		Tags:         []string{"food", "work"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     1,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      CreateTransactionParam
+		mockFields func(mockFields)
+		want       Transaction
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_fields_invalid_then_return_every_problem",
+			ctx:  ctx,
+			param: CreateTransactionParam{
+				CategoryID: -1,
+				Tags:       []string{"a,b"},
+				Type:       "transfer",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldAmount, Message: "amount must be greater than zero"},
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "category_id not valid"},
+					{Code: violationInvalid, Field: fieldTags, Message: "every tag must be at most 30 characters without a comma"},
+					{Code: violationRequired, Field: fieldTransactedAt, Message: "transacted_at is required"},
+					{Code: violationInvalid, Field: fieldType, Message: "type must be either expense or income"},
+					{Code: violationRequired, Field: fieldWalletID, Message: "wallet_id is required"},
+				},
+			},
+		},
+		{
+			name: "when_category_not_exist_then_return_validation_error",
+			ctx:  ctx,
+			param: CreateTransactionParam{
+				Amount:       25000,
+				CategoryID:   7,
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				WalletID:     1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(7)).Return(category.Category{}, category.ErrCategoryNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "category doesn't exist"},
+				},
+			},
+		},
+		{
+			name: "when_GetCategory_error_then_return_error",
+			ctx:  ctx,
+			param: CreateTransactionParam{
+				Amount:       25000,
+				CategoryID:   7,
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				WalletID:     1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(7)).Return(category.Category{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_category_type_not_match_then_return_validation_error",
+			ctx:  ctx,
+			param: CreateTransactionParam{
+				Amount:       25000,
+				CategoryID:   7,
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				WalletID:     1,
+			},
+			mockFields: func(mf mockFields) {
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(7)).Return(category.Category{
+					ID:   7,
+					Type: entity.TransactionTypeIncome,
+				}, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "category type must match the transaction type"},
+				},
+			},
+		},
+		{
+			name:  "when_wallet_not_exist_then_return_validation_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().CreateTransaction(ctx, mockSvcParam).Return(transaction.Transaction{}, transaction.ErrWalletNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldWalletID, Message: "wallet doesn't exist"},
+				},
+			},
+		},
+		{
+			name:  "when_CreateTransaction_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().CreateTransaction(ctx, mockSvcParam).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_transaction",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().CreateTransaction(ctx, mockSvcParam).Return(transaction.Transaction{
+					Amount:       25000,
+					CreatedAt:    mockTime,
+					ID:           1,
+					Note:         "lunch",
+					Payee:        "Warung",
+					Tags:         []string{"food", "work"},
+					TransactedAt: mockTime,
+					Type:         entity.TransactionTypeExpense,
+					UserID:       123,
+					WalletID:     1,
+				}, nil)
+			},
+			want: Transaction{
+				Amount:       25000,
+				CreatedAt:    mockTime,
+				ID:           1,
+				Note:         "lunch",
+				Payee:        "Warung",
+				Tags:         []string{"food", "work"},
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				WalletID:     1,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc:    NewMockcategoryServiceProvider(ctrl),
+				transactionSvc: NewMocktransactionServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category:    mockFields.categorySvc,
+				transaction: mockFields.transactionSvc,
+			}
+
+			got, err := uc.CreateTransaction(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_DeleteTransaction(t *testing.T) {
+	type mockFields struct {
+		transactionSvc *MocktransactionServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_transaction_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().DeleteTransaction(ctx, int64(123), int64(1)).Return(transaction.ErrTransactionNotFound)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_DeleteTransaction_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().DeleteTransaction(ctx, int64(123), int64(1)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().DeleteTransaction(ctx, int64(123), int64(1)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionSvc: NewMocktransactionServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				transaction: mockFields.transactionSvc,
+			}
+
+			err := uc.DeleteTransaction(test.ctx, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_GetTransaction(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		transactionSvc *MocktransactionServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	categoryID := int64(7)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       Transaction
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_transaction_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{}, transaction.ErrTransactionNotFound)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_GetTransaction_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_transaction",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{
+					Amount:       5000000,
+					CategoryID:   7,
+					CreatedAt:    mockTime,
+					ID:           1,
+					TransactedAt: mockTime,
+					Type:         entity.TransactionTypeIncome,
+					UserID:       123,
+					WalletID:     1,
+				}, nil)
+			},
+			want: Transaction{
+				Amount:       5000000,
+				CategoryID:   &categoryID,
+				CreatedAt:    mockTime,
+				ID:           1,
+				Tags:         []string{},
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeIncome,
+				WalletID:     1,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionSvc: NewMocktransactionServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				transaction: mockFields.transactionSvc,
+			}
+
+			got, err := uc.GetTransaction(test.ctx, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ListTransactions(t *testing.T) {
+	type mockFields struct {
+		transactionSvc *MocktransactionServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      ListTransactionsParam
+		mockFields func(mockFields)
+		want       []Transaction
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListTransactions_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().ListTransactions(ctx, transaction.ListTransactionsParam{
+					Limit:  defaultListTransactionsLimit,
+					UserID: 123,
+				}).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_limit_too_big_then_cap_the_limit",
+			ctx:   ctx,
+			param: ListTransactionsParam{Limit: 1000, Offset: 100, WalletID: 1},
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().ListTransactions(ctx, transaction.ListTransactionsParam{
+					Limit:    maxListTransactionsLimit,
+					Offset:   100,
+					UserID:   123,
+					WalletID: 1,
+				}).Return(nil, nil)
+			},
+			want: []Transaction{},
+		},
+		{
+			name: "when_no_error_occured_then_return_transactions",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().ListTransactions(ctx, transaction.ListTransactionsParam{
+					Limit:  defaultListTransactionsLimit,
+					UserID: 123,
+				}).Return([]transaction.Transaction{
+					{ID: 2, Tags: []string{"food"}},
+					{ID: 1},
+				}, nil)
+			},
+			want: []Transaction{
+				{ID: 2, Tags: []string{"food"}},
+				{ID: 1, Tags: []string{}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				transactionSvc: NewMocktransactionServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				transaction: mockFields.transactionSvc,
+			}
+
+			got, err := uc.ListTransactions(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_UpdateTransaction(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		categorySvc    *MockcategoryServiceProvider
+		transactionSvc *MocktransactionServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockCurrent := transaction.Transaction{
+		Amount:       25000,
+		CategoryID:   7,
+		CreatedAt:    mockTime,
+		ID:           1,
+		Payee:        "Warung",
+		Tags:         []string{"food"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     1,
+	}
+	amount := int64(30000)
+	income := entity.TransactionTypeIncome
+	invalidAmount := int64(0)
+	noCategory := int64(0)
+	walletID := int64(2)
+	mockSvcParam := transaction.UpdateTransactionParam{
+		Amount:       30000,
+		ID:           1,
+		Payee:        "Warung",
+		Tags:         []string{"food"},
+		TransactedAt: mockTime,
+		Type:         entity.TransactionTypeExpense,
+		UserID:       123,
+		WalletID:     2,
+	}
+	mockParam := UpdateTransactionParam{
+		Amount:     &amount,
+		CategoryID: &noCategory,
+		WalletID:   &walletID,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      UpdateTransactionParam
+		mockFields func(mockFields)
+		want       Transaction
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_transaction_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{}, transaction.ErrTransactionNotFound)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name: "when_GetTransaction_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_fields_invalid_then_return_error",
+			ctx:   ctx,
+			param: UpdateTransactionParam{Amount: &invalidAmount},
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldAmount, Message: "amount must be greater than zero"},
+				},
+			},
+		},
+		{
+			name:  "when_type_no_longer_match_category_then_return_validation_error",
+			ctx:   ctx,
+			param: UpdateTransactionParam{Type: &income},
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.categorySvc.EXPECT().GetCategory(ctx, int64(123), int64(7)).Return(category.Category{
+					ID:   7,
+					Type: entity.TransactionTypeExpense,
+				}, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldCategoryID, Message: "category type must match the transaction type"},
+				},
+			},
+		},
+		{
+			name:  "when_UpdateTransaction_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.transactionSvc.EXPECT().UpdateTransaction(ctx, mockSvcParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_transaction_deleted_meanwhile_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.transactionSvc.EXPECT().UpdateTransaction(ctx, mockSvcParam).Return(transaction.ErrTransactionNotFound)
+			},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name:  "when_wallet_not_exist_then_return_validation_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.transactionSvc.EXPECT().UpdateTransaction(ctx, mockSvcParam).Return(transaction.ErrWalletNotFound)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldWalletID, Message: "wallet doesn't exist"},
+				},
+			},
+		},
+		{
+			name:  "when_no_error_occured_then_return_updated_transaction",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.transactionSvc.EXPECT().UpdateTransaction(ctx, mockSvcParam).Return(nil)
+				mf.transactionSvc.EXPECT().GetTransaction(ctx, int64(123), int64(1)).Return(transaction.Transaction{
+					Amount:       30000,
+					CreatedAt:    mockTime,
+					ID:           1,
+					Payee:        "Warung",
+					Tags:         []string{"food"},
+					TransactedAt: mockTime,
+					Type:         entity.TransactionTypeExpense,
+					UpdatedAt:    mockTime,
+					UserID:       123,
+					WalletID:     2,
+				}, nil)
+			},
+			want: Transaction{
+				Amount:       30000,
+				CreatedAt:    mockTime,
+				ID:           1,
+				Payee:        "Warung",
+				Tags:         []string{"food"},
+				TransactedAt: mockTime,
+				Type:         entity.TransactionTypeExpense,
+				WalletID:     2,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				categorySvc:    NewMockcategoryServiceProvider(ctrl),
+				transactionSvc: NewMocktransactionServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				category:    mockFields.categorySvc,
+				transaction: mockFields.transactionSvc,
+			}
+
+			got, err := uc.UpdateTransaction(test.ctx, 1, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package transaction
+
+import (
+	// golang package
+	"time"
+)
+
+// ----------------
+// | Error Struct |
+// ----------------
+
+// ValidationError is returned when fields of a request aren't valid.
+// Fields holds every problem found, so all of them can be shown at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns a summary of the invalid fields.
+func (e *ValidationError) Error() string {
+	return "request not valid"
+}
+
+// FieldError describes why a field of a request isn't valid.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// -------------------
+// | Response Struct |
+// -------------------
+
+// Transaction holds information about money that comes into or goes out of a wallet.
+// Amount is in minor unit of the wallet's currency. CategoryID is empty when the transaction isn't categorized.
+type Transaction struct {
+	Amount       int64     `json:"amount"`
+	CategoryID   *int64    `json:"category_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	ID           int64     `json:"id"`
+	Note         string    `json:"note"`
+	Payee        string    `json:"payee"`
+	Tags         []string  `json:"tags"`
+	TransactedAt time.Time `json:"transacted_at"`
+	Type         string    `json:"type"`
+	WalletID     int64     `json:"wallet_id"`
+}
+
+// --------------------
+// | Parameter Struct |
+// --------------------
+
+// CreateTransactionParam represents parameter needed to record a transaction.
+// A zero CategoryID means the transaction isn't categorized.
+type CreateTransactionParam struct {
+	Amount       int64
+	CategoryID   int64
+	Note         string
+	Payee        string
+	Tags         []string
+	TransactedAt time.Time
+	Type         string
+	WalletID     int64
+}
+
+// ListTransactionsParam represents parameter needed to list transactions of a user.
+// Transactions of every wallet are listed when WalletID is zero.
+type ListTransactionsParam struct {
+	Limit    int
+	Offset   int
+	WalletID int64
+}
+
+// UpdateTransactionParam represents parameter needed to update a transaction.
+// A nil field keeps its current value, and a zero CategoryID removes the category.
+type UpdateTransactionParam struct {
+	Amount       *int64
+	CategoryID   *int64
+	Note         *string
+	Payee        *string
+	Tags         *[]string
+	TransactedAt *time.Time
+	Type         *string
+	WalletID     *int64
+}
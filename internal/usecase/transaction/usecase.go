@@ -0,0 +1,62 @@
+package transaction
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/category"
+	"github.com/arifinhermawan/bubi/internal/service/transaction"
+)
+
+//go:generate mockgen -source=usecase.go -destination=usecase_mock.go -package=transaction
+
+// transactionServiceProvider holds all methods from transaction service that wil be used in transaction's usecase.
+type transactionServiceProvider interface {
+	// CreateTransaction will record a new transaction for a user and return it.
+	// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+	CreateTransaction(ctx context.Context, param transaction.CreateTransactionParam) (transaction.Transaction, error)
+
+	// DeleteTransaction will delete a transaction of a user and take it out of the balance of its wallet.
+	// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+	DeleteTransaction(ctx context.Context, userID, transactionID int64) error
+
+	// GetTransaction will fetch a transaction of a user.
+	// If the user doesn't have the transaction, it will return ErrTransactionNotFound.
+	GetTransaction(ctx context.Context, userID, transactionID int64) (transaction.Transaction, error)
+
+	// ListTransactions will fetch transactions of a user, ordered from the most recent transaction.
+	ListTransactions(ctx context.Context, param transaction.ListTransactionsParam) ([]transaction.Transaction, error)
+
+	// UpdateTransaction will update a transaction of a user.
+	// If the user doesn't have the transaction, it will return ErrTransactionNotFound,
+	// and if the user doesn't have the new wallet, it will return ErrWalletNotFound.
+	UpdateTransaction(ctx context.Context, param transaction.UpdateTransactionParam) error
+}
+
+// categoryServiceProvider holds all methods from category service that wil be used in transaction's usecase.
+type categoryServiceProvider interface {
+	// GetCategory will fetch a category of a user.
+	// If the user doesn't have the category, it will return ErrCategoryNotFound.
+	GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error)
+}
+
+// TransactionUsecaseParam holds all parameters needed to instantiate
+// a new instance of Usecase.
+type TransactionUsecaseParam struct {
+	Category    categoryServiceProvider
+	Transaction transactionServiceProvider
+}
+
+type UseCase struct {
+	category    categoryServiceProvider
+	transaction transactionServiceProvider
+}
+
+// NewUseCase will instantiate a new instance of UseCase.
+func NewUseCase(param TransactionUsecaseParam) *UseCase {
+	return &UseCase{
+		category:    param.Category,
+		transaction: param.Transaction,
+	}
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package transaction is a generated GoMock package.
+package transaction
+
+import (
+	context "context"
+	reflect "reflect"
+
+	category "github.com/arifinhermawan/bubi/internal/service/category"
+	transaction "github.com/arifinhermawan/bubi/internal/service/transaction"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocktransactionServiceProvider is a mock of transactionServiceProvider interface.
+type MocktransactionServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactionServiceProviderMockRecorder
+}
+
+// MocktransactionServiceProviderMockRecorder is the mock recorder for MocktransactionServiceProvider.
+type MocktransactionServiceProviderMockRecorder struct {
+	mock *MocktransactionServiceProvider
+}
+
+// NewMocktransactionServiceProvider creates a new mock instance.
+func NewMocktransactionServiceProvider(ctrl *gomock.Controller) *MocktransactionServiceProvider {
+	mock := &MocktransactionServiceProvider{ctrl: ctrl}
+	mock.recorder = &MocktransactionServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransactionServiceProvider) EXPECT() *MocktransactionServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateTransaction mocks base method.
+func (m *MocktransactionServiceProvider) CreateTransaction(ctx context.Context, param transaction.CreateTransactionParam) (transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, param)
+	ret0, _ := ret[0].(transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MocktransactionServiceProviderMockRecorder) CreateTransaction(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MocktransactionServiceProvider)(nil).CreateTransaction), ctx, param)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MocktransactionServiceProvider) DeleteTransaction(ctx context.Context, userID, transactionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, userID, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MocktransactionServiceProviderMockRecorder) DeleteTransaction(ctx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MocktransactionServiceProvider)(nil).DeleteTransaction), ctx, userID, transactionID)
+}
+
+// GetTransaction mocks base method.
+func (m *MocktransactionServiceProvider) GetTransaction(ctx context.Context, userID, transactionID int64) (transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, userID, transactionID)
+	ret0, _ := ret[0].(transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MocktransactionServiceProviderMockRecorder) GetTransaction(ctx, userID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MocktransactionServiceProvider)(nil).GetTransaction), ctx, userID, transactionID)
+}
+
+// ListTransactions mocks base method.
+func (m *MocktransactionServiceProvider) ListTransactions(ctx context.Context, param transaction.ListTransactionsParam) ([]transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, param)
+	ret0, _ := ret[0].([]transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MocktransactionServiceProviderMockRecorder) ListTransactions(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MocktransactionServiceProvider)(nil).ListTransactions), ctx, param)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MocktransactionServiceProvider) UpdateTransaction(ctx context.Context, param transaction.UpdateTransactionParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MocktransactionServiceProviderMockRecorder) UpdateTransaction(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MocktransactionServiceProvider)(nil).UpdateTransaction), ctx, param)
+}
+
+// MockcategoryServiceProvider is a mock of categoryServiceProvider interface.
+type MockcategoryServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockcategoryServiceProviderMockRecorder
+}
+
+// MockcategoryServiceProviderMockRecorder is the mock recorder for MockcategoryServiceProvider.
+type MockcategoryServiceProviderMockRecorder struct {
+	mock *MockcategoryServiceProvider
+}
+
+// NewMockcategoryServiceProvider creates a new mock instance.
+func NewMockcategoryServiceProvider(ctrl *gomock.Controller) *MockcategoryServiceProvider {
+	mock := &MockcategoryServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockcategoryServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcategoryServiceProvider) EXPECT() *MockcategoryServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetCategory mocks base method.
+func (m *MockcategoryServiceProvider) GetCategory(ctx context.Context, userID, categoryID int64) (category.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, userID, categoryID)
+	ret0, _ := ret[0].(category.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockcategoryServiceProviderMockRecorder) GetCategory(ctx, userID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockcategoryServiceProvider)(nil).GetCategory), ctx, userID, categoryID)
+}
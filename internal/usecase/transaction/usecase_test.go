@@ -0,0 +1,25 @@
+package transaction
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCategorySvc := NewMockcategoryServiceProvider(ctrl)
+	mockTransactionSvc := NewMocktransactionServiceProvider(ctrl)
+
+	want := &UseCase{
+		category:    mockCategorySvc,
+		transaction: mockTransactionSvc,
+	}
+	assert.Equal(t, want, NewUseCase(TransactionUsecaseParam{
+		Category:    mockCategorySvc,
+		Transaction: mockTransactionSvc,
+	}))
+}
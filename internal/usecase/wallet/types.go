@@ -0,0 +1,75 @@
+package wallet
+
+import (
+	// golang package
+	"time"
+)
+
+// ----------------
+// | Error Struct |
+// ----------------
+
+// ValidationError is returned when fields of a request aren't valid.
+// Fields holds every problem found, so all of them can be shown at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns a summary of the invalid fields.
+func (e *ValidationError) Error() string {
+	return "request not valid"
+}
+
+// FieldError describes why a field of a request isn't valid.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// -------------------
+// | Response Struct |
+// -------------------
+
+// Wallet holds information about a place where user keeps their money.
+// Balance and OpeningBalance are in minor unit of the currency. ArchivedAt is empty unless the wallet is archived.
+type Wallet struct {
+	ArchivedAt     *time.Time `json:"archived_at"`
+	Balance        int64      `json:"balance"`
+	CreatedAt      time.Time  `json:"created_at"`
+	Currency       string     `json:"currency"`
+	DisplayOrder   int        `json:"display_order"`
+	ID             int64      `json:"id"`
+	Name           string     `json:"name"`
+	OpeningBalance int64      `json:"opening_balance"`
+	Type           string     `json:"type"`
+}
+
+// --------------------
+// | Parameter Struct |
+// --------------------
+
+// CreateWalletParam represents parameter needed to create a wallet.
+type CreateWalletParam struct {
+	Currency       string
+	Name           string
+	OpeningBalance int64
+	Type           string
+}
+
+// ListWalletsParam represents parameter needed to list wallets of a user.
+// Archived wallets are left out unless IncludeArchived is true.
+type ListWalletsParam struct {
+	IncludeArchived bool
+}
+
+// UpdateWalletParam represents parameter needed to update a wallet.
+// A nil field keeps its current value.
+type UpdateWalletParam struct {
+	Archived       *bool
+	Currency       *string
+	DisplayOrder   *int
+	Name           *string
+	OpeningBalance *int64
+	Type           *string
+}
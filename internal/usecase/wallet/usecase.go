@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
+)
+
+//go:generate mockgen -source=usecase.go -destination=usecase_mock.go -package=wallet
+
+// walletServiceProvider holds all methods from wallet service that wil be used in wallet's usecase.
+type walletServiceProvider interface {
+	// CreateWallet will create a new wallet for a user and return it.
+	// The new wallet is placed after every other wallet of the user.
+	CreateWallet(ctx context.Context, param wallet.CreateWalletParam) (wallet.Wallet, error)
+
+	// DeleteWallet will delete a wallet of a user.
+	// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+	// A wallet with transactions is refused with ErrWalletHasTransactions.
+	DeleteWallet(ctx context.Context, userID, walletID int64) error
+
+	// GetWallet will fetch a wallet of a user.
+	// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+	GetWallet(ctx context.Context, userID, walletID int64) (wallet.Wallet, error)
+
+	// ListWallets will fetch every wallet of a user, ordered by their display order.
+	ListWallets(ctx context.Context, userID int64) ([]wallet.Wallet, error)
+
+	// UpdateWallet will update a wallet of a user.
+	// If the user doesn't have the wallet, it will return ErrWalletNotFound.
+	UpdateWallet(ctx context.Context, param wallet.UpdateWalletParam) error
+}
+
+// WalletUsecaseParam holds all parameters needed to instantiate
+// a new instance of Usecase.
+type WalletUsecaseParam struct {
+	Wallet walletServiceProvider
+}
+
+type UseCase struct {
+	wallet walletServiceProvider
+}
+
+// NewUseCase will instantiate a new instance of UseCase.
+func NewUseCase(param WalletUsecaseParam) *UseCase {
+	return &UseCase{
+		wallet: param.Wallet,
+	}
+}
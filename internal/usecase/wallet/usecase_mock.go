@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package wallet is a generated GoMock package.
+package wallet
+
+import (
+	context "context"
+	reflect "reflect"
+
+	wallet "github.com/arifinhermawan/bubi/internal/service/wallet"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockwalletServiceProvider is a mock of walletServiceProvider interface.
+type MockwalletServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockwalletServiceProviderMockRecorder
+}
+
+// MockwalletServiceProviderMockRecorder is the mock recorder for MockwalletServiceProvider.
+type MockwalletServiceProviderMockRecorder struct {
+	mock *MockwalletServiceProvider
+}
+
+// NewMockwalletServiceProvider creates a new mock instance.
+func NewMockwalletServiceProvider(ctrl *gomock.Controller) *MockwalletServiceProvider {
+	mock := &MockwalletServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockwalletServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwalletServiceProvider) EXPECT() *MockwalletServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockwalletServiceProvider) CreateWallet(ctx context.Context, param wallet.CreateWalletParam) (wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, param)
+	ret0, _ := ret[0].(wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockwalletServiceProviderMockRecorder) CreateWallet(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockwalletServiceProvider)(nil).CreateWallet), ctx, param)
+}
+
+// DeleteWallet mocks base method.
+func (m *MockwalletServiceProvider) DeleteWallet(ctx context.Context, userID, walletID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWallet", ctx, userID, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWallet indicates an expected call of DeleteWallet.
+func (mr *MockwalletServiceProviderMockRecorder) DeleteWallet(ctx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWallet", reflect.TypeOf((*MockwalletServiceProvider)(nil).DeleteWallet), ctx, userID, walletID)
+}
+
+// GetWallet mocks base method.
+func (m *MockwalletServiceProvider) GetWallet(ctx context.Context, userID, walletID int64) (wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, userID, walletID)
+	ret0, _ := ret[0].(wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockwalletServiceProviderMockRecorder) GetWallet(ctx, userID, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockwalletServiceProvider)(nil).GetWallet), ctx, userID, walletID)
+}
+
+// ListWallets mocks base method.
+func (m *MockwalletServiceProvider) ListWallets(ctx context.Context, userID int64) ([]wallet.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWallets", ctx, userID)
+	ret0, _ := ret[0].([]wallet.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWallets indicates an expected call of ListWallets.
+func (mr *MockwalletServiceProviderMockRecorder) ListWallets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWallets", reflect.TypeOf((*MockwalletServiceProvider)(nil).ListWallets), ctx, userID)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockwalletServiceProvider) UpdateWallet(ctx context.Context, param wallet.UpdateWalletParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockwalletServiceProviderMockRecorder) UpdateWallet(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockwalletServiceProvider)(nil).UpdateWallet), ctx, param)
+}
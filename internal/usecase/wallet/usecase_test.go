@@ -0,0 +1,20 @@
+package wallet
+
+import (
+	// golang package
+	"testing"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWalletSvc := NewMockwalletServiceProvider(ctrl)
+
+	want := &UseCase{
+		wallet: mockWalletSvc,
+	}
+	assert.Equal(t, want, NewUseCase(WalletUsecaseParam{Wallet: mockWalletSvc}))
+}
@@ -0,0 +1,322 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
+)
+
+const (
+	fieldCurrency     = "currency"
+	fieldDisplayOrder = "display_order"
+	fieldName         = "name"
+	fieldType         = "type"
+
+	violationInvalid  = "invalid"
+	violationRequired = "required"
+	violationTooLong  = "too_long"
+
+	maxWalletNameLength = 50
+)
+
+var (
+	// ErrWalletHasTransactions is returned when the wallet to be deleted still has transactions booked to it.
+	ErrWalletHasTransactions = errors.New("wallet has transactions, archive it instead")
+
+	// ErrWalletNotFound is returned when the user doesn't have the requested wallet.
+	ErrWalletNotFound = errors.New("wallet not found")
+
+	errUnauthorized = errors.New("unauthorized!")
+
+	// currencyPattern matches an ISO 4217 currency code.
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// CreateWallet will create a new wallet for the user acting on ctx.
+// The new wallet is placed after every other wallet of the user.
+// Fields that aren't valid are refused with ValidationError.
+func (uc *UseCase) CreateWallet(ctx context.Context, param CreateWalletParam) (Wallet, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[CreateWallet] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Wallet{}, errUnauthorized
+	}
+
+	svcParam := wallet.CreateWalletParam{
+		Currency:       strings.ToUpper(strings.TrimSpace(param.Currency)),
+		Name:           strings.TrimSpace(param.Name),
+		OpeningBalance: param.OpeningBalance,
+		Type:           strings.TrimSpace(param.Type),
+		UserID:         principal.UserID,
+	}
+
+	meta := map[string]interface{}{
+		"param":   svcParam,
+		"user_id": principal.UserID,
+	}
+
+	err := validateWallet(wallet.UpdateWalletParam{
+		Currency: svcParam.Currency,
+		Name:     svcParam.Name,
+		Type:     svcParam.Type,
+	})
+	if err != nil {
+		log.Printf("[CreateWallet] validateWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	created, err := uc.wallet.CreateWallet(ctx, svcParam)
+	if err != nil {
+		log.Printf("[CreateWallet] uc.wallet.CreateWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	return convertWallet(created), nil
+}
+
+// DeleteWallet will delete a wallet of the user acting on ctx.
+// A user can only delete their own wallet, and only while no transaction is booked to it.
+func (uc *UseCase) DeleteWallet(ctx context.Context, walletID int64) error {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[DeleteWallet] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return errUnauthorized
+	}
+
+	err := uc.wallet.DeleteWallet(ctx, principal.UserID, walletID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":   principal.UserID,
+			"wallet_id": walletID,
+		}
+
+		log.Printf("[DeleteWallet] uc.wallet.DeleteWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		switch {
+		case errors.Is(err, wallet.ErrWalletNotFound):
+			return ErrWalletNotFound
+		case errors.Is(err, wallet.ErrWalletHasTransactions):
+			return ErrWalletHasTransactions
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetWallet will fetch a wallet of the user acting on ctx.
+func (uc *UseCase) GetWallet(ctx context.Context, walletID int64) (Wallet, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[GetWallet] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Wallet{}, errUnauthorized
+	}
+
+	result, err := uc.wallet.GetWallet(ctx, principal.UserID, walletID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id":   principal.UserID,
+			"wallet_id": walletID,
+		}
+
+		log.Printf("[GetWallet] uc.wallet.GetWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, wallet.ErrWalletNotFound) {
+			return Wallet{}, ErrWalletNotFound
+		}
+
+		return Wallet{}, err
+	}
+
+	return convertWallet(result), nil
+}
+
+// ListWallets will fetch wallets of the user acting on ctx, ordered by their display order.
+// Archived wallets are left out unless param.IncludeArchived is true.
+func (uc *UseCase) ListWallets(ctx context.Context, param ListWalletsParam) ([]Wallet, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[ListWallets] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return nil, errUnauthorized
+	}
+
+	wallets, err := uc.wallet.ListWallets(ctx, principal.UserID)
+	if err != nil {
+		meta := map[string]interface{}{
+			"user_id": principal.UserID,
+		}
+
+		log.Printf("[ListWallets] uc.wallet.ListWallets() got an error: %+v\nMeta:%+v\n", err, meta)
+		return nil, err
+	}
+
+	result := make([]Wallet, 0, len(wallets))
+	for _, w := range wallets {
+		if !param.IncludeArchived && !w.ArchivedAt.IsZero() {
+			continue
+		}
+
+		result = append(result, convertWallet(w))
+	}
+
+	return result, nil
+}
+
+// UpdateWallet will update a wallet of the user acting on ctx and return the updated wallet.
+// Only fields given in param are changed. Fields that aren't valid are refused with ValidationError.
+func (uc *UseCase) UpdateWallet(ctx context.Context, walletID int64, param UpdateWalletParam) (Wallet, error) {
+	principal, ok := entity.GetPrincipalFromContext(ctx)
+	if !ok {
+		log.Printf("[UpdateWallet] entity.GetPrincipalFromContext() got an error: %+v\n", errUnauthorized)
+		return Wallet{}, errUnauthorized
+	}
+
+	meta := map[string]interface{}{
+		"user_id":   principal.UserID,
+		"wallet_id": walletID,
+	}
+
+	current, err := uc.wallet.GetWallet(ctx, principal.UserID, walletID)
+	if err != nil {
+		log.Printf("[UpdateWallet] uc.wallet.GetWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, wallet.ErrWalletNotFound) {
+			return Wallet{}, ErrWalletNotFound
+		}
+
+		return Wallet{}, err
+	}
+
+	svcParam := wallet.UpdateWalletParam{
+		Archived:       !current.ArchivedAt.IsZero(),
+		Currency:       current.Currency,
+		DisplayOrder:   current.DisplayOrder,
+		ID:             current.ID,
+		Name:           current.Name,
+		OpeningBalance: current.OpeningBalance,
+		Type:           current.Type,
+		UserID:         principal.UserID,
+	}
+
+	if param.Archived != nil {
+		svcParam.Archived = *param.Archived
+	}
+
+	if param.Currency != nil {
+		svcParam.Currency = strings.ToUpper(strings.TrimSpace(*param.Currency))
+	}
+
+	if param.DisplayOrder != nil {
+		svcParam.DisplayOrder = *param.DisplayOrder
+	}
+
+	if param.Name != nil {
+		svcParam.Name = strings.TrimSpace(*param.Name)
+	}
+
+	if param.OpeningBalance != nil {
+		svcParam.OpeningBalance = *param.OpeningBalance
+	}
+
+	if param.Type != nil {
+		svcParam.Type = strings.TrimSpace(*param.Type)
+	}
+
+	err = validateWallet(svcParam)
+	if err != nil {
+		log.Printf("[UpdateWallet] validateWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		return Wallet{}, err
+	}
+
+	err = uc.wallet.UpdateWallet(ctx, svcParam)
+	if err != nil {
+		log.Printf("[UpdateWallet] uc.wallet.UpdateWallet() got an error: %+v\nMeta:%+v\n", err, meta)
+		if errors.Is(err, wallet.ErrWalletNotFound) {
+			return Wallet{}, ErrWalletNotFound
+		}
+
+		return Wallet{}, err
+	}
+
+	return uc.GetWallet(ctx, walletID)
+}
+
+// validateWallet will check fields of a wallet that is about to be saved.
+// Every problem found is returned at once as a ValidationError.
+func validateWallet(param wallet.UpdateWalletParam) error {
+	var fields []FieldError
+	switch {
+	case param.Name == "":
+		fields = append(fields, FieldError{
+			Code:    violationRequired,
+			Field:   fieldName,
+			Message: "name is required",
+		})
+	case utf8.RuneCountInString(param.Name) > maxWalletNameLength:
+		fields = append(fields, FieldError{
+			Code:    violationTooLong,
+			Field:   fieldName,
+			Message: fmt.Sprintf("name must be at most %d characters", maxWalletNameLength),
+		})
+	}
+
+	if !entity.IsWalletType(param.Type) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldType,
+			Message: "type must be one of bank, cash, credit_card or e_wallet",
+		})
+	}
+
+	if !currencyPattern.MatchString(param.Currency) {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldCurrency,
+			Message: "currency must be a 3 letter ISO 4217 code",
+		})
+	}
+
+	if param.DisplayOrder < 0 {
+		fields = append(fields, FieldError{
+			Code:    violationInvalid,
+			Field:   fieldDisplayOrder,
+			Message: "display_order can't be negative",
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// convertWallet will convert a wallet from wallet service into its response format.
+func convertWallet(w wallet.Wallet) Wallet {
+	result := Wallet{
+		Balance:        w.Balance,
+		CreatedAt:      w.CreatedAt,
+		Currency:       w.Currency,
+		DisplayOrder:   w.DisplayOrder,
+		ID:             w.ID,
+		Name:           w.Name,
+		OpeningBalance: w.OpeningBalance,
+		Type:           w.Type,
+	}
+
+	if !w.ArchivedAt.IsZero() {
+		archivedAt := w.ArchivedAt
+		result.ArchivedAt = &archivedAt
+	}
+
+	return result
+}
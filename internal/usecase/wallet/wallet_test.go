@@ -0,0 +1,517 @@
+package wallet
+
+import (
+	// golang package
+	"context"
+	"testing"
+	"time"
+
+	// external package
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	// internal package
+	"github.com/arifinhermawan/bubi/internal/entity"
+	"github.com/arifinhermawan/bubi/internal/service/wallet"
+)
+
+func TestUseCase_CreateWallet(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		walletSvc *MockwalletServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockParam := CreateWalletParam{
+		Currency:       " idr ",
+		Name:           " BCA ",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+	}
+	mockSvcParam := wallet.CreateWalletParam{
+		Currency:       "IDR",
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      CreateWalletParam
+		mockFields func(mockFields)
+		want       Wallet
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			param:      mockParam,
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_fields_invalid_then_return_every_problem",
+			ctx:  ctx,
+			param: CreateWalletParam{
+				Currency: "rupiah",
+				Name:     " ",
+				Type:     "crypto",
+			},
+			mockFields: func(mf mockFields) {},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationRequired, Field: fieldName, Message: "name is required"},
+					{Code: violationInvalid, Field: fieldType, Message: "type must be one of bank, cash, credit_card or e_wallet"},
+					{Code: violationInvalid, Field: fieldCurrency, Message: "currency must be a 3 letter ISO 4217 code"},
+				},
+			},
+		},
+		{
+			name:  "when_CreateWallet_error_then_return_error",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().CreateWallet(ctx, mockSvcParam).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_no_error_occured_then_return_wallet",
+			ctx:   ctx,
+			param: mockParam,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().CreateWallet(ctx, mockSvcParam).Return(wallet.Wallet{
+					Balance:        150000,
+					CreatedAt:      mockTime,
+					Currency:       "IDR",
+					DisplayOrder:   2,
+					ID:             1,
+					Name:           "BCA",
+					OpeningBalance: 150000,
+					Type:           entity.WalletTypeBank,
+					UserID:         123,
+				}, nil)
+			},
+			want: Wallet{
+				Balance:        150000,
+				CreatedAt:      mockTime,
+				Currency:       "IDR",
+				DisplayOrder:   2,
+				ID:             1,
+				Name:           "BCA",
+				OpeningBalance: 150000,
+				Type:           entity.WalletTypeBank,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletSvc: NewMockwalletServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				wallet: mockFields.walletSvc,
+			}
+
+			got, err := uc.CreateWallet(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_DeleteWallet(t *testing.T) {
+	type mockFields struct {
+		walletSvc *MockwalletServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().DeleteWallet(ctx, int64(123), int64(1)).Return(wallet.ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_wallet_has_transactions_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().DeleteWallet(ctx, int64(123), int64(1)).Return(wallet.ErrWalletHasTransactions)
+			},
+			wantErr: ErrWalletHasTransactions,
+		},
+		{
+			name: "when_DeleteWallet_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().DeleteWallet(ctx, int64(123), int64(1)).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_nil",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().DeleteWallet(ctx, int64(123), int64(1)).Return(nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletSvc: NewMockwalletServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				wallet: mockFields.walletSvc,
+			}
+
+			err := uc.DeleteWallet(test.ctx, 1)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_GetWallet(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		walletSvc *MockwalletServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockFields func(mockFields)
+		want       Wallet
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{}, wallet.ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_GetWallet_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_no_error_occured_then_return_wallet",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{
+					ArchivedAt: mockTime,
+					CreatedAt:  mockTime,
+					Currency:   "IDR",
+					ID:         1,
+					Name:       "Cash",
+					Type:       entity.WalletTypeCash,
+					UserID:     123,
+				}, nil)
+			},
+			want: Wallet{
+				ArchivedAt: &mockTime,
+				CreatedAt:  mockTime,
+				Currency:   "IDR",
+				ID:         1,
+				Name:       "Cash",
+				Type:       entity.WalletTypeCash,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletSvc: NewMockwalletServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				wallet: mockFields.walletSvc,
+			}
+
+			got, err := uc.GetWallet(test.ctx, 1)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_ListWallets(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		walletSvc *MockwalletServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockWallets := []wallet.Wallet{
+		{ID: 1, Name: "Cash"},
+		{ArchivedAt: mockTime, ID: 2, Name: "Old card"},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      ListWalletsParam
+		mockFields func(mockFields)
+		want       []Wallet
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_ListWallets_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().ListWallets(ctx, int64(123)).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "when_archived_not_included_then_leave_archived_wallets_out",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().ListWallets(ctx, int64(123)).Return(mockWallets, nil)
+			},
+			want: []Wallet{
+				{ID: 1, Name: "Cash"},
+			},
+		},
+		{
+			name:  "when_archived_included_then_return_every_wallet",
+			ctx:   ctx,
+			param: ListWalletsParam{IncludeArchived: true},
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().ListWallets(ctx, int64(123)).Return(mockWallets, nil)
+			},
+			want: []Wallet{
+				{ID: 1, Name: "Cash"},
+				{ArchivedAt: &mockTime, ID: 2, Name: "Old card"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletSvc: NewMockwalletServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				wallet: mockFields.walletSvc,
+			}
+
+			got, err := uc.ListWallets(test.ctx, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestUseCase_UpdateWallet(t *testing.T) {
+	mockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockFields struct {
+		walletSvc *MockwalletServiceProvider
+	}
+
+	ctx := entity.NewContextWithPrincipal(context.Background(), entity.Principal{
+		Email:  "email",
+		UserID: 123,
+	})
+	mockCurrent := wallet.Wallet{
+		CreatedAt:      mockTime,
+		Currency:       "IDR",
+		DisplayOrder:   1,
+		ID:             1,
+		Name:           "BCA",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+	archived := true
+	name := " BCA Tahapan "
+	invalidDisplayOrder := -1
+	mockSvcParam := wallet.UpdateWalletParam{
+		Archived:       true,
+		Currency:       "IDR",
+		DisplayOrder:   1,
+		ID:             1,
+		Name:           "BCA Tahapan",
+		OpeningBalance: 150000,
+		Type:           entity.WalletTypeBank,
+		UserID:         123,
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		param      UpdateWalletParam
+		mockFields func(mockFields)
+		want       Wallet
+		wantErr    error
+	}{
+		{
+			name:       "when_principal_not_exist_then_return_error",
+			ctx:        context.Background(),
+			mockFields: func(mf mockFields) {},
+			wantErr:    errUnauthorized,
+		},
+		{
+			name: "when_wallet_not_exist_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{}, wallet.ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name: "when_GetWallet_error_then_return_error",
+			ctx:  ctx,
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_fields_invalid_then_return_error",
+			ctx:   ctx,
+			param: UpdateWalletParam{DisplayOrder: &invalidDisplayOrder},
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+			},
+			wantErr: &ValidationError{
+				Fields: []FieldError{
+					{Code: violationInvalid, Field: fieldDisplayOrder, Message: "display_order can't be negative"},
+				},
+			},
+		},
+		{
+			name:  "when_UpdateWallet_error_then_return_error",
+			ctx:   ctx,
+			param: UpdateWalletParam{Archived: &archived, Name: &name},
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.walletSvc.EXPECT().UpdateWallet(ctx, mockSvcParam).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "when_wallet_deleted_meanwhile_then_return_error",
+			ctx:   ctx,
+			param: UpdateWalletParam{Archived: &archived, Name: &name},
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.walletSvc.EXPECT().UpdateWallet(ctx, mockSvcParam).Return(wallet.ErrWalletNotFound)
+			},
+			wantErr: ErrWalletNotFound,
+		},
+		{
+			name:  "when_no_error_occured_then_return_updated_wallet",
+			ctx:   ctx,
+			param: UpdateWalletParam{Archived: &archived, Name: &name},
+			mockFields: func(mf mockFields) {
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(mockCurrent, nil)
+				mf.walletSvc.EXPECT().UpdateWallet(ctx, mockSvcParam).Return(nil)
+				mf.walletSvc.EXPECT().GetWallet(ctx, int64(123), int64(1)).Return(wallet.Wallet{
+					ArchivedAt:     mockTime,
+					Balance:        97500,
+					CreatedAt:      mockTime,
+					Currency:       "IDR",
+					DisplayOrder:   1,
+					ID:             1,
+					Name:           "BCA Tahapan",
+					OpeningBalance: 150000,
+					Type:           entity.WalletTypeBank,
+					UpdatedAt:      mockTime,
+					UserID:         123,
+				}, nil)
+			},
+			want: Wallet{
+				ArchivedAt:     &mockTime,
+				Balance:        97500,
+				CreatedAt:      mockTime,
+				Currency:       "IDR",
+				DisplayOrder:   1,
+				ID:             1,
+				Name:           "BCA Tahapan",
+				OpeningBalance: 150000,
+				Type:           entity.WalletTypeBank,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFields := mockFields{
+				walletSvc: NewMockwalletServiceProvider(ctrl),
+			}
+			test.mockFields(mockFields)
+
+			uc := &UseCase{
+				wallet: mockFields.walletSvc,
+			}
+
+			got, err := uc.UpdateWallet(test.ctx, 1, test.param)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE user_account
+    DROP COLUMN email_verified_at;
@@ -0,0 +1,2 @@
+ALTER TABLE user_account
+    ADD COLUMN email_verified_at TIMESTAMP NULL;
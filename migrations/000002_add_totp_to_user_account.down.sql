@@ -0,0 +1,4 @@
+ALTER TABLE user_account
+    DROP COLUMN totp_secret,
+    DROP COLUMN totp_recovery_codes,
+    DROP COLUMN totp_enabled_at;
@@ -0,0 +1,4 @@
+ALTER TABLE user_account
+    ADD COLUMN totp_secret TEXT NULL,
+    ADD COLUMN totp_recovery_codes TEXT NULL,
+    ADD COLUMN totp_enabled_at TIMESTAMP NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS user_account_deletion_requested_at_idx;
+
+ALTER TABLE user_account
+    DROP COLUMN deletion_requested_at;
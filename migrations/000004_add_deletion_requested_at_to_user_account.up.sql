@@ -0,0 +1,6 @@
+ALTER TABLE user_account
+    ADD COLUMN deletion_requested_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS user_account_deletion_requested_at_idx
+    ON user_account(deletion_requested_at)
+    WHERE deletion_requested_at IS NOT NULL;
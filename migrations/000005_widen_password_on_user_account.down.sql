@@ -0,0 +1,4 @@
+-- Intentionally irreversible: password is left as TEXT on rollback.
+-- argon2id hashes in PHC format are longer than the bcrypt sized column password had before,
+-- so narrowing it back would fail, or truncate the hashes of every user that has been rehashed.
+SELECT 1;
@@ -0,0 +1,3 @@
+-- argon2id hashes in PHC format are longer than bcrypt hashes.
+ALTER TABLE user_account
+    ALTER COLUMN password TYPE TEXT;
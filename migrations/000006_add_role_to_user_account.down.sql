@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS admin_audit_log_target_user_id_idx;
+
+DROP TABLE IF EXISTS admin_audit_log;
+
+ALTER TABLE user_account
+    DROP COLUMN disabled_at,
+    DROP COLUMN role;
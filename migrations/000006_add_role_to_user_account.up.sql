@@ -0,0 +1,16 @@
+ALTER TABLE user_account
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'user',
+    ADD COLUMN disabled_at TIMESTAMPTZ NULL;
+
+-- admin_audit_log has no foreign key to user_account on purpose,
+-- so the trail of an admin action outlives the accounts it mentions.
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT NOT NULL,
+    action TEXT NOT NULL,
+    target_user_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_target_user_id_idx
+    ON admin_audit_log(target_user_id);
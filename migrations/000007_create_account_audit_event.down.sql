@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS account_audit_event;
+
+DROP FUNCTION IF EXISTS reject_account_audit_event_update();
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS account_audit_event (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES user_account(id) ON DELETE CASCADE,
+    event TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    request_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_audit_event_user_id_created_at_idx
+    ON account_audit_event(user_id, created_at DESC);
+
+-- events are append-only, an event can only be removed alongside its account.
+CREATE OR REPLACE FUNCTION reject_account_audit_event_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'account_audit_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER account_audit_event_append_only
+    BEFORE UPDATE ON account_audit_event
+    FOR EACH ROW EXECUTE FUNCTION reject_account_audit_event_update();
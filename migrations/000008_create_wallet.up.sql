@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS wallet (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES user_account(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('bank', 'cash', 'credit_card', 'e_wallet')),
+    currency CHAR(3) NOT NULL,
+    opening_balance BIGINT NOT NULL DEFAULT 0,
+    display_order INT NOT NULL DEFAULT 0,
+    archived_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS wallet_user_id_display_order_idx
+    ON wallet(user_id, display_order);
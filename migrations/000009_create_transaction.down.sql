@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS transaction;
+
+ALTER TABLE wallet DROP COLUMN IF EXISTS balance;
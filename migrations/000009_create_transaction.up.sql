@@ -0,0 +1,24 @@
+-- balance starts from the opening balance and moves with every transaction of the wallet.
+ALTER TABLE wallet ADD COLUMN IF NOT EXISTS balance BIGINT NOT NULL DEFAULT 0;
+UPDATE wallet SET balance = opening_balance;
+
+CREATE TABLE IF NOT EXISTS transaction (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES user_account(id) ON DELETE CASCADE,
+    wallet_id BIGINT NOT NULL REFERENCES wallet(id) ON DELETE CASCADE,
+    category_id BIGINT NULL,
+    type TEXT NOT NULL CHECK (type IN ('expense', 'income')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    transacted_at TIMESTAMPTZ NOT NULL,
+    payee TEXT NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    tags TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS transaction_user_id_transacted_at_idx
+    ON transaction(user_id, transacted_at DESC);
+
+CREATE INDEX IF NOT EXISTS transaction_wallet_id_idx
+    ON transaction(wallet_id);
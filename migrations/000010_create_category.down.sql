@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS transaction_category_id_idx;
+
+-- category_id of transactions is kept as it is, pointing at the ids categories had.
+ALTER TABLE transaction DROP CONSTRAINT IF EXISTS transaction_category_id_fkey;
+
+DROP TABLE IF EXISTS category;
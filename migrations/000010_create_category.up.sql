@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS category (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES user_account(id) ON DELETE CASCADE,
+    parent_id BIGINT NULL REFERENCES category(id),
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('expense', 'income')),
+    icon TEXT NOT NULL DEFAULT '',
+    color TEXT NOT NULL DEFAULT '',
+    archived_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS category_user_id_idx
+    ON category(user_id);
+
+-- transactions could carry a category_id before categories existed. Each one a user has used
+-- becomes a placeholder category the transactions are moved to, so no categorisation is lost
+-- and the user can rename or merge it later. legacy_id is only kept while moving them.
+ALTER TABLE category ADD COLUMN legacy_id BIGINT NULL;
+
+INSERT INTO category(user_id, name, type, legacy_id, created_at)
+SELECT DISTINCT
+    user_id,
+    'Category ' || category_id,
+    type,
+    category_id,
+    NOW()
+FROM
+    transaction
+WHERE
+    category_id IS NOT NULL;
+
+UPDATE
+    transaction
+SET
+    category_id = category.id
+FROM
+    category
+WHERE
+    category.legacy_id = transaction.category_id
+    AND category.user_id = transaction.user_id
+    AND category.type = transaction.type;
+
+ALTER TABLE category DROP COLUMN legacy_id;
+
+ALTER TABLE transaction
+    ADD CONSTRAINT transaction_category_id_fkey
+    FOREIGN KEY (category_id) REFERENCES category(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS transaction_category_id_idx
+    ON transaction(category_id);
@@ -0,0 +1,2 @@
+ALTER TABLE user_account
+    DROP COLUMN timezone;
@@ -0,0 +1,2 @@
+ALTER TABLE user_account
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Asia/Jakarta';
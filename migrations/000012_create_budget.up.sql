@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS budget (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES user_account(id) ON DELETE CASCADE,
+    category_id BIGINT NOT NULL REFERENCES category(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NULL,
+    UNIQUE (user_id, category_id, period_start)
+);
+
+CREATE INDEX IF NOT EXISTS budget_user_id_period_start_idx
+    ON budget(user_id, period_start);
@@ -0,0 +1,3 @@
+ALTER TABLE budget
+    DROP COLUMN carried_over,
+    DROP COLUMN rollover_mode;
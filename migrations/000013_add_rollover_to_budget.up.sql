@@ -0,0 +1,5 @@
+-- carried_over is frozen when the budget is created from the budget of the previous period,
+-- so changing the rollover mode or spending of a past period never rewrites later periods.
+ALTER TABLE budget
+    ADD COLUMN rollover_mode TEXT NOT NULL DEFAULT 'none' CHECK (rollover_mode IN ('both', 'none', 'positive')),
+    ADD COLUMN carried_over BIGINT NOT NULL DEFAULT 0;
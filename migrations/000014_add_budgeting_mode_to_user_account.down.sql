@@ -0,0 +1,8 @@
+DELETE FROM budget WHERE amount = 0;
+
+ALTER TABLE budget
+    DROP CONSTRAINT IF EXISTS budget_amount_check,
+    ADD CONSTRAINT budget_amount_check CHECK (amount > 0);
+
+ALTER TABLE user_account
+    DROP COLUMN budgeting_mode;
@@ -0,0 +1,8 @@
+-- envelope budgeting assigns income to budgets until nothing is left to assign,
+-- moving money out of an envelope may leave it empty so a budget of zero is allowed.
+ALTER TABLE user_account
+    ADD COLUMN budgeting_mode TEXT NOT NULL DEFAULT 'classic' CHECK (budgeting_mode IN ('classic', 'envelope'));
+
+ALTER TABLE budget
+    DROP CONSTRAINT IF EXISTS budget_amount_check,
+    ADD CONSTRAINT budget_amount_check CHECK (amount >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE transaction
+    DROP CONSTRAINT IF EXISTS transaction_wallet_id_fkey,
+    ADD CONSTRAINT transaction_wallet_id_fkey FOREIGN KEY (wallet_id) REFERENCES wallet(id) ON DELETE CASCADE;
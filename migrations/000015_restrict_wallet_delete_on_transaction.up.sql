@@ -0,0 +1,6 @@
+-- a wallet that has transactions can't be deleted, so its history isn't lost with it.
+-- NO ACTION is checked at the end of the statement rather than per row like RESTRICT,
+-- so purging a user account still cascades to both its wallets and its transactions.
+ALTER TABLE transaction
+    DROP CONSTRAINT IF EXISTS transaction_wallet_id_fkey,
+    ADD CONSTRAINT transaction_wallet_id_fkey FOREIGN KEY (wallet_id) REFERENCES wallet(id) ON DELETE NO ACTION;
@@ -0,0 +1,2 @@
+ALTER TABLE user_account
+    DROP COLUMN totp_last_used_step;
@@ -0,0 +1,3 @@
+-- the last TOTP time step accepted for user, so a code can't be replayed within its validity window.
+ALTER TABLE user_account
+    ADD COLUMN totp_last_used_step BIGINT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS budget_rollover;
@@ -0,0 +1,22 @@
+-- budgets are rolled over through every period since the one they were last rolled over into,
+-- so what they carry over doesn't depend on when the user opens their budgets.
+CREATE TABLE IF NOT EXISTS budget_rollover (
+    user_id BIGINT PRIMARY KEY REFERENCES user_account(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NULL
+);
+
+-- budgets used to be rolled over only into the period they were viewed in,
+-- so rolling over continues from the latest period that already has budgets.
+INSERT INTO budget_rollover(user_id, period_start, created_at)
+SELECT
+    user_id,
+    MAX(period_start),
+    NOW()
+FROM
+    budget
+WHERE
+    period_start <= CURRENT_DATE
+GROUP BY
+    user_id;
@@ -0,0 +1,2 @@
+ALTER TABLE budget
+    DROP COLUMN carry_pending;
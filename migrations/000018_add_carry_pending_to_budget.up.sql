@@ -0,0 +1,4 @@
+-- a budget created before the previous period has ended can't know yet what that period leaves,
+-- its carried_over stays pending until budgets are rolled over past the end of the previous period.
+ALTER TABLE budget
+    ADD COLUMN carry_pending BOOLEAN NOT NULL DEFAULT FALSE;
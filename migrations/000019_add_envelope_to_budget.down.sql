@@ -0,0 +1,3 @@
+ALTER TABLE budget
+    DROP COLUMN released,
+    DROP COLUMN envelope;
@@ -0,0 +1,19 @@
+-- only money assigned to budgets in envelope mode is taken out of what is left to assign,
+-- budgets set before this are counted as envelopes if their user budgets in envelope mode.
+ALTER TABLE budget
+    ADD COLUMN envelope BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE
+    budget
+SET
+    envelope = TRUE
+FROM
+    user_account
+WHERE
+    user_account.id = budget.user_id
+    AND user_account.budgeting_mode = 'envelope';
+
+-- released is what a budget gives back to what is left to assign once its period has ended,
+-- the part of what is left of it that isn't carried over. It's NULL until then.
+ALTER TABLE budget
+    ADD COLUMN released BIGINT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE admin_audit_log
+    DROP COLUMN refused;
@@ -0,0 +1,4 @@
+-- an action refused because its target has the same or a higher role than the admin
+-- is still written to the audit trail, marked as refused.
+ALTER TABLE admin_audit_log
+    ADD COLUMN refused BOOLEAN NOT NULL DEFAULT FALSE;